@@ -0,0 +1,68 @@
+// Command refdata-snapshot downloads TikTok reference data into a local
+// snapshot file for offline validation in CI.
+//
+// Usage:
+//
+//	TIKTOK_ACCESS_TOKEN=... refdata-snapshot -advertiser-id 123 -out refdata.json
+//	refdata-snapshot -check -out refdata.json -max-age 720h
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+func main() {
+	advertiserID := flag.String("advertiser-id", "", "advertiser ID used to query reference data")
+	out := flag.String("out", "refdata.json", "snapshot file path")
+	check := flag.Bool("check", false, "only check the freshness of an existing snapshot")
+	maxAge := flag.Duration("max-age", 30*24*time.Hour, "maximum snapshot age accepted by -check")
+	flag.Parse()
+
+	if *check {
+		snapshot, err := utils.LoadReferenceSnapshot(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := snapshot.CheckFreshness(*maxAge); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s is fresh (created %s)\n", *out, snapshot.CreatedAt.Format(time.RFC3339))
+		return
+	}
+
+	accessToken := os.Getenv("TIKTOK_ACCESS_TOKEN")
+	if accessToken == "" {
+		log.Fatal("TIKTOK_ACCESS_TOKEN environment variable is required")
+	}
+	if *advertiserID == "" {
+		log.Fatal("-advertiser-id is required")
+	}
+
+	config := client.DefaultConfig()
+	config.AccessToken = accessToken
+
+	tiktokClient, err := client.NewClient(config)
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+
+	snapshot, err := tiktokClient.DownloadReferenceSnapshot(context.Background(), *advertiserID)
+	if err != nil {
+		log.Fatalf("Failed to download reference data: %v", err)
+	}
+
+	if err := utils.SaveReferenceSnapshot(*out, snapshot); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Wrote %s: %d regions, %d languages, %d interest categories, %d device models\n",
+		*out, len(snapshot.Regions), len(snapshot.Languages), len(snapshot.InterestCategories), len(snapshot.DeviceModels))
+}
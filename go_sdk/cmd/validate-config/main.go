@@ -0,0 +1,127 @@
+// Command validate-config checks campaign, ad group, ad and audience
+// definitions offline, without network access or credentials, so CI for
+// configuration repositories can reject invalid definitions before they are
+// submitted.
+//
+// Each file holds one definition or a JSON array of definitions:
+//
+//	{"kind": "campaign", "spec": {"advertiser_id": "123", ...}}
+//
+// Usage:
+//
+//	validate-config campaigns/*.json audiences/*.json
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+)
+
+// kinds maps definition kinds to the request they describe
+var kinds = map[string]func() client.Validator{
+	"campaign":            func() client.Validator { return &client.CampaignCreateRequest{} },
+	"adgroup":             func() client.Validator { return &client.AdGroupCreateRequest{} },
+	"ad":                  func() client.Validator { return &client.AdCreateRequest{} },
+	"custom_audience":     func() client.Validator { return &client.CustomAudienceCreateRequest{} },
+	"rule_audience":       func() client.Validator { return &client.CustomAudienceRuleCreateRequest{} },
+	"lookalike_audience":  func() client.Validator { return &client.LookalikeAudienceCreateRequest{} },
+	"saved_audience":      func() client.Validator { return &client.SavedAudienceCreateRequest{} },
+	"smart_plus_campaign": func() client.Validator { return &client.SmartPlusCampaignCreateRequest{} },
+}
+
+// definition is a single kind-tagged request in a configuration file
+type definition struct {
+	Kind string          `json:"kind"`
+	Spec json.RawMessage `json:"spec"`
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: validate-config FILE...\n\nkinds: %s\n", strings.Join(kindNames(), ", "))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	failed := 0
+	for _, path := range flag.Args() {
+		for _, err := range validateFile(path) {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		fmt.Fprintf(os.Stderr, "%d invalid definition(s)\n", failed)
+		os.Exit(1)
+	}
+	fmt.Printf("%d file(s) valid\n", flag.NArg())
+}
+
+// validateFile decodes and validates every definition in a file
+func validateFile(path string) []error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return []error{err}
+	}
+
+	var defs []definition
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(data, &defs)
+	} else {
+		var def definition
+		err = json.Unmarshal(data, &def)
+		defs = []definition{def}
+	}
+	if err != nil {
+		return []error{fmt.Errorf("failed to parse: %w", err)}
+	}
+
+	var errs []error
+	for i, def := range defs {
+		if err := validateDefinition(def); err != nil {
+			errs = append(errs, fmt.Errorf("definition %d (%s): %w", i, def.Kind, err))
+		}
+	}
+	return errs
+}
+
+// validateDefinition decodes a spec strictly into its request and validates it
+func validateDefinition(def definition) error {
+	newRequest, ok := kinds[def.Kind]
+	if !ok {
+		return fmt.Errorf("unknown kind %q", def.Kind)
+	}
+	if len(def.Spec) == 0 {
+		return fmt.Errorf("spec is required")
+	}
+
+	req := newRequest()
+	decoder := json.NewDecoder(bytes.NewReader(def.Spec))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(req); err != nil {
+		return fmt.Errorf("invalid spec: %w", err)
+	}
+	return req.Validate()
+}
+
+// kindNames returns the supported kinds in sorted order
+func kindNames() []string {
+	names := make([]string, 0, len(kinds))
+	for name := range kinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -77,7 +77,7 @@ func main() {
 		authService := client.NewAuthService(authConfig)
 
 		// Generate authorization URL
-		scopes := []string{"user_info.basic", "video.list", "ad_management"}
+		scopes := []client.OAuthScope{client.ScopeUserInfoBasic, client.ScopeVideoList, client.ScopeAdRead}
 		authURL := authService.GetAuthorizationURL(scopes)
 		fmt.Printf("Authorization URL: %s\n", authURL)
 
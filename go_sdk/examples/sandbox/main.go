@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func main() {
+	// Sandbox access tokens are generated for sandbox advertisers in the developer portal
+	accessToken := os.Getenv("TIKTOK_SANDBOX_ACCESS_TOKEN")
+	if accessToken == "" {
+		log.Fatal("TIKTOK_SANDBOX_ACCESS_TOKEN environment variable is required")
+	}
+	advertiserID := os.Getenv("TIKTOK_SANDBOX_ADVERTISER_ID")
+	if advertiserID == "" {
+		log.Fatal("TIKTOK_SANDBOX_ADVERTISER_ID environment variable is required")
+	}
+
+	// Point the client at the sandbox; BaseURL is filled in from the environment
+	config := client.DefaultConfig()
+	config.Environment = client.EnvironmentSandbox
+	config.AccessToken = accessToken
+	config.UserAgent = "tiktok-go-sdk-sandbox-example/1.0.0"
+
+	tiktokClient, err := client.NewClient(config)
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+	fmt.Printf("Using sandbox API at %s\n", config.BaseURL)
+
+	ctx := context.Background()
+
+	// Campaigns created in the sandbox never deliver or spend
+	fmt.Println("\n=== Creating Sandbox Campaign ===")
+	campaign, err := tiktokClient.Campaign().Create(ctx, &client.CampaignCreateRequest{
+		AdvertiserID:  advertiserID,
+		CampaignName:  "Go SDK Sandbox Campaign",
+		ObjectiveType: models.ObjectiveTraffic,
+		Budget:        100.0,
+		BudgetMode:    models.BudgetModeDaily,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create campaign: %v", err)
+	}
+	fmt.Printf("Campaign created: %+v\n", campaign)
+
+	fmt.Println("\n=== Listing Sandbox Campaigns ===")
+	campaigns, err := tiktokClient.Campaign().Get(ctx, &client.CampaignGetRequest{
+		AdvertiserID: advertiserID,
+	})
+	if err != nil {
+		log.Fatalf("Failed to list campaigns: %v", err)
+	}
+	fmt.Printf("Found %d campaigns\n", len(campaigns.Data))
+}
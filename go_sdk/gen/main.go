@@ -0,0 +1,69 @@
+// Command apigen generates a service from TikTok's published OpenAPI schemas.
+// For each endpoint it emits a request struct, a response struct and a thin
+// service method in the style of the hand-written services. Required fields
+// are tagged without omitempty, so running validategen afterwards adds their
+// Validate methods.
+//
+// Types are named after the path with the -trim prefix removed, e.g.
+// /blockedword/task/check/ with -trim /blockedword/ and -prefix BlockedWord
+// gives method TaskCheck and types BlockedWordTaskCheckRequest and
+// BlockedWordTaskCheckResponse. Response data that the schema leaves
+// untyped is kept as json.RawMessage.
+//
+// Usage (from pkg/client, via go generate):
+//
+//	apigen -schemas ../../../yml_files -include 'blockedword_*.yml' \
+//		-service BlockedWordService -prefix BlockedWord -trim /blockedword/ \
+//		-out blocked_word_gen.go
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	schemas := flag.String("schemas", "../../../yml_files", "directory of OpenAPI schema files")
+	include := flag.String("include", "", "comma-separated glob patterns of schema files to generate")
+	service := flag.String("service", "", "name of the generated service type")
+	prefix := flag.String("prefix", "", "prefix of generated request and response type names")
+	trim := flag.String("trim", "/", "path prefix removed when naming methods")
+	doc := flag.String("doc", "", "doc comment of the service type, after its name")
+	pkg := flag.String("package", "client", "package of the generated file")
+	out := flag.String("out", "", "output file")
+	flag.Parse()
+
+	if *include == "" || *service == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *doc == "" {
+		*doc = "calls the endpoints generated from " + *include
+	}
+
+	patterns := strings.Split(*include, ",")
+	endpoints, err := loadEndpoints(*schemas, patterns)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	g := &generator{
+		pkg:        *pkg,
+		service:    *service,
+		typePrefix: *prefix,
+		trim:       *trim,
+		doc:        *doc,
+		source:     *include,
+	}
+	src, err := g.render(endpoints)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Clean(*out), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
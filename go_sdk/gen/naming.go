@@ -0,0 +1,74 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// initialisms are written in upper case, as in the hand-written services
+var initialisms = map[string]bool{
+	"api": true, "bc": true, "csv": true, "html": true, "http": true, "id": true,
+	"ids": true, "ip": true, "json": true, "os": true, "sku": true, "tt": true,
+	"ui": true, "url": true, "urls": true, "utc": true, "vbo": true,
+}
+
+// goName converts a snake_case, kebab-case or spaced API name to a Go
+// identifier, e.g. advertiser_id to AdvertiserID
+func goName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+	for _, word := range words {
+		lower := strings.ToLower(word)
+		switch {
+		case lower == "ids":
+			b.WriteString("IDs")
+		case lower == "urls":
+			b.WriteString("URLs")
+		case initialisms[lower]:
+			b.WriteString(strings.ToUpper(lower))
+		default:
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+
+	ident := b.String()
+	if ident == "" || unicode.IsDigit(rune(ident[0])) {
+		ident = "X" + ident
+	}
+	return ident
+}
+
+// methodName names the service method for a path with the service prefix
+// trimmed, e.g. /blockedword/task/check/ with prefix /blockedword/ is
+// TaskCheck. A path equal to the prefix is named by its last segment.
+func methodName(path, trim string) string {
+	rest := strings.Trim(strings.TrimPrefix(path, trim), "/")
+	if rest == "" {
+		segments := strings.Split(strings.Trim(path, "/"), "/")
+		rest = segments[len(segments)-1]
+	}
+	return goName(strings.ReplaceAll(rest, "/", "_"))
+}
+
+// markdownLink matches a trailing documentation link in a summary
+var markdownLink = regexp.MustCompile(`\s*\[[^\]]*\]\([^)]*\)\s*$`)
+
+// summaryPhrase turns an operation summary into a lower-case phrase for a
+// doc comment, dropping the documentation link and final period
+func summaryPhrase(summary string) string {
+	phrase := strings.TrimSpace(markdownLink.ReplaceAllString(summary, ""))
+	phrase = strings.TrimSuffix(phrase, ".")
+	if phrase == "" {
+		return ""
+	}
+	first := phrase[:1]
+	// Keep acronyms such as "BC" or "APP" intact
+	if len(phrase) < 2 || !unicode.IsUpper(rune(phrase[1])) {
+		first = strings.ToLower(first)
+	}
+	return first + phrase[1:]
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"strings"
+)
+
+// maxEnumComment is the most enum values listed in a field comment
+const maxEnumComment = 8
+
+// generator renders a service file from a set of endpoints
+type generator struct {
+	pkg        string
+	service    string
+	typePrefix string
+	trim       string
+	doc        string
+	source     string
+
+	types     bytes.Buffer
+	methods   bytes.Buffer
+	declared  map[string]bool
+	usesJSON  bool
+	needsPost bool
+}
+
+// field is a struct field of a request or nested type
+type field struct {
+	name     string
+	jsonName string
+	goType   string
+	required bool
+	comment  string
+}
+
+// render generates the service, its request and response types and one
+// method per endpoint. Multipart endpoints are skipped.
+func (g *generator) render(endpoints []endpoint) ([]byte, error) {
+	g.declared = make(map[string]bool)
+
+	for _, ep := range endpoints {
+		if _, multipart := ep.Op.bodySchema(); multipart {
+			log.Printf("skipping %s %s (%s): multipart bodies are not generated", ep.Method, ep.Path, ep.File)
+			continue
+		}
+		if err := g.renderEndpoint(ep); err != nil {
+			return nil, fmt.Errorf("%s: %w", ep.File, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by apigen from %s. DO NOT EDIT.\n\n", g.source)
+	fmt.Fprintf(&buf, "package %s\n\nimport (\n\t\"context\"\n", g.pkg)
+	if g.usesJSON || g.needsPost {
+		buf.WriteString("\t\"encoding/json\"\n")
+	}
+	buf.WriteString("\t\"fmt\"\n")
+	if g.needsPost {
+		buf.WriteString("\t\"strings\"\n")
+	}
+	buf.WriteString(")\n\n")
+
+	fmt.Fprintf(&buf, "// %s %s\n", g.service, g.doc)
+	fmt.Fprintf(&buf, "type %s struct {\n\tclient *Client\n}\n\n", g.service)
+	fmt.Fprintf(&buf, "// New%s creates a new %s\n", g.service, g.service)
+	fmt.Fprintf(&buf, "func New%s(client *Client) *%s {\n\treturn &%s{client: client}\n}\n", g.service, g.service, g.service)
+	buf.Write(g.types.Bytes())
+	buf.Write(g.methods.Bytes())
+
+	if g.needsPost {
+		fmt.Fprintf(&buf, `
+// post sends a JSON request body and decodes the response into out
+func (s *%s) post(ctx context.Context, endpoint string, req interface{}, out interface{}) error {
+	url, err := s.client.BuildURL(endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %%w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return fmt.Errorf("failed to call %%s: %%w", endpoint, err)
+	}
+
+	return s.client.ParseResponse(resp, out)
+}
+`, g.service)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), fmt.Errorf("generated code does not compile: %w", err)
+	}
+	return src, nil
+}
+
+// renderEndpoint emits the request and response types and the method of an
+// endpoint
+func (g *generator) renderEndpoint(ep endpoint) error {
+	method := methodName(ep.Path, g.trim)
+	base := g.typePrefix + method
+	reqType, respType := base+"Request", base+"Response"
+	for _, name := range []string{reqType, respType} {
+		if g.declared[name] {
+			return fmt.Errorf("%s %s: type %s is already declared", ep.Method, ep.Path, name)
+		}
+	}
+
+	var fields []field
+	if ep.Method == "GET" {
+		for _, p := range ep.Op.Parameters {
+			if p.In != "query" || p.Name == "Access-Token" {
+				continue
+			}
+			fields = append(fields, g.field(reqType, p.Name, p.schema(), p.Required))
+		}
+	} else {
+		body, _ := ep.Op.bodySchema()
+		if body != nil {
+			for _, name := range body.sortedProperties() {
+				fields = append(fields, g.field(reqType, name, body.Properties[name], body.isRequired(name)))
+			}
+		}
+	}
+	g.emitStruct(reqType, fmt.Sprintf("%s is the request for %s %s", reqType, ep.Method, ep.Path), fields)
+
+	dataType := "json.RawMessage"
+	if data := ep.Op.dataSchema(); data != nil && len(data.Properties) > 0 {
+		dataType = base + "Data"
+		var dataFields []field
+		for _, name := range data.sortedProperties() {
+			dataFields = append(dataFields, g.field(dataType, name, data.Properties[name], true))
+		}
+		g.emitStruct(dataType, fmt.Sprintf("%s is the data returned by %s %s", dataType, ep.Method, ep.Path), dataFields)
+	} else {
+		g.usesJSON = true
+	}
+
+	fmt.Fprintf(&g.types, "\n// %s is the response from %s %s\n", respType, ep.Method, ep.Path)
+	fmt.Fprintf(&g.types, "type %s struct {\n", respType)
+	g.types.WriteString("\tCode int `json:\"code\"`\n\tMessage string `json:\"message\"`\n\tRequestID string `json:\"request_id\"`\n")
+	fmt.Fprintf(&g.types, "\tData %s `json:\"data\"`\n}\n", dataType)
+	g.declared[respType] = true
+
+	g.renderMethod(ep, method, reqType, respType, fields)
+	return nil
+}
+
+// renderMethod emits the service method of an endpoint
+func (g *generator) renderMethod(ep endpoint, method, reqType, respType string, fields []field) {
+	w := &g.methods
+	w.WriteString("\n")
+	if phrase := summaryPhrase(ep.Op.Summary); phrase != "" {
+		fmt.Fprintf(w, "// %s calls %s %s to %s\n", method, ep.Method, ep.Path, phrase)
+	} else {
+		fmt.Fprintf(w, "// %s calls %s %s\n", method, ep.Method, ep.Path)
+	}
+	fmt.Fprintf(w, "func (s *%s) %s(ctx context.Context, req *%s) (*%s, error) {\n", g.service, method, reqType, respType)
+	w.WriteString("\tif req == nil {\n\t\treturn nil, fmt.Errorf(\"request cannot be nil\")\n\t}\n")
+	w.WriteString("\tif err := req.Validate(); err != nil {\n\t\treturn nil, err\n\t}\n\n")
+
+	if ep.Method == "POST" {
+		g.needsPost = true
+		fmt.Fprintf(w, "\tvar response %s\n", respType)
+		fmt.Fprintf(w, "\tif err := s.post(ctx, %q, req, &response); err != nil {\n\t\treturn nil, err\n\t}\n", ep.Path)
+		w.WriteString("\treturn &response, nil\n}\n")
+		return
+	}
+
+	w.WriteString("\tparams := map[string]interface{}{}\n")
+	for _, f := range fields {
+		switch f.goType {
+		case "string":
+			if f.required {
+				fmt.Fprintf(w, "\tparams[%q] = req.%s\n", f.jsonName, f.name)
+			} else {
+				fmt.Fprintf(w, "\tif req.%s != \"\" {\n\t\tparams[%q] = req.%s\n\t}\n", f.name, f.jsonName, f.name)
+			}
+		case "int", "float64":
+			fmt.Fprintf(w, "\tif req.%s != 0 {\n\t\tparams[%q] = req.%s\n\t}\n", f.name, f.jsonName, f.name)
+		case "bool":
+			fmt.Fprintf(w, "\tif req.%s {\n\t\tparams[%q] = req.%s\n\t}\n", f.name, f.jsonName, f.name)
+		default:
+			// Arrays and objects are passed as JSON strings
+			g.usesJSON = true
+			check := fmt.Sprintf("len(req.%s) > 0", f.name)
+			if strings.HasPrefix(f.goType, "*") || f.goType == "interface{}" {
+				check = fmt.Sprintf("req.%s != nil", f.name)
+			}
+			fmt.Fprintf(w, "\tif %s {\n", check)
+			fmt.Fprintf(w, "\t\tencoded, err := json.Marshal(req.%s)\n", f.name)
+			fmt.Fprintf(w, "\t\tif err != nil {\n\t\t\treturn nil, fmt.Errorf(\"failed to marshal %s: %%w\", err)\n\t\t}\n", f.jsonName)
+			fmt.Fprintf(w, "\t\tparams[%q] = string(encoded)\n\t}\n", f.jsonName)
+		}
+	}
+
+	fmt.Fprintf(w, "\n\turl, err := s.client.BuildURL(%q, params)\n", ep.Path)
+	w.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	w.WriteString("\tresp, err := s.client.DoRequest(ctx, \"GET\", url, nil, nil)\n")
+	fmt.Fprintf(w, "\tif err != nil {\n\t\treturn nil, fmt.Errorf(\"failed to call %s: %%w\", err)\n\t}\n\n", ep.Path)
+	fmt.Fprintf(w, "\tvar response %s\n", respType)
+	w.WriteString("\tif err := s.client.ParseResponse(resp, &response); err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	w.WriteString("\treturn &response, nil\n}\n")
+}
+
+// field resolves the Go type of a property, emitting nested struct types
+// named after their parent
+func (g *generator) field(parent, name string, s *schema, required bool) field {
+	f := field{name: goName(name), jsonName: name, required: required}
+	f.goType = g.goType(parent+f.name, s)
+
+	if keys := s.enumKeys(); len(keys) > 0 && len(keys) <= maxEnumComment {
+		f.comment = strings.Join(keys, ", ")
+	}
+	return f
+}
+
+// goType maps a schema to a Go type. Objects with properties become named
+// structs referenced by pointer so that absent objects are omitted.
+func (g *generator) goType(typeName string, s *schema) string {
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		if s.Items == nil {
+			return "[]interface{}"
+		}
+		elem := g.goType(typeName, s.Items)
+		return "[]" + strings.TrimPrefix(elem, "*")
+	case "object":
+		if len(s.Properties) == 0 {
+			return "map[string]interface{}"
+		}
+		if !g.declared[typeName] {
+			var fields []field
+			for _, name := range s.sortedProperties() {
+				fields = append(fields, g.field(typeName, name, s.Properties[name], s.isRequired(name)))
+			}
+			g.emitStruct(typeName, typeName+" is a nested object of the API schema", fields)
+		}
+		return "*" + typeName
+	default:
+		return "interface{}"
+	}
+}
+
+// emitStruct writes a struct type declaration. Optional fields are tagged
+// omitempty so validategen treats the others as required.
+func (g *generator) emitStruct(name, doc string, fields []field) {
+	g.declared[name] = true
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "\n// %s\n", doc)
+	fmt.Fprintf(&b, "type %s struct {\n", name)
+	for _, f := range fields {
+		tag := f.jsonName
+		if !f.required {
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(&b, "\t%s %s `json:%q`", f.name, f.goType, tag)
+		if f.comment != "" {
+			fmt.Fprintf(&b, " // %s", f.comment)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("}\n")
+
+	// Nested types are emitted while their parent's fields are resolved, so
+	// they land before the parent; that is valid Go and keeps them together
+	g.types.Write(b.Bytes())
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// document is the subset of an OpenAPI 3 document used by the generator
+type document struct {
+	Info struct {
+		Title string `yaml:"title"`
+	} `yaml:"info"`
+	Paths map[string]map[string]*operation `yaml:"paths"`
+}
+
+// operation is a single method on a path
+type operation struct {
+	OperationID string       `yaml:"operationId"`
+	Summary     string       `yaml:"summary"`
+	Parameters  []*parameter `yaml:"parameters"`
+	RequestBody *struct {
+		Content map[string]mediaType `yaml:"content"`
+	} `yaml:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]mediaType `yaml:"content"`
+	} `yaml:"responses"`
+}
+
+// parameter is a query or header parameter. Simple parameters carry a
+// schema; arrays and objects are described as JSON content.
+type parameter struct {
+	Name     string               `yaml:"name"`
+	In       string               `yaml:"in"`
+	Required bool                 `yaml:"required"`
+	Schema   *schema              `yaml:"schema"`
+	Content  map[string]mediaType `yaml:"content"`
+}
+
+// mediaType wraps the schema of a body or JSON parameter
+type mediaType struct {
+	Schema *schema `yaml:"schema"`
+}
+
+// schema is a JSON schema with TikTok's x-open extensions
+type schema struct {
+	Type        string             `yaml:"type"`
+	Format      string             `yaml:"format"`
+	Description string             `yaml:"description"`
+	Properties  map[string]*schema `yaml:"properties"`
+	Items       *schema            `yaml:"items"`
+	Required    []string           `yaml:"required"`
+	FieldID     int                `yaml:"x-open-field-id"`
+	Enum        *struct {
+		Enums []struct {
+			Key     string `yaml:"key"`
+			IsValid bool   `yaml:"is_valid"`
+		} `yaml:"enums"`
+	} `yaml:"x-open-enum"`
+}
+
+// endpoint is an operation resolved to a path and HTTP method
+type endpoint struct {
+	Path   string
+	Method string // GET or POST
+	Op     *operation
+	File   string
+}
+
+// loadEndpoints reads every schema file in dir matching one of patterns
+func loadEndpoints(dir string, patterns []string) ([]endpoint, error) {
+	seen := make(map[string]bool)
+	var files []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		for _, match := range matches {
+			if !seen[match] {
+				seen[match] = true
+				files = append(files, match)
+			}
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no schema files in %s match %s", dir, strings.Join(patterns, ", "))
+	}
+	sort.Strings(files)
+
+	var endpoints []endpoint
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var doc document
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+
+		paths := make([]string, 0, len(doc.Paths))
+		for path := range doc.Paths {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			for _, method := range []string{"get", "post"} {
+				if op := doc.Paths[path][method]; op != nil {
+					endpoints = append(endpoints, endpoint{
+						Path:   path,
+						Method: strings.ToUpper(method),
+						Op:     op,
+						File:   filepath.Base(file),
+					})
+				}
+			}
+		}
+	}
+	return endpoints, nil
+}
+
+// bodySchema returns the JSON request body schema and whether the body is
+// multipart, which the generator does not support
+func (op *operation) bodySchema() (*schema, bool) {
+	if op.RequestBody == nil {
+		return nil, false
+	}
+	if _, ok := op.RequestBody.Content["multipart/form-data"]; ok {
+		return nil, true
+	}
+	if media, ok := op.RequestBody.Content["application/json"]; ok {
+		return media.Schema, false
+	}
+	return nil, false
+}
+
+// dataSchema returns the schema of the data member of the 200 response
+func (op *operation) dataSchema() *schema {
+	resp, ok := op.Responses["200"]
+	if !ok {
+		return nil
+	}
+	media, ok := resp.Content["application/json"]
+	if !ok || media.Schema == nil {
+		return nil
+	}
+	return media.Schema.Properties["data"]
+}
+
+// schema returns the schema of a parameter, whichever way it is declared
+func (p *parameter) schema() *schema {
+	if p.Schema != nil {
+		return p.Schema
+	}
+	if media, ok := p.Content["application/json"]; ok {
+		return media.Schema
+	}
+	return &schema{}
+}
+
+// sortedProperties returns property names in field ID order, the order the
+// API documentation lists them in
+func (s *schema) sortedProperties() []string {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		a, b := s.Properties[names[i]], s.Properties[names[j]]
+		if a.FieldID != b.FieldID {
+			return a.FieldID < b.FieldID
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// isRequired reports whether a property is listed as required
+func (s *schema) isRequired(name string) bool {
+	for _, required := range s.Required {
+		if required == name {
+			return true
+		}
+	}
+	return false
+}
+
+// enumKeys returns the valid enum keys of a schema
+func (s *schema) enumKeys() []string {
+	if s.Enum == nil {
+		return nil
+	}
+	var keys []string
+	for _, e := range s.Enum.Enums {
+		if e.IsValid {
+			keys = append(keys, e.Key)
+		}
+	}
+	return keys
+}
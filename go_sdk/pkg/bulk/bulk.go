@@ -0,0 +1,330 @@
+// Package bulk runs large batches of API operations, such as thousands of ad
+// status updates, through a pool of workers sharing one client. Workers stay
+// within the client's rate limiter, back off together when the API reports
+// rate limiting, and record completed operations in a checkpoint so an
+// interrupted run can be resumed without repeating them.
+//
+//	runner := bulk.New(c, bulk.Options{Checkpoint: bulk.NewFileCheckpoint("pause.ckpt")})
+//	report, err := runner.Run(ctx, bulk.AdStatusOperations(advertiserID, adIDs, "DISABLE"))
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Runner defaults
+const (
+	DefaultWorkers     = 8
+	DefaultMaxAttempts = 3
+	DefaultBackoff     = 2 * time.Second
+)
+
+// Operation is one unit of bulk work
+type Operation struct {
+	// ID identifies the operation in results and checkpoints; it must be
+	// unique within a run and stable across resumed runs
+	ID string
+
+	// Do performs the operation
+	Do func(ctx context.Context, c *client.Client) error
+}
+
+// Result is the outcome of one operation
+type Result struct {
+	ID    string
+	Index int
+	Err   error
+
+	// Attempts counts the times the operation ran, including runs repeated
+	// after rate limiting
+	Attempts int
+
+	// Skipped marks operations completed by an earlier run
+	Skipped bool
+}
+
+// Report aggregates the results of a run, in operation order
+type Report struct {
+	Results   []Result
+	Succeeded int
+	Failed    int
+	Skipped   int
+	Duration  time.Duration
+}
+
+// Failures returns the results of the operations that failed
+func (r *Report) Failures() []Result {
+	var failures []Result
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// Err returns nil when every operation succeeded, or an error counting the
+// failures and naming the first
+func (r *Report) Err() error {
+	failures := r.Failures()
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d operations failed, first %s: %w", len(failures), len(r.Results), failures[0].ID, failures[0].Err)
+}
+
+// Options configures a Runner
+type Options struct {
+	// Workers caps concurrent operations. It defaults to DefaultWorkers, or
+	// to the burst of the client's rate limiter when that is smaller, since
+	// further workers would only wait on the limiter.
+	Workers int
+
+	// MaxAttempts is how often a rate limited operation runs before it fails
+	// (defaults to DefaultMaxAttempts). Other errors fail at once; the client
+	// has already retried transient ones.
+	MaxAttempts int
+
+	// Backoff is how long all workers pause after an operation is rate
+	// limited, doubling with each further attempt (defaults to DefaultBackoff)
+	Backoff time.Duration
+
+	// Checkpoint, if set, records completed operations and skips the ones
+	// completed by earlier runs
+	Checkpoint Checkpoint
+
+	// OnResult, if set, is called with the result of every operation as it
+	// completes; calls may come from several goroutines
+	OnResult func(Result)
+}
+
+// Runner fans operations out over a worker pool
+type Runner struct {
+	client  *client.Client
+	options Options
+
+	mu         sync.Mutex
+	pauseUntil time.Time
+}
+
+// New creates a Runner issuing operations through c
+func New(c *client.Client, options Options) *Runner {
+	if options.Workers <= 0 {
+		options.Workers = DefaultWorkers
+		if limiter := c.Stats().RateLimiter; limiter.Enabled && limiter.Burst > 0 && limiter.Burst < options.Workers {
+			options.Workers = limiter.Burst
+		}
+	}
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = DefaultMaxAttempts
+	}
+	if options.Backoff <= 0 {
+		options.Backoff = DefaultBackoff
+	}
+	return &Runner{client: c, options: options}
+}
+
+// Run performs the operations and reports every result. Failed operations do
+// not stop the run; see Report.Err. The returned error is for runs that could
+// not start or finish: a bad operation list, an unreadable checkpoint, a
+// canceled context, or a checkpoint write that failed, in which case the
+// affected operations run again on resume.
+func (r *Runner) Run(ctx context.Context, ops []Operation) (*Report, error) {
+	start := time.Now()
+
+	seen := make(map[string]bool, len(ops))
+	for i, op := range ops {
+		if op.ID == "" || op.Do == nil {
+			return nil, fmt.Errorf("operation %d: id and func are required", i)
+		}
+		if seen[op.ID] {
+			return nil, fmt.Errorf("operation %d: duplicate id %s", i, op.ID)
+		}
+		seen[op.ID] = true
+	}
+
+	done := map[string]bool{}
+	if r.options.Checkpoint != nil {
+		var err error
+		if done, err = r.options.Checkpoint.Done(ctx); err != nil {
+			return nil, fmt.Errorf("failed to load checkpoint: %w", err)
+		}
+	}
+
+	report := &Report{Results: make([]Result, len(ops))}
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	var checkpointErr error
+	var checkpointOnce sync.Once
+
+	for w := 0; w < r.options.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				result := r.perform(ctx, i, ops[i])
+				if result.Err == nil && r.options.Checkpoint != nil {
+					if err := r.options.Checkpoint.MarkDone(ctx, result.ID); err != nil {
+						checkpointOnce.Do(func() { checkpointErr = fmt.Errorf("failed to write checkpoint: %w", err) })
+					}
+				}
+				report.Results[i] = result
+				if r.options.OnResult != nil {
+					r.options.OnResult(result)
+				}
+			}
+		}()
+	}
+
+	next := 0
+feed:
+	for ; next < len(ops); next++ {
+		if done[ops[next].ID] {
+			report.Results[next] = Result{ID: ops[next].ID, Index: next, Skipped: true}
+			continue
+		}
+		select {
+		case queue <- next:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	for ; next < len(ops); next++ {
+		if done[ops[next].ID] {
+			report.Results[next] = Result{ID: ops[next].ID, Index: next, Skipped: true}
+		} else {
+			report.Results[next] = Result{ID: ops[next].ID, Index: next, Err: ctx.Err()}
+		}
+	}
+
+	for _, result := range report.Results {
+		switch {
+		case result.Skipped:
+			report.Skipped++
+		case result.Err != nil:
+			report.Failed++
+		default:
+			report.Succeeded++
+		}
+	}
+	report.Duration = time.Since(start)
+
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+	return report, checkpointErr
+}
+
+// perform runs one operation, repeating it after rate limiting
+func (r *Runner) perform(ctx context.Context, index int, op Operation) Result {
+	result := Result{ID: op.ID, Index: index}
+	for {
+		if err := r.waitPause(ctx); err != nil {
+			result.Err = err
+			return result
+		}
+
+		result.Attempts++
+		result.Err = op.Do(ctx, r.client)
+		if result.Err == nil || result.Attempts >= r.options.MaxAttempts {
+			return result
+		}
+
+		until, ok := r.rateLimitedUntil(result.Err, result.Attempts)
+		if !ok {
+			return result
+		}
+		r.pause(until)
+	}
+}
+
+// rateLimitedUntil reports whether err is a rate limit or open circuit the
+// operation should wait out, and until when
+func (r *Runner) rateLimitedUntil(err error, attempt int) (time.Time, bool) {
+	var circuitErr *client.CircuitOpenError
+	if errors.As(err, &circuitErr) {
+		return circuitErr.RetryAt, true
+	}
+	if !IsRateLimited(err) {
+		return time.Time{}, false
+	}
+	return time.Now().Add(r.options.Backoff << (attempt - 1)), true
+}
+
+// pause holds all workers until the given time
+func (r *Runner) pause(until time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if until.After(r.pauseUntil) {
+		r.pauseUntil = until
+	}
+}
+
+// waitPause blocks while the workers are paused
+func (r *Runner) waitPause(ctx context.Context) error {
+	r.mu.Lock()
+	wait := time.Until(r.pauseUntil)
+	r.mu.Unlock()
+	if wait <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// IsRateLimited reports whether err is the API asking callers to slow down,
+// either with HTTP 429 or a too-frequent response code
+func IsRateLimited(err error) bool {
+	var apiErr *models.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IsRateLimitError() || apiErr.Code == "40100"
+	}
+	var httpErr models.HTTPError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == 429
+}
+
+// AdStatusOperations returns one operation per ad setting its status to
+// ENABLE, DISABLE or DELETE. Operation IDs are the ad IDs.
+func AdStatusOperations(advertiserID string, adIDs []string, status string) []Operation {
+	ops := make([]Operation, len(adIDs))
+	for i, adID := range adIDs {
+		adID := adID
+		ops[i] = Operation{
+			ID: adID,
+			Do: func(ctx context.Context, c *client.Client) error {
+				resp, err := c.Ad().UpdateStatus(ctx, &client.AdStatusUpdateRequest{
+					AdvertiserID: advertiserID,
+					AdIDs:        []string{adID},
+					Operation:    status,
+				})
+				if err != nil {
+					return err
+				}
+				if resp.Code != 0 {
+					return models.NewAPIError(strconv.Itoa(resp.Code), resp.Message, resp.RequestID, 200)
+				}
+				return nil
+			},
+		}
+	}
+	return ops
+}
@@ -0,0 +1,124 @@
+package bulk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(&client.Config{
+		BaseURL:     server.URL,
+		AccessToken: "test_token",
+		Timeout:     5 * time.Second,
+		RetryConfig: &client.RetryConfig{MaxRetries: 0, BackoffStrategy: client.NewConstantBackoff(time.Millisecond)},
+		RateLimit:   &client.RateLimitConfig{RequestsPerSecond: 1000, BurstSize: 4},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return c
+}
+
+func TestRunner_AdStatusOperations(t *testing.T) {
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	var limited atomic.Bool
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req client.AdStatusUpdateRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		adID := req.AdIDs[0]
+
+		mu.Lock()
+		calls[adID]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case adID == "ad-3" && limited.CompareAndSwap(false, true):
+			_, _ = w.Write([]byte(`{"code": 40100, "message": "Too many requests"}`))
+		case adID == "ad-7":
+			_, _ = w.Write([]byte(`{"code": 40002, "message": "ad not found"}`))
+		default:
+			_, _ = w.Write([]byte(`{"code": 0, "message": "OK"}`))
+		}
+	})
+
+	adIDs := make([]string, 10)
+	for i := range adIDs {
+		adIDs[i] = "ad-" + string(rune('0'+i))
+	}
+	checkpoint := NewFileCheckpoint(filepath.Join(t.TempDir(), "run.ckpt"))
+	runner := New(c, Options{Backoff: 10 * time.Millisecond, Checkpoint: checkpoint})
+	if runner.options.Workers != 4 {
+		t.Errorf("Workers = %d, want the rate limiter burst 4", runner.options.Workers)
+	}
+
+	report, err := runner.Run(context.Background(), AdStatusOperations("123", adIDs, "DISABLE"))
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if report.Succeeded != 9 || report.Failed != 1 || report.Skipped != 0 {
+		t.Errorf("Unexpected report counts: %+v", report)
+	}
+	if result := report.Results[3]; result.Err != nil || result.Attempts != 2 {
+		t.Errorf("Expected the rate limited operation to succeed on its second attempt, got %+v", result)
+	}
+	if failures := report.Failures(); len(failures) != 1 || failures[0].ID != "ad-7" || failures[0].Attempts != 1 {
+		t.Errorf("Unexpected failures: %+v", failures)
+	}
+	if report.Err() == nil {
+		t.Error("Expected Err() to report the failed operation")
+	}
+
+	// Resuming skips completed operations and reruns the failed one
+	report, err = runner.Run(context.Background(), AdStatusOperations("123", adIDs, "DISABLE"))
+	if err != nil {
+		t.Fatalf("Resumed Run() error = %v", err)
+	}
+	if report.Skipped != 9 || report.Failed != 1 {
+		t.Errorf("Unexpected resumed report counts: %+v", report)
+	}
+	if calls["ad-0"] != 1 || calls["ad-7"] != 2 {
+		t.Errorf("Unexpected calls: %v", calls)
+	}
+}
+
+func TestRunner_Run(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {})
+	runner := New(c, Options{Workers: 2})
+	noop := func(ctx context.Context, c *client.Client) error { return nil }
+
+	if _, err := runner.Run(context.Background(), []Operation{{ID: "a", Do: noop}, {ID: "a", Do: noop}}); err == nil {
+		t.Error("Run() accepted duplicate operation IDs")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var ops []Operation
+	for i := 0; i < 20; i++ {
+		ops = append(ops, Operation{ID: string(rune('a' + i)), Do: func(ctx context.Context, c *client.Client) error {
+			cancel()
+			return nil
+		}})
+	}
+	report, err := runner.Run(ctx, ops)
+	if err != context.Canceled {
+		t.Fatalf("Run() error = %v, want context.Canceled", err)
+	}
+	if report.Succeeded == 0 || report.Failed == 0 || report.Succeeded+report.Failed != len(ops) {
+		t.Errorf("Unexpected report after cancellation: %+v", report)
+	}
+}
@@ -0,0 +1,117 @@
+package bulk
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Checkpoint records the operations a run completed
+type Checkpoint interface {
+	// Done returns the IDs of completed operations
+	Done(ctx context.Context) (map[string]bool, error)
+
+	// MarkDone records a completed operation
+	MarkDone(ctx context.Context, id string) error
+}
+
+// memoryCheckpoint keeps completed IDs in memory
+type memoryCheckpoint struct {
+	mu   sync.Mutex
+	done map[string]bool
+}
+
+// NewMemoryCheckpoint creates a Checkpoint kept in memory, resuming runs
+// within the same process
+func NewMemoryCheckpoint() Checkpoint {
+	return &memoryCheckpoint{done: make(map[string]bool)}
+}
+
+// Done returns the IDs of completed operations
+func (m *memoryCheckpoint) Done(ctx context.Context) (map[string]bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	done := make(map[string]bool, len(m.done))
+	for id := range m.done {
+		done[id] = true
+	}
+	return done, nil
+}
+
+// MarkDone records a completed operation
+func (m *memoryCheckpoint) MarkDone(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.done[id] = true
+	return nil
+}
+
+// fileCheckpoint appends completed IDs to a file, one JSON line each
+type fileCheckpoint struct {
+	mu   sync.Mutex
+	path string
+}
+
+// checkpointEntry is a line of a checkpoint file
+type checkpointEntry struct {
+	ID string `json:"id"`
+}
+
+// NewFileCheckpoint creates a Checkpoint appending to a file, so a run
+// interrupted by a crash resumes after its last completed operation. A line
+// cut short by the crash is ignored.
+func NewFileCheckpoint(path string) Checkpoint {
+	return &fileCheckpoint{path: path}
+}
+
+// Done returns the IDs of completed operations; a missing file holds none
+func (f *fileCheckpoint) Done(ctx context.Context) (map[string]bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	done := make(map[string]bool)
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return done, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var entry checkpointEntry
+		if json.Unmarshal(scanner.Bytes(), &entry) == nil && entry.ID != "" {
+			done[entry.ID] = true
+		}
+	}
+	return done, scanner.Err()
+}
+
+// MarkDone appends a completed operation
+func (f *fileCheckpoint) MarkDone(ctx context.Context, id string) error {
+	line, err := json.Marshal(checkpointEntry{ID: id})
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,400 @@
+package client
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// DefaultAdMockupWidth is the mockup width used when none is set; the height
+// follows the 9:16 aspect of the feed
+const DefaultAdMockupWidth = 360
+
+// adMockupMaxTextLines caps the ad text drawn before it is cut off, as the
+// feed collapses longer captions
+const adMockupMaxTextLines = 4
+
+var (
+	mockupBackground = color.RGBA{R: 0x16, G: 0x18, B: 0x23, A: 0xff}
+	mockupCTA        = color.RGBA{R: 0xfe, G: 0x2c, B: 0x55, A: 0xff}
+	mockupText       = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	mockupSubtle     = color.RGBA{R: 0xd0, G: 0xd0, B: 0xd0, A: 0xff}
+	mockupIcon       = color.RGBA{R: 0xe6, G: 0xe6, B: 0xe6, A: 0xe6}
+)
+
+// AdMockup describes an in-feed ad to draw for review
+type AdMockup struct {
+	// Creative is the image or video cover frame, scaled to fill the frame.
+	// A dark background is drawn when nil.
+	Creative image.Image
+
+	// DisplayName is the identity shown above the ad text, without the "@"
+	DisplayName string
+
+	AdText string
+
+	// CallToAction is the button label, either as text or as the API value
+	// (e.g. LEARN_MORE); no button is drawn when empty
+	CallToAction string
+
+	// Width of the mockup in pixels (defaults to DefaultAdMockupWidth)
+	Width int
+}
+
+// Validate checks that the mockup can be drawn
+func (m *AdMockup) Validate() error {
+	if strings.TrimSpace(m.AdText) == "" {
+		return models.NewValidationError("ad_text", "ad text is required")
+	}
+	if m.Width < 0 {
+		return models.NewValidationError("width", "width must be positive")
+	}
+	if m.Width > 0 && m.Width < 180 {
+		return models.NewValidationError("width", "width must be at least 180 pixels")
+	}
+	return nil
+}
+
+// Render draws the ad as it appears in the feed: the creative full screen,
+// the identity, ad text and call to action at the bottom and the engagement
+// buttons on the right. Text is drawn with a built-in bitmap font, so
+// characters outside ASCII are replaced.
+func (m *AdMockup) Render() (*image.RGBA, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	width := m.Width
+	if width == 0 {
+		width = DefaultAdMockupWidth
+	}
+	height := width * 16 / 9
+	unit := func(n int) int { return n * width / DefaultAdMockupWidth }
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(mockupBackground), image.Point{}, draw.Src)
+	if m.Creative != nil {
+		drawCover(img, m.Creative)
+	}
+
+	// Darken the lower half so the overlaid text stays readable
+	fadeStart := height * 11 / 20
+	for y := fadeStart; y < height; y++ {
+		alpha := uint8(0x99 * (y - fadeStart) / (height - fadeStart))
+		row := image.Rect(0, y, width, y+1)
+		draw.Draw(img, row, image.NewUniform(color.RGBA{A: alpha}), image.Point{}, draw.Over)
+	}
+
+	// Engagement column: avatar, like, comment and share
+	iconX := width - unit(32)
+	for i, radius := range []int{unit(22), unit(16), unit(16), unit(16)} {
+		fillCircle(img, iconX, height*9/20+i*unit(64), radius, mockupIcon)
+	}
+
+	scale := max(1, unit(2))
+	margin := unit(12)
+	textWidth := width - margin - unit(72)
+	y := height - unit(16)
+
+	if m.CallToAction != "" {
+		buttonHeight := unit(36)
+		y -= buttonHeight
+		button := image.Rect(margin, y, margin+textWidth, y+buttonHeight)
+		draw.Draw(img, button, image.NewUniform(mockupCTA), image.Point{}, draw.Src)
+
+		label := fitText(callToActionLabel(m.CallToAction), textWidth/glyphAdvance(scale))
+		labelX := button.Min.X + (button.Dx()-textWidthOf(label, scale))/2
+		labelY := button.Min.Y + (buttonHeight-glyphHeight*scale)/2
+		drawText(img, labelX, labelY, label, scale, mockupText, true)
+		y -= unit(12)
+	}
+
+	lineHeight := (glyphHeight + 3) * scale
+	y -= lineHeight
+	drawText(img, margin, y, "Sponsored", scale, mockupSubtle, false)
+
+	lines := wrapText(m.AdText, textWidth/glyphAdvance(scale), adMockupMaxTextLines)
+	y -= len(lines) * lineHeight
+	for i, line := range lines {
+		drawText(img, margin, y+i*lineHeight, line, scale, mockupText, false)
+	}
+
+	if m.DisplayName != "" {
+		y -= lineHeight + unit(4)
+		name := fitText("@"+strings.TrimPrefix(m.DisplayName, "@"), textWidth/glyphAdvance(scale))
+		drawText(img, margin, y, name, scale, mockupText, true)
+	}
+
+	return img, nil
+}
+
+// WritePNG renders the mockup and writes it as a PNG image
+func (m *AdMockup) WritePNG(w io.Writer) error {
+	img, err := m.Render()
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(w, img); err != nil {
+		return fmt.Errorf("failed to encode mockup: %w", err)
+	}
+	return nil
+}
+
+// callToActionLabel turns an API call to action value such as LEARN_MORE into
+// the label shown on the button
+func callToActionLabel(cta string) string {
+	if strings.ToUpper(cta) != cta {
+		return cta
+	}
+	label := strings.ToLower(strings.ReplaceAll(cta, "_", " "))
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// drawCover scales src to fill dst, cropping the overflowing sides
+func drawCover(dst *image.RGBA, src image.Image) {
+	sb, db := src.Bounds(), dst.Bounds()
+	if sb.Empty() {
+		return
+	}
+	// Scale by the larger ratio so the creative covers the whole frame
+	num, den := db.Dx(), sb.Dx()
+	if db.Dy()*sb.Dx() > db.Dx()*sb.Dy() {
+		num, den = db.Dy(), sb.Dy()
+	}
+	offsetX := (sb.Dx()*num/den - db.Dx()) / 2
+	offsetY := (sb.Dy()*num/den - db.Dy()) / 2
+	for y := db.Min.Y; y < db.Max.Y; y++ {
+		sy := sb.Min.Y + min((y-db.Min.Y+offsetY)*den/num, sb.Dy()-1)
+		for x := db.Min.X; x < db.Max.X; x++ {
+			sx := sb.Min.X + min((x-db.Min.X+offsetX)*den/num, sb.Dx()-1)
+			dst.Set(x, y, src.At(sx, sy))
+		}
+	}
+}
+
+// circleMask is an alpha mask of a filled circle
+type circleMask struct {
+	center image.Point
+	radius int
+}
+
+func (c *circleMask) ColorModel() color.Model { return color.AlphaModel }
+
+func (c *circleMask) Bounds() image.Rectangle {
+	return image.Rect(c.center.X-c.radius, c.center.Y-c.radius, c.center.X+c.radius, c.center.Y+c.radius)
+}
+
+func (c *circleMask) At(x, y int) color.Color {
+	dx, dy := x-c.center.X, y-c.center.Y
+	if dx*dx+dy*dy <= c.radius*c.radius {
+		return color.Alpha{A: 0xff}
+	}
+	return color.Alpha{}
+}
+
+// fillCircle draws a filled circle blended over dst
+func fillCircle(dst *image.RGBA, cx, cy, radius int, c color.Color) {
+	mask := &circleMask{center: image.Pt(cx, cy), radius: radius}
+	draw.DrawMask(dst, mask.Bounds(), image.NewUniform(c), image.Point{}, mask, mask.Bounds().Min, draw.Over)
+}
+
+// wrapText breaks text into lines of at most width characters, cutting it
+// off with an ellipsis after maxLines
+func wrapText(text string, width, maxLines int) []string {
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		for utf8.RuneCountInString(word) > width {
+			// Hard-break words longer than a line
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			runes := []rune(word)
+			lines = append(lines, string(runes[:width]))
+			word = string(runes[width:])
+		}
+		switch {
+		case line == "":
+			line = word
+		case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	if len(lines) > maxLines {
+		lines = lines[:maxLines]
+		lines[maxLines-1] = fitText(lines[maxLines-1]+"...", width)
+	}
+	return lines
+}
+
+// fitText shortens text to width characters, ending it with an ellipsis
+func fitText(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	if width <= 3 {
+		return string(runes[:width])
+	}
+	return strings.TrimRight(string(runes[:width-3]), " ") + "..."
+}
+
+const (
+	glyphWidth  = 5
+	glyphHeight = 8
+)
+
+// glyphAdvance is the horizontal space of one character, including spacing
+func glyphAdvance(scale int) int {
+	return (glyphWidth + 1) * scale
+}
+
+// textWidthOf is the drawn width of text in pixels
+func textWidthOf(text string, scale int) int {
+	n := utf8.RuneCountInString(text)
+	if n == 0 {
+		return 0
+	}
+	return n*glyphAdvance(scale) - scale
+}
+
+// drawText draws text with the bitmap font, its top-left corner at x, y.
+// Bold text is drawn twice, one pixel apart.
+func drawText(dst *image.RGBA, x, y int, text string, scale int, c color.Color, bold bool) {
+	src := image.NewUniform(c)
+	for _, r := range text {
+		if r < ' ' || r > '~' {
+			r = '?'
+		}
+		glyph := mockupFont[r-' ']
+		for col, bits := range glyph {
+			for row := 0; row < glyphHeight; row++ {
+				if bits&(1<<row) == 0 {
+					continue
+				}
+				px := x + col*scale
+				py := y + row*scale
+				rect := image.Rect(px, py, px+scale, py+scale)
+				if bold {
+					rect.Max.X++
+				}
+				draw.Draw(dst, rect, src, image.Point{}, draw.Over)
+			}
+		}
+		x += glyphAdvance(scale)
+	}
+}
+
+// mockupFont is a 5x8 bitmap font for printable ASCII. Each glyph is five
+// columns, with the least significant bit at the top.
+var mockupFont = [...][glyphWidth]byte{
+	{0x00, 0x00, 0x00, 0x00, 0x00}, // ' '
+	{0x00, 0x00, 0x5f, 0x00, 0x00}, // !
+	{0x00, 0x07, 0x00, 0x07, 0x00}, // "
+	{0x14, 0x7f, 0x14, 0x7f, 0x14}, // #
+	{0x24, 0x2a, 0x7f, 0x2a, 0x12}, // $
+	{0x23, 0x13, 0x08, 0x64, 0x62}, // %
+	{0x36, 0x49, 0x56, 0x20, 0x50}, // &
+	{0x00, 0x08, 0x07, 0x03, 0x00}, // '
+	{0x00, 0x1c, 0x22, 0x41, 0x00}, // (
+	{0x00, 0x41, 0x22, 0x1c, 0x00}, // )
+	{0x2a, 0x1c, 0x7f, 0x1c, 0x2a}, // *
+	{0x08, 0x08, 0x3e, 0x08, 0x08}, // +
+	{0x00, 0x80, 0x70, 0x30, 0x00}, // ,
+	{0x08, 0x08, 0x08, 0x08, 0x08}, // -
+	{0x00, 0x00, 0x60, 0x60, 0x00}, // .
+	{0x20, 0x10, 0x08, 0x04, 0x02}, // /
+	{0x3e, 0x51, 0x49, 0x45, 0x3e}, // 0
+	{0x00, 0x42, 0x7f, 0x40, 0x00}, // 1
+	{0x72, 0x49, 0x49, 0x49, 0x46}, // 2
+	{0x21, 0x41, 0x49, 0x4d, 0x33}, // 3
+	{0x18, 0x14, 0x12, 0x7f, 0x10}, // 4
+	{0x27, 0x45, 0x45, 0x45, 0x39}, // 5
+	{0x3c, 0x4a, 0x49, 0x49, 0x31}, // 6
+	{0x41, 0x21, 0x11, 0x09, 0x07}, // 7
+	{0x36, 0x49, 0x49, 0x49, 0x36}, // 8
+	{0x46, 0x49, 0x49, 0x29, 0x1e}, // 9
+	{0x00, 0x00, 0x14, 0x00, 0x00}, // :
+	{0x00, 0x40, 0x34, 0x00, 0x00}, // ;
+	{0x00, 0x08, 0x14, 0x22, 0x41}, // <
+	{0x14, 0x14, 0x14, 0x14, 0x14}, // =
+	{0x00, 0x41, 0x22, 0x14, 0x08}, // >
+	{0x02, 0x01, 0x59, 0x09, 0x06}, // ?
+	{0x3e, 0x41, 0x5d, 0x59, 0x4e}, // @
+	{0x7c, 0x12, 0x11, 0x12, 0x7c}, // A
+	{0x7f, 0x49, 0x49, 0x49, 0x36}, // B
+	{0x3e, 0x41, 0x41, 0x41, 0x22}, // C
+	{0x7f, 0x41, 0x41, 0x41, 0x3e}, // D
+	{0x7f, 0x49, 0x49, 0x49, 0x41}, // E
+	{0x7f, 0x09, 0x09, 0x09, 0x01}, // F
+	{0x3e, 0x41, 0x41, 0x51, 0x73}, // G
+	{0x7f, 0x08, 0x08, 0x08, 0x7f}, // H
+	{0x00, 0x41, 0x7f, 0x41, 0x00}, // I
+	{0x20, 0x40, 0x41, 0x3f, 0x01}, // J
+	{0x7f, 0x08, 0x14, 0x22, 0x41}, // K
+	{0x7f, 0x40, 0x40, 0x40, 0x40}, // L
+	{0x7f, 0x02, 0x1c, 0x02, 0x7f}, // M
+	{0x7f, 0x04, 0x08, 0x10, 0x7f}, // N
+	{0x3e, 0x41, 0x41, 0x41, 0x3e}, // O
+	{0x7f, 0x09, 0x09, 0x09, 0x06}, // P
+	{0x3e, 0x41, 0x51, 0x21, 0x5e}, // Q
+	{0x7f, 0x09, 0x19, 0x29, 0x46}, // R
+	{0x26, 0x49, 0x49, 0x49, 0x32}, // S
+	{0x03, 0x01, 0x7f, 0x01, 0x03}, // T
+	{0x3f, 0x40, 0x40, 0x40, 0x3f}, // U
+	{0x1f, 0x20, 0x40, 0x20, 0x1f}, // V
+	{0x3f, 0x40, 0x38, 0x40, 0x3f}, // W
+	{0x63, 0x14, 0x08, 0x14, 0x63}, // X
+	{0x03, 0x04, 0x78, 0x04, 0x03}, // Y
+	{0x61, 0x59, 0x49, 0x4d, 0x43}, // Z
+	{0x00, 0x7f, 0x41, 0x41, 0x41}, // [
+	{0x02, 0x04, 0x08, 0x10, 0x20}, // \
+	{0x00, 0x41, 0x41, 0x41, 0x7f}, // ]
+	{0x04, 0x02, 0x01, 0x02, 0x04}, // ^
+	{0x40, 0x40, 0x40, 0x40, 0x40}, // _
+	{0x00, 0x03, 0x07, 0x08, 0x00}, // `
+	{0x20, 0x54, 0x54, 0x78, 0x40}, // a
+	{0x7f, 0x28, 0x44, 0x44, 0x38}, // b
+	{0x38, 0x44, 0x44, 0x44, 0x28}, // c
+	{0x38, 0x44, 0x44, 0x28, 0x7f}, // d
+	{0x38, 0x54, 0x54, 0x54, 0x18}, // e
+	{0x00, 0x08, 0x7e, 0x09, 0x02}, // f
+	{0x18, 0xa4, 0xa4, 0x9c, 0x78}, // g
+	{0x7f, 0x08, 0x04, 0x04, 0x78}, // h
+	{0x00, 0x44, 0x7d, 0x40, 0x00}, // i
+	{0x20, 0x40, 0x40, 0x3d, 0x00}, // j
+	{0x7f, 0x10, 0x28, 0x44, 0x00}, // k
+	{0x00, 0x41, 0x7f, 0x40, 0x00}, // l
+	{0x7c, 0x04, 0x78, 0x04, 0x78}, // m
+	{0x7c, 0x08, 0x04, 0x04, 0x78}, // n
+	{0x38, 0x44, 0x44, 0x44, 0x38}, // o
+	{0xfc, 0x18, 0x24, 0x24, 0x18}, // p
+	{0x18, 0x24, 0x24, 0x18, 0xfc}, // q
+	{0x7c, 0x08, 0x04, 0x04, 0x08}, // r
+	{0x48, 0x54, 0x54, 0x54, 0x24}, // s
+	{0x04, 0x04, 0x3f, 0x44, 0x24}, // t
+	{0x3c, 0x40, 0x40, 0x20, 0x7c}, // u
+	{0x1c, 0x20, 0x40, 0x20, 0x1c}, // v
+	{0x3c, 0x40, 0x30, 0x40, 0x3c}, // w
+	{0x44, 0x28, 0x10, 0x28, 0x44}, // x
+	{0x4c, 0x90, 0x90, 0x90, 0x7c}, // y
+	{0x44, 0x64, 0x54, 0x4c, 0x44}, // z
+	{0x00, 0x08, 0x36, 0x41, 0x00}, // {
+	{0x00, 0x00, 0x77, 0x00, 0x00}, // |
+	{0x00, 0x41, 0x36, 0x08, 0x00}, // }
+	{0x02, 0x01, 0x02, 0x04, 0x02}, // ~
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"reflect"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestAdMockup(t *testing.T) {
+	creative := image.NewRGBA(image.Rect(0, 0, 90, 160))
+	for y := 0; y < 160; y++ {
+		for x := 0; x < 90; x++ {
+			creative.Set(x, y, color.RGBA{R: 0x20, G: 0x80, B: 0x20, A: 0xff})
+		}
+	}
+	mockup := &AdMockup{
+		Creative:     creative,
+		DisplayName:  "acme",
+		AdText:       "Fresh arrivals for spring, with free shipping on every order over fifty dollars this week only",
+		CallToAction: "SHOP_NOW",
+	}
+
+	var buf bytes.Buffer
+	if err := mockup.WritePNG(&buf); err != nil {
+		t.Fatalf("WritePNG() error = %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("mockup is not a PNG: %v", err)
+	}
+	if got := img.Bounds().Size(); got != image.Pt(360, 640) {
+		t.Errorf("size = %v, want 360x640", got)
+	}
+
+	// The creative fills the top, the CTA button sits at the bottom left
+	if r, g, b, _ := img.At(100, 20).RGBA(); r>>8 != 0x20 || g>>8 != 0x80 || b>>8 != 0x20 {
+		t.Errorf("creative not drawn: got %x %x %x", r>>8, g>>8, b>>8)
+	}
+	if got := color.RGBAModel.Convert(img.At(14, 640-18)); got != mockupCTA {
+		t.Errorf("CTA button color = %v, want %v", got, mockupCTA)
+	}
+
+	if got := callToActionLabel("SHOP_NOW"); got != "Shop now" {
+		t.Errorf("callToActionLabel() = %q", got)
+	}
+	if got := callToActionLabel("Book today"); got != "Book today" {
+		t.Errorf("callToActionLabel() = %q", got)
+	}
+	if got := wrapText("one two three four five six", 9, 2); !reflect.DeepEqual(got, []string{"one two", "three..."}) {
+		t.Errorf("wrapText() = %q", got)
+	}
+
+	var validationErr models.ValidationError
+	if _, err := (&AdMockup{AdText: " "}).Render(); !errors.As(err, &validationErr) {
+		t.Errorf("Render() without text error = %v, want ValidationError", err)
+	}
+}
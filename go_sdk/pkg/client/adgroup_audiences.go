@@ -0,0 +1,286 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// DefaultAudienceOverlapThreshold is the share of an included audience that,
+// when also excluded, is flagged by CheckAudienceOverlap
+const DefaultAudienceOverlapThreshold = 0.5
+
+// IncludeAudiences adds custom audiences to the targeting. Audiences already
+// included are skipped; an audience the targeting excludes is an error.
+func (t *AdGroupTargeting) IncludeAudiences(audienceIDs ...string) error {
+	for _, id := range audienceIDs {
+		if id == "" {
+			return models.NewValidationError("audience_ids", "audience ID cannot be empty")
+		}
+		if containsString(t.ExcludedAudienceIDs, id) {
+			return models.NewValidationError("audience_ids", fmt.Sprintf("audience %s is already excluded", id))
+		}
+		if !containsString(t.AudienceIDs, id) {
+			t.AudienceIDs = append(t.AudienceIDs, id)
+		}
+	}
+	return nil
+}
+
+// ExcludeAudiences adds custom audiences to the targeting's exclusions.
+// Audiences already excluded are skipped; an audience the targeting includes
+// is an error.
+func (t *AdGroupTargeting) ExcludeAudiences(audienceIDs ...string) error {
+	for _, id := range audienceIDs {
+		if id == "" {
+			return models.NewValidationError("excluded_audience_ids", "audience ID cannot be empty")
+		}
+		if containsString(t.AudienceIDs, id) {
+			return models.NewValidationError("excluded_audience_ids", fmt.Sprintf("audience %s is already included", id))
+		}
+		if !containsString(t.ExcludedAudienceIDs, id) {
+			t.ExcludedAudienceIDs = append(t.ExcludedAudienceIDs, id)
+		}
+	}
+	return nil
+}
+
+// validateAdGroupAudiences checks that no audience is both included and excluded
+func validateAdGroupAudiences(targeting *AdGroupTargeting) error {
+	for _, id := range targeting.AudienceIDs {
+		if id == "" {
+			return models.NewValidationError("audience_ids", "audience ID cannot be empty")
+		}
+		if containsString(targeting.ExcludedAudienceIDs, id) {
+			return models.NewValidationError("excluded_audience_ids", fmt.Sprintf("audience %s is both included and excluded", id))
+		}
+	}
+	for _, id := range targeting.ExcludedAudienceIDs {
+		if id == "" {
+			return models.NewValidationError("excluded_audience_ids", "audience ID cannot be empty")
+		}
+	}
+	return nil
+}
+
+// AudienceOverlapRequest represents the request for the overlap of custom audiences
+type AudienceOverlapRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	AudienceIDs  []string `json:"custom_audience_ids"`
+}
+
+// AudienceOverlap is the number of members two custom audiences share
+type AudienceOverlap struct {
+	AudienceID           string `json:"audience_id"`
+	AudienceSize         int64  `json:"audience_size"`
+	ComparedAudienceID   string `json:"compared_audience_id"`
+	ComparedAudienceSize int64  `json:"compared_audience_size"`
+	OverlapSize          int64  `json:"overlap_size"`
+}
+
+// Share returns the fraction of one of the two audiences that is also in
+// the other, or zero when its size is unknown
+func (o AudienceOverlap) Share(audienceID string) float64 {
+	size := o.AudienceSize
+	if audienceID == o.ComparedAudienceID {
+		size = o.ComparedAudienceSize
+	}
+	if size <= 0 {
+		return 0
+	}
+	return float64(o.OverlapSize) / float64(size)
+}
+
+// AudienceOverlapResponse represents the response for audience overlap
+type AudienceOverlapResponse struct {
+	models.BaseResponse
+	Data struct {
+		List []AudienceOverlap `json:"list"`
+	} `json:"data"`
+}
+
+// GetAudienceOverlap retrieves the pairwise overlap of custom audiences
+func (s *DMPService) GetAudienceOverlap(ctx context.Context, req *AudienceOverlapRequest) (*AudienceOverlapResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	audienceIDs, err := json.Marshal(req.AudienceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audience IDs: %w", err)
+	}
+
+	url, err := s.client.BuildURL("/dmp/custom_audience/overlap/", map[string]interface{}{
+		"advertiser_id":       req.AdvertiserID,
+		"custom_audience_ids": string(audienceIDs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audience overlap: %w", err)
+	}
+
+	var response AudienceOverlapResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// AudienceOverlapWarning flags an excluded audience that removes much of an
+// included one, which typically starves the ad group of delivery
+type AudienceOverlapWarning struct {
+	IncludedAudienceID string
+	ExcludedAudienceID string
+
+	// Share is the fraction of the included audience also in the excluded one
+	Share   float64
+	Message string
+}
+
+// CheckAudienceOverlap looks up the overlap of the targeting's included and
+// excluded audiences and warns about each pair where the excluded audience
+// covers at least threshold of the included one (DefaultAudienceOverlapThreshold
+// when zero)
+func (s *DMPService) CheckAudienceOverlap(ctx context.Context, advertiserID string, targeting AdGroupTargeting, threshold float64) ([]AudienceOverlapWarning, error) {
+	if len(targeting.AudienceIDs) == 0 || len(targeting.ExcludedAudienceIDs) == 0 {
+		return nil, nil
+	}
+	if threshold <= 0 {
+		threshold = DefaultAudienceOverlapThreshold
+	}
+
+	ids := append(append([]string{}, targeting.AudienceIDs...), targeting.ExcludedAudienceIDs...)
+	resp, err := s.GetAudienceOverlap(ctx, &AudienceOverlapRequest{AdvertiserID: advertiserID, AudienceIDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, fmt.Errorf("API error: %s", resp.Message)
+	}
+
+	var warnings []AudienceOverlapWarning
+	for _, included := range targeting.AudienceIDs {
+		for _, excluded := range targeting.ExcludedAudienceIDs {
+			overlap, ok := findOverlap(resp.Data.List, included, excluded)
+			if !ok {
+				continue
+			}
+			share := overlap.Share(included)
+			if share < threshold {
+				continue
+			}
+			warnings = append(warnings, AudienceOverlapWarning{
+				IncludedAudienceID: included,
+				ExcludedAudienceID: excluded,
+				Share:              share,
+				Message:            fmt.Sprintf("excluded audience %s removes %.0f%% of included audience %s", excluded, share*100, included),
+			})
+		}
+	}
+	return warnings, nil
+}
+
+// findOverlap returns the overlap of two audiences in either order
+func findOverlap(overlaps []AudienceOverlap, a, b string) (AudienceOverlap, bool) {
+	for _, overlap := range overlaps {
+		if (overlap.AudienceID == a && overlap.ComparedAudienceID == b) || (overlap.AudienceID == b && overlap.ComparedAudienceID == a) {
+			return overlap, true
+		}
+	}
+	return AudienceOverlap{}, false
+}
+
+// AdGroupAudienceRequest represents the request for adding audiences to an ad group
+type AdGroupAudienceRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	AdGroupID    string   `json:"adgroup_id"`
+	Include      []string `json:"audience_ids,omitempty"`
+	Exclude      []string `json:"excluded_audience_ids,omitempty"`
+
+	// OverlapThreshold is passed to CheckAudienceOverlap
+	OverlapThreshold float64 `json:"-"`
+
+	// SkipOverlapCheck attaches the audiences without looking up their overlap
+	SkipOverlapCheck bool `json:"-"`
+}
+
+// AdGroupAudienceResult holds the audience targeting of an ad group after
+// AttachAudiences, with any overlap warnings
+type AdGroupAudienceResult struct {
+	AudienceIDs         []string
+	ExcludedAudienceIDs []string
+	Warnings            []AudienceOverlapWarning
+}
+
+// AttachAudiences adds included and excluded audiences to an ad group,
+// keeping those it already targets. Overlap warnings do not stop the update;
+// callers decide whether to act on them.
+func (c *Client) AttachAudiences(ctx context.Context, req *AdGroupAudienceRequest) (*AdGroupAudienceResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if len(req.Include) == 0 && len(req.Exclude) == 0 {
+		return nil, models.NewValidationError("audience_ids", "at least one audience to include or exclude is required")
+	}
+
+	resp, err := c.AdGroup().Get(ctx, &AdGroupGetRequest{
+		AdvertiserID: req.AdvertiserID,
+		Filtering:    &AdGroupFiltering{AdGroupIDs: []string{req.AdGroupID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ad group %s: %w", req.AdGroupID, err)
+	}
+	if resp.Code != 0 {
+		return nil, fmt.Errorf("API error: %s", resp.Message)
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("ad group %s not found", req.AdGroupID)
+	}
+
+	targeting := AdGroupTargeting{
+		AudienceIDs:         resp.Data[0].AudienceIDs,
+		ExcludedAudienceIDs: resp.Data[0].ExcludedAudienceIDs,
+	}
+	if err := targeting.IncludeAudiences(req.Include...); err != nil {
+		return nil, err
+	}
+	if err := targeting.ExcludeAudiences(req.Exclude...); err != nil {
+		return nil, err
+	}
+
+	result := &AdGroupAudienceResult{
+		AudienceIDs:         targeting.AudienceIDs,
+		ExcludedAudienceIDs: targeting.ExcludedAudienceIDs,
+	}
+	if !req.SkipOverlapCheck {
+		if result.Warnings, err = c.DMP().CheckAudienceOverlap(ctx, req.AdvertiserID, targeting, req.OverlapThreshold); err != nil {
+			return nil, fmt.Errorf("failed to check audience overlap: %w", err)
+		}
+	}
+
+	update, err := c.AdGroup().Update(ctx, &AdGroupUpdateRequest{
+		AdvertiserID:     req.AdvertiserID,
+		AdGroupID:        req.AdGroupID,
+		AdGroupTargeting: targeting,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if update.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprint(update.Code), update.Message, update.RequestID, 0)
+	}
+	return result, nil
+}
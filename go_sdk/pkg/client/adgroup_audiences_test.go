@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAdGroupAudiences(t *testing.T) {
+	targeting := AdGroupTargeting{AudienceIDs: []string{"a1"}}
+	if err := targeting.IncludeAudiences("a1", "a2"); err != nil {
+		t.Fatalf("IncludeAudiences() error = %v", err)
+	}
+	if err := targeting.ExcludeAudiences("x1"); err != nil {
+		t.Fatalf("ExcludeAudiences() error = %v", err)
+	}
+	if !reflect.DeepEqual(targeting.AudienceIDs, []string{"a1", "a2"}) || !reflect.DeepEqual(targeting.ExcludedAudienceIDs, []string{"x1"}) {
+		t.Errorf("targeting = %+v", targeting)
+	}
+	if err := targeting.ExcludeAudiences("a2"); err == nil {
+		t.Error("ExcludeAudiences() of an included audience should fail")
+	}
+	if err := targeting.IncludeAudiences(""); err == nil {
+		t.Error("IncludeAudiences() of an empty ID should fail")
+	}
+	update := &AdGroupUpdateRequest{AdvertiserID: "123", AdGroupID: "g1"}
+	update.AudienceIDs = []string{"a1"}
+	update.ExcludedAudienceIDs = []string{"a1"}
+	if err := update.Validate(); err == nil {
+		t.Error("Validate() should reject an audience both included and excluded")
+	}
+
+	var updated AdGroupUpdateRequest
+	var overlapIDs string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/adgroup/get/":
+			w.Write([]byte(`{"code":0,"data":[{"adgroup_id":"g1","audience_ids":["a1"],"excluded_audience_ids":["x1"]}]}`))
+		case "/open_api/v1.3/dmp/custom_audience/overlap/":
+			overlapIDs = r.URL.Query().Get("custom_audience_ids")
+			w.Write([]byte(`{"code":0,"data":{"list":[
+				{"audience_id":"a1","audience_size":1000,"compared_audience_id":"x1","compared_audience_size":5000,"overlap_size":100},
+				{"audience_id":"x2","audience_size":2000,"compared_audience_id":"a1","compared_audience_size":1000,"overlap_size":800},
+				{"audience_id":"a2","audience_size":4000,"compared_audience_id":"x2","compared_audience_size":2000,"overlap_size":1500}]}}`))
+		case "/open_api/v1.3/adgroup/update/":
+			json.NewDecoder(r.Body).Decode(&updated)
+			w.Write([]byte(`{"code":0,"data":{"adgroup_id":"g1"}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	result, err := client.AttachAudiences(context.Background(), &AdGroupAudienceRequest{
+		AdvertiserID: "123",
+		AdGroupID:    "g1",
+		Include:      []string{"a2"},
+		Exclude:      []string{"x2"},
+	})
+	if err != nil {
+		t.Fatalf("AttachAudiences() error = %v", err)
+	}
+	if overlapIDs != `["a1","a2","x1","x2"]` {
+		t.Errorf("overlap requested for %s", overlapIDs)
+	}
+	if !reflect.DeepEqual(updated.AudienceIDs, []string{"a1", "a2"}) || !reflect.DeepEqual(updated.ExcludedAudienceIDs, []string{"x1", "x2"}) {
+		t.Errorf("updated targeting = %+v", updated.AdGroupTargeting)
+	}
+	// a1 loses 80% to x2 and a2 loses 37.5%, under the default threshold
+	if len(result.Warnings) != 1 || result.Warnings[0].IncludedAudienceID != "a1" || result.Warnings[0].ExcludedAudienceID != "x2" || result.Warnings[0].Share != 0.8 {
+		t.Errorf("warnings = %+v", result.Warnings)
+	}
+
+	result, err = client.AttachAudiences(context.Background(), &AdGroupAudienceRequest{
+		AdvertiserID:     "123",
+		AdGroupID:        "g1",
+		Exclude:          []string{"x2"},
+		OverlapThreshold: 0.3,
+	})
+	if err != nil {
+		t.Fatalf("AttachAudiences() error = %v", err)
+	}
+	if len(result.Warnings) != 1 {
+		t.Errorf("warnings at 0.3 = %+v", result.Warnings)
+	}
+
+	if _, err := client.AttachAudiences(context.Background(), &AdGroupAudienceRequest{AdvertiserID: "123", AdGroupID: "g1", Include: []string{"x1"}}); err == nil {
+		t.Error("AttachAudiences() should reject including an excluded audience")
+	}
+	if _, err := client.AttachAudiences(context.Background(), &AdGroupAudienceRequest{AdvertiserID: "123", AdGroupID: "g1"}); err == nil {
+		t.Error("AttachAudiences() without audiences should fail")
+	}
+}
@@ -0,0 +1,279 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultRollupMetrics are reported when AgencyRollupRequest.Metrics is empty
+var DefaultRollupMetrics = []string{"spend", "impressions", "clicks", "conversion"}
+
+// monetaryMetrics are converted to the target currency in a rollup
+var monetaryMetrics = map[string]bool{
+	"spend":                true,
+	"billed_cost":          true,
+	"cash_spend":           true,
+	"voucher_spend":        true,
+	"total_purchase_value": true,
+}
+
+// AgencyRollupRequest represents the request for a spend and performance
+// rollup across business centers
+type AgencyRollupRequest struct {
+	// BCIDs limits the rollup; every business center the token can access
+	// is included when empty
+	BCIDs []string
+
+	// StartDate and EndDate bound the report (YYYY-MM-DD)
+	StartDate string
+	EndDate   string
+
+	// Metrics are reported per campaign and summed up the hierarchy, so they
+	// should be additive (defaults to DefaultRollupMetrics)
+	Metrics []string
+
+	// TargetCurrency is the currency monetary metrics are normalized to
+	TargetCurrency string
+
+	// ExchangeRates maps a currency code to units of TargetCurrency per unit
+	ExchangeRates map[string]float64
+
+	// Concurrency caps parallel advertiser reports across all business
+	// centers (defaults to 5)
+	Concurrency int
+}
+
+// CampaignRollup is the performance of one campaign. Monetary metrics are
+// in the rollup currency.
+type CampaignRollup struct {
+	CampaignID   string             `json:"campaign_id"`
+	CampaignName string             `json:"campaign_name,omitempty"`
+	Metrics      map[string]float64 `json:"metrics"`
+}
+
+// AdvertiserRollup is the performance of one advertiser and its campaigns
+type AdvertiserRollup struct {
+	AdvertiserID   string `json:"advertiser_id"`
+	AdvertiserName string `json:"advertiser_name"`
+
+	// Currency is the advertiser's own currency and ExchangeRate converts
+	// it to the rollup currency
+	Currency     string  `json:"currency"`
+	ExchangeRate float64 `json:"exchange_rate"`
+
+	Metrics   map[string]float64 `json:"metrics"`
+	Campaigns []CampaignRollup   `json:"campaigns"`
+	Error     string             `json:"error,omitempty"`
+}
+
+// BCRollup is the performance of one business center's advertisers
+type BCRollup struct {
+	BCID        string             `json:"bc_id"`
+	BCName      string             `json:"bc_name"`
+	Metrics     map[string]float64 `json:"metrics"`
+	Advertisers []AdvertiserRollup `json:"advertisers"`
+	Error       string             `json:"error,omitempty"`
+}
+
+// AgencyRollup is performance across business centers, nested business
+// center, advertiser, campaign
+type AgencyRollup struct {
+	Currency        string             `json:"currency"`
+	StartDate       string             `json:"start_date"`
+	EndDate         string             `json:"end_date"`
+	Metrics         map[string]float64 `json:"metrics"`
+	BusinessCenters []BCRollup         `json:"business_centers"`
+	GeneratedAt     time.Time          `json:"generated_at"`
+}
+
+// GetAgencyRollup reports campaign performance for every advertiser of the
+// accessible business centers and sums it per advertiser, business center
+// and overall, with monetary metrics normalized to one currency. Failures of
+// a business center or advertiser are recorded on it and left out of the
+// totals rather than failing the whole rollup.
+func (s *BusinessCenterService) GetAgencyRollup(ctx context.Context, req *AgencyRollupRequest) (*AgencyRollup, error) {
+	if req == nil || req.TargetCurrency == "" {
+		return nil, fmt.Errorf("target_currency is required")
+	}
+	if req.StartDate == "" || req.EndDate == "" {
+		return nil, fmt.Errorf("start_date and end_date are required")
+	}
+	metrics := req.Metrics
+	if len(metrics) == 0 {
+		metrics = DefaultRollupMetrics
+	}
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = 5
+	}
+
+	centers, err := s.rollupBusinessCenters(ctx, req.BCIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range centers {
+		center := &centers[i]
+		assets, err := s.listAdvertiserAssets(ctx, center.BCID)
+		if err != nil {
+			center.Error = fmt.Sprintf("failed to list advertisers: %v", err)
+			continue
+		}
+
+		center.Advertisers = make([]AdvertiserRollup, len(assets))
+		for j, asset := range assets {
+			wg.Add(1)
+			go func(advertiser *AdvertiserRollup, asset BCAssetData) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				*advertiser = s.advertiserRollup(ctx, asset, metrics, req)
+			}(&center.Advertisers[j], asset)
+		}
+	}
+	wg.Wait()
+
+	rollup := &AgencyRollup{
+		Currency:        req.TargetCurrency,
+		StartDate:       req.StartDate,
+		EndDate:         req.EndDate,
+		Metrics:         make(map[string]float64),
+		BusinessCenters: centers,
+		GeneratedAt:     time.Now().UTC(),
+	}
+	for i := range centers {
+		center := &centers[i]
+		center.Metrics = make(map[string]float64)
+		for _, advertiser := range center.Advertisers {
+			if advertiser.Error == "" {
+				addMetrics(center.Metrics, advertiser.Metrics)
+			}
+		}
+		if center.Error == "" {
+			addMetrics(rollup.Metrics, center.Metrics)
+		}
+	}
+
+	return rollup, nil
+}
+
+// rollupBusinessCenters lists the business centers to roll up
+func (s *BusinessCenterService) rollupBusinessCenters(ctx context.Context, bcIDs []string) ([]BCRollup, error) {
+	resp, err := s.GetBusinessCenters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, fmt.Errorf("failed to get business centers: %s", resp.Message)
+	}
+
+	names := make(map[string]string, len(resp.Data))
+	for _, bc := range resp.Data {
+		names[bc.BCID] = bc.BCName
+	}
+
+	var centers []BCRollup
+	if len(bcIDs) == 0 {
+		for _, bc := range resp.Data {
+			centers = append(centers, BCRollup{BCID: bc.BCID, BCName: bc.BCName})
+		}
+		return centers, nil
+	}
+	for _, id := range bcIDs {
+		name, ok := names[id]
+		center := BCRollup{BCID: id, BCName: name}
+		if !ok {
+			center.Error = "business center is not accessible to this token"
+		}
+		centers = append(centers, center)
+	}
+	return centers, nil
+}
+
+// advertiserRollup reports the campaigns of one advertiser, converting
+// monetary metrics with the rate of the advertiser's currency
+func (s *BusinessCenterService) advertiserRollup(ctx context.Context, asset BCAssetData, metrics []string, req *AgencyRollupRequest) AdvertiserRollup {
+	result := AdvertiserRollup{
+		AdvertiserID:   asset.AssetID,
+		AdvertiserName: asset.AssetName,
+		Metrics:        make(map[string]float64),
+	}
+
+	balance, err := s.client.Account().GetAdvertiserBalance(ctx, &GetAdvertiserBalanceRequest{AdvertiserID: asset.AssetID})
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to get currency: %v", err)
+		return result
+	}
+	if balance.Code != 0 {
+		result.Error = fmt.Sprintf("failed to get currency: %s", balance.Message)
+		return result
+	}
+	result.Currency = balance.Data.Currency
+	result.ExchangeRate, err = exchangeRate(result.Currency, req.TargetCurrency, req.ExchangeRates)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	const pageSize = 1000
+	for page := 1; ; page++ {
+		report, err := s.client.Report().GetIntegratedReport(ctx, &ReportIntegratedGetRequest{
+			AdvertiserID: asset.AssetID,
+			ReportType:   "BASIC",
+			DataLevel:    "AUCTION_CAMPAIGN",
+			Dimensions:   []string{"campaign_id"},
+			Metrics:      append([]string{"campaign_name"}, metrics...),
+			StartDate:    req.StartDate,
+			EndDate:      req.EndDate,
+			Page:         page,
+			Size:         pageSize,
+		})
+		if err != nil {
+			result.Error = fmt.Sprintf("failed to get report: %v", err)
+			return result
+		}
+		if report.Code != 0 {
+			result.Error = fmt.Sprintf("failed to get report: %s", report.Message)
+			return result
+		}
+
+		for _, row := range report.Data.List {
+			name, _ := row.Metrics["campaign_name"].(string)
+			campaign := CampaignRollup{
+				CampaignID:   row.Dimensions["campaign_id"],
+				CampaignName: name,
+				Metrics:      make(map[string]float64, len(metrics)),
+			}
+			for _, metric := range metrics {
+				value := metricFloat(row.Metrics[metric])
+				if monetaryMetrics[metric] {
+					value *= result.ExchangeRate
+				}
+				campaign.Metrics[metric] = value
+			}
+			addMetrics(result.Metrics, campaign.Metrics)
+			result.Campaigns = append(result.Campaigns, campaign)
+		}
+		if len(report.Data.List) == 0 || page*pageSize >= report.Data.PageInfo.TotalCount {
+			break
+		}
+	}
+
+	sort.SliceStable(result.Campaigns, func(i, j int) bool {
+		return result.Campaigns[i].Metrics["spend"] > result.Campaigns[j].Metrics["spend"]
+	})
+	return result
+}
+
+// addMetrics adds each metric of from to totals
+func addMetrics(totals, from map[string]float64) {
+	for metric, value := range from {
+		totals[metric] += value
+	}
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetAgencyRollup(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		switch strings.TrimPrefix(r.URL.Path, "/open_api/v1.3") {
+		case "/bc/get/":
+			w.Write([]byte(`{"code":0,"data":[{"bc_id":"bc-1","bc_name":"North"},{"bc_id":"bc-2","bc_name":"South"}]}`))
+		case "/bc/asset/get/":
+			if query.Get("bc_id") == "bc-1" {
+				w.Write([]byte(`{"code":0,"data":[{"asset_id":"adv-usd","asset_name":"US"},{"asset_id":"adv-eur","asset_name":"EU"}]}`))
+				return
+			}
+			w.Write([]byte(`{"code":0,"data":[{"asset_id":"adv-jpy","asset_name":"JP"}]}`))
+		case "/advertiser/balance/get/":
+			currency := map[string]string{"adv-usd": "USD", "adv-eur": "EUR", "adv-jpy": "JPY"}[query.Get("advertiser_id")]
+			fmt.Fprintf(w, `{"code":0,"data":{"balance":100,"currency":%q}}`, currency)
+		case "/report/integrated/get/":
+			if n := inFlight.Add(1); n > maxInFlight.Load() {
+				maxInFlight.Store(n)
+			}
+			defer inFlight.Add(-1)
+			time.Sleep(10 * time.Millisecond)
+			if query.Get("data_level") != "AUCTION_CAMPAIGN" || !strings.Contains(query.Get("metrics"), "campaign_name") {
+				t.Errorf("unexpected report query %v", query)
+			}
+			switch query.Get("advertiser_id") {
+			case "adv-usd":
+				w.Write([]byte(`{"code":0,"data":{"list":[
+					{"dimensions":{"campaign_id":"c1"},"metrics":{"campaign_name":"Launch","spend":"10.00","impressions":"1000","clicks":"10","conversion":"1"}},
+					{"dimensions":{"campaign_id":"c2"},"metrics":{"campaign_name":"Retarget","spend":"30.00","impressions":"500","clicks":"20","conversion":"2"}}
+				],"page_info":{"page":1,"size":1000,"total_count":2}}}`))
+			case "adv-eur":
+				w.Write([]byte(`{"code":0,"data":{"list":[
+					{"dimensions":{"campaign_id":"c3"},"metrics":{"campaign_name":"Herbst","spend":"100.00","impressions":"2000","clicks":"40","conversion":"4"}}
+				],"page_info":{"page":1,"size":1000,"total_count":1}}}`))
+			default:
+				w.Write([]byte(`{"code":0,"data":{"list":[
+					{"dimensions":{"campaign_id":"c4"},"metrics":{"campaign_name":"Aki","spend":"1000","impressions":"100","clicks":"1","conversion":"0"}}
+				],"page_info":{"page":1,"size":1000,"total_count":1}}}`))
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	rollup, err := client.BusinessCenter().GetAgencyRollup(context.Background(), &AgencyRollupRequest{
+		StartDate:      "2026-06-01",
+		EndDate:        "2026-06-30",
+		TargetCurrency: "USD",
+		ExchangeRates:  map[string]float64{"EUR": 1.1},
+		Concurrency:    2,
+	})
+	if err != nil {
+		t.Fatalf("GetAgencyRollup() error = %v", err)
+	}
+
+	if len(rollup.BusinessCenters) != 2 {
+		t.Fatalf("business centers = %+v", rollup.BusinessCenters)
+	}
+	north, south := rollup.BusinessCenters[0], rollup.BusinessCenters[1]
+	if north.BCName != "North" || len(north.Advertisers) != 2 {
+		t.Fatalf("North = %+v", north)
+	}
+	us, eu := north.Advertisers[0], north.Advertisers[1]
+	if us.Error != "" || us.Metrics["spend"] != 40 || us.Campaigns[0].CampaignID != "c2" || us.Campaigns[0].CampaignName != "Retarget" {
+		t.Errorf("US advertiser = %+v", us)
+	}
+	if eu.Currency != "EUR" || eu.ExchangeRate != 1.1 || math.Abs(eu.Metrics["spend"]-110) > 1e-9 || eu.Metrics["clicks"] != 40 {
+		t.Errorf("EU advertiser = %+v", eu)
+	}
+	if math.Abs(north.Metrics["spend"]-150) > 1e-9 || north.Metrics["impressions"] != 3500 {
+		t.Errorf("North metrics = %v", north.Metrics)
+	}
+
+	// Without a JPY rate the advertiser is reported but left out of the totals
+	if jp := south.Advertisers[0]; jp.Error == "" || len(south.Metrics) != 0 {
+		t.Errorf("South = %+v", south)
+	}
+	if math.Abs(rollup.Metrics["spend"]-150) > 1e-9 || rollup.Metrics["conversion"] != 7 || rollup.Currency != "USD" {
+		t.Errorf("rollup metrics = %v", rollup.Metrics)
+	}
+	if maxInFlight.Load() > 2 {
+		t.Errorf("%d reports ran at once, want at most 2", maxInFlight.Load())
+	}
+
+	// Business centers outside the token's access are flagged
+	rollup, err = client.BusinessCenter().GetAgencyRollup(context.Background(), &AgencyRollupRequest{
+		BCIDs:          []string{"bc-2", "bc-9"},
+		StartDate:      "2026-06-01",
+		EndDate:        "2026-06-30",
+		TargetCurrency: "JPY",
+	})
+	if err != nil {
+		t.Fatalf("GetAgencyRollup() error = %v", err)
+	}
+	if len(rollup.BusinessCenters) != 2 || rollup.BusinessCenters[1].Error == "" || rollup.Metrics["spend"] != 1000 {
+		t.Errorf("rollup = %+v", rollup)
+	}
+}
+
+func TestGetAgencyRollupCurrencyErrors(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		advertiserID := r.URL.Query().Get("advertiser_id")
+		switch strings.TrimPrefix(r.URL.Path, "/open_api/v1.3") {
+		case "/bc/get/":
+			writeJSON(w, `{"code":0,"data":[{"bc_id":"bc-1","bc_name":"North"}]}`)
+		case "/bc/asset/get/":
+			writeJSON(w, `{"code":0,"data":[{"asset_id":"adv-usd"},{"asset_id":"adv-none"},{"asset_id":"adv-denied"}]}`)
+		case "/advertiser/balance/get/":
+			switch advertiserID {
+			case "adv-usd":
+				writeJSON(w, `{"code":0,"data":{"balance":100,"currency":"USD"}}`)
+			case "adv-none":
+				writeJSON(w, `{"code":0,"data":{"balance":100}}`)
+			default:
+				writeJSON(w, `{"code":40001,"message":"No permission to access advertiser"}`)
+			}
+		case "/report/integrated/get/":
+			if advertiserID != "adv-usd" {
+				t.Errorf("report requested for %s without a known currency", advertiserID)
+			}
+			writeJSON(w, `{"code":0,"data":{"list":[{"dimensions":{"campaign_id":"c1"},"metrics":{"campaign_name":"Launch","spend":"10.00"}}],"page_info":{"page":1,"size":1000,"total_count":1}}}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	client := newTestClient(t, server)
+
+	rollup, err := client.BusinessCenter().GetAgencyRollup(context.Background(), &AgencyRollupRequest{
+		StartDate:      "2026-06-01",
+		EndDate:        "2026-06-30",
+		TargetCurrency: "USD",
+		Metrics:        []string{"spend"},
+	})
+	if err != nil {
+		t.Fatalf("GetAgencyRollup() error = %v", err)
+	}
+
+	errs := make(map[string]string)
+	for _, advertiser := range rollup.BusinessCenters[0].Advertisers {
+		errs[advertiser.AdvertiserID] = advertiser.Error
+	}
+	want := map[string]string{
+		"adv-usd":    "",
+		"adv-none":   "currency is unknown",
+		"adv-denied": "failed to get currency: No permission to access advertiser",
+	}
+	for id, msg := range want {
+		if errs[id] != msg {
+			t.Errorf("advertiser %s error = %q, want %q", id, errs[id], msg)
+		}
+	}
+	if rollup.Metrics["spend"] != 10 {
+		t.Errorf("rollup spend = %v, want only the USD advertiser", rollup.Metrics["spend"])
+	}
+}
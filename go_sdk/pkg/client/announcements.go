@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Announcement types
+const (
+	AnnouncementDeprecation = "DEPRECATION"
+	AnnouncementChange      = "CHANGE"
+	AnnouncementNewFeature  = "NEW_FEATURE"
+)
+
+// wrappedResources are the top-level endpoint groups this SDK calls
+var wrappedResources = map[string]bool{
+	"advertiser": true, "campaign": true, "adgroup": true, "ad": true,
+	"file": true, "creative": true, "identity": true, "pixel": true,
+	"event": true, "dmp": true, "audience": true, "bc": true,
+	"catalog": true, "comment": true, "optimizer": true, "report": true,
+	"tool": true, "oauth2": true,
+}
+
+// Announcement is an entry of the Business API announcement feed
+type Announcement struct {
+	ID          string    `json:"id"`
+	Type        string    `json:"type"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	APIVersion  string    `json:"api_version,omitempty"`
+	Endpoints   []string  `json:"endpoints,omitempty"`
+	PublishedAt time.Time `json:"published_at"`
+	EffectiveAt time.Time `json:"effective_at"`
+	URL         string    `json:"url,omitempty"`
+}
+
+// AnnouncementFeed is the document served by an announcement feed or mirror
+type AnnouncementFeed struct {
+	UpdatedAt     time.Time      `json:"updated_at"`
+	Announcements []Announcement `json:"announcements"`
+}
+
+// AnnouncementService reads the Business API announcement feed and reports
+// upcoming changes to endpoints wrapped by this SDK
+type AnnouncementService struct {
+	client *Client
+	source string
+}
+
+// NewAnnouncementService creates a new AnnouncementService reading the feed
+// from source, which is an http(s) URL or the path of a local mirror file
+func NewAnnouncementService(client *Client, source string) *AnnouncementService {
+	return &AnnouncementService{client: client, source: source}
+}
+
+// Fetch retrieves the announcement feed
+func (s *AnnouncementService) Fetch(ctx context.Context) (*AnnouncementFeed, error) {
+	if s.source == "" {
+		return nil, fmt.Errorf("announcement feed source is required")
+	}
+
+	var data []byte
+	var err error
+	if strings.HasPrefix(s.source, "http://") || strings.HasPrefix(s.source, "https://") {
+		data, err = s.client.downloadFile(ctx, s.source)
+	} else {
+		data, err = os.ReadFile(s.source)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch announcement feed: %w", err)
+	}
+
+	var feed AnnouncementFeed
+	if err := json.Unmarshal(data, &feed); err != nil {
+		return nil, fmt.Errorf("failed to parse announcement feed: %w", err)
+	}
+	return &feed, nil
+}
+
+// UpcomingDeprecations returns deprecations taking effect within the given
+// window that affect endpoints wrapped by this SDK, soonest first. A zero
+// window returns every future deprecation.
+func (s *AnnouncementService) UpcomingDeprecations(ctx context.Context, within time.Duration) ([]Announcement, error) {
+	feed, err := s.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var upcoming []Announcement
+	for _, a := range feed.Announcements {
+		if a.Type != AnnouncementDeprecation || a.EffectiveAt.Before(now) {
+			continue
+		}
+		if within > 0 && a.EffectiveAt.After(now.Add(within)) {
+			continue
+		}
+		if a.AffectsSDK() {
+			upcoming = append(upcoming, a)
+		}
+	}
+
+	sort.Slice(upcoming, func(i, j int) bool { return upcoming[i].EffectiveAt.Before(upcoming[j].EffectiveAt) })
+	return upcoming, nil
+}
+
+// AffectsSDK reports whether the announcement concerns an endpoint wrapped by
+// this SDK; announcements without endpoints apply to the whole API
+func (a Announcement) AffectsSDK() bool {
+	if len(a.Endpoints) == 0 {
+		return true
+	}
+	for _, endpoint := range a.Endpoints {
+		if wrappedResources[endpointResource(endpoint)] {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointResource returns the top-level resource of an endpoint path such as
+// /open_api/v1.3/campaign/get/
+func endpointResource(endpoint string) string {
+	path := strings.Trim(endpoint, "/")
+	path = strings.TrimPrefix(path, "open_api/")
+	if strings.HasPrefix(path, "v1.") {
+		if i := strings.Index(path, "/"); i >= 0 {
+			path = path[i+1:]
+		}
+	}
+	if i := strings.Index(path, "/"); i >= 0 {
+		path = path[:i]
+	}
+	return path
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAnnouncementService_UpcomingDeprecations(t *testing.T) {
+	now := time.Now()
+	feed := AnnouncementFeed{
+		UpdatedAt: now,
+		Announcements: []Announcement{
+			{ID: "late", Type: AnnouncementDeprecation, Endpoints: []string{"/open_api/v1.3/adgroup/get/"}, EffectiveAt: now.Add(60 * 24 * time.Hour)},
+			{ID: "soon", Type: AnnouncementDeprecation, Endpoints: []string{"/campaign/update/"}, EffectiveAt: now.Add(24 * time.Hour)},
+			{ID: "past", Type: AnnouncementDeprecation, Endpoints: []string{"/campaign/get/"}, EffectiveAt: now.Add(-time.Hour)},
+			{ID: "unwrapped", Type: AnnouncementDeprecation, Endpoints: []string{"/open_api/v1.3/mentions/list/"}, EffectiveAt: now.Add(time.Hour)},
+			{ID: "feature", Type: AnnouncementNewFeature, EffectiveAt: now.Add(time.Hour)},
+		},
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(feed)
+	})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	service := NewAnnouncementService(client, server.URL)
+
+	all, err := service.UpcomingDeprecations(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("UpcomingDeprecations() error = %v", err)
+	}
+	if len(all) != 2 || all[0].ID != "soon" || all[1].ID != "late" {
+		t.Errorf("UpcomingDeprecations() = %+v, want soon then late", all)
+	}
+
+	week, err := service.UpcomingDeprecations(context.Background(), 7*24*time.Hour)
+	if err != nil {
+		t.Fatalf("UpcomingDeprecations() error = %v", err)
+	}
+	if len(week) != 1 || week[0].ID != "soon" {
+		t.Errorf("UpcomingDeprecations(7d) = %+v, want only soon", week)
+	}
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"strings"
+)
+
+// APIVersion is a version of the TikTok Business API
+type APIVersion string
+
+const (
+	// APIVersionV12 is the legacy v1.2 API
+	APIVersionV12 APIVersion = "v1.2"
+
+	// APIVersionV13 is the current v1.3 API
+	APIVersionV13 APIVersion = "v1.3"
+
+	// DefaultAPIVersion is used when Config.APIVersion is empty
+	DefaultAPIVersion = APIVersionV13
+)
+
+// apiPathPrefix is the root of all versioned API paths
+const apiPathPrefix = "/open_api/"
+
+// valid reports whether v is a supported version
+func (v APIVersion) valid() bool {
+	return v == "" || v == APIVersionV12 || v == APIVersionV13
+}
+
+// apiVersionKey overrides the API version of requests
+type apiVersionKey struct{}
+
+// WithAPIVersion returns a context whose requests use the given API version
+// instead of the one configured on the client
+func WithAPIVersion(ctx context.Context, version APIVersion) context.Context {
+	return context.WithValue(ctx, apiVersionKey{}, version)
+}
+
+// apiVersion returns the API version for a request
+func (c *Client) apiVersion(ctx context.Context) APIVersion {
+	if version, ok := ctx.Value(apiVersionKey{}).(APIVersion); ok && version != "" {
+		return version
+	}
+	if c.config.APIVersion != "" {
+		return c.config.APIVersion
+	}
+	return DefaultAPIVersion
+}
+
+// apiPath builds the versioned path of an endpoint. Endpoints are given
+// relative to the version, e.g. /campaign/get/; a version already present in
+// the endpoint is replaced.
+func apiPath(version APIVersion, endpoint string) string {
+	if rest, ok := strings.CutPrefix(endpoint, apiPathPrefix); ok {
+		endpoint = "/"
+		if i := strings.Index(rest, "/"); i >= 0 {
+			endpoint = rest[i:]
+		}
+	}
+	if !strings.HasPrefix(endpoint, "/") {
+		endpoint = "/" + endpoint
+	}
+	return apiPathPrefix + string(version) + endpoint
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_APIVersion(t *testing.T) {
+	var paths []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		writeJSON(w, `{"code":0,"data":[]}`)
+	})
+
+	client := newTestClient(t, server, func(c *Config) { c.APIVersion = APIVersionV12 })
+
+	ctx := context.Background()
+	if _, err := client.Pixel().List(ctx, &PixelGetRequest{AdvertiserID: "123"}); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if _, err := client.Account().GetAdvertisers(WithAPIVersion(ctx, APIVersionV13), &GetAdvertisersRequest{}); err != nil {
+		t.Fatalf("GetAdvertisers() error = %v", err)
+	}
+
+	want := []string{"/open_api/v1.2/pixel/list/", "/open_api/v1.3/advertiser/info/"}
+	if strings.Join(paths, " ") != strings.Join(want, " ") {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+
+	if _, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", APIVersion: "v2.0", Timeout: 5 * time.Second}); err == nil {
+		t.Error("NewClient() accepted an unsupported API version")
+	}
+}
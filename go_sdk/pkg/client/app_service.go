@@ -0,0 +1,349 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Standard app event names
+const (
+	AppEventInstall          = "InstallApp"
+	AppEventLaunch           = "LaunchAPP"
+	AppEventRegistration     = "Registration"
+	AppEventLogin            = "Login"
+	AppEventViewContent      = "ViewContent"
+	AppEventSearch           = "Search"
+	AppEventAddToCart        = "AddToCart"
+	AppEventCheckout         = "Checkout"
+	AppEventPurchase         = "Purchase"
+	AppEventSubscribe        = "Subscribe"
+	AppEventStartTrial       = "StartTrial"
+	AppEventAchieveLevel     = "AchieveLevel"
+	AppEventCompleteTutorial = "CompleteTutorial"
+	AppEventInAppADImpr      = "InAppADImpr"
+	AppEventInAppADClick     = "InAppADClick"
+)
+
+// App platforms
+const (
+	AppPlatformIOS     = "IOS"
+	AppPlatformAndroid = "ANDROID"
+)
+
+// AppService handles mobile app management and app event measurement
+type AppService struct {
+	client *Client
+}
+
+// NewAppService creates a new AppService
+func NewAppService(client *Client) *AppService {
+	return &AppService{client: client}
+}
+
+// AppInfo represents a mobile app registered with an advertiser
+type AppInfo struct {
+	AppID             string      `json:"app_id"`
+	AppName           string      `json:"app_name"`
+	Platform          string      `json:"platform"`
+	PackageName       string      `json:"package_name,omitempty"`
+	DownloadURL       string      `json:"download_url"`
+	Partner           string      `json:"partner,omitempty"`
+	TrackingURL       string      `json:"tracking_url,omitempty"`
+	TikTokAppID       string      `json:"tiktok_app_id,omitempty"`
+	CreateTime        models.Time `json:"create_time,omitempty"`
+	AttributionWindow int         `json:"attribution_window,omitempty"`
+}
+
+// AppListResponse represents the response for listing apps
+type AppListResponse struct {
+	Code      int       `json:"code"`
+	Message   string    `json:"message"`
+	RequestID string    `json:"request_id"`
+	Data      []AppInfo `json:"data"`
+}
+
+// AppCreateRequest represents the request for registering an app
+type AppCreateRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	DownloadURL  string `json:"download_url"`
+	Partner      string `json:"partner,omitempty"` // mobile measurement partner, e.g. APPSFLYER
+	TrackingURL  string `json:"tracking_url,omitempty"`
+}
+
+// AppUpdateRequest represents the request for updating an app
+type AppUpdateRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	AppID        string `json:"app_id"`
+	Partner      string `json:"partner,omitempty"`
+	TrackingURL  string `json:"tracking_url,omitempty"`
+}
+
+// AppResponse represents the response for app create and update
+type AppResponse struct {
+	Code      int     `json:"code"`
+	Message   string  `json:"message"`
+	RequestID string  `json:"request_id"`
+	Data      AppInfo `json:"data"`
+}
+
+// AppEventDevice identifies the device an app event came from. IP and user
+// agent are given on the event's User.
+type AppEventDevice struct {
+	Platform string `json:"platform"`
+	IDFA     string `json:"idfa,omitempty"`
+	IDFV     string `json:"idfv,omitempty"`
+	GAID     string `json:"gaid,omitempty"`
+
+	// LimitedAdTracking is set when the user opted out of ad tracking; the
+	// advertising ID is then not sent
+	LimitedAdTracking bool `json:"limited_ad_tracking,omitempty"`
+}
+
+// AppEvent is a single in-app event
+type AppEvent struct {
+	Event      string
+	EventTime  time.Time // defaults to now
+	EventID    string
+	Device     AppEventDevice
+	User       EventUser // hashed like web events
+	Properties *EventProperties
+}
+
+// AppEventsRequest represents the request for uploading app events
+type AppEventsRequest struct {
+	// TikTokAppID is the TikTok App ID of the registered app
+	TikTokAppID   string
+	AppName       string
+	AppVersion    string
+	Events        []AppEvent
+	TestEventCode string
+}
+
+// appEventPayload is the wire format of an app event
+type appEventPayload struct {
+	Event      string            `json:"event"`
+	EventTime  int64             `json:"event_time"`
+	EventID    string            `json:"event_id,omitempty"`
+	User       appEventUser      `json:"user"`
+	App        map[string]string `json:"app"`
+	Properties *EventProperties  `json:"properties,omitempty"`
+}
+
+// appEventUser combines the device identifiers and hashed user data
+type appEventUser struct {
+	EventUser
+	AppEventDevice
+}
+
+// GetApps lists the apps of an advertiser
+func (s *AppService) GetApps(ctx context.Context, advertiserID string) (*AppListResponse, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	url, err := s.client.BuildURL("/app/list/", map[string]interface{}{
+		"advertiser_id": advertiserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get apps: %w", err)
+	}
+
+	var response AppListResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CreateApp registers an app with an advertiser
+func (s *AppService) CreateApp(ctx context.Context, req *AppCreateRequest) (*AppResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.DownloadURL == "" {
+		return nil, fmt.Errorf("download_url is required")
+	}
+	if err := utils.ValidateURL(req.DownloadURL); err != nil {
+		return nil, err
+	}
+	return s.post(ctx, "/app/create/", req, "create app")
+}
+
+// UpdateApp updates the measurement settings of an app
+func (s *AppService) UpdateApp(ctx context.Context, req *AppUpdateRequest) (*AppResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.AppID == "" {
+		return nil, fmt.Errorf("app_id is required")
+	}
+	return s.post(ctx, "/app/update/", req, "update app")
+}
+
+// Validate checks the app ID, batch size, event names and device identifiers
+func (r *AppEventsRequest) Validate() error {
+	if r.TikTokAppID == "" {
+		return fmt.Errorf("tiktok_app_id is required")
+	}
+	if len(r.Events) == 0 {
+		return fmt.Errorf("events is required")
+	}
+	if len(r.Events) > MaxEventsPerRequest {
+		return fmt.Errorf("events cannot exceed %d per request", MaxEventsPerRequest)
+	}
+	for i, event := range r.Events {
+		if event.Event == "" {
+			return fmt.Errorf("event %d: event name is required", i)
+		}
+		if err := ValidateAppEventDevice(&event.Device); err != nil {
+			return fmt.Errorf("event %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateAppEventDevice checks the platform and the format of the device
+// identifiers. IDFA and IDFV are only valid on iOS and GAID on Android.
+func ValidateAppEventDevice(device *AppEventDevice) error {
+	switch device.Platform {
+	case AppPlatformIOS:
+		if device.GAID != "" {
+			return fmt.Errorf("gaid is only valid on Android")
+		}
+		if device.IDFA != "" && !device.LimitedAdTracking {
+			if err := utils.ValidateIDFA(device.IDFA); err != nil {
+				return err
+			}
+		}
+		if device.IDFV != "" {
+			// IDFV shares the IDFA format
+			if err := utils.ValidateIDFA(device.IDFV); err != nil {
+				return fmt.Errorf("idfv: %w", err)
+			}
+		}
+	case AppPlatformAndroid:
+		if device.IDFA != "" || device.IDFV != "" {
+			return fmt.Errorf("idfa and idfv are only valid on iOS")
+		}
+		if device.GAID != "" && !device.LimitedAdTracking {
+			if err := utils.ValidateGAID(device.GAID); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("platform must be IOS or ANDROID")
+	}
+	return nil
+}
+
+// TrackAppEvents uploads up to MaxEventsPerRequest app events
+func (s *AppService) TrackAppEvents(ctx context.Context, req *AppEventsRequest) (*TrackEventsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	app := map[string]string{"app_id": req.TikTokAppID}
+	if req.AppName != "" {
+		app["app_name"] = req.AppName
+	}
+	if req.AppVersion != "" {
+		app["app_version"] = req.AppVersion
+	}
+
+	now := time.Now()
+	payloads := make([]appEventPayload, 0, len(req.Events))
+	for _, event := range req.Events {
+		eventTime := event.EventTime
+		if eventTime.IsZero() {
+			eventTime = now
+		}
+		device := event.Device
+		if device.LimitedAdTracking {
+			device.IDFA, device.GAID = "", ""
+		}
+		// The API matches IDFA and IDFV upper case and GAID lower case
+		device.IDFA = strings.ToUpper(device.IDFA)
+		device.IDFV = strings.ToUpper(device.IDFV)
+		device.GAID = strings.ToLower(device.GAID)
+		payloads = append(payloads, appEventPayload{
+			Event:      event.Event,
+			EventTime:  eventTime.Unix(),
+			EventID:    event.EventID,
+			User:       appEventUser{EventUser: event.User.Hashed(), AppEventDevice: device},
+			App:        app,
+			Properties: event.Properties,
+		})
+	}
+
+	body := map[string]interface{}{
+		"event_source":    "app",
+		"event_source_id": req.TikTokAppID,
+		"data":            payloads,
+	}
+	if req.TestEventCode != "" {
+		body["test_event_code"] = req.TestEventCode
+	}
+
+	url, err := s.client.BuildURL("/event/track/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(data)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to track app events: %w", err)
+	}
+
+	var response TrackEventsResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// post sends an app management request
+func (s *AppService) post(ctx context.Context, endpoint string, req interface{}, action string) (*AppResponse, error) {
+	url, err := s.client.BuildURL(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	var response AppResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+func TestAppService_TrackAppEvents(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/event/track/" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		var body struct {
+			EventSource   string            `json:"event_source"`
+			EventSourceID string            `json:"event_source_id"`
+			Data          []appEventPayload `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body.EventSource != "app" || body.EventSourceID != "7000000000" {
+			t.Errorf("event_source = %q, event_source_id = %q", body.EventSource, body.EventSourceID)
+		}
+		user := body.Data[0].User
+		if user.IDFA != "6D92078A-8246-4BA4-AE5B-76104861E7DC" || user.Email != utils.HashSHA256("jane@example.com") {
+			t.Errorf("user = %+v, want upper case IDFA and hashed email", user)
+		}
+		if body.Data[1].User.GAID != "" {
+			t.Errorf("GAID sent under limited ad tracking: %q", body.Data[1].User.GAID)
+		}
+		if body.Data[0].App["app_id"] != "7000000000" {
+			t.Errorf("app = %v", body.Data[0].App)
+		}
+		writeJSON(w, `{"code":0,"message":"OK"}`)
+	})
+
+	client := newTestClient(t, server)
+
+	req := &AppEventsRequest{
+		TikTokAppID: "7000000000",
+		Events: []AppEvent{
+			{
+				Event:  AppEventPurchase,
+				Device: AppEventDevice{Platform: AppPlatformIOS, IDFA: "6d92078a-8246-4ba4-ae5b-76104861e7dc"},
+				User:   EventUser{Email: "Jane@Example.com"},
+			},
+			{
+				Event:  AppEventInstall,
+				Device: AppEventDevice{Platform: AppPlatformAndroid, GAID: "00000000-0000-0000-0000-000000000000", LimitedAdTracking: true},
+			},
+		},
+	}
+	if _, err := client.App().TrackAppEvents(context.Background(), req); err != nil {
+		t.Fatalf("TrackAppEvents() error = %v", err)
+	}
+
+	invalid := []AppEventDevice{
+		{Platform: AppPlatformIOS, IDFA: "not-an-idfa"},
+		{Platform: AppPlatformIOS, IDFA: "00000000-0000-0000-0000-000000000000"},
+		{Platform: AppPlatformAndroid, IDFA: "6D92078A-8246-4BA4-AE5B-76104861E7DC"},
+		{Platform: "WEB"},
+	}
+	for _, device := range invalid {
+		req := &AppEventsRequest{TikTokAppID: "7000000000", Events: []AppEvent{{Event: AppEventLaunch, Device: device}}}
+		if _, err := client.App().TrackAppEvents(context.Background(), req); err == nil {
+			t.Errorf("TrackAppEvents() accepted device %+v", device)
+		}
+	}
+}
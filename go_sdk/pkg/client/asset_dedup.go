@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// AssetHashStore remembers the asset uploaded for each content hash
+type AssetHashStore interface {
+	// LookupAsset returns the asset ID recorded for a content hash
+	LookupAsset(advertiserID, kind, hash string) (string, bool)
+
+	// StoreAsset records the asset ID of a content hash
+	StoreAsset(advertiserID, kind, hash, assetID string)
+}
+
+// MemoryAssetHashStore is an in-memory AssetHashStore
+type MemoryAssetHashStore struct {
+	mu     sync.RWMutex
+	assets map[string]string
+}
+
+// NewMemoryAssetHashStore creates an empty MemoryAssetHashStore
+func NewMemoryAssetHashStore() *MemoryAssetHashStore {
+	return &MemoryAssetHashStore{assets: make(map[string]string)}
+}
+
+// LookupAsset implements AssetHashStore
+func (s *MemoryAssetHashStore) LookupAsset(advertiserID, kind, hash string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	id, ok := s.assets[assetHashKey(advertiserID, kind, hash)]
+	return id, ok
+}
+
+// StoreAsset implements AssetHashStore
+func (s *MemoryAssetHashStore) StoreAsset(advertiserID, kind, hash, assetID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.assets[assetHashKey(advertiserID, kind, hash)] = assetID
+}
+
+// assetHashKey identifies an asset of an advertiser by content
+func assetHashKey(advertiserID, kind, hash string) string {
+	return advertiserID + "/" + kind + "/" + hash
+}
+
+// AssetDeduplicator uploads images and videos only when the advertiser's
+// library does not already hold the same content. Assets are matched by the
+// MD5 signature the API records for every upload, so assets uploaded by
+// earlier runs are found as well.
+type AssetDeduplicator struct {
+	client *Client
+	store  AssetHashStore
+
+	mu       sync.Mutex
+	loaded   map[string]*sync.Once
+	loadErrs map[string]error
+	inflight map[string]chan struct{}
+}
+
+// NewAssetDeduplicator creates an AssetDeduplicator. A nil store keeps hashes
+// in memory.
+func NewAssetDeduplicator(client *Client, store AssetHashStore) *AssetDeduplicator {
+	if store == nil {
+		store = NewMemoryAssetHashStore()
+	}
+	return &AssetDeduplicator{
+		client:   client,
+		store:    store,
+		loaded:   make(map[string]*sync.Once),
+		loadErrs: make(map[string]error),
+		inflight: make(map[string]chan struct{}),
+	}
+}
+
+// UploadImage uploads an image unless identical content exists, returning
+// the asset ID and whether it was an existing asset
+func (d *AssetDeduplicator) UploadImage(ctx context.Context, req *ImageUploadRequest) (string, bool, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return "", false, fmt.Errorf("advertiser_id is required")
+	}
+	return d.upload(ctx, req.AdvertiserID, CreativeKindImage, req.ImageData, func() (string, error) {
+		resp, err := d.client.Creative().UploadImage(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		if resp.Code != 0 {
+			return "", fmt.Errorf("API error: %s", resp.Message)
+		}
+		return resp.Data.ImageID, nil
+	})
+}
+
+// UploadVideo uploads a video unless identical content exists, returning
+// the asset ID and whether it was an existing asset
+func (d *AssetDeduplicator) UploadVideo(ctx context.Context, req *VideoUploadRequest) (string, bool, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return "", false, fmt.Errorf("advertiser_id is required")
+	}
+	return d.upload(ctx, req.AdvertiserID, CreativeKindVideo, req.VideoData, func() (string, error) {
+		resp, err := d.client.Creative().UploadVideo(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		if resp.Code != 0 {
+			return "", fmt.Errorf("API error: %s", resp.Message)
+		}
+		return resp.Data.VideoID, nil
+	})
+}
+
+// upload returns the existing asset for data or runs upload, making sure
+// concurrent uploads of the same content upload it once
+func (d *AssetDeduplicator) upload(ctx context.Context, advertiserID, kind string, data []byte, upload func() (string, error)) (string, bool, error) {
+	if err := d.loadLibrary(ctx, advertiserID, kind); err != nil {
+		return "", false, err
+	}
+
+	sum := md5.Sum(data)
+	hash := hex.EncodeToString(sum[:])
+	key := assetHashKey(advertiserID, kind, hash)
+
+	for {
+		if id, ok := d.store.LookupAsset(advertiserID, kind, hash); ok {
+			return id, true, nil
+		}
+
+		d.mu.Lock()
+		wait, busy := d.inflight[key]
+		if !busy {
+			done := make(chan struct{})
+			d.inflight[key] = done
+			d.mu.Unlock()
+
+			id, err := upload()
+			if err == nil {
+				d.store.StoreAsset(advertiserID, kind, hash, id)
+			}
+
+			d.mu.Lock()
+			delete(d.inflight, key)
+			d.mu.Unlock()
+			close(done)
+			return id, false, err
+		}
+		d.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return "", false, ctx.Err()
+		}
+	}
+}
+
+// loadLibrary records the signatures of the advertiser's existing assets of
+// a kind, once per deduplicator
+func (d *AssetDeduplicator) loadLibrary(ctx context.Context, advertiserID, kind string) error {
+	key := advertiserID + "/" + kind
+
+	d.mu.Lock()
+	once, ok := d.loaded[key]
+	if !ok {
+		once = new(sync.Once)
+		d.loaded[key] = once
+	}
+	d.mu.Unlock()
+
+	once.Do(func() {
+		err := d.searchLibrary(ctx, advertiserID, kind)
+		d.mu.Lock()
+		d.loadErrs[key] = err
+		if err != nil {
+			// Let a later upload try again
+			delete(d.loaded, key)
+		}
+		d.mu.Unlock()
+	})
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.loadErrs[key]; err != nil {
+		return fmt.Errorf("failed to list existing %s assets: %w", kind, err)
+	}
+	return nil
+}
+
+// assetSearchResponse is a page of the image or video library
+type assetSearchResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		List []struct {
+			ImageID   string `json:"image_id"`
+			VideoID   string `json:"video_id"`
+			Signature string `json:"signature"`
+		} `json:"list"`
+		PageInfo struct {
+			Page      int `json:"page"`
+			TotalPage int `json:"total_page"`
+		} `json:"page_info"`
+	} `json:"data"`
+}
+
+// searchLibrary pages through the image or video library of an advertiser
+func (d *AssetDeduplicator) searchLibrary(ctx context.Context, advertiserID, kind string) error {
+	endpoint := "/file/image/ad/search/"
+	if kind == CreativeKindVideo {
+		endpoint = "/file/video/ad/search/"
+	}
+
+	for page := 1; ; page++ {
+		url, err := d.client.BuildURL(endpoint, map[string]interface{}{
+			"advertiser_id": advertiserID,
+			"page":          page,
+			"page_size":     100,
+		})
+		if err != nil {
+			return err
+		}
+		resp, err := d.client.DoRequest(ctx, "GET", url, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var response assetSearchResponse
+		if err := d.client.ParseResponse(resp, &response); err != nil {
+			return err
+		}
+		if response.Code != 0 {
+			return fmt.Errorf("API error: %s", response.Message)
+		}
+
+		for _, asset := range response.Data.List {
+			id := asset.ImageID
+			if kind == CreativeKindVideo {
+				id = asset.VideoID
+			}
+			if id != "" && asset.Signature != "" {
+				d.store.StoreAsset(advertiserID, kind, strings.ToLower(asset.Signature), id)
+			}
+		}
+		if page >= response.Data.PageInfo.TotalPage {
+			return nil
+		}
+	}
+}
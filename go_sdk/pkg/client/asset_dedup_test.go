@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAssetDeduplicator(t *testing.T) {
+	existing := []byte("existing image")
+	sum := md5.Sum(existing)
+	var uploads atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/file/image/ad/search/":
+			fmt.Fprintf(w, `{"code":0,"data":{"list":[{"image_id":"img-old","signature":"%s"}],"page_info":{"page":1,"total_page":1}}}`, hex.EncodeToString(sum[:]))
+		case "/open_api/v1.3/file/image/ad/upload/":
+			uploads.Add(1)
+			w.Write([]byte(`{"code":0,"data":{"image_id":"img-new"}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+	dedup := NewAssetDeduplicator(client, nil)
+	ctx := context.Background()
+
+	id, duplicate, err := dedup.UploadImage(ctx, &ImageUploadRequest{AdvertiserID: "123", ImageData: existing, ImageName: "a"})
+	if err != nil || id != "img-old" || !duplicate {
+		t.Errorf("UploadImage(existing) = %s, %v, %v; want img-old duplicate", id, duplicate, err)
+	}
+
+	// Concurrent uploads of new content upload it once
+	results := make(chan string, 3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			id, _, err := dedup.UploadImage(ctx, &ImageUploadRequest{AdvertiserID: "123", ImageData: []byte("new image"), ImageName: "b"})
+			if err != nil {
+				t.Errorf("UploadImage(new) error = %v", err)
+			}
+			results <- id
+		}()
+	}
+	for i := 0; i < 3; i++ {
+		if id := <-results; id != "img-new" {
+			t.Errorf("UploadImage(new) = %s, want img-new", id)
+		}
+	}
+	if got := uploads.Load(); got != 1 {
+		t.Errorf("uploaded %d times, want 1", got)
+	}
+}
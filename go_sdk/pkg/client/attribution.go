@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// AttributionAnalyticsRequest represents the request for attribution analytics
+type AttributionAnalyticsRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+
+	// CampaignIDs and AdGroupIDs limit the analytics; every ad group is
+	// reported when both are empty
+	CampaignIDs []string `json:"campaign_ids,omitempty"`
+	AdGroupIDs  []string `json:"adgroup_ids,omitempty"`
+
+	StartDate string `json:"start_date"` // YYYY-MM-DD
+	EndDate   string `json:"end_date"`
+}
+
+// AdGroupAttributionStats is an ad group's attribution settings with its
+// conversions split by how they were attributed
+type AdGroupAttributionStats struct {
+	AdGroupID   string `json:"adgroup_id"`
+	AdGroupName string `json:"adgroup_name"`
+	AdGroupAttribution
+
+	Conversions             float64 `json:"conversions"`
+	ClickThroughConversions float64 `json:"click_through_conversions"`
+	ViewThroughConversions  float64 `json:"view_through_conversions"`
+}
+
+// ViewThroughShare returns the fraction of attributed conversions credited
+// to views rather than clicks
+func (s AdGroupAttributionStats) ViewThroughShare() float64 {
+	total := s.ClickThroughConversions + s.ViewThroughConversions
+	if total == 0 {
+		return 0
+	}
+	return s.ViewThroughConversions / total
+}
+
+// AttributionAnalytics holds the attribution of an advertiser's ad groups
+// over a date range
+type AttributionAnalytics struct {
+	AdvertiserID string                    `json:"advertiser_id"`
+	StartDate    string                    `json:"start_date"`
+	EndDate      string                    `json:"end_date"`
+	AdGroups     []AdGroupAttributionStats `json:"adgroups"`
+}
+
+// GetAttributionAnalytics reports click-through and view-through conversions
+// per ad group, reading every page of the report, alongside each ad group's
+// attribution windows. Ad groups are returned most conversions first.
+func (r *reportingService) GetAttributionAnalytics(ctx context.Context, req *AttributionAnalyticsRequest) (*AttributionAnalytics, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var filters []ReportingFilter
+	for _, filter := range []struct {
+		field string
+		ids   []string
+	}{{"campaign_ids", req.CampaignIDs}, {"adgroup_ids", req.AdGroupIDs}} {
+		if len(filter.ids) == 0 {
+			continue
+		}
+		ids, err := json.Marshal(filter.ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %w", filter.field, err)
+		}
+		filters = append(filters, ReportingFilter{FieldName: filter.field, FilterType: "IN", FilterValue: string(ids)})
+	}
+
+	analytics := &AttributionAnalytics{AdvertiserID: req.AdvertiserID, StartDate: req.StartDate, EndDate: req.EndDate}
+	for page := 1; ; page++ {
+		resp, err := r.GetIntegratedReport(ctx, &ReportingRequest{
+			AdvertiserID: req.AdvertiserID,
+			ReportType:   models.ReportTypeBasic,
+			DataLevel:    models.DataLevelAdGroup,
+			Dimensions:   []models.Dimension{models.DimensionAdGroupID},
+			Metrics:      []models.Metric{models.MetricAdGroupName, models.MetricConversion, models.MetricCTAConversion, models.MetricVTAConversion},
+			Filters:      filters,
+			StartDate:    req.StartDate,
+			EndDate:      req.EndDate,
+			Page:         page,
+			PageSize:     1000,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attribution report: %w", err)
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+		for _, row := range resp.Data.List {
+			analytics.AdGroups = append(analytics.AdGroups, AdGroupAttributionStats{
+				AdGroupID:               row.Dimensions.AdGroupID,
+				AdGroupName:             row.Metrics.AdGroupName,
+				Conversions:             float64(row.Metrics.Conversion),
+				ClickThroughConversions: float64(row.Metrics.CTAConversion),
+				ViewThroughConversions:  float64(row.Metrics.VTAConversion),
+			})
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			break
+		}
+	}
+
+	if err := r.addAttributionSettings(ctx, req.AdvertiserID, analytics.AdGroups); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(analytics.AdGroups, func(i, j int) bool {
+		return analytics.AdGroups[i].Conversions > analytics.AdGroups[j].Conversions
+	})
+	return analytics, nil
+}
+
+// addAttributionSettings fills in the attribution windows of reported ad
+// groups, looking them up in batches of MaxAdGroupStatusIDs
+func (r *reportingService) addAttributionSettings(ctx context.Context, advertiserID string, stats []AdGroupAttributionStats) error {
+	index := make(map[string]int, len(stats))
+	ids := make([]string, 0, len(stats))
+	for i, s := range stats {
+		index[s.AdGroupID] = i
+		ids = append(ids, s.AdGroupID)
+	}
+
+	for start := 0; start < len(ids); start += MaxAdGroupStatusIDs {
+		batch := ids[start:min(start+MaxAdGroupStatusIDs, len(ids))]
+		resp, err := r.client.AdGroup().Get(ctx, &AdGroupGetRequest{
+			AdvertiserID: advertiserID,
+			Fields:       []string{"adgroup_id", "click_attribution_window", "view_attribution_window", "attribution_event_count"},
+			Filtering:    &AdGroupFiltering{AdGroupIDs: batch},
+			PageSize:     len(batch),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get attribution settings: %w", err)
+		}
+		if resp.Code != 0 {
+			return models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+		for _, adGroup := range resp.Data {
+			if i, ok := index[adGroup.AdGroupID]; ok {
+				stats[i].AdGroupAttribution = adGroup.AdGroupAttribution
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the advertiser and date range
+func (r *AttributionAnalyticsRequest) Validate() error {
+	if r.AdvertiserID == "" {
+		return models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+	if r.StartDate == "" || r.EndDate == "" {
+		return models.NewValidationError("start_date", "start_date and end_date are required")
+	}
+	return nil
+}
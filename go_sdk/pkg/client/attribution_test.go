@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestAttributionAnalytics(t *testing.T) {
+	var adGroupFields string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/adgroup/get/") {
+			adGroupFields = r.URL.Query().Get("fields")
+			w.Write([]byte(`{"code":0,"data":[
+				{"adgroup_id":"g1","click_attribution_window":"SEVEN_DAYS","view_attribution_window":"ONE_DAY","attribution_event_count":"EVERY"},
+				{"adgroup_id":"g2","click_attribution_window":"TWENTY_EIGHT_DAYS","view_attribution_window":"OFF"}]}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"data":{"page_info":{"page":1,"total_page":1},"list":[
+			{"dimensions":{"adgroup_id":"g2"},"metrics":{"adgroup_name":"Prospecting","conversion":"10","cta_conversion":"10","vta_conversion":"0"}},
+			{"dimensions":{"adgroup_id":"g1"},"metrics":{"adgroup_name":"Retargeting","conversion":"40","cta_conversion":"30","vta_conversion":"10"}}]}}`))
+	})
+
+	client := newTestClient(t, server)
+	analytics, err := client.Reporting().GetAttributionAnalytics(context.Background(), &AttributionAnalyticsRequest{
+		AdvertiserID: "123",
+		StartDate:    "2026-06-01",
+		EndDate:      "2026-06-30",
+	})
+	if err != nil {
+		t.Fatalf("GetAttributionAnalytics() error = %v", err)
+	}
+	if !strings.Contains(adGroupFields, "click_attribution_window") {
+		t.Errorf("adgroup fields = %s", adGroupFields)
+	}
+	if len(analytics.AdGroups) != 2 {
+		t.Fatalf("adgroups = %+v", analytics.AdGroups)
+	}
+	if g := analytics.AdGroups[0]; g.AdGroupID != "g1" || g.AdGroupName != "Retargeting" || g.ViewThroughShare() != 0.25 ||
+		g.ClickAttributionWindow != models.AttributionWindowSevenDays || g.AttributionEventCount != models.AttributionEventCountEvery {
+		t.Errorf("adgroups[0] = %+v", g)
+	}
+	if g := analytics.AdGroups[1]; g.ViewAttributionWindow != models.AttributionWindowOff || g.ViewThroughShare() != 0 {
+		t.Errorf("adgroups[1] = %+v", g)
+	}
+
+	update := &AdGroupUpdateRequest{AdvertiserID: "123", AdGroupID: "g1"}
+	for attribution, valid := range map[AdGroupAttribution]bool{
+		{ClickAttributionWindow: models.AttributionWindowTwentyEightDays, ViewAttributionWindow: models.AttributionWindowSevenDays}: true,
+		{AttributionEventCount: models.AttributionEventCountOnce}:                                                                   true,
+		{ViewAttributionWindow: models.AttributionWindowFourteenDays}:                                                               false,
+		{ClickAttributionWindow: models.AttributionWindowOff, ViewAttributionWindow: models.AttributionWindowOff}:                   false,
+		{AttributionEventCount: "FIRST"}: false,
+	} {
+		update.AdGroupAttribution = attribution
+		if err := update.Validate(); (err == nil) != valid {
+			t.Errorf("Validate(%+v) error = %v, want valid %v", attribution, err, valid)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// AudienceBreakdown is an audience attribute performance can be split by
+type AudienceBreakdown string
+
+const (
+	AudienceBreakdownAge      AudienceBreakdown = "age"
+	AudienceBreakdownGender   AudienceBreakdown = "gender"
+	AudienceBreakdownCountry  AudienceBreakdown = "country"
+	AudienceBreakdownInterest AudienceBreakdown = "interest"
+	AudienceBreakdownDevice   AudienceBreakdown = "device"
+)
+
+// audienceBreakdowns maps each breakdown to its report dimension and the
+// row field holding its value
+var audienceBreakdowns = map[AudienceBreakdown]struct {
+	dimension models.Dimension
+	value     func(ReportingDimensions) string
+}{
+	AudienceBreakdownAge:      {models.DimensionAge, func(d ReportingDimensions) string { return d.Age }},
+	AudienceBreakdownGender:   {models.DimensionGender, func(d ReportingDimensions) string { return d.Gender }},
+	AudienceBreakdownCountry:  {models.DimensionCountryCode, func(d ReportingDimensions) string { return d.CountryCode }},
+	AudienceBreakdownInterest: {models.DimensionInterestCategory, func(d ReportingDimensions) string { return d.InterestCategory }},
+	AudienceBreakdownDevice:   {models.DimensionPlatform, func(d ReportingDimensions) string { return d.Platform }},
+}
+
+// AllAudienceBreakdowns are reported when AudienceInsightsRequest.Breakdowns is empty
+var AllAudienceBreakdowns = []AudienceBreakdown{
+	AudienceBreakdownAge,
+	AudienceBreakdownGender,
+	AudienceBreakdownCountry,
+	AudienceBreakdownInterest,
+	AudienceBreakdownDevice,
+}
+
+// AudienceInsightsRequest represents the request for audience insights
+type AudienceInsightsRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+
+	// CampaignIDs limits the insights to some campaigns
+	CampaignIDs []string `json:"campaign_ids,omitempty"`
+
+	// Breakdowns to report, each as a separate report (defaults to
+	// AllAudienceBreakdowns)
+	Breakdowns []AudienceBreakdown `json:"breakdowns,omitempty"`
+
+	StartDate string `json:"start_date"` // YYYY-MM-DD
+	EndDate   string `json:"end_date"`
+}
+
+// AudienceSegment is the performance of one value of a breakdown, such as
+// the 25-34 age group
+type AudienceSegment struct {
+	Value       string  `json:"value"`
+	Spend       float64 `json:"spend"`
+	Impressions float64 `json:"impressions"`
+	Clicks      float64 `json:"clicks"`
+	Conversions float64 `json:"conversions"`
+
+	// SpendShare and ImpressionShare are fractions of the breakdown's totals
+	SpendShare      float64 `json:"spend_share"`
+	ImpressionShare float64 `json:"impression_share"`
+}
+
+// CTR returns clicks per impression
+func (s AudienceSegment) CTR() float64 {
+	if s.Impressions == 0 {
+		return 0
+	}
+	return s.Clicks / s.Impressions
+}
+
+// CostPerConversion returns spend per conversion, or zero without conversions
+func (s AudienceSegment) CostPerConversion() float64 {
+	if s.Conversions == 0 {
+		return 0
+	}
+	return s.Spend / s.Conversions
+}
+
+// AudienceInsights holds an advertiser's performance split by audience
+// attributes. Each breakdown covers all delivery, so the segments of one
+// breakdown add up to the same totals.
+type AudienceInsights struct {
+	AdvertiserID string                                  `json:"advertiser_id"`
+	StartDate    string                                  `json:"start_date"`
+	EndDate      string                                  `json:"end_date"`
+	Breakdowns   map[AudienceBreakdown][]AudienceSegment `json:"breakdowns"`
+}
+
+// GetAudienceInsights runs an audience report for each breakdown, reading
+// every page, and returns the segments of each by spend
+func (r *reportingService) GetAudienceInsights(ctx context.Context, req *AudienceInsightsRequest) (*AudienceInsights, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	breakdowns := req.Breakdowns
+	if len(breakdowns) == 0 {
+		breakdowns = AllAudienceBreakdowns
+	}
+
+	var filters []ReportingFilter
+	if len(req.CampaignIDs) > 0 {
+		ids, err := json.Marshal(req.CampaignIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal campaign_ids: %w", err)
+		}
+		filters = append(filters, ReportingFilter{FieldName: "campaign_ids", FilterType: "IN", FilterValue: string(ids)})
+	}
+
+	insights := &AudienceInsights{
+		AdvertiserID: req.AdvertiserID,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		Breakdowns:   make(map[AudienceBreakdown][]AudienceSegment, len(breakdowns)),
+	}
+	for _, breakdown := range breakdowns {
+		spec := audienceBreakdowns[breakdown]
+		var segments []AudienceSegment
+		for page := 1; ; page++ {
+			resp, err := r.GetAudienceReports(ctx, &AudienceReportingRequest{ReportingRequest: ReportingRequest{
+				AdvertiserID: req.AdvertiserID,
+				DataLevel:    models.DataLevelAdvertiser,
+				Dimensions:   []models.Dimension{models.DimensionAdvertiserID, spec.dimension},
+				Metrics:      []models.Metric{models.MetricSpend, models.MetricImpressions, models.MetricClicks, models.MetricConversion},
+				Filters:      filters,
+				StartDate:    req.StartDate,
+				EndDate:      req.EndDate,
+				Page:         page,
+				PageSize:     1000,
+			}})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get %s breakdown: %w", breakdown, err)
+			}
+			if resp.Code != 0 {
+				return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+			}
+			for _, row := range resp.Data.List {
+				segments = append(segments, AudienceSegment{
+					Value:       spec.value(row.Dimensions),
+					Spend:       float64(row.Metrics.Spend),
+					Impressions: float64(row.Metrics.Impressions),
+					Clicks:      float64(row.Metrics.Clicks),
+					Conversions: float64(row.Metrics.Conversion),
+				})
+			}
+			if page >= resp.Data.PageInfo.TotalPage {
+				break
+			}
+		}
+		insights.Breakdowns[breakdown] = MergeAudienceSegments(segments)
+	}
+	return insights, nil
+}
+
+// Segments returns the segments of a breakdown, most spend first
+func (i *AudienceInsights) Segments(breakdown AudienceBreakdown) []AudienceSegment {
+	return i.Breakdowns[breakdown]
+}
+
+// Top returns up to n segments of a breakdown ranked by a metric: spend,
+// impressions, clicks, conversions or ctr
+func (i *AudienceInsights) Top(breakdown AudienceBreakdown, metric string, n int) []AudienceSegment {
+	segments := append([]AudienceSegment(nil), i.Breakdowns[breakdown]...)
+	value := func(s AudienceSegment) float64 {
+		switch metric {
+		case "impressions":
+			return s.Impressions
+		case "clicks":
+			return s.Clicks
+		case "conversions":
+			return s.Conversions
+		case "ctr":
+			return s.CTR()
+		}
+		return s.Spend
+	}
+	sort.SliceStable(segments, func(a, b int) bool { return value(segments[a]) > value(segments[b]) })
+	if n > 0 && len(segments) > n {
+		segments = segments[:n]
+	}
+	return segments
+}
+
+// Merge adds the segments of other, such as another advertiser or period,
+// to the insights, recomputing shares
+func (i *AudienceInsights) Merge(other *AudienceInsights) {
+	if i.Breakdowns == nil {
+		i.Breakdowns = make(map[AudienceBreakdown][]AudienceSegment)
+	}
+	for breakdown, segments := range other.Breakdowns {
+		i.Breakdowns[breakdown] = MergeAudienceSegments(i.Breakdowns[breakdown], segments)
+	}
+}
+
+// MergeAudienceSegments sums segments with the same value and recomputes
+// their shares of the total, returning them most spend first
+func MergeAudienceSegments(lists ...[]AudienceSegment) []AudienceSegment {
+	index := make(map[string]int)
+	var merged []AudienceSegment
+	var spend, impressions float64
+	for _, list := range lists {
+		for _, segment := range list {
+			i, ok := index[segment.Value]
+			if !ok {
+				i = len(merged)
+				index[segment.Value] = i
+				merged = append(merged, AudienceSegment{Value: segment.Value})
+			}
+			merged[i].Spend += segment.Spend
+			merged[i].Impressions += segment.Impressions
+			merged[i].Clicks += segment.Clicks
+			merged[i].Conversions += segment.Conversions
+			spend += segment.Spend
+			impressions += segment.Impressions
+		}
+	}
+
+	for i := range merged {
+		merged[i].SpendShare, merged[i].ImpressionShare = 0, 0
+		if spend > 0 {
+			merged[i].SpendShare = merged[i].Spend / spend
+		}
+		if impressions > 0 {
+			merged[i].ImpressionShare = merged[i].Impressions / impressions
+		}
+	}
+	sort.SliceStable(merged, func(a, b int) bool { return merged[a].Spend > merged[b].Spend })
+	return merged
+}
+
+// Validate checks the advertiser, breakdowns and date range
+func (r *AudienceInsightsRequest) Validate() error {
+	if r.AdvertiserID == "" {
+		return models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+	for _, breakdown := range r.Breakdowns {
+		if _, ok := audienceBreakdowns[breakdown]; !ok {
+			return models.NewValidationError("breakdowns", fmt.Sprintf("unknown breakdown %s", breakdown))
+		}
+	}
+	if r.StartDate == "" || r.EndDate == "" {
+		return models.NewValidationError("start_date", "start_date and end_date are required")
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAudienceInsights(t *testing.T) {
+	var reports []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		reports = append(reports, query.Get("report_type")+" "+query.Get("dimensions"))
+		switch {
+		case strings.Contains(query.Get("dimensions"), "gender"):
+			w.Write([]byte(`{"code":0,"data":{"page_info":{"page":1,"total_page":1},"list":[
+				{"dimensions":{"gender":"MALE"},"metrics":{"spend":"60.00","impressions":"3000","clicks":"30","conversion":"3"}},
+				{"dimensions":{"gender":"FEMALE"},"metrics":{"spend":"140.00","impressions":"5000","clicks":"100","conversion":"7"}}]}}`))
+		case query.Get("page") == "1":
+			w.Write([]byte(`{"code":0,"data":{"page_info":{"page":1,"total_page":2},"list":[
+				{"dimensions":{"age":"AGE_18_24"},"metrics":{"spend":"50.00","impressions":"2000","clicks":"40","conversion":"2"}}]}}`))
+		default:
+			w.Write([]byte(`{"code":0,"data":{"page_info":{"page":2,"total_page":2},"list":[
+				{"dimensions":{"age":"AGE_25_34"},"metrics":{"spend":"150.00","impressions":"6000","clicks":"90","conversion":"8"}}]}}`))
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	insights, err := client.Reporting().GetAudienceInsights(context.Background(), &AudienceInsightsRequest{
+		AdvertiserID: "123",
+		Breakdowns:   []AudienceBreakdown{AudienceBreakdownAge, AudienceBreakdownGender},
+		StartDate:    "2026-06-01",
+		EndDate:      "2026-06-30",
+	})
+	if err != nil {
+		t.Fatalf("GetAudienceInsights() error = %v", err)
+	}
+	if len(reports) != 3 || !strings.HasPrefix(reports[0], "AUDIENCE") || !strings.Contains(reports[0], "age") {
+		t.Errorf("reports = %v", reports)
+	}
+
+	age := insights.Segments(AudienceBreakdownAge)
+	if len(age) != 2 || age[0].Value != "AGE_25_34" || age[0].SpendShare != 0.75 || age[0].ImpressionShare != 0.75 || age[0].CostPerConversion() != 18.75 {
+		t.Fatalf("age = %+v", age)
+	}
+	gender := insights.Segments(AudienceBreakdownGender)
+	if len(gender) != 2 || gender[0].Value != "FEMALE" || gender[0].SpendShare != 0.7 || gender[1].CTR() != 0.01 {
+		t.Fatalf("gender = %+v", gender)
+	}
+	if top := insights.Top(AudienceBreakdownAge, "ctr", 1); len(top) != 1 || top[0].Value != "AGE_18_24" {
+		t.Errorf("Top(ctr) = %+v", top)
+	}
+
+	insights.Merge(&AudienceInsights{Breakdowns: map[AudienceBreakdown][]AudienceSegment{
+		AudienceBreakdownGender: {{Value: "MALE", Spend: 200, Impressions: 2000}},
+	}})
+	if gender := insights.Segments(AudienceBreakdownGender); gender[0].Value != "MALE" || gender[0].Spend != 260 || gender[0].SpendShare != 0.65 {
+		t.Errorf("merged gender = %+v", gender)
+	}
+
+	if _, err := client.Reporting().GetAudienceInsights(context.Background(), &AudienceInsightsRequest{
+		AdvertiserID: "123",
+		Breakdowns:   []AudienceBreakdown{"income"},
+		StartDate:    "2026-06-01",
+		EndDate:      "2026-06-30",
+	}); err == nil {
+		t.Error("GetAudienceInsights() with an unknown breakdown should fail")
+	}
+}
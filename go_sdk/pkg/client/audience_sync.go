@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// DefaultAudienceSyncBatchSize is the number of identifiers sent per apply call
+const DefaultAudienceSyncBatchSize = 10000
+
+// AudienceMemberStore persists the member set last uploaded to a custom audience.
+// TikTok does not expose audience membership, so the SDK tracks it client-side.
+type AudienceMemberStore interface {
+	// Load returns the previously uploaded members for an audience
+	Load(ctx context.Context, audienceID string) ([]string, error)
+
+	// Save records the members currently uploaded to an audience
+	Save(ctx context.Context, audienceID string, members []string) error
+}
+
+// memoryAudienceMemberStore keeps audience members in process memory
+type memoryAudienceMemberStore struct {
+	mu      sync.RWMutex
+	members map[string][]string
+}
+
+// NewMemoryAudienceMemberStore creates an in-memory AudienceMemberStore
+func NewMemoryAudienceMemberStore() AudienceMemberStore {
+	return &memoryAudienceMemberStore{members: make(map[string][]string)}
+}
+
+// Load returns the previously uploaded members for an audience
+func (m *memoryAudienceMemberStore) Load(ctx context.Context, audienceID string) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return append([]string(nil), m.members[audienceID]...), nil
+}
+
+// Save records the members currently uploaded to an audience
+func (m *memoryAudienceMemberStore) Save(ctx context.Context, audienceID string, members []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.members[audienceID] = append([]string(nil), members...)
+	return nil
+}
+
+// AudienceSyncRequest describes the desired member set of a custom audience
+type AudienceSyncRequest struct {
+	AdvertiserID     string
+	CustomAudienceID string
+
+	// Members is the complete desired set of hashed identifiers
+	Members []string
+
+	// BatchSize caps the identifiers per apply call (defaults to DefaultAudienceSyncBatchSize)
+	BatchSize int
+
+	// Store overrides the service's in-memory member store
+	Store AudienceMemberStore
+}
+
+// AudienceSyncResult summarizes the changes applied by SyncAudienceMembers
+type AudienceSyncResult struct {
+	Added     int
+	Removed   int
+	Unchanged int
+	Batches   int
+}
+
+// SyncAudienceMembers brings a custom audience to the desired member set by
+// applying ADD and REMOVE deltas against the previously uploaded set instead
+// of a full REPLACE upload. A failed batch, including one the API rejects
+// with an error code, stops the sync; the batches applied before it are
+// saved, so a subsequent call only resends the outstanding changes.
+func (s *DMPService) SyncAudienceMembers(ctx context.Context, req *AudienceSyncRequest) (*AudienceSyncResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.CustomAudienceID == "" {
+		return nil, fmt.Errorf("custom_audience_id is required")
+	}
+
+	store := req.Store
+	if store == nil {
+		store = s.memberStore
+	}
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultAudienceSyncBatchSize
+	}
+
+	previous, err := store.Load(ctx, req.CustomAudienceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load audience members: %w", err)
+	}
+
+	current := toMemberSet(previous)
+	toAdd, toRemove := diffAudienceMembers(current, toMemberSet(req.Members))
+	result := &AudienceSyncResult{Unchanged: len(current) - len(toRemove)}
+
+	apply := func(operation string, ids []string) error {
+		for start := 0; start < len(ids); start += batchSize {
+			end := start + batchSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+			batch := ids[start:end]
+
+			resp, err := s.ApplyCustomAudience(ctx, &CustomAudienceApplyRequest{
+				AdvertiserID:     req.AdvertiserID,
+				CustomAudienceID: req.CustomAudienceID,
+				UserData:         batch,
+				Operation:        operation,
+			})
+			if err == nil && resp.Code != 0 {
+				err = models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to %s audience members (batch %d): %w", operation, result.Batches+1, err)
+			}
+
+			result.Batches++
+			for _, id := range batch {
+				if operation == "ADD" {
+					current[id] = struct{}{}
+					result.Added++
+				} else {
+					delete(current, id)
+					result.Removed++
+				}
+			}
+		}
+		return nil
+	}
+
+	syncErr := apply("REMOVE", toRemove)
+	if syncErr == nil {
+		syncErr = apply("ADD", toAdd)
+	}
+
+	if err := store.Save(ctx, req.CustomAudienceID, sortedMembers(current)); err != nil && syncErr == nil {
+		syncErr = fmt.Errorf("failed to save audience members: %w", err)
+	}
+	if syncErr != nil {
+		return result, syncErr
+	}
+
+	return result, nil
+}
+
+// diffAudienceMembers returns the sorted identifiers to add and remove
+func diffAudienceMembers(current, desired map[string]struct{}) (toAdd, toRemove []string) {
+	for id := range desired {
+		if _, ok := current[id]; !ok {
+			toAdd = append(toAdd, id)
+		}
+	}
+	for id := range current {
+		if _, ok := desired[id]; !ok {
+			toRemove = append(toRemove, id)
+		}
+	}
+
+	sort.Strings(toAdd)
+	sort.Strings(toRemove)
+	return toAdd, toRemove
+}
+
+// toMemberSet deduplicates identifiers, ignoring empty values
+func toMemberSet(ids []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if id != "" {
+			set[id] = struct{}{}
+		}
+	}
+	return set
+}
+
+// sortedMembers returns the members of a set in sorted order
+func sortedMembers(set map[string]struct{}) []string {
+	members := make([]string, 0, len(set))
+	for id := range set {
+		members = append(members, id)
+	}
+	sort.Strings(members)
+	return members
+}
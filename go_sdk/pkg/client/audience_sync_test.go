@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestDiffAudienceMembers(t *testing.T) {
+	current := toMemberSet([]string{"a", "b", "c", "", "b"})
+	desired := toMemberSet([]string{"e", "c", "d", "b", ""})
+
+	toAdd, toRemove := diffAudienceMembers(current, desired)
+	if !reflect.DeepEqual(toAdd, []string{"d", "e"}) || !reflect.DeepEqual(toRemove, []string{"a"}) {
+		t.Errorf("diffAudienceMembers() = add %v, remove %v", toAdd, toRemove)
+	}
+}
+
+// audienceApplyServer records apply calls as "OPERATION:id,id" and answers
+// the call numbered failAt, counting from 1, with an API error
+func audienceApplyServer(t *testing.T, failAt int) (*Client, func() []string) {
+	var mu sync.Mutex
+	var calls []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/dmp/custom_audience/apply/" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		var req CustomAudienceApplyRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode apply request: %v", err)
+		}
+
+		mu.Lock()
+		calls = append(calls, req.Operation+":"+strings.Join(req.UserData, ","))
+		n := len(calls)
+		mu.Unlock()
+
+		if n == failAt {
+			writeJSON(w, `{"code":40002,"message":"Audience is being processed","request_id":"req1"}`)
+			return
+		}
+		writeJSON(w, `{"code":0,"data":{"custom_audience_id":"aud1"}}`)
+	})
+
+	return newTestClient(t, server), func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), calls...)
+	}
+}
+
+func TestDMPService_SyncAudienceMembers(t *testing.T) {
+	client, calls := audienceApplyServer(t, 0)
+	store := NewMemoryAudienceMemberStore()
+	ctx := context.Background()
+	if err := store.Save(ctx, "aud1", []string{"a", "b", "c"}); err != nil {
+		t.Fatal(err)
+	}
+	req := &AudienceSyncRequest{AdvertiserID: "123", CustomAudienceID: "aud1", Members: []string{"b", "c", "d", "e", "f"}, BatchSize: 2, Store: store}
+
+	result, err := client.DMP().SyncAudienceMembers(ctx, req)
+	if err != nil {
+		t.Fatalf("SyncAudienceMembers() error = %v", err)
+	}
+	if *result != (AudienceSyncResult{Added: 3, Removed: 1, Unchanged: 2, Batches: 3}) {
+		t.Errorf("result = %+v", result)
+	}
+	if got, want := calls(), []string{"REMOVE:a", "ADD:d,e", "ADD:f"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("apply calls = %v, want %v", got, want)
+	}
+	if saved, _ := store.Load(ctx, "aud1"); !reflect.DeepEqual(saved, []string{"b", "c", "d", "e", "f"}) {
+		t.Errorf("saved members = %v", saved)
+	}
+
+	// An unchanged member set sends nothing
+	result, err = client.DMP().SyncAudienceMembers(ctx, req)
+	if err != nil || result.Batches != 0 || result.Unchanged != 5 || len(calls()) != 3 {
+		t.Errorf("second sync = %+v, %v after %d calls", result, err, len(calls()))
+	}
+}
+
+func TestDMPService_SyncAudienceMembersAPIError(t *testing.T) {
+	client, calls := audienceApplyServer(t, 2)
+	store := NewMemoryAudienceMemberStore()
+	ctx := context.Background()
+	req := &AudienceSyncRequest{AdvertiserID: "123", CustomAudienceID: "aud1", Members: []string{"a", "b", "c", "d", "e"}, BatchSize: 2, Store: store}
+
+	result, err := client.DMP().SyncAudienceMembers(ctx, req)
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "40002" {
+		t.Fatalf("SyncAudienceMembers() error = %v, want API error 40002", err)
+	}
+	if result.Added != 2 || result.Batches != 1 {
+		t.Errorf("result = %+v, want only the first batch applied", result)
+	}
+	if got := calls(); len(got) != 2 {
+		t.Errorf("apply calls = %v, want the sync to stop at the failed batch", got)
+	}
+	if saved, _ := store.Load(ctx, "aud1"); !reflect.DeepEqual(saved, []string{"a", "b"}) {
+		t.Errorf("saved members = %v, want the failed batch left out", saved)
+	}
+
+	// The retry resends only the outstanding members
+	client, calls = audienceApplyServer(t, 0)
+	if _, err := client.DMP().SyncAudienceMembers(ctx, req); err != nil {
+		t.Fatalf("retried SyncAudienceMembers() error = %v", err)
+	}
+	if got, want := calls(), []string{"ADD:c,d", "ADD:e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("retried apply calls = %v, want %v", got, want)
+	}
+}
+
+// failingAudienceMemberStore fails to load or save members
+type failingAudienceMemberStore struct {
+	loadErr, saveErr error
+}
+
+func (f failingAudienceMemberStore) Load(ctx context.Context, audienceID string) ([]string, error) {
+	return nil, f.loadErr
+}
+
+func (f failingAudienceMemberStore) Save(ctx context.Context, audienceID string, members []string) error {
+	return f.saveErr
+}
+
+func TestDMPService_SyncAudienceMembersStoreErrors(t *testing.T) {
+	client, calls := audienceApplyServer(t, 0)
+	ctx := context.Background()
+	storeErr := fmt.Errorf("disk full")
+
+	_, err := client.DMP().SyncAudienceMembers(ctx, &AudienceSyncRequest{AdvertiserID: "123", CustomAudienceID: "aud1", Members: []string{"a"}, Store: failingAudienceMemberStore{loadErr: storeErr}})
+	if !errors.Is(err, storeErr) || len(calls()) != 0 {
+		t.Errorf("load failure error = %v after %d calls, want no calls", err, len(calls()))
+	}
+
+	result, err := client.DMP().SyncAudienceMembers(ctx, &AudienceSyncRequest{AdvertiserID: "123", CustomAudienceID: "aud1", Members: []string{"a"}, Store: failingAudienceMemberStore{saveErr: storeErr}})
+	if !errors.Is(err, storeErr) || result == nil || result.Added != 1 {
+		t.Errorf("save failure = %+v, %v, want the applied result and the save error", result, err)
+	}
+}
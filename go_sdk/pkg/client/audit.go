@@ -0,0 +1,335 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuditConfig configures the audit trail of mutating API calls
+type AuditConfig struct {
+	// Sink receives a record of every create, update and delete request
+	Sink AuditSink
+
+	// Actor identifies who issued requests whose context carries no actor
+	// from WithAuditActor, e.g. a service account name
+	Actor string
+
+	// Logger reports records the sink failed to store, slog.Default() when nil
+	Logger *slog.Logger
+}
+
+// AuditRecord describes one mutating request sent to the API. Retried
+// requests are recorded once per attempt.
+type AuditRecord struct {
+	Time     time.Time     `json:"time"`
+	Actor    string        `json:"actor,omitempty"`
+	Method   string        `json:"method"`
+	Endpoint string        `json:"endpoint"`
+	Duration time.Duration `json:"duration"`
+
+	// AdvertiserID is read from the request payload when present
+	AdvertiserID string `json:"advertiser_id,omitempty"`
+
+	// PayloadHash is the hex SHA-256 of the request body as sent, so a
+	// change can be matched to its payload without the audit trail holding
+	// the payload
+	PayloadHash string `json:"payload_hash"`
+
+	// StatusCode is the HTTP status and Code the API response code; both are
+	// zero when no response was received
+	StatusCode int    `json:"status_code"`
+	Code       int    `json:"code"`
+	Message    string `json:"message,omitempty"`
+	RequestID  string `json:"request_id,omitempty"`
+
+	// Error is set when the request failed without a response
+	Error string `json:"error,omitempty"`
+}
+
+// Succeeded reports whether the API accepted the change
+func (r AuditRecord) Succeeded() bool {
+	return r.Error == "" && r.StatusCode >= 200 && r.StatusCode < 300 && r.Code == 0
+}
+
+// AuditSink stores audit records. Record is called concurrently.
+type AuditSink interface {
+	Record(ctx context.Context, record AuditRecord) error
+}
+
+// AuditSinkFunc adapts a function to the AuditSink interface
+type AuditSinkFunc func(ctx context.Context, record AuditRecord) error
+
+// Record calls f(ctx, record)
+func (f AuditSinkFunc) Record(ctx context.Context, record AuditRecord) error {
+	return f(ctx, record)
+}
+
+// JSONLinesAuditSink writes audit records as JSON lines, e.g. to an
+// append-only file
+type JSONLinesAuditSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONLinesAuditSink creates a sink writing one JSON record per line to w
+func NewJSONLinesAuditSink(w io.Writer) *JSONLinesAuditSink {
+	return &JSONLinesAuditSink{w: w}
+}
+
+// Record writes the record as a single line
+func (s *JSONLinesAuditSink) Record(ctx context.Context, record AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit record: %w", err)
+	}
+	data = append(data, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.w.Write(data); err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	return nil
+}
+
+// auditActorKey carries the actor recorded for a request
+type auditActorKey struct{}
+
+// WithAuditActor returns a context whose mutating requests are recorded as
+// issued by actor, e.g. the user of an ad operations tool
+func WithAuditActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// AuditMiddleware records every mutating request to the config's sink.
+// Requests are classified by endpoint, see isAuditedRequest: reads sent as
+// POST, such as report tasks, event tracking and OAuth token requests, are
+// not recorded.
+func AuditMiddleware(config AuditConfig) Middleware {
+	logger := config.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if !isAuditedRequest(req.Method, req.URL.Path) {
+				return next.RoundTrip(req)
+			}
+
+			record := AuditRecord{
+				Time:     time.Now().UTC(),
+				Actor:    config.Actor,
+				Method:   req.Method,
+				Endpoint: auditEndpoint(req.URL.Path),
+			}
+			if actor, ok := req.Context().Value(auditActorKey{}).(string); ok && actor != "" {
+				record.Actor = actor
+			}
+
+			var body *auditedBody
+			if req.Body != nil && req.Body != http.NoBody {
+				body = &auditedBody{ReadCloser: req.Body, hash: sha256.New()}
+				req = req.Clone(req.Context())
+				req.Body = body
+			}
+
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+			record.Duration = time.Since(start)
+			record.PayloadHash, record.AdvertiserID = body.summary(req.Header.Get("Content-Type"))
+			if err != nil {
+				record.Error = err.Error()
+			} else {
+				record.StatusCode = resp.StatusCode
+				auditResponse(resp, &record)
+			}
+
+			if sinkErr := config.Sink.Record(req.Context(), record); sinkErr != nil {
+				logger.ErrorContext(req.Context(), "failed to record audit entry", "endpoint", record.Endpoint, "error", sinkErr)
+			}
+			return resp, err
+		})
+	}
+}
+
+// auditedActions are the final path segments of endpoints that change
+// entities, funds or assets
+var auditedActions = map[string]bool{
+	"add":      true,
+	"apply":    true,
+	"assign":   true,
+	"bind":     true,
+	"copy":     true,
+	"create":   true,
+	"delete":   true,
+	"edit":     true,
+	"invite":   true,
+	"post":     true,
+	"remove":   true,
+	"share":    true,
+	"transfer": true,
+	"unassign": true,
+	"update":   true,
+	"upload":   true,
+}
+
+// isAuditedRequest reports whether a request changes account state. Task
+// endpoints such as /report/task/create/ start exports rather than change
+// entities, so they are not audited either.
+func isAuditedRequest(method, path string) bool {
+	if method == http.MethodGet || method == http.MethodHead {
+		return false
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	last := len(segments) - 1
+	if last > 0 && segments[last-1] == "task" {
+		return false
+	}
+	return auditedActions[segments[last]]
+}
+
+// auditEndpoint strips the /open_api/<version> prefix from a request path
+func auditEndpoint(path string) string {
+	rest, ok := strings.CutPrefix(path, apiPathPrefix)
+	if !ok {
+		return path
+	}
+	if i := strings.Index(rest, "/"); i >= 0 {
+		return rest[i:]
+	}
+	return "/"
+}
+
+// auditPayloadPeek is how much of a payload is kept to find its advertiser ID
+const auditPayloadPeek = 64 * 1024
+
+// auditedBody hashes a request body as the transport sends it, so uploads
+// are not buffered or read twice
+type auditedBody struct {
+	io.ReadCloser
+
+	mu   sync.Mutex
+	hash hash.Hash
+	head []byte
+}
+
+func (b *auditedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.mu.Lock()
+	b.hash.Write(p[:n])
+	if room := auditPayloadPeek - len(b.head); room > 0 {
+		b.head = append(b.head, p[:min(n, room)]...)
+	}
+	b.mu.Unlock()
+	return n, err
+}
+
+// summary returns the hex SHA-256 of the bytes sent and the advertiser ID
+// found in them. A request without a body hashes as empty.
+func (b *auditedBody) summary(contentType string) (string, string) {
+	if b == nil {
+		sum := sha256.Sum256(nil)
+		return hex.EncodeToString(sum[:]), ""
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return hex.EncodeToString(b.hash.Sum(nil)), payloadAdvertiserID(contentType, b.head)
+}
+
+// payloadAdvertiserID reads advertiser_id from the start of a JSON or
+// multipart payload
+func payloadAdvertiserID(contentType string, payload []byte) string {
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	if strings.HasPrefix(mediaType, "multipart/") {
+		// Fields precede files, so a truncated payload still has them
+		reader := multipart.NewReader(bytes.NewReader(payload), params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				return ""
+			}
+			if part.FormName() == "advertiser_id" {
+				value, _ := io.ReadAll(io.LimitReader(part, 64))
+				return string(value)
+			}
+		}
+	}
+
+	var body struct {
+		AdvertiserID json.RawMessage `json:"advertiser_id"`
+	}
+	if json.Unmarshal(payload, &body) != nil || len(body.AdvertiserID) == 0 {
+		return ""
+	}
+	return strings.Trim(string(body.AdvertiserID), `"`)
+}
+
+// auditResponse copies the API code of the response into the record, leaving
+// the body readable for the caller. Only the head of the body is read; the
+// rest streams on unread, so large downloads are not buffered.
+func auditResponse(resp *http.Response, record *AuditRecord) {
+	if resp.Body == nil {
+		return
+	}
+	head, err := io.ReadAll(io.LimitReader(resp.Body, auditPayloadPeek))
+	if err != nil {
+		// Hand the read error on to the caller after the bytes received
+		resp.Body = auditResponseBody{Reader: io.MultiReader(bytes.NewReader(head), &failingReader{err: err}), Closer: resp.Body}
+		return
+	}
+	resp.Body = auditResponseBody{Reader: io.MultiReader(bytes.NewReader(head), resp.Body), Closer: resp.Body}
+
+	decoder := json.NewDecoder(bytes.NewReader(head))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return
+	}
+	// Walk the top-level fields until the head runs out
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return
+		}
+		var target interface{}
+		switch key {
+		case "code":
+			target = &record.Code
+		case "message":
+			target = &record.Message
+		case "request_id":
+			target = &record.RequestID
+		default:
+			target = &json.RawMessage{}
+		}
+		if decoder.Decode(target) != nil {
+			return
+		}
+	}
+}
+
+// auditResponseBody is a response body whose head was read for auditing
+type auditResponseBody struct {
+	io.Reader
+	io.Closer
+}
+
+// failingReader returns err on every read
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_Audit(t *testing.T) {
+	var mu sync.Mutex
+	bodies := map[string][]byte{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		bodies[r.URL.Path] = body
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/open_api/v1.3/campaign/create/":
+			w.Write([]byte(`{"code":0,"message":"OK","request_id":"req-1","data":{"campaign_id":"c1"}}`))
+		case "/open_api/v1.3/campaign/update/":
+			w.Write([]byte(`{"code":40002,"message":"Budget too low","request_id":"req-2"}`))
+		default:
+			w.Write([]byte(`{"code":0,"message":"OK","data":[]}`))
+		}
+	})
+
+	var records []AuditRecord
+	var lines bytes.Buffer
+	jsonLines := NewJSONLinesAuditSink(&lines)
+	sink := AuditSinkFunc(func(ctx context.Context, record AuditRecord) error {
+		records = append(records, record)
+		return jsonLines.Record(ctx, record)
+	})
+	client := newTestClient(t, server, func(c *Config) { c.Audit = &AuditConfig{Sink: sink, Actor: "svc-adops"} })
+
+	ctx := WithAuditActor(context.Background(), "jane@example.com")
+	if _, err := client.Campaign().Create(ctx, &CampaignCreateRequest{AdvertiserID: "123", CampaignName: "Spring", ObjectiveType: "TRAFFIC"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := client.Campaign().Get(context.Background(), &CampaignGetRequest{AdvertiserID: "123"}); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	client.Campaign().Update(context.Background(), &CampaignUpdateRequest{AdvertiserID: "123", CampaignID: "c1", Budget: 1})
+	resp, err := client.DoMultipartRequest(context.Background(), "/file/image/ad/upload/", map[string]string{"advertiser_id": "456"},
+		[]MultipartFile{{FieldName: "image_file", FileName: "a.png", Reader: bytes.NewReader([]byte("png"))}}, nil)
+	if err != nil {
+		t.Fatalf("DoMultipartRequest() error = %v", err)
+	}
+	resp.Body.Close()
+	// Report tasks and event tracking are POSTs that change no entities
+	for _, path := range []string{"/report/task/create/", "/event/track/", "/oauth2/access_token/"} {
+		resp, err := client.DoRequest(context.Background(), http.MethodPost, server.URL+"/open_api/v1.3"+path, strings.NewReader(`{"advertiser_id":"123"}`), nil)
+		if err != nil {
+			t.Fatalf("DoRequest(%s) error = %v", path, err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(records) != 3 {
+		t.Fatalf("recorded %d requests, want 3 mutations: %+v", len(records), records)
+	}
+	hash := func(path string) string {
+		sum := sha256.Sum256(bodies["/open_api/v1.3"+path])
+		return hex.EncodeToString(sum[:])
+	}
+
+	create := records[0]
+	if create.Actor != "jane@example.com" || create.Endpoint != "/campaign/create/" || create.AdvertiserID != "123" ||
+		create.RequestID != "req-1" || !create.Succeeded() || create.PayloadHash != hash("/campaign/create/") {
+		t.Errorf("create record = %+v", create)
+	}
+	update := records[1]
+	if update.Actor != "svc-adops" || update.Code != 40002 || update.StatusCode != http.StatusOK || update.Succeeded() {
+		t.Errorf("update record = %+v", update)
+	}
+	upload := records[2]
+	if upload.AdvertiserID != "456" || upload.PayloadHash != hash("/file/image/ad/upload/") {
+		t.Errorf("upload record = %+v", upload)
+	}
+
+	if n := strings.Count(lines.String(), "\n"); n != 3 || !strings.Contains(lines.String(), `"payload_hash":"`+create.PayloadHash+`"`) {
+		t.Errorf("JSON lines =\n%s", lines.String())
+	}
+
+	if _, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second, Audit: &AuditConfig{}}); err == nil {
+		t.Error("NewClient() accepted an audit config without a sink")
+	}
+}
+
+func TestClient_AuditLargeResponse(t *testing.T) {
+	payload := `{"code":0,"message":"OK","request_id":"req-3","data":{"blob":"` + strings.Repeat("a", 3*auditPayloadPeek) + `"}}`
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, payload)
+	})
+
+	var records []AuditRecord
+	sink := AuditSinkFunc(func(ctx context.Context, record AuditRecord) error {
+		records = append(records, record)
+		return nil
+	})
+	client := newTestClient(t, server, func(c *Config) {
+		c.Audit = &AuditConfig{Sink: sink}
+		c.MaxResponseBytes = -1
+	})
+
+	resp, err := client.DoRequest(context.Background(), http.MethodPost, server.URL+"/open_api/v1.3/campaign/create/", strings.NewReader(`{"advertiser_id":"123"}`), nil)
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != payload {
+		t.Errorf("read %d bytes, error = %v, want the whole %d byte body", len(body), err, len(payload))
+	}
+
+	if len(records) != 1 || records[0].RequestID != "req-3" || records[0].Message != "OK" || !records[0].Succeeded() {
+		t.Errorf("records = %+v", records)
+	}
+}
@@ -3,9 +3,14 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
 )
 
 // AuthConfig holds authentication configuration
@@ -14,28 +19,148 @@ type AuthConfig struct {
 	ClientSecret string
 	RedirectURI  string
 	BaseURL      string
+	Environment  Environment
+	APIVersion   APIVersion
+
+	// ValidationCacheTTL is how long ValidateToken results are reused. Zero
+	// uses DefaultValidationCacheTTL; a negative value disables the cache.
+	ValidationCacheTTL time.Duration
+}
+
+// OAuthScope is a permission requested on the authorization page
+type OAuthScope string
+
+// OAuth scopes
+const (
+	ScopeUserInfoBasic      OAuthScope = "user.info.basic"
+	ScopeUserInsights       OAuthScope = "user.insights"
+	ScopeVideoList          OAuthScope = "video.list"
+	ScopeVideoInsights      OAuthScope = "video.insights"
+	ScopeVideoPublish       OAuthScope = "video.publish"
+	ScopeCommentList        OAuthScope = "comment.list"
+	ScopeCommentManage      OAuthScope = "comment.list.manage"
+	ScopeBizCreatorInfo     OAuthScope = "biz.creator.info"
+	ScopeBizCreatorInsights OAuthScope = "biz.creator.insights"
+	ScopeAdRead             OAuthScope = "ad.read"
+)
+
+// OAuthScopes is the list of scopes granted to a token. The API returns it
+// either as a comma-separated string or as an array of scope names or
+// permission IDs; it is encoded back as a comma-separated string.
+type OAuthScopes []OAuthScope
+
+// Has reports whether scope was granted
+func (s OAuthScopes) Has(scope OAuthScope) bool {
+	for _, granted := range s {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the scopes as a comma-separated list
+func (s OAuthScopes) String() string {
+	names := make([]string, len(s))
+	for i, scope := range s {
+		names[i] = string(scope)
+	}
+	return strings.Join(names, ",")
+}
+
+// MarshalJSON encodes the scopes as a comma-separated string
+func (s OAuthScopes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON decodes a comma or space separated string, or an array of
+// strings or numeric permission IDs
+func (s *OAuthScopes) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*s = nil
+		for _, name := range strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ' ' }) {
+			*s = append(*s, OAuthScope(name))
+		}
+		return nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return fmt.Errorf("scope must be a string or an array: %w", err)
+	}
+	*s = make(OAuthScopes, 0, len(items))
+	for _, item := range items {
+		var name string
+		if err := json.Unmarshal(item, &name); err == nil {
+			*s = append(*s, OAuthScope(name))
+			continue
+		}
+		var id json.Number
+		if err := json.Unmarshal(item, &id); err != nil {
+			return fmt.Errorf("invalid scope %s", item)
+		}
+		*s = append(*s, OAuthScope(id.String()))
+	}
+	return nil
 }
 
+// OAuth grant errors. GetAccessToken and RefreshToken wrap them together with
+// the *models.APIError returned by the API, so errors.Is and errors.As both apply.
+var (
+	ErrAuthCodeInvalid     = errors.New("authorization code is invalid")
+	ErrAuthCodeExpired     = errors.New("authorization code has expired")
+	ErrRefreshTokenInvalid = errors.New("refresh token is invalid")
+	ErrRefreshTokenExpired = errors.New("refresh token has expired")
+)
+
 // authService implements the AuthService interface
 type authService struct {
 	client *Client
 	config *AuthConfig
+	cache  *tokenCache
+
+	// err is why no client could be created for a standalone auth service
+	err error
 }
 
 // NewAuthService creates a new authentication service
 func NewAuthService(config *AuthConfig) AuthService {
 	if config.BaseURL == "" {
-		config.BaseURL = "https://business-api.tiktok.com"
+		config.BaseURL = config.Environment.BaseURL()
 	}
 
-	return &authService{
+	clientConfig := DefaultConfig()
+	clientConfig.BaseURL = config.BaseURL
+	clientConfig.Environment = config.Environment
+	clientConfig.APIVersion = config.APIVersion
+	clientConfig.ClientID = config.ClientID
+	clientConfig.ClientSecret = config.ClientSecret
+	client, err := NewClient(clientConfig)
+
+	auth := &authService{
+		client: client,
 		config: config,
+		cache:  newTokenCache(config.ValidationCacheTTL),
+		err:    err,
 	}
+	if client != nil {
+		client.RegisterCacheStats("token_validation", auth.cache.stats)
+	}
+	return auth
 }
 
 // GetAuthorizationURL generates an OAuth authorization URL
-func (a *authService) GetAuthorizationURL(scopes []string) string {
-	baseURL := a.config.BaseURL + "/open_api/v1.3/oauth2/authorize/"
+func (a *authService) GetAuthorizationURL(scopes []OAuthScope) string {
+	host := a.config.BaseURL
+	if host == a.config.Environment.BaseURL() {
+		host = a.config.Environment.AuthorizationURL()
+	}
+	version := a.config.APIVersion
+	if version == "" {
+		version = DefaultAPIVersion
+	}
+	baseURL := host + apiPath(version, "/oauth2/authorize/")
 
 	params := url.Values{}
 	params.Set("client_key", a.config.ClientID)
@@ -44,60 +169,65 @@ func (a *authService) GetAuthorizationURL(scopes []string) string {
 	params.Set("state", "your_custom_params")
 
 	if len(scopes) > 0 {
-		params.Set("scope", strings.Join(scopes, ","))
+		params.Set("scope", OAuthScopes(scopes).String())
 	}
 
 	return baseURL + "?" + params.Encode()
 }
 
-// GetAccessToken exchanges an authorization code for an access token
+// GetAccessToken exchanges an authorization code for an access token. Codes
+// are single-use, so the exchange is not retried; an invalid, used or expired
+// code returns an error wrapping ErrAuthCodeInvalid or ErrAuthCodeExpired.
 func (a *authService) GetAccessToken(ctx context.Context, code string) (*TokenResponse, error) {
-	endpoint := "/open_api/v1.3/oauth2/access_token/"
-
-	data := map[string]interface{}{
-		"client_key":    a.config.ClientID,
-		"client_secret": a.config.ClientSecret,
-		"auth_code":     code,
-		"grant_type":    "authorization_code",
+	if code == "" {
+		return nil, models.NewValidationError("auth_code", "authorization code is required")
 	}
 
-	body, err := json.Marshal(data)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	data := map[string]interface{}{
+		"app_id":     a.config.ClientID,
+		"secret":     a.config.ClientSecret,
+		"auth_code":  code,
+		"grant_type": "authorization_code",
 	}
 
-	resp, err := a.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	token, err := a.requestToken(ctx, "/oauth2/access_token/", data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return nil, grantError(err, []string{"auth_code", "authorization_code"}, ErrAuthCodeInvalid, ErrAuthCodeExpired)
 	}
+	return token, nil
+}
 
-	var tokenResp struct {
-		Code      int           `json:"code"`
-		Message   string        `json:"message"`
-		RequestID string        `json:"request_id"`
-		Data      TokenResponse `json:"data"`
+// RefreshToken refreshes an access token using a refresh token. The refresh
+// is not retried, since the API may rotate the refresh token on success; an
+// invalid or expired refresh token returns an error wrapping
+// ErrRefreshTokenInvalid or ErrRefreshTokenExpired.
+func (a *authService) RefreshToken(ctx context.Context, refreshToken string) (*TokenResponse, error) {
+	if refreshToken == "" {
+		return nil, models.NewValidationError("refresh_token", "refresh token is required")
 	}
 
-	if err := a.client.ParseResponse(resp, &tokenResp); err != nil {
-		return nil, err
+	data := map[string]interface{}{
+		"app_id":        a.config.ClientID,
+		"secret":        a.config.ClientSecret,
+		"refresh_token": refreshToken,
+		"grant_type":    "refresh_token",
 	}
 
-	if tokenResp.Code != 0 {
-		return nil, fmt.Errorf("API error: %s", tokenResp.Message)
+	token, err := a.requestToken(ctx, "/oauth2/refresh_token/", data)
+	if err != nil {
+		return nil, grantError(err, []string{"refresh_token"}, ErrRefreshTokenInvalid, ErrRefreshTokenExpired)
 	}
-
-	return &tokenResp.Data, nil
+	return token, nil
 }
 
-// RefreshToken refreshes an access token using a refresh token
-func (a *authService) RefreshToken(ctx context.Context, refreshToken string) (*TokenResponse, error) {
-	endpoint := "/open_api/v1.3/oauth2/refresh_token/"
-
-	data := map[string]interface{}{
-		"client_key":    a.config.ClientID,
-		"client_secret": a.config.ClientSecret,
-		"refresh_token": refreshToken,
-		"grant_type":    "refresh_token",
+// requestToken posts an OAuth grant and returns the issued token. Failures
+// reported by the API are returned as *models.APIError.
+func (a *authService) requestToken(ctx context.Context, endpoint string, data map[string]interface{}) (*TokenResponse, error) {
+	if a.client == nil {
+		return nil, a.err
+	}
+	if a.config.ClientID == "" || a.config.ClientSecret == "" {
+		return nil, models.NewConfigurationError("ClientID", "client ID and secret are required")
 	}
 
 	body, err := json.Marshal(data)
@@ -105,6 +235,7 @@ func (a *authService) RefreshToken(ctx context.Context, refreshToken string) (*T
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
+	ctx = WithoutRetry(withoutTokenSource(ctx))
 	resp, err := a.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
@@ -122,15 +253,54 @@ func (a *authService) RefreshToken(ctx context.Context, refreshToken string) (*T
 	}
 
 	if tokenResp.Code != 0 {
-		return nil, fmt.Errorf("API error: %s", tokenResp.Message)
+		code := strconv.Itoa(tokenResp.Code)
+		return nil, &models.APIError{
+			Code:            code,
+			Message:         tokenResp.Message,
+			RequestID:       tokenResp.RequestID,
+			HTTPStatusCode:  resp.StatusCode,
+			SuggestedAction: models.SuggestedActionFor(code, resp.StatusCode),
+		}
 	}
 
+	a.cache.storeExpiry(tokenResp.Data.AccessToken, tokenResp.Data.ExpiresIn, time.Now())
 	return &tokenResp.Data, nil
 }
 
-// ValidateToken validates an access token
+// grantError wraps an API error about the grant named by params with the
+// matching expired or invalid sentinel. The API reports these as parameter
+// errors, so they are told apart by message.
+func grantError(err error, params []string, invalid, expired error) error {
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	message := strings.ToLower(strings.ReplaceAll(apiErr.Message, " ", "_"))
+	for _, param := range params {
+		if !strings.Contains(message, param) {
+			continue
+		}
+		if strings.Contains(message, "expire") {
+			return fmt.Errorf("%w: %w", expired, err)
+		}
+		return fmt.Errorf("%w: %w", invalid, err)
+	}
+	return err
+}
+
+// ValidateToken validates an access token. Results are cached for
+// ValidationCacheTTL, and tokens known to have expired are reported invalid
+// without calling the API.
 func (a *authService) ValidateToken(ctx context.Context, token string) (*TokenValidationResponse, error) {
-	endpoint := "/open_api/v1.3/oauth2/user_info/"
+	if result, ok := a.cache.lookup(token, time.Now()); ok {
+		return result, nil
+	}
+	if a.client == nil {
+		return nil, a.err
+	}
+
+	endpoint := "/oauth2/user_info/"
 
 	headers := map[string]string{
 		"Access-Token": token,
@@ -152,17 +322,27 @@ func (a *authService) ValidateToken(ctx context.Context, token string) (*TokenVa
 		return nil, err
 	}
 
-	if validationResp.Code != 0 {
-		return &TokenValidationResponse{Valid: false}, nil
+	result := &TokenValidationResponse{Valid: false}
+	if validationResp.Code == 0 {
+		result = &validationResp.Data
+		result.Valid = true
 	}
+	a.cache.store(token, result, time.Now())
+	return result, nil
+}
 
-	validationResp.Data.Valid = true
-	return &validationResp.Data, nil
+// InvalidateToken drops any cached validation result for a token
+func (a *authService) InvalidateToken(token string) {
+	a.cache.invalidate(token)
 }
 
 // RevokeToken revokes an access token
 func (a *authService) RevokeToken(ctx context.Context, token string) error {
-	endpoint := "/open_api/v1.3/oauth2/revoke/"
+	if a.client == nil {
+		return a.err
+	}
+
+	endpoint := "/oauth2/revoke/"
 
 	data := map[string]interface{}{
 		"client_key":    a.config.ClientID,
@@ -194,5 +374,6 @@ func (a *authService) RevokeToken(ctx context.Context, token string) error {
 		return fmt.Errorf("API error: %s", revokeResp.Message)
 	}
 
+	a.cache.invalidate(token)
 	return nil
 }
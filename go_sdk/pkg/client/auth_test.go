@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestAuth_TokenGrants(t *testing.T) {
+	var calls atomic.Int32
+	var sent map[string]interface{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		sent = nil
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/open_api/v1.3/oauth2/access_token/" && sent["auth_code"] == "good":
+			w.Write([]byte(`{"code":0,"data":{"access_token":"act","refresh_token":"rft","expires_in":86400,"scope":[1,4],"advertiser_ids":["123"]}}`))
+		case r.URL.Path == "/open_api/v1.3/oauth2/access_token/" && sent["auth_code"] == "stale":
+			w.Write([]byte(`{"code":40002,"message":"Auth_code has expired","request_id":"r1"}`))
+		case r.URL.Path == "/open_api/v1.3/oauth2/access_token/":
+			w.Write([]byte(`{"code":40002,"message":"auth_code is invalid"}`))
+		case r.URL.Path == "/open_api/v1.3/oauth2/refresh_token/" && sent["refresh_token"] == "good":
+			w.Write([]byte(`{"code":0,"data":{"access_token":"act2","refresh_token":"rft2","expires_in":86400,"scope":"user.info.basic,video.list"}}`))
+		case r.URL.Path == "/open_api/v1.3/oauth2/refresh_token/":
+			w.Write([]byte(`{"code":40002,"message":"Refresh token is expired"}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	auth := NewAuthService(&AuthConfig{ClientID: "app", ClientSecret: "secret", BaseURL: server.URL})
+	ctx := context.Background()
+
+	token, err := auth.GetAccessToken(ctx, "good")
+	if err != nil {
+		t.Fatalf("GetAccessToken() error = %v", err)
+	}
+	if token.AccessToken != "act" || len(token.AdvertiserIDs) != 1 || !token.Scope.Has("4") {
+		t.Errorf("token = %+v", token)
+	}
+	if sent["app_id"] != "app" || sent["secret"] != "secret" {
+		t.Errorf("sent %v", sent)
+	}
+
+	_, err = auth.GetAccessToken(ctx, "stale")
+	var apiErr *models.APIError
+	if !errors.Is(err, ErrAuthCodeExpired) || !errors.As(err, &apiErr) || apiErr.RequestID != "r1" {
+		t.Errorf("expired code error = %v", err)
+	}
+	if _, err := auth.GetAccessToken(ctx, "used"); !errors.Is(err, ErrAuthCodeInvalid) {
+		t.Errorf("invalid code error = %v", err)
+	}
+	if _, err := auth.GetAccessToken(ctx, ""); err == nil {
+		t.Error("GetAccessToken() accepted an empty code")
+	}
+
+	token, err = auth.RefreshToken(ctx, "good")
+	if err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+	if token.AccessToken != "act2" || !token.Scope.Has(ScopeVideoList) {
+		t.Errorf("token = %+v", token)
+	}
+	if _, err := auth.RefreshToken(ctx, "old"); !errors.Is(err, ErrRefreshTokenExpired) {
+		t.Errorf("expired refresh token error = %v", err)
+	}
+
+	if got := calls.Load(); got != 5 {
+		t.Errorf("API called %d times, want 5 without retries", got)
+	}
+
+	unconfigured := NewAuthService(&AuthConfig{BaseURL: server.URL})
+	if _, err := unconfigured.GetAccessToken(ctx, "good"); err == nil {
+		t.Error("GetAccessToken() succeeded without client credentials")
+	}
+}
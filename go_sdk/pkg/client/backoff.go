@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// BackoffStrategy computes the delay before a retry
+type BackoffStrategy interface {
+	// Backoff returns the delay before the given retry, 1 for the first.
+	// previous is the delay used before the prior retry, zero for the first.
+	Backoff(retry int, previous time.Duration) time.Duration
+}
+
+// BackoffFunc adapts a function to the BackoffStrategy interface
+type BackoffFunc func(retry int, previous time.Duration) time.Duration
+
+// Backoff calls f(retry, previous)
+func (f BackoffFunc) Backoff(retry int, previous time.Duration) time.Duration {
+	return f(retry, previous)
+}
+
+// BackoffPreset is a built-in strategy tuned by the InitialDelay, MaxDelay
+// and Multiplier fields of RetryConfig
+type BackoffPreset int
+
+const (
+	// LinearBackoff increases delay linearly
+	LinearBackoff BackoffPreset = iota
+
+	// ExponentialBackoff increases delay exponentially
+	ExponentialBackoff
+
+	// FixedBackoff uses a fixed delay
+	FixedBackoff
+
+	// ExponentialJitterBackoff waits a random delay up to the exponential delay
+	ExponentialJitterBackoff
+
+	// DecorrelatedJitterBackoff waits a random delay between the initial
+	// delay and three times the previous delay
+	DecorrelatedJitterBackoff
+)
+
+// Default tuning for presets used outside a RetryConfig
+const (
+	defaultInitialDelay = time.Second
+	defaultMaxDelay     = 30 * time.Second
+	defaultMultiplier   = 2.0
+)
+
+// Backoff returns the preset's delay with the default tuning
+func (p BackoffPreset) Backoff(retry int, previous time.Duration) time.Duration {
+	return p.strategy(defaultInitialDelay, defaultMaxDelay, defaultMultiplier).Backoff(retry, previous)
+}
+
+// strategy returns the preset with the given tuning
+func (p BackoffPreset) strategy(initial, max time.Duration, multiplier float64) BackoffStrategy {
+	switch p {
+	case LinearBackoff:
+		return BackoffFunc(func(retry int, _ time.Duration) time.Duration {
+			return capDelay(time.Duration(retry)*initial, max)
+		})
+	case FixedBackoff:
+		return NewConstantBackoff(initial)
+	case ExponentialJitterBackoff:
+		return NewExponentialJitterBackoff(initial, max, multiplier)
+	case DecorrelatedJitterBackoff:
+		return NewDecorrelatedJitterBackoff(initial, max)
+	default:
+		return NewExponentialBackoff(initial, max, multiplier)
+	}
+}
+
+// exponentialBackoff multiplies the delay on every retry, optionally
+// randomizing it over [0, delay]
+type exponentialBackoff struct {
+	initial    time.Duration
+	max        time.Duration
+	multiplier float64
+	jitter     bool
+}
+
+// NewExponentialBackoff returns a strategy waiting initial, then multiplying
+// the delay by multiplier on every retry, up to max
+func NewExponentialBackoff(initial, max time.Duration, multiplier float64) BackoffStrategy {
+	return &exponentialBackoff{initial: initial, max: max, multiplier: multiplier}
+}
+
+// NewExponentialJitterBackoff returns an exponential strategy that waits a
+// random delay up to the exponential delay ("full jitter"), spreading out
+// retries from concurrent clients
+func NewExponentialJitterBackoff(initial, max time.Duration, multiplier float64) BackoffStrategy {
+	return &exponentialBackoff{initial: initial, max: max, multiplier: multiplier, jitter: true}
+}
+
+func (b *exponentialBackoff) Backoff(retry int, _ time.Duration) time.Duration {
+	delay := b.initial
+	if d := float64(b.initial) * math.Pow(b.multiplier, float64(retry-1)); d < math.MaxInt64 {
+		delay = capDelay(time.Duration(d), b.max)
+	} else if b.max > 0 {
+		delay = b.max
+	}
+	if b.jitter && delay > 0 {
+		delay = rand.N(delay + 1)
+	}
+	return delay
+}
+
+// decorrelatedJitterBackoff grows a random delay from the previous one
+type decorrelatedJitterBackoff struct {
+	base time.Duration
+	max  time.Duration
+}
+
+// NewDecorrelatedJitterBackoff returns a strategy waiting a random delay
+// between base and three times the previous delay, up to max
+func NewDecorrelatedJitterBackoff(base, max time.Duration) BackoffStrategy {
+	return &decorrelatedJitterBackoff{base: base, max: max}
+}
+
+func (b *decorrelatedJitterBackoff) Backoff(_ int, previous time.Duration) time.Duration {
+	upper := 3 * previous
+	if upper <= b.base {
+		return capDelay(b.base, b.max)
+	}
+	return capDelay(b.base+rand.N(upper-b.base), b.max)
+}
+
+// NewConstantBackoff returns a strategy waiting the same delay before every retry
+func NewConstantBackoff(delay time.Duration) BackoffStrategy {
+	return BackoffFunc(func(int, time.Duration) time.Duration { return delay })
+}
+
+// capDelay limits delay to max; a non-positive max means no limit
+func capDelay(delay, max time.Duration) time.Duration {
+	if max > 0 && delay > max {
+		return max
+	}
+	return delay
+}
+
+// backoff returns the strategy for the config; presets are tuned by its
+// delay fields and a nil strategy means ExponentialBackoff
+func (r *RetryConfig) backoff() BackoffStrategy {
+	switch s := r.BackoffStrategy.(type) {
+	case nil:
+		return ExponentialBackoff.strategy(r.InitialDelay, r.MaxDelay, r.Multiplier)
+	case BackoffPreset:
+		return s.strategy(r.InitialDelay, r.MaxDelay, r.Multiplier)
+	default:
+		return s
+	}
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBackoffStrategies(t *testing.T) {
+	exponential := NewExponentialBackoff(100*time.Millisecond, time.Second, 2)
+	for retry, want := range map[int]time.Duration{1: 100 * time.Millisecond, 3: 400 * time.Millisecond, 10: time.Second, 100: time.Second} {
+		if got := exponential.Backoff(retry, 0); got != want {
+			t.Errorf("Exponential retry %d: expected %v, got %v", retry, want, got)
+		}
+	}
+
+	jitter := NewExponentialJitterBackoff(100*time.Millisecond, time.Second, 2)
+	decorrelated := NewDecorrelatedJitterBackoff(100*time.Millisecond, time.Second)
+	previous := time.Duration(0)
+	for retry := 1; retry <= 20; retry++ {
+		if got := jitter.Backoff(retry, 0); got < 0 || got > time.Second {
+			t.Errorf("Jitter retry %d out of range: %v", retry, got)
+		}
+		got := decorrelated.Backoff(retry, previous)
+		if got < 100*time.Millisecond || got > time.Second {
+			t.Errorf("Decorrelated retry %d out of range: %v", retry, got)
+		}
+		previous = got
+	}
+
+	if got := NewConstantBackoff(time.Second).Backoff(5, 0); got != time.Second {
+		t.Errorf("Constant: expected 1s, got %v", got)
+	}
+
+	retry := &RetryConfig{BackoffStrategy: LinearBackoff, InitialDelay: time.Second, MaxDelay: 2 * time.Second, Multiplier: 1}
+	if got := retry.backoff().Backoff(3, 0); got != 2*time.Second {
+		t.Errorf("Linear preset: expected 2s, got %v", got)
+	}
+
+	// Custom strategies do not need the preset delay settings
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var delays []int
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		AccessToken: "test_token",
+		Timeout:     5 * time.Second,
+		RetryConfig: &RetryConfig{
+			MaxRetries: 3,
+			BackoffStrategy: BackoffFunc(func(retry int, previous time.Duration) time.Duration {
+				delays = append(delays, retry)
+				return time.Millisecond
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	resp, err := client.DoRequest(context.Background(), "GET", "/test", nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+	resp.Body.Close()
+	if len(delays) != 2 || delays[0] != 1 || delays[1] != 2 {
+		t.Errorf("Expected backoff for retries 1 and 2, got %v", delays)
+	}
+}
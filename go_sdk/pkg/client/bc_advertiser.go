@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// BCAdvertiserInfo holds the settings of a new ad account. Currency and
+// Timezone cannot be changed once the account exists.
+type BCAdvertiserInfo struct {
+	Name     string `json:"name"`
+	Currency string `json:"currency"` // ISO 4217, e.g. USD
+	Timezone string `json:"timezone"` // e.g. America/New_York
+}
+
+// BCAdvertiserCustomerInfo describes the business the ad account advertises for
+type BCAdvertiserCustomerInfo struct {
+	Company string `json:"company"`
+
+	// IndustryID is the tiered industry ID of the business
+	IndustryID string `json:"industry"`
+
+	// RegisteredArea is the country or region code the business is registered in
+	RegisteredArea string `json:"registered_area"`
+}
+
+// BCAdvertiserQualificationInfo holds the business license and supporting
+// documents reviewed before the account can run ads
+type BCAdvertiserQualificationInfo struct {
+	PromotionLink  string `json:"promotion_link"`
+	LicenseNo      string `json:"license_no,omitempty"`
+	LicenseImageID string `json:"license_image_id,omitempty"`
+
+	// QualificationImageIDs are industry qualification documents uploaded
+	// with UploadQualificationImage
+	QualificationImageIDs []string `json:"qualification_image_ids,omitempty"`
+}
+
+// BCAdvertiserContactInfo is the person TikTok contacts about the account
+type BCAdvertiserContactInfo struct {
+	Name   string `json:"name,omitempty"`
+	Email  string `json:"email,omitempty"`
+	Number string `json:"number,omitempty"`
+}
+
+// BCAdvertiserBillingInfo holds the invoicing details of the account
+type BCAdvertiserBillingInfo struct {
+	Address string `json:"address,omitempty"`
+
+	// TaxMap holds the tax IDs required in the registered area, keyed by
+	// tax field name
+	TaxMap map[string]string `json:"tax_map,omitempty"`
+
+	// BillingGroupID adds the account to a billing group of the business center
+	BillingGroupID string `json:"billing_group_id,omitempty"`
+}
+
+// BCQualificationDocument is a document uploaded while creating an advertiser
+type BCQualificationDocument struct {
+	FileName string
+	Reader   io.Reader
+
+	// License marks the business license, sent as license_image_id rather
+	// than a qualification image
+	License bool
+}
+
+// BCAdvertiserCreateRequest represents the request for creating an ad
+// account owned by a business center
+type BCAdvertiserCreateRequest struct {
+	BCID              string                        `json:"bc_id"`
+	AdvertiserInfo    BCAdvertiserInfo              `json:"advertiser_info"`
+	CustomerInfo      BCAdvertiserCustomerInfo      `json:"customer_info"`
+	QualificationInfo BCAdvertiserQualificationInfo `json:"qualification_info"`
+	ContactInfo       *BCAdvertiserContactInfo      `json:"contact_info,omitempty"`
+	BillingInfo       *BCAdvertiserBillingInfo      `json:"billing_info,omitempty"`
+
+	// Documents are uploaded before the account is created and their image
+	// IDs added to QualificationInfo
+	Documents []BCQualificationDocument `json:"-"`
+}
+
+// BCAdvertiserCreateResponse represents the response from creating an ad account
+type BCAdvertiserCreateResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		AdvertiserID string `json:"advertiser_id"`
+	} `json:"data"`
+}
+
+// CreateAdvertiser uploads the request's documents and creates an ad account
+// in the business center. The uploaded image IDs are added to those already
+// in QualificationInfo; req itself is not modified. The create call is not
+// retried, so a timeout cannot provision a duplicate account.
+func (s *BusinessCenterService) CreateAdvertiser(ctx context.Context, req *BCAdvertiserCreateRequest) (*BCAdvertiserCreateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	create := *req
+	create.QualificationInfo.QualificationImageIDs = append([]string(nil), req.QualificationInfo.QualificationImageIDs...)
+	for i, document := range req.Documents {
+		imageID, err := s.UploadQualificationImage(ctx, req.BCID, document.FileName, document.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload document %d (%s): %w", i, document.FileName, err)
+		}
+		if document.License {
+			create.QualificationInfo.LicenseImageID = imageID
+		} else {
+			create.QualificationInfo.QualificationImageIDs = append(create.QualificationInfo.QualificationImageIDs, imageID)
+		}
+	}
+
+	url, err := s.client.BuildURL("/bc/advertiser/create/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(&create)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(WithoutRetry(ctx), "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create advertiser: %w", err)
+	}
+
+	var response BCAdvertiserCreateResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UploadQualificationImage uploads a license or qualification document to a
+// business center and returns its image ID
+func (s *BusinessCenterService) UploadQualificationImage(ctx context.Context, bcID, fileName string, r io.Reader) (string, error) {
+	if bcID == "" {
+		return "", fmt.Errorf("bc_id is required")
+	}
+	if r == nil {
+		return "", fmt.Errorf("document reader is required")
+	}
+
+	fields := map[string]string{"bc_id": bcID}
+	file := MultipartFile{FieldName: "image_file", FileName: fileName, Reader: r}
+
+	resp, err := s.client.DoMultipartRequest(ctx, "/bc/image/upload/", fields, []MultipartFile{file}, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload image: %w", err)
+	}
+
+	var response BCImageUploadResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return "", err
+	}
+	if response.Code != 0 {
+		return "", fmt.Errorf("API error: %s", response.Message)
+	}
+
+	return response.Data.ImageID, nil
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBusinessCenterService_CreateAdvertiser(t *testing.T) {
+	var uploads atomic.Int32
+	var created BCAdvertiserCreateRequest
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/bc/image/upload/":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Fatalf("failed to parse upload: %v", err)
+			}
+			if r.FormValue("bc_id") != "bc_1" || r.MultipartForm.File["image_file"] == nil {
+				t.Errorf("upload form = %v", r.MultipartForm)
+			}
+			fmt.Fprintf(w, `{"code":0,"data":{"image_id":"img_%d"}}`, uploads.Add(1))
+		case "/open_api/v1.3/bc/advertiser/create/":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.Write([]byte(`{"code":0,"message":"OK","data":{"advertiser_id":"adv_new"}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	req := &BCAdvertiserCreateRequest{
+		BCID:           "bc_1",
+		AdvertiserInfo: BCAdvertiserInfo{Name: "Shop US", Currency: "USD", Timezone: "America/New_York"},
+		CustomerInfo:   BCAdvertiserCustomerInfo{Company: "Shop Inc", IndustryID: "290702", RegisteredArea: "US"},
+		QualificationInfo: BCAdvertiserQualificationInfo{
+			PromotionLink:         "https://shop.example.com",
+			LicenseNo:             "LIC-1",
+			QualificationImageIDs: []string{"img_existing"},
+		},
+		BillingInfo: &BCAdvertiserBillingInfo{Address: "1 Main St", TaxMap: map[string]string{"vat": "123"}},
+		Documents: []BCQualificationDocument{
+			{FileName: "license.png", Reader: strings.NewReader("license"), License: true},
+			{FileName: "permit.png", Reader: strings.NewReader("permit")},
+		},
+	}
+
+	invalid := *req
+	invalid.AdvertiserInfo.Currency = "usd"
+	if _, err := client.BusinessCenter().CreateAdvertiser(context.Background(), &invalid); err == nil {
+		t.Error("CreateAdvertiser() accepted a lowercase currency")
+	}
+
+	resp, err := client.BusinessCenter().CreateAdvertiser(context.Background(), req)
+	if err != nil {
+		t.Fatalf("CreateAdvertiser() error = %v", err)
+	}
+	if resp.Data.AdvertiserID != "adv_new" {
+		t.Errorf("advertiser_id = %q", resp.Data.AdvertiserID)
+	}
+	qualification := created.QualificationInfo
+	if qualification.LicenseImageID != "img_1" || strings.Join(qualification.QualificationImageIDs, ",") != "img_existing,img_2" {
+		t.Errorf("qualification_info = %+v", qualification)
+	}
+	if created.CustomerInfo.IndustryID != "290702" || created.BillingInfo.TaxMap["vat"] != "123" {
+		t.Errorf("request = %+v", created)
+	}
+	if len(req.QualificationInfo.QualificationImageIDs) != 1 || req.QualificationInfo.LicenseImageID != "" {
+		t.Errorf("CreateAdvertiser() modified the request: %+v", req.QualificationInfo)
+	}
+}
@@ -0,0 +1,234 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// BCFinancialSnapshotRequest represents the request for a consolidated BC financial snapshot
+type BCFinancialSnapshotRequest struct {
+	BCID string
+
+	// StartDate and EndDate bound the spend window (YYYY-MM-DD)
+	StartDate string
+	EndDate   string
+
+	// TargetCurrency is the currency all amounts are normalized to
+	TargetCurrency string
+
+	// ExchangeRates maps a currency code to units of TargetCurrency per unit
+	ExchangeRates map[string]float64
+
+	// Concurrency caps parallel per-advertiser lookups (defaults to 5)
+	Concurrency int
+}
+
+// AdvertiserFinancials represents the balance and spend of a single advertiser
+type AdvertiserFinancials struct {
+	AdvertiserID      string  `json:"advertiser_id"`
+	AdvertiserName    string  `json:"advertiser_name"`
+	Currency          string  `json:"currency"`
+	Balance           float64 `json:"balance"`
+	Spend             float64 `json:"spend"`
+	NormalizedBalance float64 `json:"normalized_balance"`
+	NormalizedSpend   float64 `json:"normalized_spend"`
+	Error             string  `json:"error,omitempty"`
+}
+
+// BCFinancialSnapshot represents consolidated balance and spend across a business center
+type BCFinancialSnapshot struct {
+	BCID         string                 `json:"bc_id"`
+	Currency     string                 `json:"currency"`
+	StartDate    string                 `json:"start_date"`
+	EndDate      string                 `json:"end_date"`
+	BCBalances   []BalanceInfo          `json:"bc_balances"`
+	TotalBalance float64                `json:"total_balance"`
+	TotalSpend   float64                `json:"total_spend"`
+	Advertisers  []AdvertiserFinancials `json:"advertisers"`
+	GeneratedAt  time.Time              `json:"generated_at"`
+}
+
+// GetFinancialSnapshot fans out balance and spend lookups across every
+// advertiser in a business center and returns currency-normalized totals.
+// Per-advertiser failures are recorded on the breakdown and excluded from the
+// totals rather than failing the whole snapshot.
+func (s *BusinessCenterService) GetFinancialSnapshot(ctx context.Context, req *BCFinancialSnapshotRequest) (*BCFinancialSnapshot, error) {
+	if req == nil || req.BCID == "" {
+		return nil, fmt.Errorf("bc_id is required")
+	}
+	if req.TargetCurrency == "" {
+		return nil, fmt.Errorf("target_currency is required")
+	}
+	if req.StartDate == "" || req.EndDate == "" {
+		return nil, fmt.Errorf("start_date and end_date are required")
+	}
+
+	advertisers, err := s.listAdvertiserAssets(ctx, req.BCID)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.GetBalance(ctx, &BCBalanceGetRequest{BCID: req.BCID})
+	if err != nil {
+		return nil, err
+	}
+	if balance.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", balance.Code), balance.Message, balance.RequestID, 0)
+	}
+
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = 5
+	}
+
+	breakdown := make([]AdvertiserFinancials, len(advertisers))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, asset := range advertisers {
+		wg.Add(1)
+		go func(i int, asset BCAssetData) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			breakdown[i] = s.advertiserFinancials(ctx, asset, req)
+		}(i, asset)
+	}
+	wg.Wait()
+
+	snapshot := &BCFinancialSnapshot{
+		BCID:        req.BCID,
+		Currency:    req.TargetCurrency,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		BCBalances:  balance.Data.Balances,
+		Advertisers: breakdown,
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	for _, adv := range breakdown {
+		if adv.Error != "" {
+			continue
+		}
+		snapshot.TotalBalance += adv.NormalizedBalance
+		snapshot.TotalSpend += adv.NormalizedSpend
+	}
+
+	return snapshot, nil
+}
+
+// listAdvertiserAssets pages through all advertiser assets in a business center
+func (s *BusinessCenterService) listAdvertiserAssets(ctx context.Context, bcID string) ([]BCAssetData, error) {
+	const pageSize = 100
+
+	var assets []BCAssetData
+	for page := 1; ; page++ {
+		resp, err := s.GetAssets(ctx, &BCAssetGetRequest{
+			BCID:      bcID,
+			AssetType: "ADVERTISER",
+			Page:      page,
+			Size:      pageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+
+		assets = append(assets, resp.Data...)
+		if len(resp.Data) < pageSize {
+			return assets, nil
+		}
+	}
+}
+
+// advertiserFinancials collects balance and spend for one advertiser
+func (s *BusinessCenterService) advertiserFinancials(ctx context.Context, asset BCAssetData, req *BCFinancialSnapshotRequest) AdvertiserFinancials {
+	result := AdvertiserFinancials{
+		AdvertiserID:   asset.AssetID,
+		AdvertiserName: asset.AssetName,
+	}
+
+	balance, err := s.client.Account().GetAdvertiserBalance(ctx, &GetAdvertiserBalanceRequest{AdvertiserID: asset.AssetID})
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to get balance: %v", err)
+		return result
+	}
+	if balance.Code != 0 {
+		result.Error = fmt.Sprintf("failed to get balance: %s", balance.Message)
+		return result
+	}
+	result.Balance = balance.Data.Balance
+	result.Currency = balance.Data.Currency
+
+	report, err := s.client.Report().GetIntegratedReport(ctx, &ReportIntegratedGetRequest{
+		AdvertiserID: asset.AssetID,
+		ReportType:   "BASIC",
+		DataLevel:    "AUCTION_ADVERTISER",
+		Dimensions:   []string{"advertiser_id"},
+		Metrics:      []string{"spend"},
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+	})
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to get spend: %v", err)
+		return result
+	}
+	if report.Code != 0 {
+		result.Error = fmt.Sprintf("failed to get spend: %s", report.Message)
+		return result
+	}
+	for _, row := range report.Data.List {
+		result.Spend += metricFloat(row.Metrics["spend"])
+	}
+
+	rate, err := exchangeRate(result.Currency, req.TargetCurrency, req.ExchangeRates)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.NormalizedBalance = result.Balance * rate
+	result.NormalizedSpend = result.Spend * rate
+
+	return result
+}
+
+// exchangeRate returns the multiplier converting from one currency to the
+// target. An unknown source currency is an error, as amounts in it cannot be
+// compared.
+func exchangeRate(from, target string, rates map[string]float64) (float64, error) {
+	if from == "" {
+		return 0, fmt.Errorf("currency is unknown")
+	}
+	if from == target {
+		return 1, nil
+	}
+	rate, ok := rates[from]
+	if !ok || rate <= 0 {
+		return 0, fmt.Errorf("no exchange rate from %s to %s", from, target)
+	}
+	return rate, nil
+}
+
+// metricFloat converts a report metric value, which the API may encode as a
+// string or a number, to float64
+func metricFloat(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0
+		}
+		return f
+	}
+	return 0
+}
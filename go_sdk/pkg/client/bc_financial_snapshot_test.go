@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestExchangeRate(t *testing.T) {
+	rates := map[string]float64{"EUR": 1.1, "JPY": 0}
+	tests := []struct {
+		from    string
+		want    float64
+		wantErr bool
+	}{
+		{from: "USD", want: 1},
+		{from: "EUR", want: 1.1},
+		{from: "JPY", wantErr: true},
+		{from: "GBP", wantErr: true},
+		{from: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := exchangeRate(tt.from, "USD", rates)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("exchangeRate(%q) = %v, %v", tt.from, got, err)
+		}
+	}
+}
+
+func TestBusinessCenterService_GetFinancialSnapshot(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		advertiserID := r.URL.Query().Get("advertiser_id")
+		switch strings.TrimPrefix(r.URL.Path, "/open_api/v1.3") {
+		case "/bc/asset/get/":
+			writeJSON(w, `{"code":0,"data":[{"asset_id":"adv-usd"},{"asset_id":"adv-eur"},{"asset_id":"adv-none"},{"asset_id":"adv-denied"},{"asset_id":"adv-noreport"}]}`)
+		case "/bc/balance/get/":
+			writeJSON(w, `{"code":0,"data":{"balances":[{"account_id":"bc-1","currency":"USD","balance":500}]}}`)
+		case "/advertiser/balance/get/":
+			currency := map[string]string{"adv-usd": "USD", "adv-eur": "EUR", "adv-noreport": "USD"}[advertiserID]
+			if advertiserID == "adv-denied" {
+				writeJSON(w, `{"code":40001,"message":"No permission to access advertiser"}`)
+				return
+			}
+			writeJSON(w, fmt.Sprintf(`{"code":0,"data":{"balance":100,"currency":%q}}`, currency))
+		case "/report/integrated/get/":
+			if advertiserID == "adv-noreport" {
+				writeJSON(w, `{"code":40002,"message":"Invalid report dates"}`)
+				return
+			}
+			writeJSON(w, `{"code":0,"data":{"list":[{"dimensions":{"advertiser_id":"`+advertiserID+`"},"metrics":{"spend":"10.00"}}]}}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	client := newTestClient(t, server)
+
+	snapshot, err := client.BusinessCenter().GetFinancialSnapshot(context.Background(), &BCFinancialSnapshotRequest{
+		BCID:           "bc-1",
+		StartDate:      "2026-06-01",
+		EndDate:        "2026-06-30",
+		TargetCurrency: "USD",
+		ExchangeRates:  map[string]float64{"EUR": 1.1},
+	})
+	if err != nil {
+		t.Fatalf("GetFinancialSnapshot() error = %v", err)
+	}
+
+	errs := make(map[string]string)
+	for _, adv := range snapshot.Advertisers {
+		errs[adv.AdvertiserID] = adv.Error
+	}
+	want := map[string]string{
+		"adv-usd":      "",
+		"adv-eur":      "",
+		"adv-none":     "currency is unknown",
+		"adv-denied":   "failed to get balance: No permission to access advertiser",
+		"adv-noreport": "failed to get spend: Invalid report dates",
+	}
+	for id, msg := range want {
+		if errs[id] != msg {
+			t.Errorf("advertiser %s error = %q, want %q", id, errs[id], msg)
+		}
+	}
+
+	// Only the advertisers without errors count towards the totals
+	if snapshot.TotalBalance != 210 || snapshot.TotalSpend != 21 {
+		t.Errorf("totals = balance %v, spend %v, want 210 and 21", snapshot.TotalBalance, snapshot.TotalSpend)
+	}
+}
+
+func TestBusinessCenterService_GetFinancialSnapshotAPIError(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"code":40001,"message":"No permission to access business center","request_id":"req1"}`)
+	})
+	client := newTestClient(t, server)
+
+	_, err := client.BusinessCenter().GetFinancialSnapshot(context.Background(), &BCFinancialSnapshotRequest{BCID: "bc-1", StartDate: "2026-06-01", EndDate: "2026-06-30", TargetCurrency: "USD"})
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "40001" {
+		t.Errorf("GetFinancialSnapshot() error = %v, want API error 40001", err)
+	}
+}
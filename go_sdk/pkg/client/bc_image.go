@@ -0,0 +1,100 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // register GIF decoder for conversion
+	"image/jpeg"
+	_ "image/png" // register PNG decoder for conversion
+	"net/http"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// BCImageMaxBytes is the largest image the business center accepts
+const BCImageMaxBytes = 10 * 1024 * 1024
+
+// DefaultJPEGQuality is the quality images are converted to JPEG at
+const DefaultJPEGQuality = 90
+
+// bcImageTypes maps the sniffed content types the business center accepts
+// to their image_type
+var bcImageTypes = map[string]string{
+	"image/jpeg": "JPG",
+	"image/png":  "PNG",
+}
+
+// SniffImageType detects the format of image data from its content,
+// returning the image type, such as JPG, PNG, GIF or WEBP, and its MIME
+// content type
+func SniffImageType(data []byte) (imageType, contentType string, err error) {
+	contentType = http.DetectContentType(data)
+	if !strings.HasPrefix(contentType, "image/") {
+		return "", contentType, fmt.Errorf("data is not an image (%s)", contentType)
+	}
+	imageType = strings.ToUpper(strings.TrimPrefix(contentType, "image/"))
+	if imageType == "JPEG" {
+		imageType = "JPG"
+	}
+	return imageType, contentType, nil
+}
+
+// ConvertToJPEG re-encodes a GIF, PNG or JPEG image as JPEG at the given
+// quality (DefaultJPEGQuality when zero). Transparent areas become white and
+// only the first frame of an animated GIF is kept.
+func ConvertToJPEG(data []byte, quality int) ([]byte, error) {
+	if quality <= 0 {
+		quality = DefaultJPEGQuality
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	flat := image.NewRGBA(src.Bounds())
+	draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), src, src.Bounds().Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, flat, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, fmt.Errorf("failed to encode JPEG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// prepareBCImage checks the image against the declared type and the
+// business center limits, converting it to JPEG when allowed. It returns
+// the data to upload with its image type and content type.
+func prepareBCImage(req *BCImageUploadRequest) ([]byte, string, string, error) {
+	data := req.ImageData
+	imageType, contentType, err := SniffImageType(data)
+	if err != nil {
+		return nil, "", "", models.NewValidationError("image_data", err.Error())
+	}
+	declared := strings.ToUpper(req.ImageType)
+	if declared == "JPEG" {
+		declared = "JPG"
+	}
+	if declared != "" && declared != imageType {
+		return nil, "", "", models.NewValidationError("image_type", fmt.Sprintf("image_type is %s but the data is %s", req.ImageType, imageType))
+	}
+
+	_, accepted := bcImageTypes[contentType]
+	if req.AutoConvert && (!accepted || len(data) > BCImageMaxBytes) {
+		if data, err = ConvertToJPEG(data, 0); err != nil {
+			return nil, "", "", fmt.Errorf("failed to convert %s image to JPG: %w", imageType, err)
+		}
+		imageType, contentType, accepted = "JPG", "image/jpeg", true
+	}
+	if !accepted {
+		return nil, "", "", models.NewValidationError("image_data", fmt.Sprintf("%s images are not accepted; use JPG or PNG, or set AutoConvert", imageType))
+	}
+	if len(data) > BCImageMaxBytes {
+		return nil, "", "", models.NewValidationError("image_data", fmt.Sprintf("image cannot exceed %d bytes, got %d", BCImageMaxBytes, len(data)))
+	}
+	return data, imageType, contentType, nil
+}
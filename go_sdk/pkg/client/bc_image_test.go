@@ -0,0 +1,80 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestBCUploadImage(t *testing.T) {
+	var uploads []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("ParseMultipartForm() error = %v", err)
+		}
+		file, header, err := r.FormFile("image_file")
+		if err != nil {
+			t.Fatalf("FormFile() error = %v", err)
+		}
+		data, _ := io.ReadAll(file)
+		contentType := header.Header.Get("Content-Type")
+		if sniffed := http.DetectContentType(data); sniffed != contentType {
+			t.Errorf("content type = %s, data is %s", contentType, sniffed)
+		}
+		uploads = append(uploads, r.FormValue("bc_id")+" "+header.Filename+" "+contentType)
+		writeJSON(w, `{"code":0,"data":{"image_id":"img1"}}`)
+	})
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+	var pngData, gifData bytes.Buffer
+	png.Encode(&pngData, img)
+	gif.Encode(&gifData, img, nil)
+
+	resp, err := client.BusinessCenter().UploadImage(ctx, &BCImageUploadRequest{BCID: "bc1", ImageData: pngData.Bytes(), ImageName: "logo.png"})
+	if err != nil || resp.Data.ImageID != "img1" {
+		t.Fatalf("UploadImage(png) = %+v, %v", resp, err)
+	}
+	if _, err := client.BusinessCenter().UploadImage(ctx, &BCImageUploadRequest{BCID: "bc1", ImageData: gifData.Bytes(), ImageName: "logo.gif", AutoConvert: true}); err != nil {
+		t.Fatalf("UploadImage(gif, AutoConvert) error = %v", err)
+	}
+	if want := []string{"bc1 logo.png image/png", "bc1 logo.jpg image/jpeg"}; !reflect.DeepEqual(uploads, want) {
+		t.Errorf("uploads = %v, want %v", uploads, want)
+	}
+
+	for name, req := range map[string]*BCImageUploadRequest{
+		"gif without conversion": {BCID: "bc1", ImageData: gifData.Bytes()},
+		"mismatched type":        {BCID: "bc1", ImageData: pngData.Bytes(), ImageType: "JPG"},
+		"not an image":           {BCID: "bc1", ImageData: []byte("%PDF-1.4")},
+		"missing bc_id":          {ImageData: pngData.Bytes()},
+	} {
+		var validationErr models.ValidationError
+		if _, err := client.BusinessCenter().UploadImage(ctx, req); !errors.As(err, &validationErr) {
+			t.Errorf("%s: error = %v, want a validation error", name, err)
+		}
+	}
+	if len(uploads) != 2 {
+		t.Errorf("invalid images were uploaded: %v", uploads)
+	}
+
+	jpg, err := ConvertToJPEG(pngData.Bytes(), 0)
+	if err != nil {
+		t.Fatalf("ConvertToJPEG() error = %v", err)
+	}
+	if imageType, _, _ := SniffImageType(jpg); imageType != "JPG" {
+		t.Errorf("converted image type = %s", imageType)
+	}
+}
@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// BCInvoiceGetRequest represents the request for listing business center invoices
+type BCInvoiceGetRequest struct {
+	BCID string `json:"bc_id"`
+
+	// StartDate and EndDate bound the invoice issue date (YYYY-MM-DD)
+	StartDate string `json:"start_date,omitempty"`
+	EndDate   string `json:"end_date,omitempty"`
+
+	InvoiceType string `json:"invoice_type,omitempty"` // e.g. MONTHLY_STATEMENT, RECHARGE
+	Status      string `json:"status,omitempty"`       // e.g. PAID, UNPAID
+	Page        int    `json:"page,omitempty"`
+	Size        int    `json:"size,omitempty"`
+}
+
+// BCInvoiceLineItem is the charge of one advertiser on an invoice
+type BCInvoiceLineItem struct {
+	AdvertiserID   string  `json:"advertiser_id"`
+	AdvertiserName string  `json:"advertiser_name"`
+	Description    string  `json:"description,omitempty"`
+	Amount         float64 `json:"amount"`
+	TaxAmount      float64 `json:"tax_amount"`
+	TotalAmount    float64 `json:"total_amount"`
+	Currency       string  `json:"currency"`
+	PeriodStart    string  `json:"period_start,omitempty"`
+	PeriodEnd      string  `json:"period_end,omitempty"`
+}
+
+// BCInvoice is an invoice issued to a business center
+type BCInvoice struct {
+	InvoiceID    string              `json:"invoice_id"`
+	SerialNumber string              `json:"invoice_serial_number"`
+	InvoiceType  string              `json:"invoice_type"`
+	Status       string              `json:"status"`
+	Amount       float64             `json:"amount"`
+	TaxAmount    float64             `json:"tax_amount"`
+	TotalAmount  float64             `json:"total_amount"`
+	Currency     string              `json:"currency"`
+	IssueDate    string              `json:"issue_date"`
+	DueDate      string              `json:"due_date,omitempty"`
+	PeriodStart  string              `json:"period_start,omitempty"`
+	PeriodEnd    string              `json:"period_end,omitempty"`
+	LineItems    []BCInvoiceLineItem `json:"line_items,omitempty"`
+}
+
+// BCInvoiceResponse represents the response for listing invoices
+type BCInvoiceResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		Invoices []BCInvoice `json:"invoices"`
+		PageInfo struct {
+			Page       int `json:"page"`
+			Size       int `json:"size"`
+			TotalCount int `json:"total_count"`
+		} `json:"page_info"`
+	} `json:"data"`
+}
+
+// GetInvoices retrieves a page of invoices with their line items
+func (s *BusinessCenterService) GetInvoices(ctx context.Context, req *BCInvoiceGetRequest) (*BCInvoiceResponse, error) {
+	if req == nil || req.BCID == "" {
+		return nil, fmt.Errorf("bc_id is required")
+	}
+
+	params := map[string]interface{}{
+		"bc_id": req.BCID,
+	}
+	if req.StartDate != "" {
+		params["start_date"] = req.StartDate
+	}
+	if req.EndDate != "" {
+		params["end_date"] = req.EndDate
+	}
+	if req.InvoiceType != "" {
+		params["invoice_type"] = req.InvoiceType
+	}
+	if req.Status != "" {
+		params["status"] = req.Status
+	}
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+	if req.Size > 0 {
+		params["size"] = req.Size
+	}
+
+	url, err := s.client.BuildURL("/bc/invoice/get/", params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoices: %w", err)
+	}
+
+	var response BCInvoiceResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetAllInvoices pages through every invoice matching the request, ignoring
+// its Page, for a full billing history
+func (s *BusinessCenterService) GetAllInvoices(ctx context.Context, req *BCInvoiceGetRequest) ([]BCInvoice, error) {
+	if req == nil || req.BCID == "" {
+		return nil, fmt.Errorf("bc_id is required")
+	}
+
+	pageReq := *req
+	if pageReq.Size <= 0 {
+		pageReq.Size = 100
+	}
+
+	var invoices []BCInvoice
+	for page := 1; ; page++ {
+		pageReq.Page = page
+		resp, err := s.GetInvoices(ctx, &pageReq)
+		if err != nil {
+			return invoices, err
+		}
+		if resp.Code != 0 {
+			return invoices, fmt.Errorf("API error: %s", resp.Message)
+		}
+
+		invoices = append(invoices, resp.Data.Invoices...)
+		if len(resp.Data.Invoices) < pageReq.Size || len(invoices) >= resp.Data.PageInfo.TotalCount {
+			return invoices, nil
+		}
+	}
+}
+
+// DownloadInvoice streams the PDF of an invoice. The caller must close the
+// returned reader.
+func (s *BusinessCenterService) DownloadInvoice(ctx context.Context, bcID, invoiceID string) (io.ReadCloser, error) {
+	if bcID == "" {
+		return nil, fmt.Errorf("bc_id is required")
+	}
+	if invoiceID == "" {
+		return nil, fmt.Errorf("invoice_id is required")
+	}
+
+	url, err := s.client.BuildURL("/bc/invoice/download/", map[string]interface{}{
+		"bc_id":      bcID,
+		"invoice_id": invoiceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := s.client.streamDownload(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download invoice %s: %w", invoiceID, err)
+	}
+	return body, nil
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestBusinessCenterService_Invoices(t *testing.T) {
+	pdf := []byte("%PDF-1.4 invoice")
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/open_api/v1.3/bc/invoice/get/":
+			w.Header().Set("Content-Type", "application/json")
+			if query.Get("start_date") != "2026-01-01" || query.Get("size") != "1" {
+				t.Errorf("query = %v", query)
+			}
+			fmt.Fprintf(w, `{"code":0,"data":{"invoices":[{"invoice_id":"inv_%s","invoice_type":"MONTHLY_STATEMENT","issue_date":"2026-02-01","total_amount":120,"currency":"USD",
+				"line_items":[{"advertiser_id":"adv_1","total_amount":100,"currency":"USD"},{"advertiser_id":"adv_2","total_amount":20,"currency":"USD"}]}],
+				"page_info":{"page":%[1]s,"size":1,"total_count":2}}}`, query.Get("page"))
+		case "/open_api/v1.3/bc/invoice/download/":
+			if query.Get("invoice_id") == "missing" {
+				writeJSON(w, `{"code":40002,"message":"invoice not found"}`)
+				return
+			}
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Write(pdf)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	invoices, err := client.BusinessCenter().GetAllInvoices(ctx, &BCInvoiceGetRequest{BCID: "bc_1", StartDate: "2026-01-01", Size: 1})
+	if err != nil {
+		t.Fatalf("GetAllInvoices() error = %v", err)
+	}
+	if len(invoices) != 2 || invoices[1].InvoiceID != "inv_2" || len(invoices[0].LineItems) != 2 {
+		t.Fatalf("invoices = %+v", invoices)
+	}
+
+	entries := LedgerEntriesFromInvoiceLineItems(invoices)
+	if len(entries) != 4 || entries[0].TransactionID != "inv_1/adv_1" || entries[0].Amount != -100 || entries[0].Time.IsZero() {
+		t.Errorf("entries = %+v", entries)
+	}
+
+	body, err := client.BusinessCenter().DownloadInvoice(ctx, "bc_1", "inv_1")
+	if err != nil {
+		t.Fatalf("DownloadInvoice() error = %v", err)
+	}
+	data, _ := io.ReadAll(body)
+	body.Close()
+	if !bytes.Equal(data, pdf) {
+		t.Errorf("DownloadInvoice() = %q", data)
+	}
+
+	_, err = client.BusinessCenter().DownloadInvoice(ctx, "bc_1", "missing")
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("DownloadInvoice() error = %v, want an APIError", err)
+	}
+}
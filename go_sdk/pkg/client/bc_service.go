@@ -1,10 +1,15 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
 )
 
 // BusinessCenterService handles Business Center related operations
@@ -54,8 +59,8 @@ type BCData struct {
 	ContactInfo ContactInfo `json:"contact_info"`
 	TimeZone    string      `json:"timezone"`
 	Status      string      `json:"status"`
-	CreateTime  string      `json:"create_time"`
-	UpdateTime  string      `json:"update_time"`
+	CreateTime  models.Time `json:"create_time"`
+	UpdateTime  models.Time `json:"update_time"`
 }
 
 // BCListResponse represents the response for listing business centers
@@ -82,7 +87,10 @@ func (s *BusinessCenterService) Get(ctx context.Context, req *BCGetRequest) (*BC
 		params["scene"] = "SINGLE_ACCOUNT"
 	}
 
-	url := s.client.BuildURL("/bc/get/", params)
+	url, err := s.client.BuildURL("/bc/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -116,7 +124,10 @@ func (s *BusinessCenterService) Create(ctx context.Context, req *BCCreateRequest
 		return nil, fmt.Errorf("contact name is required")
 	}
 
-	url := s.client.BuildURL("/bc/create/", nil)
+	url, err := s.client.BuildURL("/bc/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -136,6 +147,91 @@ func (s *BusinessCenterService) Create(ctx context.Context, req *BCCreateRequest
 	return &response, nil
 }
 
+// GetBusinessCenters retrieves the business centers the token can access
+func (s *BusinessCenterService) GetBusinessCenters(ctx context.Context) (*BCListResponse, error) {
+	url, err := s.client.BuildURL("/bc/get/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get business centers: %w", err)
+	}
+
+	var response BCListResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetBusinessCenterInfo retrieves business center information
+func (s *BusinessCenterService) GetBusinessCenterInfo(ctx context.Context, bcID string) (*BCResponse, error) {
+	if bcID == "" {
+		return nil, fmt.Errorf("bc_id is required")
+	}
+	return s.Get(ctx, &BCGetRequest{BCID: bcID})
+}
+
+// bcAssetPageSize is the page size GetAdvertisersInBC lists assets with
+const bcAssetPageSize = 50
+
+// GetAdvertisersInBC retrieves every advertiser account of a business center,
+// paging through the assets until a page comes back short
+func (s *BusinessCenterService) GetAdvertisersInBC(ctx context.Context, bcID string) (*BCAssetResponse, error) {
+	var advertisers *BCAssetResponse
+	for page := 1; ; page++ {
+		resp, err := s.GetAssets(ctx, &BCAssetGetRequest{BCID: bcID, AssetType: "ADVERTISER", Page: page, Size: bcAssetPageSize})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+		if advertisers == nil {
+			advertisers = resp
+		} else {
+			advertisers.Data = append(advertisers.Data, resp.Data...)
+		}
+		if len(resp.Data) < bcAssetPageSize {
+			return advertisers, nil
+		}
+	}
+}
+
+// TransferAdvertiserRequest moves an advertiser account into a business center
+type TransferAdvertiserRequest struct {
+	BCID         string `json:"bc_id"`
+	AdvertiserID string `json:"advertiser_id"`
+}
+
+// TransferAdvertiserResponse reports the advertiser assigned to the business center
+type TransferAdvertiserResponse struct {
+	Code      int               `json:"code"`
+	Message   string            `json:"message"`
+	RequestID string            `json:"request_id"`
+	Data      BCAssetAssignData `json:"data"`
+}
+
+// TransferAdvertiser transfers an advertiser to a business center by
+// assigning it as an ADVERTISER asset
+func (s *BusinessCenterService) TransferAdvertiser(ctx context.Context, req *TransferAdvertiserRequest) (*TransferAdvertiserResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.AssignAsset(ctx, &BCAssetAssignRequest{BCID: req.BCID, AssetID: req.AdvertiserID, AssetType: "ADVERTISER"})
+	if err != nil {
+		return nil, err
+	}
+	return &TransferAdvertiserResponse{Code: resp.Code, Message: resp.Message, RequestID: resp.RequestID, Data: resp.Data}, nil
+}
+
 // BCMemberGetRequest represents the request for getting BC members
 type BCMemberGetRequest struct {
 	BCID string `json:"bc_id"`
@@ -177,7 +273,10 @@ func (s *BusinessCenterService) GetMembers(ctx context.Context, req *BCMemberGet
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/bc/member/get/", params)
+	url, err := s.client.BuildURL("/bc/member/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -202,11 +301,11 @@ type BCAssetGetRequest struct {
 
 // BCAssetData represents BC asset information
 type BCAssetData struct {
-	AssetID   string `json:"asset_id"`
-	AssetType string `json:"asset_type"`
-	AssetName string `json:"asset_name"`
-	Status    string `json:"status"`
-	CreateTime string `json:"create_time"`
+	AssetID    string      `json:"asset_id"`
+	AssetType  string      `json:"asset_type"`
+	AssetName  string      `json:"asset_name"`
+	Status     string      `json:"status"`
+	CreateTime models.Time `json:"create_time"`
 }
 
 // BCAssetResponse represents the response for BC asset operations
@@ -236,7 +335,10 @@ func (s *BusinessCenterService) GetAssets(ctx context.Context, req *BCAssetGetRe
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/bc/asset/get/", params)
+	url, err := s.client.BuildURL("/bc/asset/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -338,9 +440,9 @@ type BCMemberUpdateResponse struct {
 }
 
 type BCMemberUpdateData struct {
-	MemberID   string `json:"member_id"`
-	Status     string `json:"status"`
-	UpdateTime string `json:"update_time"`
+	MemberID   string      `json:"member_id"`
+	Status     string      `json:"status"`
+	UpdateTime models.Time `json:"update_time"`
 }
 
 type BCMemberDeleteRequest struct {
@@ -441,7 +543,10 @@ func (s *BusinessCenterService) Transfer(ctx context.Context, req *BCTransferReq
 		return nil, fmt.Errorf("amount must be positive")
 	}
 
-	url := s.client.BuildURL("/bc/transfer/", nil)
+	url, err := s.client.BuildURL("/bc/transfer/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -475,7 +580,10 @@ func (s *BusinessCenterService) GetBalance(ctx context.Context, req *BCBalanceGe
 		params["account_id"] = req.AccountID
 	}
 
-	url := s.client.BuildURL("/bc/balance/get/", params)
+	url, err := s.client.BuildURL("/bc/balance/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -505,7 +613,10 @@ func (s *BusinessCenterService) InviteMember(ctx context.Context, req *BCMemberI
 		return nil, fmt.Errorf("role is required")
 	}
 
-	url := s.client.BuildURL("/bc/member/invite/", nil)
+	url, err := s.client.BuildURL("/bc/member/invite/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -537,7 +648,10 @@ func (s *BusinessCenterService) UpdateMember(ctx context.Context, req *BCMemberU
 		return nil, fmt.Errorf("member_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/member/update/", nil)
+	url, err := s.client.BuildURL("/bc/member/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -569,7 +683,10 @@ func (s *BusinessCenterService) DeleteMember(ctx context.Context, req *BCMemberD
 		return nil, fmt.Errorf("member_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/member/delete/", nil)
+	url, err := s.client.BuildURL("/bc/member/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -604,7 +721,10 @@ func (s *BusinessCenterService) AssignMember(ctx context.Context, req *BCMemberA
 		return nil, fmt.Errorf("asset_ids is required")
 	}
 
-	url := s.client.BuildURL("/bc/member/assign/", nil)
+	url, err := s.client.BuildURL("/bc/member/assign/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -639,7 +759,10 @@ func (s *BusinessCenterService) AssignAsset(ctx context.Context, req *BCAssetAss
 		return nil, fmt.Errorf("asset_type is required")
 	}
 
-	url := s.client.BuildURL("/bc/asset/assign/", nil)
+	url, err := s.client.BuildURL("/bc/asset/assign/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -671,7 +794,10 @@ func (s *BusinessCenterService) UnassignAsset(ctx context.Context, req *BCAssetU
 		return nil, fmt.Errorf("asset_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/asset/unassign/", nil)
+	url, err := s.client.BuildURL("/bc/asset/unassign/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -717,13 +843,13 @@ type BCTransactionData struct {
 }
 
 type TransactionInfo struct {
-	TransactionID string  `json:"transaction_id"`
-	Type          string  `json:"type"`
-	Amount        float64 `json:"amount"`
-	Currency      string  `json:"currency"`
-	Description   string  `json:"description"`
-	Status        string  `json:"status"`
-	CreateTime    string  `json:"create_time"`
+	TransactionID string      `json:"transaction_id"`
+	Type          string      `json:"type"`
+	Amount        float64     `json:"amount"`
+	Currency      string      `json:"currency"`
+	Description   string      `json:"description"`
+	Status        string      `json:"status"`
+	CreateTime    models.Time `json:"create_time"`
 }
 
 type BCAssetGroupCreateRequest struct {
@@ -740,12 +866,12 @@ type BCAssetGroupResponse struct {
 }
 
 type BCAssetGroupData struct {
-	GroupID     string `json:"group_id"`
-	GroupName   string `json:"group_name"`
-	Description string `json:"description"`
-	Status      string `json:"status"`
-	CreateTime  string `json:"create_time"`
-	UpdateTime  string `json:"update_time"`
+	GroupID     string      `json:"group_id"`
+	GroupName   string      `json:"group_name"`
+	Description string      `json:"description"`
+	Status      string      `json:"status"`
+	CreateTime  models.Time `json:"create_time"`
+	UpdateTime  models.Time `json:"update_time"`
 }
 
 type BCAssetGroupGetRequest struct {
@@ -776,11 +902,19 @@ type BCAssetGroupDeleteRequest struct {
 	GroupID string `json:"group_id"`
 }
 
+// BCImageUploadRequest represents the request for uploading an image to a
+// business center. The image is sent as a multipart file.
 type BCImageUploadRequest struct {
 	BCID      string `json:"bc_id"`
-	ImageData []byte `json:"image_data"`
-	ImageName string `json:"image_name"`
-	ImageType string `json:"image_type"` // JPG, PNG, GIF
+	ImageData []byte `json:"-"`
+	ImageName string `json:"image_name,omitempty"`
+
+	// ImageType is JPG or PNG; it is detected from ImageData when empty
+	ImageType string `json:"image_type,omitempty"`
+
+	// AutoConvert re-encodes images the business center rejects, such as
+	// GIF, and PNGs over BCImageMaxBytes as JPEG instead of failing
+	AutoConvert bool `json:"-"`
 }
 
 type BCImageUploadResponse struct {
@@ -821,7 +955,10 @@ func (s *BusinessCenterService) GetTransactions(ctx context.Context, req *BCTran
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/bc/transaction/get/", params)
+	url, err := s.client.BuildURL("/bc/transaction/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -848,7 +985,10 @@ func (s *BusinessCenterService) CreateAssetGroup(ctx context.Context, req *BCAss
 		return nil, fmt.Errorf("group_name is required")
 	}
 
-	url := s.client.BuildURL("/bc/asset_group/create/", nil)
+	url, err := s.client.BuildURL("/bc/asset_group/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -882,7 +1022,10 @@ func (s *BusinessCenterService) GetAssetGroups(ctx context.Context, req *BCAsset
 		params["group_id"] = req.GroupID
 	}
 
-	url := s.client.BuildURL("/bc/asset_group/get/", params)
+	url, err := s.client.BuildURL("/bc/asset_group/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -909,7 +1052,10 @@ func (s *BusinessCenterService) UpdateAssetGroup(ctx context.Context, req *BCAss
 		return nil, fmt.Errorf("group_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/asset_group/update/", nil)
+	url, err := s.client.BuildURL("/bc/asset_group/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -941,7 +1087,10 @@ func (s *BusinessCenterService) DeleteAssetGroup(ctx context.Context, req *BCAss
 		return nil, fmt.Errorf("group_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/asset_group/delete/", nil)
+	url, err := s.client.BuildURL("/bc/asset_group/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -961,26 +1110,33 @@ func (s *BusinessCenterService) DeleteAssetGroup(ctx context.Context, req *BCAss
 	return &response, nil
 }
 
-// UploadImage uploads an image to business center
+// UploadImage uploads an image to business center. The format is sniffed
+// from the data and checked against the declared type and the size limit
+// before anything is sent.
 func (s *BusinessCenterService) UploadImage(ctx context.Context, req *BCImageUploadRequest) (*BCImageUploadResponse, error) {
 	if req == nil {
 		return nil, fmt.Errorf("request cannot be nil")
 	}
-	if req.BCID == "" {
-		return nil, fmt.Errorf("bc_id is required")
+	if err := req.Validate(); err != nil {
+		return nil, err
 	}
-	if len(req.ImageData) == 0 {
-		return nil, fmt.Errorf("image_data is required")
+	data, imageType, contentType, err := prepareBCImage(req)
+	if err != nil {
+		return nil, err
 	}
 
-	url := s.client.BuildURL("/bc/image/upload/", nil)
-	
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	fields := map[string]string{"bc_id": req.BCID}
+	if req.ImageName != "" {
+		fields["image_name"] = req.ImageName
+	}
+	file := MultipartFile{
+		FieldName:   "image_file",
+		FileName:    uploadFileName(strings.TrimSuffix(req.ImageName, path.Ext(req.ImageName)), imageType),
+		ContentType: contentType,
+		Reader:      bytes.NewReader(data),
 	}
 
-	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	resp, err := s.client.DoMultipartRequest(ctx, "/bc/image/upload/", fields, []MultipartFile{file}, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload image: %w", err)
 	}
@@ -993,6 +1149,17 @@ func (s *BusinessCenterService) UploadImage(ctx context.Context, req *BCImageUpl
 	return &response, nil
 }
 
+// Validate checks the business center ID and that there is image data
+func (r *BCImageUploadRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if len(r.ImageData) == 0 {
+		return models.NewValidationError("image_data", "image_data is required")
+	}
+	return nil
+}
+
 // ListAssetGroups retrieves a list of asset groups
 func (s *BusinessCenterService) ListAssetGroups(ctx context.Context, req *BCAssetGroupListRequest) (*BCAssetGroupListResponse, error) {
 	if req == nil || req.BCID == "" {
@@ -1010,7 +1177,10 @@ func (s *BusinessCenterService) ListAssetGroups(ctx context.Context, req *BCAsse
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/bc/asset_group/list/", params)
+	url, err := s.client.BuildURL("/bc/asset_group/list/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -1042,7 +1212,10 @@ func (s *BusinessCenterService) GetAssetMembers(ctx context.Context, req *BCAsse
 		params["asset_type"] = req.AssetType
 	}
 
-	url := s.client.BuildURL("/bc/asset_member/get/", params)
+	url, err := s.client.BuildURL("/bc/asset_member/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -1071,7 +1244,10 @@ func (s *BusinessCenterService) GetAssetPartners(ctx context.Context, req *BCAss
 		params["asset_id"] = req.AssetID
 	}
 
-	url := s.client.BuildURL("/bc/asset_partner/get/", params)
+	url, err := s.client.BuildURL("/bc/asset_partner/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -1100,7 +1276,10 @@ func (s *BusinessCenterService) GetAssetAdmins(ctx context.Context, req *BCAsset
 		params["asset_id"] = req.AssetID
 	}
 
-	url := s.client.BuildURL("/bc/asset_admin/get/", params)
+	url, err := s.client.BuildURL("/bc/asset_admin/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -1130,7 +1309,10 @@ func (s *BusinessCenterService) DeleteAssetAdmin(ctx context.Context, req *BCAss
 		return nil, fmt.Errorf("user_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/asset_admin/delete/", nil)
+	url, err := s.client.BuildURL("/bc/asset_admin/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -1277,7 +1459,10 @@ func (s *BusinessCenterService) GetAccountTransactions(ctx context.Context, req
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/bc/account_transaction/get/", params)
+	url, err := s.client.BuildURL("/bc/account_transaction/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -1304,7 +1489,10 @@ func (s *BusinessCenterService) CreateBillingGroup(ctx context.Context, req *BCB
 		return nil, fmt.Errorf("group_name is required")
 	}
 
-	url := s.client.BuildURL("/bc/billing_group/create/", nil)
+	url, err := s.client.BuildURL("/bc/billing_group/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -1338,7 +1526,10 @@ func (s *BusinessCenterService) GetBillingGroup(ctx context.Context, req *BCBill
 		params["group_id"] = req.GroupID
 	}
 
-	url := s.client.BuildURL("/bc/billing_group/get/", params)
+	url, err := s.client.BuildURL("/bc/billing_group/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -1365,7 +1556,10 @@ func (s *BusinessCenterService) UpdateBillingGroup(ctx context.Context, req *BCB
 		return nil, fmt.Errorf("group_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/billing_group/update/", nil)
+	url, err := s.client.BuildURL("/bc/billing_group/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -1402,7 +1596,10 @@ func (s *BusinessCenterService) GetUnpaidInvoices(ctx context.Context, req *BCIn
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/bc/invoice_unpaid/get/", params)
+	url, err := s.client.BuildURL("/bc/invoice_unpaid/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -1429,7 +1626,10 @@ func (s *BusinessCenterService) AddPartner(ctx context.Context, req *BCPartnerAd
 		return nil, fmt.Errorf("partner_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/partner/add/", nil)
+	url, err := s.client.BuildURL("/bc/partner/add/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -1463,7 +1663,10 @@ func (s *BusinessCenterService) GetPartner(ctx context.Context, req *BCPartnerGe
 		params["partner_id"] = req.PartnerID
 	}
 
-	url := s.client.BuildURL("/bc/partner/get/", params)
+	url, err := s.client.BuildURL("/bc/partner/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -1490,7 +1693,10 @@ func (s *BusinessCenterService) DeletePartner(ctx context.Context, req *BCPartne
 		return nil, fmt.Errorf("partner_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/partner/delete/", nil)
+	url, err := s.client.BuildURL("/bc/partner/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -1527,7 +1733,10 @@ func (s *BusinessCenterService) GetPartnerAssets(ctx context.Context, req *BCPar
 		params["asset_type"] = req.AssetType
 	}
 
-	url := s.client.BuildURL("/bc/partner_asset/get/", params)
+	url, err := s.client.BuildURL("/bc/partner_asset/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -1557,7 +1766,10 @@ func (s *BusinessCenterService) DeletePartnerAsset(ctx context.Context, req *BCP
 		return nil, fmt.Errorf("asset_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/partner_asset/delete/", nil)
+	url, err := s.client.BuildURL("/bc/partner_asset/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -1591,7 +1803,10 @@ func (s *BusinessCenterService) GetPixelLinks(ctx context.Context, req *BCPixelL
 		params["pixel_id"] = req.PixelID
 	}
 
-	url := s.client.BuildURL("/bc/pixel_link/get/", params)
+	url, err := s.client.BuildURL("/bc/pixel_link/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -1618,7 +1833,10 @@ func (s *BusinessCenterService) UpdatePixelLink(ctx context.Context, req *BCPixe
 		return nil, fmt.Errorf("pixel_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/pixel_link/update/", nil)
+	url, err := s.client.BuildURL("/bc/pixel_link/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -1653,7 +1871,10 @@ func (s *BusinessCenterService) TransferPixel(ctx context.Context, req *BCPixelT
 		return nil, fmt.Errorf("target_bc_id is required")
 	}
 
-	url := s.client.BuildURL("/bc/pixel/transfer/", nil)
+	url, err := s.client.BuildURL("/bc/pixel/transfer/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -1700,15 +1921,15 @@ type BCAccountTransactionData struct {
 }
 
 type BCAccountTransaction struct {
-	TransactionID   string  `json:"transaction_id"`
-	AccountID       string  `json:"account_id"`
-	TransactionType string  `json:"transaction_type"`
-	Amount          float64 `json:"amount"`
-	Currency        string  `json:"currency"`
-	Status          string  `json:"status"`
-	CreateTime      string  `json:"create_time"`
-	UpdateTime      string  `json:"update_time"`
-	Description     string  `json:"description,omitempty"`
+	TransactionID   string      `json:"transaction_id"`
+	AccountID       string      `json:"account_id"`
+	TransactionType string      `json:"transaction_type"`
+	Amount          float64     `json:"amount"`
+	Currency        string      `json:"currency"`
+	Status          string      `json:"status"`
+	CreateTime      models.Time `json:"create_time"`
+	UpdateTime      models.Time `json:"update_time"`
+	Description     string      `json:"description,omitempty"`
 }
 
 type BCBillingGroupCreateRequest struct {
@@ -1743,12 +1964,12 @@ type BCBillingGroupData struct {
 }
 
 type BCBillingGroup struct {
-	GroupID     string   `json:"group_id"`
-	GroupName   string   `json:"group_name"`
-	Description string   `json:"description"`
-	AccountIDs  []string `json:"account_ids"`
-	CreateTime  string   `json:"create_time"`
-	UpdateTime  string   `json:"update_time"`
+	GroupID     string      `json:"group_id"`
+	GroupName   string      `json:"group_name"`
+	Description string      `json:"description"`
+	AccountIDs  []string    `json:"account_ids"`
+	CreateTime  models.Time `json:"create_time"`
+	UpdateTime  models.Time `json:"update_time"`
 }
 
 type BCInvoiceUnpaidGetRequest struct {
@@ -1774,13 +1995,13 @@ type BCInvoiceUnpaidData struct {
 }
 
 type BCInvoiceUnpaid struct {
-	InvoiceID    string  `json:"invoice_id"`
-	Amount       float64 `json:"amount"`
-	Currency     string  `json:"currency"`
-	DueDate      string  `json:"due_date"`
-	Status       string  `json:"status"`
-	CreateTime   string  `json:"create_time"`
-	Description  string  `json:"description,omitempty"`
+	InvoiceID   string      `json:"invoice_id"`
+	Amount      float64     `json:"amount"`
+	Currency    string      `json:"currency"`
+	DueDate     string      `json:"due_date"`
+	Status      string      `json:"status"`
+	CreateTime  models.Time `json:"create_time"`
+	Description string      `json:"description,omitempty"`
 }
 
 type BCPartnerAddRequest struct {
@@ -1889,9 +2110,9 @@ type BCPixelLinkUpdateResponse struct {
 }
 
 type BCPixelLinkUpdateData struct {
-	PixelID    string `json:"pixel_id"`
-	Status     string `json:"status"`
-	UpdateTime string `json:"update_time"`
+	PixelID    string      `json:"pixel_id"`
+	Status     string      `json:"status"`
+	UpdateTime models.Time `json:"update_time"`
 }
 
 type BCPixelTransferRequest struct {
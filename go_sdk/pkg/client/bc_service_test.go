@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestClient_BC(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/open_api/v1.3/bc/get/" && r.URL.Query().Get("bc_id") == "":
+			w.Write([]byte(`{"code":0,"message":"OK","data":[{"bc_id":"bc_1","bc_name":"One"},{"bc_id":"bc_2","bc_name":"Two"}]}`))
+		case r.URL.Path == "/open_api/v1.3/bc/get/":
+			w.Write([]byte(`{"code":0,"message":"OK","data":{"bc_id":"` + r.URL.Query().Get("bc_id") + `","bc_name":"One"}}`))
+		case r.URL.Path == "/open_api/v1.3/bc/asset/get/":
+			query := r.URL.Query()
+			if query.Get("asset_type") != "ADVERTISER" || query.Get("size") != strconv.Itoa(bcAssetPageSize) {
+				t.Errorf("asset query = %v", query)
+			}
+			// A full first page and a short second page
+			page, _ := strconv.Atoi(query.Get("page"))
+			count := bcAssetPageSize
+			if page == 2 {
+				count = 1
+			}
+			assets := make([]string, count)
+			for i := range assets {
+				assets[i] = fmt.Sprintf(`{"asset_id":"adv_%d_%d","asset_type":"ADVERTISER"}`, page, i)
+			}
+			w.Write([]byte(`{"code":0,"message":"OK","data":[` + strings.Join(assets, ",") + `]}`))
+		case r.URL.Path == "/open_api/v1.3/bc/asset/assign/":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["bc_id"] != "bc_1" || body["asset_id"] != "adv_9" || body["asset_type"] != "ADVERTISER" {
+				t.Errorf("assign body = %v", body)
+			}
+			w.Write([]byte(`{"code":0,"message":"OK","data":{"asset_id":"adv_9","status":"ASSIGNED"}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	centers, err := client.BC().GetBusinessCenters(ctx)
+	if err != nil || len(centers.Data) != 2 || centers.Data[1].BCID != "bc_2" {
+		t.Fatalf("GetBusinessCenters() = %+v, %v", centers, err)
+	}
+	info, err := client.BC().GetBusinessCenterInfo(ctx, "bc_1")
+	if err != nil || info.Data.BCID != "bc_1" {
+		t.Errorf("GetBusinessCenterInfo() = %+v, %v", info, err)
+	}
+	if _, err := client.BC().GetBusinessCenterInfo(ctx, ""); err == nil {
+		t.Error("GetBusinessCenterInfo() accepted an empty bc_id")
+	}
+	advertisers, err := client.BC().GetAdvertisersInBC(ctx, "bc_1")
+	if err != nil || len(advertisers.Data) != bcAssetPageSize+1 || advertisers.Data[bcAssetPageSize].AssetID != "adv_2_0" {
+		t.Errorf("GetAdvertisersInBC() = %+v, %v", advertisers, err)
+	}
+
+	transferred, err := client.BC().TransferAdvertiser(ctx, &TransferAdvertiserRequest{BCID: "bc_1", AdvertiserID: "adv_9"})
+	if err != nil || transferred.Data.AssetID != "adv_9" || transferred.Data.Status != "ASSIGNED" {
+		t.Errorf("TransferAdvertiser() = %+v, %v", transferred, err)
+	}
+	if _, err := client.BC().TransferAdvertiser(ctx, &TransferAdvertiserRequest{BCID: "bc_1"}); err == nil {
+		t.Error("TransferAdvertiser() accepted an empty advertiser_id")
+	}
+}
+
+func TestClient_BCAdvertisersError(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"code":40002,"message":"No permission"}`)
+	})
+	client := newTestClient(t, server)
+
+	var apiErr *models.APIError
+	if _, err := client.BC().GetAdvertisersInBC(context.Background(), "bc_1"); !errors.As(err, &apiErr) || apiErr.Code != "40002" {
+		t.Errorf("GetAdvertisersInBC() error = %v, want API error 40002", err)
+	}
+}
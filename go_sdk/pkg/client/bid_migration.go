@@ -0,0 +1,317 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// BidStrategy is the bidding approach an ad group is migrated to
+type BidStrategy string
+
+const (
+	// BidStrategyLowestCost spends the budget for the most results (BID_TYPE_NO_BID)
+	BidStrategyLowestCost BidStrategy = "LOWEST_COST"
+
+	// BidStrategyCostCap keeps the average cost per result under a cap (BID_TYPE_CUSTOM)
+	BidStrategyCostCap BidStrategy = "COST_CAP"
+)
+
+// bidRollbackTimeout bounds the rollback of a failed batch, which runs even
+// when the migration's context is done
+const bidRollbackTimeout = 30 * time.Second
+
+// Bid migration outcomes
+const (
+	BidMigrationPlanned    = "PLANNED"
+	BidMigrationMigrated   = "MIGRATED"
+	BidMigrationSkipped    = "SKIPPED"
+	BidMigrationFailed     = "FAILED"
+	BidMigrationRolledBack = "ROLLED_BACK"
+)
+
+// BidMigrationRequest represents the request for migrating ad groups between bid strategies
+type BidMigrationRequest struct {
+	AdvertiserID string
+	AdGroupIDs   []string
+	Target       BidStrategy
+
+	// StartDate and EndDate bound the window used to observe CPA (YYYY-MM-DD)
+	StartDate string
+	EndDate   string
+
+	// CapMultiplier scales the observed CPA into the recommended cost cap
+	// (defaults to 1.1)
+	CapMultiplier float64
+
+	// MinConversions is the number of conversions needed to trust the
+	// observed CPA (defaults to 10); ad groups below it are skipped
+	MinConversions float64
+
+	// BatchSize is the number of ad groups updated before moving on (defaults to 10)
+	BatchSize int
+
+	// DryRun computes the plan without updating any ad group
+	DryRun bool
+}
+
+// BidSettings are the bid fields changed by a migration
+type BidSettings struct {
+	BidType            models.BidType `json:"bid_type"`
+	BidPrice           float64        `json:"bid_price,omitempty"`
+	ConversionBidPrice float64        `json:"conversion_bid_price,omitempty"`
+}
+
+// BidMigrationResult describes the migration of one ad group
+type BidMigrationResult struct {
+	AdGroupID   string      `json:"adgroup_id"`
+	AdGroupName string      `json:"adgroup_name"`
+	Before      BidSettings `json:"before"`
+	After       BidSettings `json:"after"`
+	Spend       float64     `json:"spend"`
+	Conversions float64     `json:"conversions"`
+	ObservedCPA float64     `json:"observed_cpa"`
+	Status      string      `json:"status"`
+	Error       string      `json:"error,omitempty"`
+}
+
+// BidMigrationReport summarizes a bid strategy migration
+type BidMigrationReport struct {
+	Target  BidStrategy          `json:"target"`
+	Results []BidMigrationResult `json:"results"`
+
+	// RolledBack is set when a failed batch was reverted and the migration stopped
+	RolledBack bool `json:"rolled_back"`
+}
+
+// BidStrategyMigrator moves ad groups between lowest cost and cost cap bidding
+type BidStrategyMigrator struct {
+	client *Client
+}
+
+// NewBidStrategyMigrator creates a new BidStrategyMigrator
+func NewBidStrategyMigrator(client *Client) *BidStrategyMigrator {
+	return &BidStrategyMigrator{client: client}
+}
+
+// Migrate reads each ad group's current bid settings and performance, derives
+// the cost cap from observed CPA when migrating to cost cap, and applies the
+// updates in batches. When an update fails, the ad groups already updated in
+// that batch are restored to their previous settings and the migration stops;
+// earlier batches stay migrated.
+func (m *BidStrategyMigrator) Migrate(ctx context.Context, req *BidMigrationRequest) (*BidMigrationReport, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.AdGroupIDs) == 0 {
+		return nil, fmt.Errorf("adgroup_ids is required")
+	}
+	if req.Target != BidStrategyLowestCost && req.Target != BidStrategyCostCap {
+		return nil, fmt.Errorf("target must be LOWEST_COST or COST_CAP")
+	}
+	if req.StartDate == "" || req.EndDate == "" {
+		return nil, fmt.Errorf("start_date and end_date are required")
+	}
+
+	adGroups, err := m.adGroups(ctx, req.AdvertiserID, req.AdGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+	performance, err := m.performance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &BidMigrationReport{Target: req.Target}
+	var pending []int
+	for _, adGroup := range adGroups {
+		result := m.plan(req, adGroup, performance[adGroup.AdGroupID])
+		report.Results = append(report.Results, result)
+		if result.Status == BidMigrationPlanned {
+			pending = append(pending, len(report.Results)-1)
+		}
+	}
+	if req.DryRun {
+		return report, nil
+	}
+
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = 10
+	}
+	for start := 0; start < len(pending); start += batchSize {
+		end := start + batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		if !m.applyBatch(ctx, req.AdvertiserID, report.Results, pending[start:end]) {
+			report.RolledBack = true
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// plan computes the target settings of one ad group
+func (m *BidStrategyMigrator) plan(req *BidMigrationRequest, adGroup AdGroupInfo, metrics ReportingMetrics) BidMigrationResult {
+	result := BidMigrationResult{
+		AdGroupID:   adGroup.AdGroupID,
+		AdGroupName: adGroup.AdGroupName,
+		Before: BidSettings{
+			BidType:            adGroup.BidType,
+			BidPrice:           adGroup.BidPrice,
+			ConversionBidPrice: adGroup.ConversionBidPrice,
+		},
+		Spend:       float64(metrics.Spend),
+		Conversions: float64(metrics.Conversion),
+		Status:      BidMigrationPlanned,
+	}
+	if result.Conversions > 0 {
+		result.ObservedCPA = result.Spend / result.Conversions
+	}
+
+	switch req.Target {
+	case BidStrategyLowestCost:
+		if adGroup.BidType == models.BidTypeBidCap {
+			result.Status = BidMigrationSkipped
+			result.Error = "ad group already uses lowest cost bidding"
+			return result
+		}
+		result.After = BidSettings{BidType: models.BidTypeBidCap}
+
+	case BidStrategyCostCap:
+		if adGroup.BidType == models.BidTypeMaxBid {
+			result.Status = BidMigrationSkipped
+			result.Error = "ad group already uses cost cap bidding"
+			return result
+		}
+		minConversions := req.MinConversions
+		if minConversions <= 0 {
+			minConversions = 10
+		}
+		if result.Conversions < minConversions {
+			result.Status = BidMigrationSkipped
+			result.Error = fmt.Sprintf("%.0f conversions observed, at least %.0f needed to derive a cost cap", result.Conversions, minConversions)
+			return result
+		}
+		multiplier := req.CapMultiplier
+		if multiplier <= 0 {
+			multiplier = 1.1
+		}
+		result.After = BidSettings{
+			BidType:            models.BidTypeMaxBid,
+			ConversionBidPrice: math.Round(result.ObservedCPA*multiplier*100) / 100,
+		}
+	}
+
+	return result
+}
+
+// applyBatch updates the given results and reverts the batch when one fails.
+// The update may have failed because ctx is done, so the rollback detaches
+// from its cancellation and runs under its own timeout.
+func (m *BidStrategyMigrator) applyBatch(ctx context.Context, advertiserID string, results []BidMigrationResult, batch []int) bool {
+	for n, i := range batch {
+		if err := m.update(ctx, advertiserID, results[i].AdGroupID, results[i].After); err != nil {
+			results[i].Status = BidMigrationFailed
+			results[i].Error = err.Error()
+
+			rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), bidRollbackTimeout)
+			defer cancel()
+			for _, j := range batch[:n] {
+				results[j].Status = BidMigrationRolledBack
+				if err := m.update(rollbackCtx, advertiserID, results[j].AdGroupID, results[j].Before); err != nil {
+					results[j].Status = BidMigrationFailed
+					results[j].Error = fmt.Sprintf("rollback failed: %v", err)
+				}
+			}
+			return false
+		}
+		results[i].Status = BidMigrationMigrated
+	}
+	return true
+}
+
+// update applies bid settings to an ad group
+func (m *BidStrategyMigrator) update(ctx context.Context, advertiserID, adGroupID string, settings BidSettings) error {
+	resp, err := m.client.AdGroup().Update(ctx, &AdGroupUpdateRequest{
+		AdvertiserID: advertiserID,
+		AdGroupID:    adGroupID,
+		AdGroupBidding: AdGroupBidding{
+			BidType:            settings.BidType,
+			BidPrice:           settings.BidPrice,
+			ConversionBidPrice: settings.ConversionBidPrice,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Code != 0 {
+		return fmt.Errorf("API error: %s", resp.Message)
+	}
+	return nil
+}
+
+// adGroups loads the ad groups being migrated
+func (m *BidStrategyMigrator) adGroups(ctx context.Context, advertiserID string, adGroupIDs []string) ([]AdGroupInfo, error) {
+	var adGroups []AdGroupInfo
+	for page := 1; ; page++ {
+		resp, err := m.client.AdGroup().Get(ctx, &AdGroupGetRequest{
+			AdvertiserID: advertiserID,
+			Filtering:    &AdGroupFiltering{AdGroupIDs: adGroupIDs},
+			Page:         page,
+			PageSize:     100,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ad groups: %w", err)
+		}
+		if resp.Code != 0 {
+			return nil, fmt.Errorf("failed to get ad groups: %w", models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0))
+		}
+		adGroups = append(adGroups, resp.Data...)
+		if page >= resp.PageInfo.TotalPage {
+			return adGroups, nil
+		}
+	}
+}
+
+// performance reports spend and conversions per ad group over the lookback window
+func (m *BidStrategyMigrator) performance(ctx context.Context, req *BidMigrationRequest) (map[string]ReportingMetrics, error) {
+	ids, err := json.Marshal(req.AdGroupIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal adgroup_ids: %w", err)
+	}
+
+	metrics := make(map[string]ReportingMetrics, len(req.AdGroupIDs))
+	for page := 1; ; page++ {
+		resp, err := m.client.Reporting().GetIntegratedReport(ctx, &ReportingRequest{
+			AdvertiserID: req.AdvertiserID,
+			ReportType:   models.ReportTypeBasic,
+			DataLevel:    models.DataLevelAdGroup,
+			Dimensions:   []models.Dimension{models.DimensionAdGroupID},
+			Metrics:      []models.Metric{models.MetricSpend, models.MetricConversion},
+			Filters:      []ReportingFilter{{FieldName: "adgroup_ids", FilterType: "IN", FilterValue: string(ids)}},
+			StartDate:    req.StartDate,
+			EndDate:      req.EndDate,
+			Page:         page,
+			PageSize:     1000,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ad group performance: %w", err)
+		}
+		if resp.Code != 0 {
+			return nil, fmt.Errorf("failed to get ad group performance: %w", models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0))
+		}
+		for _, row := range resp.Data.List {
+			metrics[row.Dimensions.AdGroupID] = row.Metrics
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			return metrics, nil
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestBidStrategyMigrator_Migrate(t *testing.T) {
+	var updates []AdGroupUpdateRequest
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/adgroup/get/":
+			w.Write([]byte(`{"code":0,"data":[
+				{"adgroup_id":"1","bid_type":"BID_TYPE_NO_BID"},
+				{"adgroup_id":"2","bid_type":"BID_TYPE_NO_BID"},
+				{"adgroup_id":"3","bid_type":"BID_TYPE_NO_BID"}],"page_info":{"page":1,"total_page":1}}`))
+		case "/open_api/v1.3/report/integrated/get/":
+			w.Write([]byte(`{"code":0,"data":{"list":[
+				{"dimensions":{"adgroup_id":"1"},"metrics":{"spend":"200","conversion":"20"}},
+				{"dimensions":{"adgroup_id":"2"},"metrics":{"spend":"50","conversion":"2"}},
+				{"dimensions":{"adgroup_id":"3"},"metrics":{"spend":"300","conversion":"15"}}],"page_info":{"page":1,"total_page":1}}}`))
+		case "/open_api/v1.3/adgroup/update/":
+			var req AdGroupUpdateRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			updates = append(updates, req)
+			if req.AdGroupID == "3" {
+				w.Write([]byte(`{"code":40002,"message":"bid too low"}`))
+				return
+			}
+			w.Write([]byte(`{"code":0,"message":"OK"}`))
+		}
+	})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	report, err := NewBidStrategyMigrator(client).Migrate(context.Background(), &BidMigrationRequest{
+		AdvertiserID: "123",
+		AdGroupIDs:   []string{"1", "2", "3"},
+		Target:       BidStrategyCostCap,
+		StartDate:    "2024-01-01",
+		EndDate:      "2024-01-31",
+	})
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+
+	statuses := make(map[string]string)
+	for _, result := range report.Results {
+		statuses[result.AdGroupID] = result.Status
+	}
+	if statuses["1"] != BidMigrationRolledBack || statuses["2"] != BidMigrationSkipped || statuses["3"] != BidMigrationFailed || !report.RolledBack {
+		t.Errorf("Unexpected results: %+v", report.Results)
+	}
+	if report.Results[0].After.ConversionBidPrice != 11 {
+		t.Errorf("Expected cost cap 11, got %v", report.Results[0].After.ConversionBidPrice)
+	}
+	if len(updates) != 3 || updates[2].AdGroupID != "1" || updates[2].BidType != models.BidTypeBidCap {
+		t.Errorf("Expected ad group 1 to be restored last, got %+v", updates)
+	}
+}
+
+// bidMigrationServer serves two lowest cost ad groups with enough
+// conversions for a cost cap and hands ad group updates to update
+func bidMigrationServer(t *testing.T, update func(w http.ResponseWriter, r *http.Request, req AdGroupUpdateRequest)) *Client {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/adgroup/get/":
+			writeJSON(w, `{"code":0,"data":[{"adgroup_id":"1","bid_type":"BID_TYPE_NO_BID"},{"adgroup_id":"2","bid_type":"BID_TYPE_NO_BID"}],"page_info":{"page":1,"total_page":1}}`)
+		case "/open_api/v1.3/report/integrated/get/":
+			writeJSON(w, `{"code":0,"data":{"list":[
+				{"dimensions":{"adgroup_id":"1"},"metrics":{"spend":"200","conversion":"20"}},
+				{"dimensions":{"adgroup_id":"2"},"metrics":{"spend":"300","conversion":"15"}}],"page_info":{"page":1,"total_page":1}}}`)
+		case "/open_api/v1.3/adgroup/update/":
+			var req AdGroupUpdateRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			update(w, r, req)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	return newTestClient(t, server)
+}
+
+func TestBidStrategyMigrator_RollbackAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var updates []AdGroupUpdateRequest
+	client := bidMigrationServer(t, func(w http.ResponseWriter, r *http.Request, req AdGroupUpdateRequest) {
+		mu.Lock()
+		updates = append(updates, req)
+		mu.Unlock()
+		if req.AdGroupID == "2" {
+			// The migration is cancelled while ad group 2 is updated
+			cancel()
+			<-r.Context().Done()
+			return
+		}
+		writeJSON(w, `{"code":0,"message":"OK"}`)
+	})
+
+	report, err := NewBidStrategyMigrator(client).Migrate(ctx, &BidMigrationRequest{
+		AdvertiserID: "123",
+		AdGroupIDs:   []string{"1", "2"},
+		Target:       BidStrategyCostCap,
+		StartDate:    "2024-01-01",
+		EndDate:      "2024-01-31",
+	})
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	if report.Results[0].Status != BidMigrationRolledBack || report.Results[1].Status != BidMigrationFailed {
+		t.Errorf("results = %+v", report.Results)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(updates) != 3 || updates[2].AdGroupID != "1" || updates[2].BidType != models.BidTypeBidCap {
+		t.Errorf("updates = %+v, want ad group 1 restored after the cancellation", updates)
+	}
+}
+
+func TestBidStrategyMigrator_MigrateAPIErrors(t *testing.T) {
+	for _, path := range []string{"/open_api/v1.3/adgroup/get/", "/open_api/v1.3/report/integrated/get/"} {
+		t.Run(path, func(t *testing.T) {
+			server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case path:
+					writeJSON(w, `{"code":40001,"message":"No permission","request_id":"req1"}`)
+				case "/open_api/v1.3/adgroup/get/":
+					writeJSON(w, `{"code":0,"data":[{"adgroup_id":"1","bid_type":"BID_TYPE_NO_BID"}],"page_info":{"page":1,"total_page":1}}`)
+				default:
+					t.Errorf("Unexpected path %s", r.URL.Path)
+				}
+			})
+
+			_, err := NewBidStrategyMigrator(newTestClient(t, server)).Migrate(context.Background(), &BidMigrationRequest{
+				AdvertiserID: "123",
+				AdGroupIDs:   []string{"1"},
+				Target:       BidStrategyLowestCost,
+				StartDate:    "2024-01-01",
+				EndDate:      "2024-01-31",
+			})
+			var apiErr *models.APIError
+			if !errors.As(err, &apiErr) || apiErr.Code != "40001" {
+				t.Errorf("Migrate() error = %v, want API error 40001", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBlockedWordService(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/blockedword/list/":
+			if got := r.URL.Query().Get("page_info"); got != `{"page":2}` {
+				t.Errorf("page_info = %q", got)
+			}
+			w.Write([]byte(`{"code":0,"data":{"blocked_words":["spam"]}}`))
+		case "/open_api/v1.3/blockedword/create/":
+			var req BlockedWordCreateRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if len(req.BlockedWords) != 1 || req.BlockedWords[0] != "spam" {
+				t.Errorf("blocked_words = %v", req.BlockedWords)
+			}
+			w.Write([]byte(`{"code":0,"data":{}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	list, err := client.BlockedWord().List(context.Background(), &BlockedWordListRequest{
+		AdvertiserID: "123",
+		PageInfo:     &BlockedWordListRequestPageInfo{Page: 2},
+	})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if !strings.Contains(string(list.Data), "spam") {
+		t.Errorf("data = %s", list.Data)
+	}
+
+	if _, err := client.BlockedWord().Create(context.Background(), &BlockedWordCreateRequest{AdvertiserID: "123", BlockedWords: []string{"spam"}}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := client.BlockedWord().Create(context.Background(), &BlockedWordCreateRequest{AdvertiserID: "123"}); err == nil {
+		t.Error("Create() accepted a request without blocked_words")
+	}
+}
@@ -0,0 +1,325 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// BudgetProjectionRequest represents the request for projecting the effect
+// of budget changes on campaigns
+type BudgetProjectionRequest struct {
+	AdvertiserID string
+
+	// CampaignIDs limits the projection to these campaigns; all campaigns
+	// with spend in the window are projected when empty
+	CampaignIDs []string
+
+	// StartDate and EndDate bound the history the response curves are
+	// fitted on (YYYY-MM-DD)
+	StartDate string
+	EndDate   string
+
+	// BudgetChanges are the scenarios to project as fractions of the current
+	// daily spend, e.g. 0.2 for +20% and -0.1 for -10%
+	BudgetChanges []float64
+
+	// Confidence is the coverage of the projection bands (defaults to 0.8)
+	Confidence float64
+
+	// MinDays is the number of days with spend needed to fit a curve
+	// (defaults to 7)
+	MinDays int
+}
+
+// MetricProjection is the expected daily value of a metric with its
+// confidence band
+type MetricProjection struct {
+	Expected float64 `json:"expected"`
+	Low      float64 `json:"low"`
+	High     float64 `json:"high"`
+}
+
+// BudgetScenario is the projected outcome of one budget change
+type BudgetScenario struct {
+	Change      float64           `json:"change"`
+	DailySpend  float64           `json:"daily_spend"`
+	Impressions *MetricProjection `json:"impressions,omitempty"`
+	Conversions *MetricProjection `json:"conversions,omitempty"`
+}
+
+// CampaignProjection holds the fitted response curves and scenarios of a campaign
+type CampaignProjection struct {
+	CampaignID   string `json:"campaign_id"`
+	CampaignName string `json:"campaign_name,omitempty"`
+	Days         int    `json:"days"`
+
+	// DailySpend, DailyImpressions and DailyConversions are averages over
+	// the days with spend
+	DailySpend       float64 `json:"daily_spend"`
+	DailyImpressions float64 `json:"daily_impressions"`
+	DailyConversions float64 `json:"daily_conversions"`
+
+	// ImpressionElasticity and ConversionElasticity are the fitted percentage
+	// change of the metric per percent of spend, between 0 and 1
+	ImpressionElasticity float64 `json:"impression_elasticity"`
+	ConversionElasticity float64 `json:"conversion_elasticity,omitempty"`
+
+	Scenarios []BudgetScenario `json:"scenarios,omitempty"`
+
+	// Error explains why no scenarios were projected
+	Error string `json:"error,omitempty"`
+}
+
+// BudgetProjection is the result of a budget projection
+type BudgetProjection struct {
+	Confidence float64              `json:"confidence"`
+	Campaigns  []CampaignProjection `json:"campaigns"`
+}
+
+// BudgetProjector estimates how impressions and conversions respond to
+// budget changes from historical daily performance
+type BudgetProjector struct {
+	client *Client
+}
+
+// NewBudgetProjector creates a new BudgetProjector
+func NewBudgetProjector(client *Client) *BudgetProjector {
+	return &BudgetProjector{client: client}
+}
+
+// dailyPerformance is one campaign day of the report
+type dailyPerformance struct {
+	spend, impressions, conversions float64
+}
+
+// responseCurve is a least squares fit of log(metric) = a + b*log(spend)
+type responseCurve struct {
+	elasticity float64
+	residual   float64 // standard error of the fit in log space
+	n          int
+	meanX      float64
+	sxx        float64
+}
+
+// Project fits a diminishing returns curve, metric = a * spend^b, to each
+// campaign's daily spend, impressions and conversions and evaluates it at
+// every budget change. Bands are confidence intervals of the fitted curve,
+// so they widen the further a scenario moves from the spend levels seen in
+// the window. Conversions are only projected when enough days converted.
+func (p *BudgetProjector) Project(ctx context.Context, req *BudgetProjectionRequest) (*BudgetProjection, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.StartDate == "" || req.EndDate == "" {
+		return nil, fmt.Errorf("start_date and end_date are required")
+	}
+	if len(req.BudgetChanges) == 0 {
+		return nil, fmt.Errorf("budget_changes is required")
+	}
+	for _, change := range req.BudgetChanges {
+		if change <= -1 {
+			return nil, fmt.Errorf("budget change %v would leave no budget", change)
+		}
+	}
+	confidence := req.Confidence
+	if confidence == 0 {
+		confidence = 0.8
+	}
+	if confidence <= 0 || confidence >= 1 {
+		return nil, fmt.Errorf("confidence must be between 0 and 1")
+	}
+	minDays := req.MinDays
+	if minDays == 0 {
+		minDays = 7
+	}
+	if minDays < 3 {
+		return nil, fmt.Errorf("min_days must be at least 3")
+	}
+
+	days, names, err := p.history(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(days))
+	for id := range days {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	z := math.Sqrt2 * math.Erfinv(confidence)
+	projection := &BudgetProjection{Confidence: confidence}
+	for _, id := range ids {
+		campaign := projectCampaign(days[id], req.BudgetChanges, minDays, z)
+		campaign.CampaignID = id
+		campaign.CampaignName = names[id]
+		projection.Campaigns = append(projection.Campaigns, campaign)
+	}
+	return projection, nil
+}
+
+// history reports daily performance per campaign over the window
+func (p *BudgetProjector) history(ctx context.Context, req *BudgetProjectionRequest) (map[string][]dailyPerformance, map[string]string, error) {
+	var filters []ReportingFilter
+	if len(req.CampaignIDs) > 0 {
+		ids, err := json.Marshal(req.CampaignIDs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal campaign_ids: %w", err)
+		}
+		filters = append(filters, ReportingFilter{FieldName: "campaign_ids", FilterType: "IN", FilterValue: string(ids)})
+	}
+
+	days := make(map[string][]dailyPerformance)
+	names := make(map[string]string)
+	for page := 1; ; page++ {
+		resp, err := p.client.Reporting().GetIntegratedReport(ctx, &ReportingRequest{
+			AdvertiserID: req.AdvertiserID,
+			ReportType:   models.ReportTypeBasic,
+			DataLevel:    models.DataLevelCampaign,
+			Dimensions:   []models.Dimension{models.DimensionCampaignID, models.DimensionStatTimeDay},
+			Metrics:      []models.Metric{models.MetricCampaignName, models.MetricSpend, models.MetricImpressions, models.MetricConversion},
+			Filters:      filters,
+			StartDate:    req.StartDate,
+			EndDate:      req.EndDate,
+			Page:         page,
+			PageSize:     1000,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get campaign performance: %w", err)
+		}
+		for _, row := range resp.Data.List {
+			id := row.Dimensions.CampaignID
+			if row.Metrics.CampaignName != "" {
+				names[id] = row.Metrics.CampaignName
+			}
+			if row.Metrics.Spend <= 0 {
+				continue
+			}
+			days[id] = append(days[id], dailyPerformance{
+				spend:       float64(row.Metrics.Spend),
+				impressions: float64(row.Metrics.Impressions),
+				conversions: float64(row.Metrics.Conversion),
+			})
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			return days, names, nil
+		}
+	}
+}
+
+// projectCampaign fits the response curves of a campaign and evaluates the scenarios
+func projectCampaign(days []dailyPerformance, changes []float64, minDays int, z float64) CampaignProjection {
+	campaign := CampaignProjection{Days: len(days)}
+	for _, day := range days {
+		campaign.DailySpend += day.spend
+		campaign.DailyImpressions += day.impressions
+		campaign.DailyConversions += day.conversions
+	}
+	if len(days) > 0 {
+		n := float64(len(days))
+		campaign.DailySpend /= n
+		campaign.DailyImpressions /= n
+		campaign.DailyConversions /= n
+	}
+	if len(days) < minDays {
+		campaign.Error = fmt.Sprintf("only %d days with spend, need %d", len(days), minDays)
+		return campaign
+	}
+
+	impressions, ok := fitResponseCurve(days, func(d dailyPerformance) float64 { return d.impressions }, minDays)
+	if !ok {
+		campaign.Error = "daily spend did not vary enough to fit a response curve"
+		return campaign
+	}
+	conversions, hasConversions := fitResponseCurve(days, func(d dailyPerformance) float64 { return d.conversions }, minDays)
+
+	campaign.ImpressionElasticity = impressions.elasticity
+	if hasConversions {
+		campaign.ConversionElasticity = conversions.elasticity
+	}
+
+	for _, change := range changes {
+		scenario := BudgetScenario{
+			Change:      change,
+			DailySpend:  campaign.DailySpend * (1 + change),
+			Impressions: impressions.project(campaign.DailyImpressions, campaign.DailySpend, change, z),
+		}
+		if hasConversions {
+			scenario.Conversions = conversions.project(campaign.DailyConversions, campaign.DailySpend, change, z)
+		}
+		campaign.Scenarios = append(campaign.Scenarios, scenario)
+	}
+	return campaign
+}
+
+// fitResponseCurve fits log(metric) against log(spend) over the days where
+// the metric is positive. It reports false when there are fewer than minDays
+// such days or spend did not vary between them.
+func fitResponseCurve(days []dailyPerformance, metric func(dailyPerformance) float64, minDays int) (responseCurve, bool) {
+	var xs, ys []float64
+	for _, day := range days {
+		if value := metric(day); value > 0 {
+			xs = append(xs, math.Log(day.spend))
+			ys = append(ys, math.Log(value))
+		}
+	}
+	n := len(xs)
+	if n < minDays {
+		return responseCurve{}, false
+	}
+
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(n)
+	meanY /= float64(n)
+
+	var sxx, sxy float64
+	for i := range xs {
+		sxx += (xs[i] - meanX) * (xs[i] - meanX)
+		sxy += (xs[i] - meanX) * (ys[i] - meanY)
+	}
+	if sxx < 1e-9 {
+		return responseCurve{}, false
+	}
+
+	// Spend never buys less than nothing, and returns do not grow with
+	// scale, so noisy fits are held to 0 <= b <= 1
+	slope := math.Min(math.Max(sxy/sxx, 0), 1)
+	intercept := meanY - slope*meanX
+
+	var rss float64
+	for i := range xs {
+		r := ys[i] - intercept - slope*xs[i]
+		rss += r * r
+	}
+
+	return responseCurve{
+		elasticity: slope,
+		residual:   math.Sqrt(rss / float64(n-2)),
+		n:          n,
+		meanX:      meanX,
+		sxx:        sxx,
+	}, true
+}
+
+// project scales the baseline along the curve to the changed spend, with a
+// band from the confidence interval of the fit at that spend
+func (c responseCurve) project(baseline, spend, change, z float64) *MetricProjection {
+	expected := baseline * math.Pow(1+change, c.elasticity)
+
+	x := math.Log(spend * (1 + change))
+	se := c.residual * math.Sqrt(1/float64(c.n)+(x-c.meanX)*(x-c.meanX)/c.sxx)
+	return &MetricProjection{
+		Expected: expected,
+		Low:      expected * math.Exp(-z*se),
+		High:     expected * math.Exp(z*se),
+	}
+}
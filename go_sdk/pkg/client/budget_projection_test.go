@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBudgetProjector_Project(t *testing.T) {
+	var rows []string
+	for day := 0; day < 10; day++ {
+		spend := 50.0 + 10*float64(day)
+		noise := 1.05
+		if day%2 == 1 {
+			noise = 0.95
+		}
+		date := fmt.Sprintf("2024-01-%02d", day+1)
+		rows = append(rows,
+			fmt.Sprintf(`{"dimensions":{"campaign_id":"c1","stat_time_day":"%s"},"metrics":{"campaign_name":"Prospecting","spend":"%.2f","impressions":"%.0f","conversion":"%.2f"}}`,
+				date, spend, 1000*math.Pow(spend, 0.8)*noise, 0.5*math.Pow(spend, 0.5)),
+			fmt.Sprintf(`{"dimensions":{"campaign_id":"c2","stat_time_day":"%s"},"metrics":{"spend":"100","impressions":"20000","conversion":"0"}}`, date))
+	}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/report/integrated/get/" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"code":0,"data":{"list":[%s],"page_info":{"page":1,"total_page":1}}}`, strings.Join(rows, ","))
+	})
+
+	client := newTestClient(t, server)
+
+	projection, err := NewBudgetProjector(client).Project(context.Background(), &BudgetProjectionRequest{
+		AdvertiserID:  "123",
+		StartDate:     "2024-01-01",
+		EndDate:       "2024-01-10",
+		BudgetChanges: []float64{0, 0.5},
+	})
+	if err != nil {
+		t.Fatalf("Project() error = %v", err)
+	}
+	if len(projection.Campaigns) != 2 {
+		t.Fatalf("campaigns = %d, want 2", len(projection.Campaigns))
+	}
+
+	c1 := projection.Campaigns[0]
+	if c1.CampaignID != "c1" || c1.CampaignName != "Prospecting" || c1.Error != "" {
+		t.Fatalf("c1 = %+v", c1)
+	}
+	if math.Abs(c1.ImpressionElasticity-0.8) > 0.1 || math.Abs(c1.ConversionElasticity-0.5) > 0.01 {
+		t.Errorf("elasticities = %v, %v", c1.ImpressionElasticity, c1.ConversionElasticity)
+	}
+	current, raised := c1.Scenarios[0].Impressions, c1.Scenarios[1].Impressions
+	if math.Abs(current.Expected-c1.DailyImpressions) > 1e-6 {
+		t.Errorf("expected at current spend = %v, want %v", current.Expected, c1.DailyImpressions)
+	}
+	if raised.Expected <= current.Expected || raised.Expected >= 1.5*current.Expected {
+		t.Errorf("expected at +50%% = %v, want diminishing growth from %v", raised.Expected, current.Expected)
+	}
+	if !(raised.Low < raised.Expected && raised.Expected < raised.High) {
+		t.Errorf("band = %+v", raised)
+	}
+	if raised.High-raised.Low <= current.High-current.Low {
+		t.Errorf("band at +50%% %+v is not wider than at current spend %+v", raised, current)
+	}
+
+	if c2 := projection.Campaigns[1]; c2.Error == "" || len(c2.Scenarios) != 0 {
+		t.Errorf("c2 = %+v, want an error for constant spend", c2)
+	}
+
+	if _, err := NewBudgetProjector(client).Project(context.Background(), &BudgetProjectionRequest{
+		AdvertiserID: "123", StartDate: "2024-01-01", EndDate: "2024-01-10", BudgetChanges: []float64{-1},
+	}); err == nil {
+		t.Error("Project() accepted a -100% budget change")
+	}
+}
@@ -0,0 +1,67 @@
+package client
+
+import "github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+
+// Campaign defaults filled in by the guided constructors
+const (
+	regularCampaignType = "REGULAR_CAMPAIGN"
+	appInstallPromotion = "APP_INSTALL"
+	defaultBudgetMode   = models.BudgetModeDaily
+)
+
+// CampaignBasics holds the settings shared by every campaign objective.
+// BudgetMode defaults to a daily budget.
+type CampaignBasics struct {
+	AdvertiserID string
+	CampaignName string
+	Budget       float64
+	BudgetMode   models.BudgetMode
+}
+
+// NewTrafficCampaign builds a request for a campaign driving visits to a
+// website or app. specialIndustries declares housing, employment or credit ads.
+func NewTrafficCampaign(basics CampaignBasics, specialIndustries ...string) *CampaignCreateRequest {
+	req := newCampaignRequest(basics, models.ObjectiveTraffic)
+	req.SpecialIndustries = specialIndustries
+	return req
+}
+
+// NewAppInstallCampaign builds a request for an app promotion campaign
+// optimizing for installs
+func NewAppInstallCampaign(basics CampaignBasics) *CampaignCreateRequest {
+	req := newCampaignRequest(basics, models.ObjectiveAppPromotion)
+	req.AppPromotionType = appInstallPromotion
+	return req
+}
+
+// NewIOS14AppInstallCampaign builds a request for an iOS 14 dedicated app
+// install campaign, attributed through SKAdNetwork
+func NewIOS14AppInstallCampaign(basics CampaignBasics) *CampaignCreateRequest {
+	req := NewAppInstallCampaign(basics)
+	req.CampaignType = CampaignTypeIOS14
+	return req
+}
+
+// NewLeadGenCampaign builds a request for a campaign collecting leads through
+// instant forms. specialIndustries declares housing, employment or credit ads.
+func NewLeadGenCampaign(basics CampaignBasics, specialIndustries ...string) *CampaignCreateRequest {
+	req := newCampaignRequest(basics, models.ObjectiveLeadGeneration)
+	req.SpecialIndustries = specialIndustries
+	return req
+}
+
+// newCampaignRequest fills the fields common to all objectives
+func newCampaignRequest(basics CampaignBasics, objective models.ObjectiveType) *CampaignCreateRequest {
+	budgetMode := basics.BudgetMode
+	if budgetMode == "" {
+		budgetMode = defaultBudgetMode
+	}
+	return &CampaignCreateRequest{
+		AdvertiserID:  basics.AdvertiserID,
+		CampaignName:  basics.CampaignName,
+		ObjectiveType: objective,
+		Budget:        basics.Budget,
+		BudgetMode:    budgetMode,
+		CampaignType:  regularCampaignType,
+	}
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestGuidedCampaignConstructors(t *testing.T) {
+	basics := CampaignBasics{AdvertiserID: "123", CampaignName: "Spring sale", Budget: 100}
+
+	tests := []struct {
+		name      string
+		req       *CampaignCreateRequest
+		objective models.ObjectiveType
+		promotion string
+	}{
+		{name: "traffic", req: NewTrafficCampaign(basics, "HOUSING"), objective: models.ObjectiveTraffic},
+		{name: "app install", req: NewAppInstallCampaign(basics), objective: models.ObjectiveAppPromotion, promotion: "APP_INSTALL"},
+		{name: "lead generation", req: NewLeadGenCampaign(basics), objective: models.ObjectiveLeadGeneration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.req.ObjectiveType != tt.objective || tt.req.AppPromotionType != tt.promotion {
+				t.Errorf("objective = %s/%q, want %s/%q", tt.req.ObjectiveType, tt.req.AppPromotionType, tt.objective, tt.promotion)
+			}
+			if tt.req.BudgetMode != models.BudgetModeDaily {
+				t.Errorf("BudgetMode = %s, want daily default", tt.req.BudgetMode)
+			}
+			if err := tt.req.Validate(); err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+		})
+	}
+
+	if got := NewTrafficCampaign(basics, "HOUSING").SpecialIndustries; len(got) != 1 || got[0] != "HOUSING" {
+		t.Errorf("SpecialIndustries = %v", got)
+	}
+}
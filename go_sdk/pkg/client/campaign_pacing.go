@@ -0,0 +1,232 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// CampaignPacingRequest represents the request for an intraday pacing report
+type CampaignPacingRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+
+	// CampaignIDs limits the report; every campaign with spend is reported
+	// when empty
+	CampaignIDs []string `json:"campaign_ids,omitempty"`
+
+	// Date is the day to report (YYYY-MM-DD), today in Location when empty
+	Date string `json:"date,omitempty"`
+
+	// Location is the advertiser's time zone, which hourly stats are
+	// reported in; UTC when nil
+	Location *time.Location `json:"-"`
+
+	// Now is when the projection is made, time.Now when zero. Days before
+	// Now are projected as complete.
+	Now time.Time `json:"-"`
+}
+
+// PacingPoint is a campaign's spend in one hour of the day
+type PacingPoint struct {
+	Hour       int     `json:"hour"` // 0-23
+	Spend      float64 `json:"spend"`
+	Cumulative float64 `json:"cumulative"`
+}
+
+// CampaignPacing is the intraday spend curve of a campaign with its
+// projected end-of-day spend
+type CampaignPacing struct {
+	CampaignID   string            `json:"campaign_id"`
+	CampaignName string            `json:"campaign_name"`
+	Budget       float64           `json:"budget"`
+	BudgetMode   models.BudgetMode `json:"budget_mode"`
+
+	// Curve has an entry for every hour of the day; hours not yet reported
+	// carry the spend to date
+	Curve []PacingPoint `json:"curve"`
+
+	SpendToDate    float64 `json:"spend_to_date"`
+	ProjectedSpend float64 `json:"projected_spend"`
+}
+
+// DailyBudget returns the campaign's daily budget, zero when it has a
+// lifetime or no budget
+func (p CampaignPacing) DailyBudget() float64 {
+	if p.BudgetMode != models.BudgetModeDaily {
+		return 0
+	}
+	return p.Budget
+}
+
+// ProjectedUtilization returns projected end-of-day spend as a fraction of
+// the daily budget, zero without one
+func (p CampaignPacing) ProjectedUtilization() float64 {
+	budget := p.DailyBudget()
+	if budget == 0 {
+		return 0
+	}
+	return p.ProjectedSpend / budget
+}
+
+// PacingReport holds the intraday pacing of an advertiser's campaigns on
+// one day
+type PacingReport struct {
+	AdvertiserID string `json:"advertiser_id"`
+	Date         string `json:"date"`
+
+	// Elapsed is the fraction of the day that had passed when the
+	// projection was made
+	Elapsed   float64          `json:"elapsed"`
+	Campaigns []CampaignPacing `json:"campaigns"`
+}
+
+// GetCampaignPacing reads hourly campaign spend for a day, reading every
+// page of the report, and projects each campaign's end-of-day spend by
+// extending its spend to date linearly over the rest of the day. Campaigns
+// are returned highest projected utilization first.
+func (r *reportingService) GetCampaignPacing(ctx context.Context, req *CampaignPacingRequest) (*PacingReport, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	loc := req.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	now := req.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	now = now.In(loc)
+	date := req.Date
+	if date == "" {
+		date = now.Format("2006-01-02")
+	}
+	day, _ := time.ParseInLocation("2006-01-02", date, loc)
+	elapsed := now.Sub(day).Hours() / 24
+	if elapsed <= 0 {
+		return nil, models.NewValidationError("date", fmt.Sprintf("date %s has not started yet", date))
+	}
+	elapsed = min(elapsed, 1)
+
+	var filters []ReportingFilter
+	if len(req.CampaignIDs) > 0 {
+		ids, err := json.Marshal(req.CampaignIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal campaign_ids: %w", err)
+		}
+		filters = append(filters, ReportingFilter{FieldName: "campaign_ids", FilterType: "IN", FilterValue: string(ids)})
+	}
+
+	hourly := make(map[string]*[24]float64)
+	var campaignIDs []string
+	for page := 1; ; page++ {
+		resp, err := r.GetIntegratedReport(ctx, &ReportingRequest{
+			AdvertiserID: req.AdvertiserID,
+			ReportType:   models.ReportTypeBasic,
+			DataLevel:    models.DataLevelCampaign,
+			Dimensions:   []models.Dimension{models.DimensionCampaignID, models.DimensionStatTimeHour},
+			Metrics:      []models.Metric{models.MetricSpend},
+			Filters:      filters,
+			StartDate:    date,
+			EndDate:      date,
+			Page:         page,
+			PageSize:     1000,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get hourly spend report: %w", err)
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+		for _, row := range resp.Data.List {
+			hour, err := time.Parse("2006-01-02 15:04:05", row.Dimensions.StatTimeHour)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse stat_time_hour %q: %w", row.Dimensions.StatTimeHour, err)
+			}
+			spend, ok := hourly[row.Dimensions.CampaignID]
+			if !ok {
+				spend = new([24]float64)
+				hourly[row.Dimensions.CampaignID] = spend
+				campaignIDs = append(campaignIDs, row.Dimensions.CampaignID)
+			}
+			spend[hour.Hour()] += float64(row.Metrics.Spend)
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			break
+		}
+	}
+
+	report := &PacingReport{AdvertiserID: req.AdvertiserID, Date: date, Elapsed: elapsed}
+	for _, id := range campaignIDs {
+		pacing := CampaignPacing{CampaignID: id, Curve: make([]PacingPoint, 24)}
+		for hour, spend := range hourly[id] {
+			pacing.SpendToDate += spend
+			pacing.Curve[hour] = PacingPoint{Hour: hour, Spend: spend, Cumulative: pacing.SpendToDate}
+		}
+		pacing.ProjectedSpend = pacing.SpendToDate / elapsed
+		report.Campaigns = append(report.Campaigns, pacing)
+	}
+
+	if err := r.addCampaignBudgets(ctx, req.AdvertiserID, report.Campaigns); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(report.Campaigns, func(i, j int) bool {
+		return report.Campaigns[i].ProjectedUtilization() > report.Campaigns[j].ProjectedUtilization()
+	})
+	return report, nil
+}
+
+// addCampaignBudgets fills in the names and budgets of reported campaigns,
+// looking them up in batches of MaxCampaignStatusIDs
+func (r *reportingService) addCampaignBudgets(ctx context.Context, advertiserID string, campaigns []CampaignPacing) error {
+	index := make(map[string]int, len(campaigns))
+	ids := make([]string, 0, len(campaigns))
+	for i, c := range campaigns {
+		index[c.CampaignID] = i
+		ids = append(ids, c.CampaignID)
+	}
+
+	for start := 0; start < len(ids); start += MaxCampaignStatusIDs {
+		batch := ids[start:min(start+MaxCampaignStatusIDs, len(ids))]
+		resp, err := r.client.Campaign().Get(ctx, &CampaignGetRequest{
+			AdvertiserID: advertiserID,
+			Fields:       []string{"campaign_id", "campaign_name", "budget", "budget_mode"},
+			Filtering:    &CampaignFiltering{CampaignIDs: batch},
+			PageSize:     len(batch),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get campaign budgets: %w", err)
+		}
+		if resp.Code != 0 {
+			return models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+		for _, campaign := range resp.Data {
+			if i, ok := index[campaign.CampaignID]; ok {
+				campaigns[i].CampaignName = campaign.CampaignName
+				campaigns[i].Budget = campaign.Budget
+				campaigns[i].BudgetMode = models.BudgetMode(campaign.BudgetMode)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the advertiser and date
+func (r *CampaignPacingRequest) Validate() error {
+	if r.AdvertiserID == "" {
+		return models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+	if r.Date != "" {
+		if _, err := time.Parse("2006-01-02", r.Date); err != nil {
+			return models.NewValidationError("date", "date must be YYYY-MM-DD")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCampaignPacing(t *testing.T) {
+	var reportQuery, campaignFilter string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/campaign/get/") {
+			campaignFilter = r.URL.Query().Get("filtering")
+			w.Write([]byte(`{"code":0,"data":[
+				{"campaign_id":"c1","campaign_name":"Always On","budget":100,"budget_mode":"BUDGET_MODE_DAY"},
+				{"campaign_id":"c2","campaign_name":"Launch","budget":5000,"budget_mode":"BUDGET_MODE_TOTAL"}]}`))
+			return
+		}
+		reportQuery = r.URL.RawQuery
+		w.Write([]byte(`{"code":0,"data":{"page_info":{"page":1,"total_page":1},"list":[
+			{"dimensions":{"campaign_id":"c2","stat_time_hour":"2026-06-01 01:00:00"},"metrics":{"spend":"30"}},
+			{"dimensions":{"campaign_id":"c1","stat_time_hour":"2026-06-01 00:00:00"},"metrics":{"spend":"20"}},
+			{"dimensions":{"campaign_id":"c1","stat_time_hour":"2026-06-01 03:00:00"},"metrics":{"spend":"10"}}]}}`))
+	})
+
+	client := newTestClient(t, server)
+	loc := time.FixedZone("UTC-7", -7*3600)
+	report, err := client.Reporting().GetCampaignPacing(context.Background(), &CampaignPacingRequest{
+		AdvertiserID: "123",
+		Location:     loc,
+		Now:          time.Date(2026, 6, 1, 6, 0, 0, 0, loc),
+	})
+	if err != nil {
+		t.Fatalf("GetCampaignPacing() error = %v", err)
+	}
+	if !strings.Contains(reportQuery, "stat_time_hour") || !strings.Contains(reportQuery, "2026-06-01") {
+		t.Errorf("report query = %s", reportQuery)
+	}
+	if !strings.Contains(campaignFilter, "c1") || !strings.Contains(campaignFilter, "c2") {
+		t.Errorf("campaign filtering = %s", campaignFilter)
+	}
+	if report.Date != "2026-06-01" || report.Elapsed != 0.25 || len(report.Campaigns) != 2 {
+		t.Fatalf("report = %+v", report)
+	}
+	c1 := report.Campaigns[0]
+	if c1.CampaignID != "c1" || c1.CampaignName != "Always On" || c1.SpendToDate != 30 || c1.ProjectedSpend != 120 || c1.ProjectedUtilization() != 1.2 {
+		t.Errorf("campaigns[0] = %+v", c1)
+	}
+	if len(c1.Curve) != 24 || c1.Curve[3].Spend != 10 || c1.Curve[2].Cumulative != 20 || c1.Curve[23].Cumulative != 30 {
+		t.Errorf("curve = %+v", c1.Curve)
+	}
+	if c2 := report.Campaigns[1]; c2.DailyBudget() != 0 || c2.ProjectedUtilization() != 0 || c2.ProjectedSpend != 120 {
+		t.Errorf("campaigns[1] = %+v", c2)
+	}
+
+	past, err := client.Reporting().GetCampaignPacing(context.Background(), &CampaignPacingRequest{
+		AdvertiserID: "123",
+		Date:         "2026-06-01",
+		Now:          time.Date(2026, 6, 3, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil || past.Elapsed != 1 || past.Campaigns[0].ProjectedSpend != 30 {
+		t.Errorf("past day = %+v, %v", past, err)
+	}
+	if _, err := client.Reporting().GetCampaignPacing(context.Background(), &CampaignPacingRequest{AdvertiserID: "123", Date: "2026-06-02", Now: time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)}); err == nil {
+		t.Error("expected error for a day that has not started")
+	}
+	if err := (&CampaignPacingRequest{AdvertiserID: "123", Date: "06/01/2026"}).Validate(); err == nil {
+		t.Error("expected error for malformed date")
+	}
+}
@@ -0,0 +1,282 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+	"gopkg.in/yaml.v3"
+)
+
+// CampaignSnapshotVersion is the snapshot document format written by Export
+const CampaignSnapshotVersion = 1
+
+// CampaignSnapshot is a portable document of campaign trees. Entities are
+// kept as the API returned them, so a snapshot is also a faithful backup.
+type CampaignSnapshot struct {
+	Version      int                `json:"version" yaml:"version"`
+	AdvertiserID string             `json:"advertiser_id" yaml:"advertiser_id"`
+	ExportedAt   time.Time          `json:"exported_at" yaml:"exported_at"`
+	Campaigns    []SnapshotCampaign `json:"campaigns" yaml:"campaigns"`
+
+	// References names the pixels, audiences, identities, videos and images
+	// the entities use, by reference kind and ID, so Import can find the
+	// same-named assets in the target advertiser
+	References map[string]map[string]string `json:"references,omitempty" yaml:"references,omitempty"`
+}
+
+// SnapshotCampaign is a campaign with its ad groups
+type SnapshotCampaign struct {
+	Campaign map[string]interface{} `json:"campaign" yaml:"campaign"`
+	AdGroups []SnapshotAdGroup      `json:"adgroups,omitempty" yaml:"adgroups,omitempty"`
+}
+
+// SnapshotAdGroup is an ad group with its ads
+type SnapshotAdGroup struct {
+	AdGroup map[string]interface{}   `json:"adgroup" yaml:"adgroup"`
+	Ads     []map[string]interface{} `json:"ads,omitempty" yaml:"ads,omitempty"`
+}
+
+// SnapshotImportRequest represents the request for importing a snapshot
+type SnapshotImportRequest struct {
+	TargetAdvertiserID string
+
+	// CampaignIDs limits the import to these snapshot campaigns; all are
+	// imported when empty
+	CampaignIDs []string
+
+	// NameSuffix is appended to imported campaign, ad group and ad names
+	NameSuffix string
+
+	// KeepOperationStatus creates entities with their snapshot operation
+	// status; by default every imported entity is created disabled
+	KeepOperationStatus bool
+}
+
+// WriteJSON writes the snapshot as indented JSON
+func (s *CampaignSnapshot) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(s); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// WriteYAML writes the snapshot as YAML
+func (s *CampaignSnapshot) WriteYAML(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(s); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return encoder.Close()
+}
+
+// ReadCampaignSnapshot reads a snapshot written by WriteJSON or WriteYAML
+func ReadCampaignSnapshot(r io.Reader) (*CampaignSnapshot, error) {
+	// YAML is a superset of JSON, so one decoder reads both
+	var snapshot CampaignSnapshot
+	if err := yaml.NewDecoder(r).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	if snapshot.Version > CampaignSnapshotVersion {
+		return nil, fmt.Errorf("snapshot version %d is newer than the supported version %d", snapshot.Version, CampaignSnapshotVersion)
+	}
+	return &snapshot, nil
+}
+
+// Export reads campaigns with their ad groups and ads into a snapshot, along
+// with the names of the assets they reference. All campaigns of the
+// advertiser are exported when campaignIDs is empty.
+func (c *CrossAccountCopier) Export(ctx context.Context, advertiserID string, campaignIDs ...string) (*CampaignSnapshot, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	campaigns, err := c.listRaw(ctx, "/campaign/get/", advertiserID, "campaign_ids", campaignIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read campaigns: %w", err)
+	}
+
+	snapshot := &CampaignSnapshot{
+		Version:      CampaignSnapshotVersion,
+		AdvertiserID: advertiserID,
+		ExportedAt:   time.Now().UTC(),
+	}
+	used := map[string]map[string]bool{}
+	use := func(kind string, ids ...string) {
+		for _, id := range ids {
+			if id == "" {
+				continue
+			}
+			if used[kind] == nil {
+				used[kind] = map[string]bool{}
+			}
+			used[kind][id] = true
+		}
+	}
+
+	for _, campaign := range campaigns {
+		campaignID := rawString(campaign, "campaign_id")
+		entry := SnapshotCampaign{Campaign: campaign}
+
+		adGroups, err := c.listRaw(ctx, "/adgroup/get/", advertiserID, "campaign_ids", []string{campaignID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ad groups of campaign %s: %w", campaignID, err)
+		}
+		for _, adGroup := range adGroups {
+			adGroupID := rawString(adGroup, "adgroup_id")
+			use(ReferenceKindPixel, rawString(adGroup, "pixel_id"))
+			use(ReferenceKindAudience, rawStrings(adGroup, "audience_ids")...)
+			use(ReferenceKindAudience, rawStrings(adGroup, "excluded_audience_ids")...)
+
+			ads, err := c.listRaw(ctx, "/ad/get/", advertiserID, "adgroup_ids", []string{adGroupID})
+			if err != nil {
+				return nil, fmt.Errorf("failed to read ads of ad group %s: %w", adGroupID, err)
+			}
+			for _, ad := range ads {
+				use(ReferenceKindIdentity, rawString(ad, "identity_id"))
+				use(ReferenceKindPixel, rawString(ad, "tracking_pixel_id"))
+				use(ReferenceKindVideo, rawString(ad, "video_id"))
+				use(ReferenceKindImage, rawStrings(ad, "image_ids")...)
+			}
+			entry.AdGroups = append(entry.AdGroups, SnapshotAdGroup{AdGroup: adGroup, Ads: ads})
+		}
+		snapshot.Campaigns = append(snapshot.Campaigns, entry)
+	}
+
+	snapshot.References = make(map[string]map[string]string, len(used))
+	for kind, ids := range used {
+		names, err := c.referenceNames(ctx, kind, advertiserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s references: %w", strings.ToLower(kind), err)
+		}
+		snapshot.References[kind] = make(map[string]string, len(ids))
+		for id := range ids {
+			if name, ok := names[id]; ok {
+				snapshot.References[kind][id] = name
+			}
+		}
+	}
+	return snapshot, nil
+}
+
+// Import recreates the campaign trees of a snapshot under the target
+// advertiser, disabled unless KeepOperationStatus is set. References are
+// remapped to same-named assets of the target, as with Copy; any that cannot
+// be remapped are dropped and listed in the report, which also maps each
+// snapshot ID to its new ID.
+func (c *CrossAccountCopier) Import(ctx context.Context, snapshot *CampaignSnapshot, req *SnapshotImportRequest) (*CrossAccountCopyReport, error) {
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot cannot be nil")
+	}
+	if req == nil || req.TargetAdvertiserID == "" {
+		return nil, fmt.Errorf("target advertiser_id is required")
+	}
+
+	refs := make(map[string]*referenceMap, len(snapshot.References))
+	for kind, source := range snapshot.References {
+		target, err := c.referenceNames(ctx, kind, req.TargetAdvertiserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load target %s references: %w", strings.ToLower(kind), err)
+		}
+		refs[kind] = newReferenceMap(source, target)
+	}
+	// Referenced assets the snapshot could not name are still remapped, and
+	// reported as unmapped, rather than sent to the target as they are
+	for _, kind := range []string{ReferenceKindPixel, ReferenceKindAudience, ReferenceKindIdentity, ReferenceKindVideo, ReferenceKindImage} {
+		if refs[kind] == nil {
+			refs[kind] = newReferenceMap(nil, nil)
+		}
+	}
+
+	target := copyTarget{
+		advertiserID: req.TargetAdvertiserID,
+		nameSuffix:   req.NameSuffix,
+		keepStatus:   req.KeepOperationStatus,
+	}
+	report := &CrossAccountCopyReport{
+		CampaignIDs: make(map[string]string),
+		AdGroupIDs:  make(map[string]string),
+		AdIDs:       make(map[string]string),
+	}
+	for _, entry := range snapshot.Campaigns {
+		sourceID := rawString(entry.Campaign, "campaign_id")
+		if len(req.CampaignIDs) > 0 && !containsString(req.CampaignIDs, sourceID) {
+			continue
+		}
+
+		entity := prepareCopy(entry.Campaign, target, "campaign_name")
+		targetID, err := c.createRaw(ctx, "/campaign/create/", entity, "campaign_id")
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("campaign %s: %v", sourceID, err))
+			continue
+		}
+		report.CampaignIDs[sourceID] = targetID
+
+		for _, adGroup := range entry.AdGroups {
+			adGroupID, ok := c.createAdGroup(ctx, refs, target, adGroup.AdGroup, targetID, report)
+			if !ok {
+				continue
+			}
+			for _, ad := range adGroup.Ads {
+				c.createAd(ctx, refs, target, ad, adGroupID, report)
+			}
+		}
+	}
+	return report, nil
+}
+
+// referenceNames returns the names of an advertiser's assets of one
+// reference kind, keyed by ID
+func (c *CrossAccountCopier) referenceNames(ctx context.Context, kind, advertiserID string) (map[string]string, error) {
+	switch kind {
+	case ReferenceKindPixel:
+		return c.pixelNames(ctx, advertiserID)
+	case ReferenceKindAudience:
+		return c.audienceNames(ctx, advertiserID)
+	case ReferenceKindIdentity:
+		return c.identityNames(ctx, advertiserID)
+	case ReferenceKindVideo, ReferenceKindImage:
+		return c.creativeNames(ctx, advertiserID, kind)
+	}
+	return nil, fmt.Errorf("unknown reference kind %q", kind)
+}
+
+// creativeNames returns creative library names of one type keyed by ID
+func (c *CrossAccountCopier) creativeNames(ctx context.Context, advertiserID, creativeType string) (map[string]string, error) {
+	names := make(map[string]string)
+	for page := 1; ; page++ {
+		resp, err := c.client.Creative().GetCreatives(ctx, &CreativeGetRequest{AdvertiserID: advertiserID, CreativeType: creativeType, Page: page, Size: referencePageSize})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+		for _, creative := range resp.Data.Creatives {
+			names[creative.CreativeID] = creative.CreativeName
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			return names, nil
+		}
+	}
+}
+
+// rawStrings reads a list of string-like values from a generic entity
+func rawStrings(entity map[string]interface{}, field string) []string {
+	values, ok := entity[field].([]interface{})
+	if !ok {
+		return nil
+	}
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, rawString(map[string]interface{}{field: value}, field))
+	}
+	return strs
+}
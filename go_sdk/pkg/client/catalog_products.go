@@ -0,0 +1,298 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// CatalogProductUploadMaxBatch is the most products one upload request accepts
+const CatalogProductUploadMaxBatch = 5000
+
+// ProductAvailability is the stock status of a catalog product
+type ProductAvailability string
+
+const (
+	ProductInStock           ProductAvailability = "IN_STOCK"
+	ProductOutOfStock        ProductAvailability = "OUT_OF_STOCK"
+	ProductPreorder          ProductAvailability = "PREORDER"
+	ProductAvailableForOrder ProductAvailability = "AVAILABLE_FOR_ORDER"
+	ProductDiscontinued      ProductAvailability = "DISCONTINUED"
+)
+
+// ProductCondition is the condition of a catalog product
+type ProductCondition string
+
+const (
+	ProductConditionNew         ProductCondition = "NEW"
+	ProductConditionRefurbished ProductCondition = "REFURBISHED"
+	ProductConditionUsed        ProductCondition = "USED"
+)
+
+// Product is a catalog item. Fields of the hotel, flight and vehicle verticals
+// are set through the embedded structs and are sent alongside the common
+// fields.
+type Product struct {
+	SKUID               string              `json:"sku_id"`
+	Title               string              `json:"title"`
+	Description         string              `json:"description"`
+	Availability        ProductAvailability `json:"availability"`
+	Condition           ProductCondition    `json:"condition,omitempty"`
+	Brand               string              `json:"brand,omitempty"`
+	ImageURL            string              `json:"image_url"`
+	AdditionalImageURLs []string            `json:"additional_image_urls,omitempty"`
+	VideoURL            string              `json:"video_url,omitempty"`
+	LandingPageURL      string              `json:"landing_page_url,omitempty"`
+	Price               float64             `json:"price"`
+	SalePrice           float64             `json:"sale_price,omitempty"`
+	Currency            string              `json:"currency"`
+	Inventory           *int                `json:"inventory,omitempty"`
+	GTIN                string              `json:"gtin,omitempty"`
+	MPN                 string              `json:"mpn,omitempty"`
+	ItemGroupID         string              `json:"item_group_id,omitempty"`
+	ProductCategory     string              `json:"google_product_category,omitempty"`
+	ProductType         string              `json:"product_type,omitempty"`
+	Color               string              `json:"color,omitempty"`
+	Size                string              `json:"size,omitempty"`
+	Gender              string              `json:"gender,omitempty"`
+	AgeGroup            string              `json:"age_group,omitempty"`
+	CustomLabels        []string            `json:"custom_labels,omitempty"`
+
+	*HotelProductFields
+	*FlightProductFields
+	*VehicleProductFields
+}
+
+// HotelProductFields are the fields of products in HOTEL catalogs
+type HotelProductFields struct {
+	HotelID    string  `json:"hotel_id"`
+	StarRating float64 `json:"star_rating,omitempty"`
+	Address    string  `json:"address,omitempty"`
+	City       string  `json:"city,omitempty"`
+	Country    string  `json:"country,omitempty"`
+	Latitude   float64 `json:"latitude,omitempty"`
+	Longitude  float64 `json:"longitude,omitempty"`
+}
+
+// FlightProductFields are the fields of products in FLIGHT catalogs
+type FlightProductFields struct {
+	OriginAirport      string `json:"origin_airport"`
+	DestinationAirport string `json:"destination_airport"`
+	OriginCity         string `json:"origin_city,omitempty"`
+	DestinationCity    string `json:"destination_city,omitempty"`
+}
+
+// VehicleProductFields are the fields of products in VEHICLE catalogs
+type VehicleProductFields struct {
+	VIN            string `json:"vin,omitempty"`
+	Make           string `json:"make"`
+	Model          string `json:"model"`
+	Year           int    `json:"year"`
+	Mileage        int    `json:"mileage,omitempty"`
+	MileageUnit    string `json:"mileage_unit,omitempty"` // KM, MI
+	BodyStyle      string `json:"body_style,omitempty"`
+	StateOfVehicle string `json:"state_of_vehicle,omitempty"` // NEW, USED, CPO
+}
+
+// CatalogProduct is a product as stored in a catalog
+type CatalogProduct struct {
+	Product
+	ProductID    string      `json:"product_id"`
+	AuditStatus  string      `json:"audit_status,omitempty"` // APPROVED, REJECTED, PROCESSING
+	RejectReason string      `json:"reject_reason,omitempty"`
+	CreateTime   models.Time `json:"create_time,omitempty"`
+	UpdateTime   models.Time `json:"update_time,omitempty"`
+}
+
+// CatalogProductUploadRequest represents the request for uploading a batch
+// of products
+type CatalogProductUploadRequest struct {
+	AdvertiserID string    `json:"advertiser_id"`
+	CatalogID    string    `json:"catalog_id"`
+	Products     []Product `json:"products"`
+}
+
+// CatalogProductUploadResponse represents the response for a product upload.
+// Uploads are processed asynchronously; GetProductLog reports the outcome.
+type CatalogProductUploadResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		FeedLogID string `json:"feed_log_id"`
+	} `json:"data"`
+}
+
+// CatalogProductFiltering narrows the products returned by GetProducts
+type CatalogProductFiltering struct {
+	ProductIDs   []string            `json:"product_ids,omitempty"`
+	SKUIDs       []string            `json:"sku_ids,omitempty"`
+	Availability ProductAvailability `json:"availability,omitempty"`
+	AuditStatus  string              `json:"audit_status,omitempty"`
+	Title        string              `json:"title,omitempty"`
+}
+
+// CatalogProductGetRequest represents the request for listing catalog products
+type CatalogProductGetRequest struct {
+	AdvertiserID string                   `json:"advertiser_id"`
+	CatalogID    string                   `json:"catalog_id"`
+	Filtering    *CatalogProductFiltering `json:"filtering,omitempty"`
+	Page         int                      `json:"page,omitempty"`
+	PageSize     int                      `json:"page_size,omitempty"`
+}
+
+// CatalogProductGetResponse represents the response for listing catalog products
+type CatalogProductGetResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		Products []CatalogProduct `json:"products"`
+		PageInfo struct {
+			Page        int `json:"page"`
+			PageSize    int `json:"page_size"`
+			TotalNumber int `json:"total_number"`
+			TotalPage   int `json:"total_page"`
+		} `json:"page_info"`
+	} `json:"data"`
+}
+
+// UploadProducts uploads one batch of up to CatalogProductUploadMaxBatch
+// products. Products whose SKU already exists in the catalog are replaced.
+func (s *CatalogService) UploadProducts(ctx context.Context, req *CatalogProductUploadRequest) (*CatalogProductUploadResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	url, err := s.client.BuildURL("/catalog/product/upload/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload catalog products: %w", err)
+	}
+
+	var response CatalogProductUploadResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UploadAllProducts uploads any number of products, splitting them into
+// batches of CatalogProductUploadMaxBatch, and returns the feed log ID of each
+// batch. Every product is validated before the first batch is sent.
+func (s *CatalogService) UploadAllProducts(ctx context.Context, advertiserID, catalogID string, products []Product) ([]string, error) {
+	for i := range products {
+		if err := products[i].Validate(); err != nil {
+			return nil, fmt.Errorf("product %d (%s): %w", i, products[i].SKUID, err)
+		}
+	}
+
+	var feedLogIDs []string
+	for start := 0; start < len(products); start += CatalogProductUploadMaxBatch {
+		end := start + CatalogProductUploadMaxBatch
+		if end > len(products) {
+			end = len(products)
+		}
+
+		resp, err := s.UploadProducts(ctx, &CatalogProductUploadRequest{
+			AdvertiserID: advertiserID,
+			CatalogID:    catalogID,
+			Products:     products[start:end],
+		})
+		if err != nil {
+			return feedLogIDs, err
+		}
+		if resp.Code != 0 {
+			return feedLogIDs, fmt.Errorf("API error: %s", resp.Message)
+		}
+		feedLogIDs = append(feedLogIDs, resp.Data.FeedLogID)
+	}
+	return feedLogIDs, nil
+}
+
+// GetProducts lists the products of a catalog
+func (s *CatalogService) GetProducts(ctx context.Context, req *CatalogProductGetRequest) (*CatalogProductGetResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+		"catalog_id":    req.CatalogID,
+	}
+	if req.Filtering != nil {
+		filtering, err := json.Marshal(req.Filtering)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal filtering: %w", err)
+		}
+		params["filtering"] = string(filtering)
+	}
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+	if req.PageSize > 0 {
+		params["page_size"] = req.PageSize
+	}
+
+	url, err := s.client.BuildURL("/catalog/product/get/", params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog products: %w", err)
+	}
+
+	var response CatalogProductGetResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetProduct retrieves a single catalog product by SKU
+func (s *CatalogService) GetProduct(ctx context.Context, advertiserID, catalogID, skuID string) (*CatalogProduct, error) {
+	if skuID == "" {
+		return nil, fmt.Errorf("sku_id is required")
+	}
+
+	resp, err := s.GetProducts(ctx, &CatalogProductGetRequest{
+		AdvertiserID: advertiserID,
+		CatalogID:    catalogID,
+		Filtering:    &CatalogProductFiltering{SKUIDs: []string{skuID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, fmt.Errorf("API error: %s", resp.Message)
+	}
+
+	for i := range resp.Data.Products {
+		if resp.Data.Products[i].SKUID == skuID {
+			return &resp.Data.Products[i], nil
+		}
+	}
+	return nil, fmt.Errorf("catalog product not found: %s", skuID)
+}
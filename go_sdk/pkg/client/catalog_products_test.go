@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCatalogService_Products(t *testing.T) {
+	var batches []int
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/catalog/product/upload/":
+			var body struct {
+				Products []map[string]interface{} `json:"products"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.Products[0]["make"] != "Volvo" || body.Products[0]["inventory"] != float64(0) {
+				t.Errorf("product = %v", body.Products[0])
+			}
+			batches = append(batches, len(body.Products))
+			fmt.Fprintf(w, `{"code":0,"data":{"feed_log_id":"log-%d"}}`, len(batches))
+		case "/open_api/v1.3/catalog/product/get/":
+			if got := r.URL.Query().Get("filtering"); got != `{"sku_ids":["sku-1"]}` {
+				t.Errorf("filtering = %q", got)
+			}
+			w.Write([]byte(`{"code":0,"data":{"products":[{"product_id":"p1","sku_id":"sku-1","availability":"IN_STOCK","price":19.99,"make":"Volvo","audit_status":"APPROVED"}]}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	stock := 0
+	products := make([]Product, CatalogProductUploadMaxBatch+1)
+	for i := range products {
+		products[i] = Product{
+			SKUID:                fmt.Sprintf("sku-%d", i),
+			Title:                "Used XC60",
+			Description:          "One owner",
+			Availability:         ProductInStock,
+			ImageURL:             "https://example.com/xc60.jpg",
+			Price:                19.99,
+			SalePrice:            17.5,
+			Currency:             "USD",
+			Inventory:            &stock,
+			VehicleProductFields: &VehicleProductFields{Make: "Volvo", Model: "XC60", Year: 2021},
+		}
+	}
+
+	logs, err := client.Catalog().UploadAllProducts(context.Background(), "123", "cat-1", products)
+	if err != nil {
+		t.Fatalf("UploadAllProducts() error = %v", err)
+	}
+	if len(logs) != 2 || len(batches) != 2 || batches[0] != CatalogProductUploadMaxBatch || batches[1] != 1 {
+		t.Errorf("logs = %v, batches = %v", logs, batches)
+	}
+
+	product, err := client.Catalog().GetProduct(context.Background(), "123", "cat-1", "sku-1")
+	if err != nil {
+		t.Fatalf("GetProduct() error = %v", err)
+	}
+	if product.ProductID != "p1" || product.VehicleProductFields == nil || product.Make != "Volvo" {
+		t.Errorf("product = %+v", product)
+	}
+
+	invalid := products[0]
+	invalid.SalePrice = 25
+	if _, err := client.Catalog().UploadAllProducts(context.Background(), "123", "cat-1", []Product{invalid}); err == nil {
+		t.Error("UploadAllProducts() accepted a sale price above the price")
+	}
+	if len(batches) != 2 {
+		t.Errorf("invalid products were uploaded")
+	}
+}
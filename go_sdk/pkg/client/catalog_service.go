@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
 )
 
 // CatalogService handles Catalog related operations
@@ -51,16 +53,16 @@ type CatalogDeleteRequest struct {
 
 // CatalogData represents catalog information
 type CatalogData struct {
-	CatalogID       string `json:"catalog_id"`
-	CatalogName     string `json:"catalog_name"`
-	CatalogType     string `json:"catalog_type"`
-	Description     string `json:"description"`
-	DefaultLocale   string `json:"default_locale"`
-	DefaultCurrency string `json:"default_currency"`
-	Status          string `json:"status"`
-	ProductCount    int    `json:"product_count"`
-	CreateTime      string `json:"create_time"`
-	UpdateTime      string `json:"update_time"`
+	CatalogID       string      `json:"catalog_id"`
+	CatalogName     string      `json:"catalog_name"`
+	CatalogType     string      `json:"catalog_type"`
+	Description     string      `json:"description"`
+	DefaultLocale   string      `json:"default_locale"`
+	DefaultCurrency string      `json:"default_currency"`
+	Status          string      `json:"status"`
+	ProductCount    int         `json:"product_count"`
+	CreateTime      models.Time `json:"create_time"`
+	UpdateTime      models.Time `json:"update_time"`
 }
 
 // CatalogResponse represents the response from catalog operations
@@ -95,7 +97,10 @@ func (s *CatalogService) Create(ctx context.Context, req *CatalogCreateRequest)
 		return nil, fmt.Errorf("catalog_type is required")
 	}
 
-	url := s.client.BuildURL("/catalog/create/", nil)
+	url, err := s.client.BuildURL("/catalog/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -134,7 +139,10 @@ func (s *CatalogService) Get(ctx context.Context, req *CatalogGetRequest) (*Cata
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/catalog/get/", params)
+	url, err := s.client.BuildURL("/catalog/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -162,7 +170,10 @@ func (s *CatalogService) Update(ctx context.Context, req *CatalogUpdateRequest)
 		return nil, fmt.Errorf("catalog_id is required")
 	}
 
-	url := s.client.BuildURL("/catalog/update/", nil)
+	url, err := s.client.BuildURL("/catalog/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -195,7 +206,10 @@ func (s *CatalogService) Delete(ctx context.Context, req *CatalogDeleteRequest)
 		return nil, fmt.Errorf("catalog_id is required")
 	}
 
-	url := s.client.BuildURL("/catalog/delete/", nil)
+	url, err := s.client.BuildURL("/catalog/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -252,7 +266,10 @@ func (s *CatalogService) GetOverview(ctx context.Context, req *CatalogOverviewRe
 		"catalog_id":    req.CatalogID,
 	}
 
-	url := s.client.BuildURL("/catalog/overview/", params)
+	url, err := s.client.BuildURL("/catalog/overview/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -282,7 +299,10 @@ func (s *CatalogService) CreateFeed(ctx context.Context, req *CatalogFeedCreateR
 		return nil, fmt.Errorf("feed_url is required")
 	}
 
-	url := s.client.BuildURL("/catalog/feed/create/", nil)
+	url, err := s.client.BuildURL("/catalog/feed/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -317,7 +337,10 @@ func (s *CatalogService) GetFeed(ctx context.Context, req *CatalogFeedGetRequest
 		params["feed_id"] = req.FeedID
 	}
 
-	url := s.client.BuildURL("/catalog/feed/get/", params)
+	url, err := s.client.BuildURL("/catalog/feed/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -347,7 +370,10 @@ func (s *CatalogService) UpdateFeed(ctx context.Context, req *CatalogFeedUpdateR
 		return nil, fmt.Errorf("feed_id is required")
 	}
 
-	url := s.client.BuildURL("/catalog/feed/update/", nil)
+	url, err := s.client.BuildURL("/catalog/feed/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -382,7 +408,10 @@ func (s *CatalogService) DeleteFeed(ctx context.Context, req *CatalogFeedDeleteR
 		return nil, fmt.Errorf("feed_id is required")
 	}
 
-	url := s.client.BuildURL("/catalog/feed/delete/", nil)
+	url, err := s.client.BuildURL("/catalog/feed/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -421,7 +450,10 @@ func (s *CatalogService) GetFeedLog(ctx context.Context, req *CatalogFeedLogRequ
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/catalog/feed/log/", params)
+	url, err := s.client.BuildURL("/catalog/feed/log/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -451,7 +483,10 @@ func (s *CatalogService) DeleteProduct(ctx context.Context, req *CatalogProductD
 		return nil, fmt.Errorf("product_ids is required")
 	}
 
-	url := s.client.BuildURL("/catalog/product/delete/", nil)
+	url, err := s.client.BuildURL("/catalog/product/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -486,7 +521,10 @@ func (s *CatalogService) GetProductFile(ctx context.Context, req *CatalogProduct
 		params["file_type"] = req.FileType
 	}
 
-	url := s.client.BuildURL("/catalog/product/file/", params)
+	url, err := s.client.BuildURL("/catalog/product/file/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -519,7 +557,10 @@ func (s *CatalogService) GetProductLog(ctx context.Context, req *CatalogProductL
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/catalog/product/log/", params)
+	url, err := s.client.BuildURL("/catalog/product/log/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -551,13 +592,13 @@ type CatalogFeedResponse struct {
 }
 
 type CatalogFeedData struct {
-	FeedID     string `json:"feed_id"`
-	FeedName   string `json:"feed_name"`
-	FeedURL    string `json:"feed_url"`
-	Status     string `json:"status"`
-	Schedule   string `json:"schedule"`
-	CreateTime string `json:"create_time"`
-	UpdateTime string `json:"update_time"`
+	FeedID     string      `json:"feed_id"`
+	FeedName   string      `json:"feed_name"`
+	FeedURL    string      `json:"feed_url"`
+	Status     string      `json:"status"`
+	Schedule   string      `json:"schedule"`
+	CreateTime models.Time `json:"create_time"`
+	UpdateTime models.Time `json:"update_time"`
 }
 
 type CatalogFeedGetRequest struct {
@@ -663,11 +704,11 @@ type CatalogProductFileResponse struct {
 }
 
 type CatalogProductFileData struct {
-	FileURL    string `json:"file_url"`
-	FileType   string `json:"file_type"`
-	FileSize   int64  `json:"file_size"`
-	CreateTime string `json:"create_time"`
-	ExpiryTime string `json:"expiry_time"`
+	FileURL    string      `json:"file_url"`
+	FileType   string      `json:"file_type"`
+	FileSize   int64       `json:"file_size"`
+	CreateTime models.Time `json:"create_time"`
+	ExpiryTime string      `json:"expiry_time"`
 }
 
 type CatalogProductLogRequest struct {
@@ -0,0 +1,403 @@
+package client
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Catalog sync defaults
+const (
+	DefaultCatalogSyncPageSize    = 500
+	DefaultCatalogSyncDeleteBatch = 1000
+)
+
+// Catalog sync operations
+const (
+	CatalogSyncCreate = "CREATE"
+	CatalogSyncUpdate = "UPDATE"
+	CatalogSyncDelete = "DELETE"
+)
+
+// ProductSourceFormat is the encoding of a merchant product list
+type ProductSourceFormat string
+
+const (
+	// ProductSourceCSV is a CSV file with a header row of product field
+	// names, e.g. sku_id,title,price. List fields are comma separated within
+	// their cell.
+	ProductSourceCSV ProductSourceFormat = "CSV"
+	// ProductSourceJSON is a JSON array of products
+	ProductSourceJSON ProductSourceFormat = "JSON"
+)
+
+// CatalogSyncConfig configures a CatalogSync
+type CatalogSyncConfig struct {
+	AdvertiserID string
+	CatalogID    string
+
+	// UploadBatchSize caps the products per upload (defaults to CatalogProductUploadMaxBatch)
+	UploadBatchSize int
+
+	// DeleteBatchSize caps the products per delete (defaults to DefaultCatalogSyncDeleteBatch)
+	DeleteBatchSize int
+
+	// KeepMissing leaves catalog products absent from the source in place
+	// instead of deleting them
+	KeepMissing bool
+
+	// DryRun computes the changes without applying them
+	DryRun bool
+}
+
+// CatalogSyncItemError is a change that could not be applied to one product
+type CatalogSyncItemError struct {
+	SKUID     string `json:"sku_id"`
+	Operation string `json:"operation"`
+	Message   string `json:"message"`
+}
+
+// CatalogSyncResult summarizes the changes made by a catalog sync. Uploads
+// are processed asynchronously; FeedLogIDs identify them in GetProductLog.
+type CatalogSyncResult struct {
+	Created    []string               `json:"created"`
+	Updated    []string               `json:"updated"`
+	Deleted    []string               `json:"deleted"`
+	Unchanged  int                    `json:"unchanged"`
+	FeedLogIDs []string               `json:"feed_log_ids,omitempty"`
+	Errors     []CatalogSyncItemError `json:"errors,omitempty"`
+}
+
+// CatalogSync brings a catalog in line with a merchant's product list,
+// issuing only the uploads and deletes needed to get there
+type CatalogSync struct {
+	client *Client
+	config CatalogSyncConfig
+}
+
+// NewCatalogSync creates a new CatalogSync
+func NewCatalogSync(client *Client, config CatalogSyncConfig) (*CatalogSync, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+	if config.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if config.CatalogID == "" {
+		return nil, fmt.Errorf("catalog_id is required")
+	}
+	if config.UploadBatchSize <= 0 || config.UploadBatchSize > CatalogProductUploadMaxBatch {
+		config.UploadBatchSize = CatalogProductUploadMaxBatch
+	}
+	if config.DeleteBatchSize <= 0 {
+		config.DeleteBatchSize = DefaultCatalogSyncDeleteBatch
+	}
+
+	return &CatalogSync{client: client, config: config}, nil
+}
+
+// SyncFrom reads the merchant's product list from r and syncs the catalog to it
+func (s *CatalogSync) SyncFrom(ctx context.Context, r io.Reader, format ProductSourceFormat) (*CatalogSyncResult, error) {
+	products, err := ReadProducts(r, format)
+	if err != nil {
+		return nil, err
+	}
+	return s.Sync(ctx, products)
+}
+
+// Sync compares products with the live catalog by SKU, uploads new and
+// changed products and deletes products missing from the list. Invalid
+// products and failed batches are reported per item in the result; the
+// returned error is reserved for failures that stop the sync.
+func (s *CatalogSync) Sync(ctx context.Context, products []Product) (*CatalogSyncResult, error) {
+	live, err := s.liveProducts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CatalogSyncResult{}
+	var uploads []Product
+	operations := make(map[string]string)
+	seen := make(map[string]bool, len(products))
+	for _, product := range products {
+		if seen[product.SKUID] {
+			result.addError(product.SKUID, CatalogSyncUpdate, "duplicate sku_id in source")
+			continue
+		}
+		seen[product.SKUID] = true
+
+		current, exists := live[product.SKUID]
+		operation := CatalogSyncCreate
+		if exists {
+			if reflect.DeepEqual(current.Product, product) {
+				result.Unchanged++
+				continue
+			}
+			operation = CatalogSyncUpdate
+		}
+		if err := product.Validate(); err != nil {
+			result.addError(product.SKUID, operation, err.Error())
+			continue
+		}
+		operations[product.SKUID] = operation
+		uploads = append(uploads, product)
+	}
+
+	var deletes []string
+	if !s.config.KeepMissing {
+		for sku := range live {
+			if !seen[sku] {
+				deletes = append(deletes, sku)
+			}
+		}
+		sort.Strings(deletes)
+	}
+
+	for start := 0; start < len(uploads); start += s.config.UploadBatchSize {
+		end := start + s.config.UploadBatchSize
+		if end > len(uploads) {
+			end = len(uploads)
+		}
+		batch := uploads[start:end]
+
+		if !s.config.DryRun {
+			resp, err := s.client.Catalog().UploadProducts(ctx, &CatalogProductUploadRequest{
+				AdvertiserID: s.config.AdvertiserID,
+				CatalogID:    s.config.CatalogID,
+				Products:     batch,
+			})
+			if err == nil && resp.Code != 0 {
+				err = fmt.Errorf("API error: %s", resp.Message)
+			}
+			if err != nil {
+				for _, product := range batch {
+					result.addError(product.SKUID, operations[product.SKUID], err.Error())
+				}
+				continue
+			}
+			result.FeedLogIDs = append(result.FeedLogIDs, resp.Data.FeedLogID)
+		}
+		for _, product := range batch {
+			if operations[product.SKUID] == CatalogSyncCreate {
+				result.Created = append(result.Created, product.SKUID)
+			} else {
+				result.Updated = append(result.Updated, product.SKUID)
+			}
+		}
+	}
+
+	for start := 0; start < len(deletes); start += s.config.DeleteBatchSize {
+		end := start + s.config.DeleteBatchSize
+		if end > len(deletes) {
+			end = len(deletes)
+		}
+		batch := deletes[start:end]
+
+		if s.config.DryRun {
+			result.Deleted = append(result.Deleted, batch...)
+			continue
+		}
+		s.deleteBatch(ctx, batch, live, result)
+	}
+
+	return result, nil
+}
+
+// deleteBatch deletes one batch of products, recording per-product outcomes
+func (s *CatalogSync) deleteBatch(ctx context.Context, skus []string, live map[string]CatalogProduct, result *CatalogSyncResult) {
+	ids := make([]string, len(skus))
+	skuByID := make(map[string]string, len(skus))
+	for i, sku := range skus {
+		ids[i] = live[sku].ProductID
+		skuByID[ids[i]] = sku
+	}
+
+	resp, err := s.client.Catalog().DeleteProduct(ctx, &CatalogProductDeleteRequest{
+		AdvertiserID: s.config.AdvertiserID,
+		CatalogID:    s.config.CatalogID,
+		ProductIDs:   ids,
+	})
+	if err == nil && resp.Code != 0 {
+		err = fmt.Errorf("API error: %s", resp.Message)
+	}
+	if err != nil {
+		for _, sku := range skus {
+			result.addError(sku, CatalogSyncDelete, err.Error())
+		}
+		return
+	}
+
+	failed := make(map[string]bool)
+	for _, r := range resp.Data.Results {
+		if r.Status != "" && r.Status != "SUCCESS" {
+			failed[r.ProductID] = true
+			result.addError(skuByID[r.ProductID], CatalogSyncDelete, r.Message)
+		}
+	}
+	for _, id := range ids {
+		if !failed[id] {
+			result.Deleted = append(result.Deleted, skuByID[id])
+		}
+	}
+}
+
+// liveProducts fetches every product in the catalog, keyed by SKU
+func (s *CatalogSync) liveProducts(ctx context.Context) (map[string]CatalogProduct, error) {
+	live := make(map[string]CatalogProduct)
+	for page := 1; ; page++ {
+		resp, err := s.client.Catalog().GetProducts(ctx, &CatalogProductGetRequest{
+			AdvertiserID: s.config.AdvertiserID,
+			CatalogID:    s.config.CatalogID,
+			Page:         page,
+			PageSize:     DefaultCatalogSyncPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get catalog products: %w", err)
+		}
+		if resp.Code != 0 {
+			return nil, fmt.Errorf("API error: %s", resp.Message)
+		}
+		for _, product := range resp.Data.Products {
+			live[product.SKUID] = product
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			return live, nil
+		}
+	}
+}
+
+// addError records a failed change
+func (r *CatalogSyncResult) addError(sku, operation, message string) {
+	r.Errors = append(r.Errors, CatalogSyncItemError{SKUID: sku, Operation: operation, Message: message})
+}
+
+// ReadProducts decodes a merchant product list
+func ReadProducts(r io.Reader, format ProductSourceFormat) ([]Product, error) {
+	switch format {
+	case ProductSourceJSON:
+		var products []Product
+		if err := json.NewDecoder(r).Decode(&products); err != nil {
+			return nil, fmt.Errorf("failed to decode products: %w", err)
+		}
+		return products, nil
+	case ProductSourceCSV:
+		return readProductsCSV(r)
+	default:
+		return nil, fmt.Errorf("unsupported product source format: %s", format)
+	}
+}
+
+// readProductsCSV decodes products from a CSV file whose header names the
+// json field of each column
+func readProductsCSV(r io.Reader) ([]Product, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read product header: %w", err)
+	}
+	fields := productFields()
+	columns := make([]reflect.StructField, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		field, ok := fields[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown product column %q", name)
+		}
+		columns[i] = field
+	}
+
+	var products []Product
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return products, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read products: %w", err)
+		}
+
+		var product Product
+		value := reflect.ValueOf(&product).Elem()
+		for i, cell := range record {
+			if i >= len(columns) || strings.TrimSpace(cell) == "" {
+				continue
+			}
+			if err := setProductField(value, columns[i], strings.TrimSpace(cell)); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		products = append(products, product)
+	}
+}
+
+// productFields maps json field names to the fields of Product, including
+// those of the embedded vertical structs
+func productFields() map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for _, field := range reflect.VisibleFields(reflect.TypeOf(Product{})) {
+		if field.Anonymous {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			fields[name] = field
+		}
+	}
+	return fields
+}
+
+// setProductField parses a CSV cell into a product field, allocating the
+// embedded vertical struct or pointer it belongs to as needed
+func setProductField(product reflect.Value, field reflect.StructField, cell string) error {
+	target := product
+	for i, index := range field.Index {
+		if i > 0 && target.Kind() == reflect.Ptr {
+			if target.IsNil() {
+				target.Set(reflect.New(target.Type().Elem()))
+			}
+			target = target.Elem()
+		}
+		target = target.Field(index)
+	}
+	if target.Kind() == reflect.Ptr {
+		target.Set(reflect.New(target.Type().Elem()))
+		target = target.Elem()
+	}
+
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	switch target.Kind() {
+	case reflect.String:
+		target.SetString(cell)
+	case reflect.Int:
+		n, err := strconv.Atoi(cell)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer: %q", name, cell)
+		}
+		target.SetInt(int64(n))
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return fmt.Errorf("%s must be a number: %q", name, cell)
+		}
+		target.SetFloat(f)
+	case reflect.Slice:
+		var values []string
+		for _, v := range strings.Split(cell, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		target.Set(reflect.ValueOf(values))
+	default:
+		return fmt.Errorf("unsupported product column %s", name)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCatalogSync(t *testing.T) {
+	var uploaded []string
+	var deleted []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/catalog/product/get/":
+			w.Write([]byte(`{"code":0,"data":{"products":[
+				{"product_id":"p1","sku_id":"sku-1","title":"Mug","description":"Blue mug","availability":"IN_STOCK","image_url":"https://example.com/1.jpg","price":12,"currency":"USD","custom_labels":["kitchen","sale"]},
+				{"product_id":"p2","sku_id":"sku-2","title":"Cup","description":"Red cup","availability":"IN_STOCK","image_url":"https://example.com/2.jpg","price":8,"currency":"USD"},
+				{"product_id":"p9","sku_id":"sku-old","title":"Plate","description":"Old plate","availability":"IN_STOCK","image_url":"https://example.com/9.jpg","price":5,"currency":"USD"}
+			],"page_info":{"total_page":1}}}`))
+		case "/open_api/v1.3/catalog/product/upload/":
+			var body CatalogProductUploadRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			for _, product := range body.Products {
+				uploaded = append(uploaded, product.SKUID)
+			}
+			w.Write([]byte(`{"code":0,"data":{"feed_log_id":"log-1"}}`))
+		case "/open_api/v1.3/catalog/product/delete/":
+			var body CatalogProductDeleteRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			deleted = body.ProductIDs
+			w.Write([]byte(`{"code":0,"data":{"results":[{"product_id":"p9","status":"SUCCESS"}]}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+	sync, err := NewCatalogSync(client, CatalogSyncConfig{AdvertiserID: "123", CatalogID: "cat-1"})
+	if err != nil {
+		t.Fatalf("NewCatalogSync() error = %v", err)
+	}
+
+	source := "sku_id,title,description,availability,image_url,price,currency,custom_labels\n" +
+		"sku-1,Mug,Blue mug,IN_STOCK,https://example.com/1.jpg,12,USD,\"kitchen, sale\"\n" +
+		"sku-2,Cup,Red cup,IN_STOCK,https://example.com/2.jpg,9.5,USD,\n" +
+		"sku-3,Bowl,White bowl,PREORDER,https://example.com/3.jpg,15,USD,\n" +
+		"sku-4,Spoon,Steel spoon,SOLD_OUT,https://example.com/4.jpg,2,USD,\n"
+	result, err := sync.SyncFrom(context.Background(), strings.NewReader(source), ProductSourceCSV)
+	if err != nil {
+		t.Fatalf("SyncFrom() error = %v", err)
+	}
+
+	if result.Unchanged != 1 || !reflect.DeepEqual(result.Created, []string{"sku-3"}) || !reflect.DeepEqual(result.Updated, []string{"sku-2"}) || !reflect.DeepEqual(result.Deleted, []string{"sku-old"}) {
+		t.Errorf("result = %+v", result)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].SKUID != "sku-4" || result.Errors[0].Operation != CatalogSyncCreate {
+		t.Errorf("errors = %+v", result.Errors)
+	}
+	if !reflect.DeepEqual(uploaded, []string{"sku-2", "sku-3"}) || !reflect.DeepEqual(deleted, []string{"p9"}) {
+		t.Errorf("uploaded = %v, deleted = %v", uploaded, deleted)
+	}
+
+	if _, err := ReadProducts(strings.NewReader("sku_id,colour\n1,red\n"), ProductSourceCSV); err == nil {
+		t.Error("ReadProducts() accepted an unknown column")
+	}
+}
@@ -0,0 +1,331 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Chunk sizes of chunked uploads
+const (
+	DefaultUploadChunkSize = 5 * 1024 * 1024
+	MinUploadChunkSize     = 1024 * 1024
+)
+
+// uploadCleanupTimeout bounds the calls that close the session of a failed
+// upload or delete the video of a cancelled one
+const uploadCleanupTimeout = 30 * time.Second
+
+// ErrUploadAborted is returned by VideoUpload.Wait after Abort
+var ErrUploadAborted = errors.New("upload aborted")
+
+// ChunkedVideoUploadRequest represents the request for uploading a video in chunks
+type ChunkedVideoUploadRequest struct {
+	AdvertiserID string
+	VideoName    string
+	VideoType    string // MP4, MOV, AVI
+
+	// Reader supplies the video content and Size its length in bytes
+	Reader io.Reader
+	Size   int64
+
+	// ChunkSize is the bytes sent per request (defaults to DefaultUploadChunkSize)
+	ChunkSize int64
+}
+
+// UploadSessionResponse represents the response for starting or finishing
+// a chunked upload
+type UploadSessionResponse struct {
+	models.BaseResponse
+	Data struct {
+		UploadID string `json:"upload_id"`
+		FileID   string `json:"file_id,omitempty"`
+	} `json:"data"`
+}
+
+// VideoUpload is a chunked video upload running in the background. Its
+// chunks stop on Abort or when the context passed to StartVideoUpload is
+// cancelled: an open upload session is then closed and a video already
+// registered is deleted.
+type VideoUpload struct {
+	client *Client
+	req    ChunkedVideoUploadRequest
+	cancel context.CancelCauseFunc
+
+	sent atomic.Int64
+	done chan struct{}
+
+	// Set before done is closed
+	video *VideoUploadData
+	err   error
+}
+
+// StartVideoUpload validates the request and starts uploading the video in
+// chunks, returning a handle to wait for or abort the upload
+func (c *Client) StartVideoUpload(ctx context.Context, req *ChunkedVideoUploadRequest) (*VideoUpload, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	upload := &VideoUpload{client: c, req: *req, cancel: cancel, done: make(chan struct{})}
+	if upload.req.ChunkSize == 0 {
+		upload.req.ChunkSize = DefaultUploadChunkSize
+	}
+	go func() {
+		defer close(upload.done)
+		defer cancel(nil)
+		upload.video, upload.err = upload.run(ctx)
+	}()
+	return upload, nil
+}
+
+// Wait blocks until the upload finishes and returns the registered video
+func (u *VideoUpload) Wait() (*VideoUploadData, error) {
+	<-u.done
+	return u.video, u.err
+}
+
+// Done is closed once the upload has finished, failed or been aborted
+func (u *VideoUpload) Done() <-chan struct{} {
+	return u.done
+}
+
+// Abort stops the upload and waits for its cleanup: the upload session is
+// closed, or, once the video is being registered, the registration completes
+// and the video is deleted. It has no effect on a finished upload.
+func (u *VideoUpload) Abort() {
+	u.cancel(ErrUploadAborted)
+	<-u.done
+}
+
+// Progress returns the bytes sent so far and the size of the video
+func (u *VideoUpload) Progress() (sent, total int64) {
+	return u.sent.Load(), u.req.Size
+}
+
+// run sends the chunks, then registers the uploaded file as a video
+func (u *VideoUpload) run(ctx context.Context) (*VideoUploadData, error) {
+	session, err := u.session(ctx, "/file/start/upload/", map[string]interface{}{
+		"advertiser_id": u.req.AdvertiserID,
+		"size":          u.req.Size,
+		"content_type":  "video",
+	})
+	if err != nil {
+		return nil, u.failed(ctx, "failed to start video upload", err)
+	}
+	uploadID := session.Data.UploadID
+
+	signature := md5.New()
+	chunk := make([]byte, u.req.ChunkSize)
+	for offset := int64(0); offset < u.req.Size; {
+		if ctx.Err() != nil {
+			return nil, u.closeSession(ctx, uploadID, context.Cause(ctx))
+		}
+		n := min(u.req.ChunkSize, u.req.Size-offset)
+		if _, err := io.ReadFull(u.req.Reader, chunk[:n]); err != nil {
+			return nil, u.closeSession(ctx, uploadID, fmt.Errorf("failed to read video at offset %d: %w", offset, err))
+		}
+		if err := u.transfer(ctx, uploadID, offset, chunk[:n], signature); err != nil {
+			return nil, u.closeSession(ctx, uploadID, u.failed(ctx, fmt.Sprintf("failed to upload chunk at offset %d", offset), err))
+		}
+		offset += n
+		u.sent.Store(offset)
+	}
+
+	finished, err := u.session(ctx, "/file/finish/upload/", map[string]interface{}{
+		"advertiser_id": u.req.AdvertiserID,
+		"upload_id":     uploadID,
+	})
+	if err != nil {
+		return nil, u.closeSession(ctx, uploadID, u.failed(ctx, "failed to finish video upload", err))
+	}
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
+
+	// A registration cut off by an abort may still create the video, so it
+	// runs to completion and an abort is handled once its ID is known
+	video, err := u.register(context.WithoutCancel(ctx), finished.Data.FileID, hex.EncodeToString(signature.Sum(nil)))
+	if err != nil {
+		return nil, u.failed(ctx, "failed to register video", err)
+	}
+	if ctx.Err() != nil {
+		cleanupCtx, cancel := cleanupContext(ctx)
+		defer cancel()
+		assets := map[string][]string{"VIDEO": {video.VideoID}}
+		if err := u.client.deleteUploadedAssets(cleanupCtx, u.req.AdvertiserID, assets); err != nil {
+			return nil, fmt.Errorf("%w; video %s was left behind: %v", context.Cause(ctx), video.VideoID, err)
+		}
+		return nil, context.Cause(ctx)
+	}
+	return video, nil
+}
+
+// failed wraps a step error, reporting cancellation by its cause
+func (u *VideoUpload) failed(ctx context.Context, step string, err error) error {
+	if ctx.Err() != nil {
+		return context.Cause(ctx)
+	}
+	return fmt.Errorf("%s: %w", step, err)
+}
+
+// closeSession finishes the session of an upload that failed or was
+// cancelled, so the server does not hold it open, and returns err. The
+// finished file is never registered as a video.
+func (u *VideoUpload) closeSession(ctx context.Context, uploadID string, err error) error {
+	cleanupCtx, cancel := cleanupContext(ctx)
+	defer cancel()
+	_, closeErr := u.session(cleanupCtx, "/file/finish/upload/", map[string]interface{}{
+		"advertiser_id": u.req.AdvertiserID,
+		"upload_id":     uploadID,
+	})
+	if closeErr != nil {
+		return fmt.Errorf("%w; upload session %s was left open: %v", err, uploadID, closeErr)
+	}
+	return err
+}
+
+// session starts or finishes the upload session
+func (u *VideoUpload) session(ctx context.Context, endpoint string, payload map[string]interface{}) (*UploadSessionResponse, error) {
+	url, err := u.client.BuildURL(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := u.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, err
+	}
+	var response UploadSessionResponse
+	if err := u.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+	if response.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", response.Code), response.Message, response.RequestID, 0)
+	}
+	return &response, nil
+}
+
+// transfer sends one chunk, adding it to the signature of the whole video
+func (u *VideoUpload) transfer(ctx context.Context, uploadID string, offset int64, chunk []byte, signature hash.Hash) error {
+	fields := map[string]string{
+		"advertiser_id": u.req.AdvertiserID,
+		"upload_id":     uploadID,
+		"start_offset":  strconv.FormatInt(offset, 10),
+	}
+	file := MultipartFile{
+		FieldName:      "file",
+		FileName:       uploadFileName(u.req.VideoName, u.req.VideoType),
+		ContentType:    "application/octet-stream",
+		Reader:         bytes.NewReader(chunk),
+		SignatureField: "signature",
+	}
+
+	resp, err := u.client.DoMultipartRequest(ctx, "/file/transfer/upload/", fields, []MultipartFile{file}, nil)
+	if err != nil {
+		return err
+	}
+	var response models.BaseResponse
+	if err := u.client.ParseResponse(resp, &response); err != nil {
+		return err
+	}
+	if response.Code != 0 {
+		return models.NewAPIError(fmt.Sprintf("%d", response.Code), response.Message, response.RequestID, 0)
+	}
+	signature.Write(chunk)
+	return nil
+}
+
+// register creates the video from the uploaded file
+func (u *VideoUpload) register(ctx context.Context, fileID, signature string) (*VideoUploadData, error) {
+	url, err := u.client.BuildURL("/file/video/ad/upload/", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(map[string]string{
+		"advertiser_id":   u.req.AdvertiserID,
+		"upload_type":     "UPLOAD_BY_FILE_ID",
+		"file_id":         fileID,
+		"file_name":       u.req.VideoName,
+		"video_signature": signature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := u.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, err
+	}
+	var response VideoUploadResponse
+	if err := u.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+	if response.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", response.Code), response.Message, response.RequestID, 0)
+	}
+	return &response.Data, nil
+}
+
+// cleanupContext returns a context for closing or deleting what a cancelled
+// upload created; it keeps the values of ctx but not its cancellation
+func cleanupContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), uploadCleanupTimeout)
+}
+
+// deleteUploadedAssets deletes assets created by a cancelled upload,
+// grouping them by asset type
+func (c *Client) deleteUploadedAssets(ctx context.Context, advertiserID string, assets map[string][]string) error {
+	var errs []error
+	for _, assetType := range []string{"IMAGE", "VIDEO"} {
+		ids := assets[assetType]
+		if len(ids) == 0 {
+			continue
+		}
+		resp, err := c.Creative().DeleteAssets(ctx, &CreativeAssetDeleteRequest{AdvertiserID: advertiserID, AssetIDs: ids, AssetType: assetType})
+		if err == nil && resp.Code != 0 {
+			err = models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete %s assets: %w", strings.ToLower(assetType), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the video size and chunk size
+func (r *ChunkedVideoUploadRequest) Validate() error {
+	if r.AdvertiserID == "" {
+		return models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+	if r.Reader == nil {
+		return models.NewValidationError("reader", "reader is required")
+	}
+	if r.Size <= 0 || r.Size > CreativeVideoMaxBytes {
+		return models.NewValidationError("size", fmt.Sprintf("size must be between 1 and %d bytes", CreativeVideoMaxBytes))
+	}
+	if r.ChunkSize != 0 && r.ChunkSize < MinUploadChunkSize {
+		return models.NewValidationError("chunk_size", fmt.Sprintf("chunk_size must be at least %d bytes", MinUploadChunkSize))
+	}
+	return nil
+}
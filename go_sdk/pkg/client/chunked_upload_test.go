@@ -0,0 +1,221 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// chunkedUploadServer serves the chunked upload endpoints, recording calls
+type chunkedUploadServer struct {
+	mu        sync.Mutex
+	offsets   []string
+	signature string
+	deleted   []string
+	finished  int
+
+	// onTransfer, if set, handles the transfer of a chunk
+	onTransfer func(w http.ResponseWriter, r *http.Request, offset string) bool
+}
+
+func (s *chunkedUploadServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch strings.TrimPrefix(r.URL.Path, "/open_api/v1.3") {
+	case "/file/start/upload/":
+		w.Write([]byte(`{"code":0,"data":{"upload_id":"up-1"}}`))
+	case "/file/transfer/upload/":
+		r.ParseMultipartForm(8 << 20)
+		offset := r.FormValue("start_offset")
+		s.mu.Lock()
+		s.offsets = append(s.offsets, offset)
+		s.mu.Unlock()
+		if s.onTransfer != nil && s.onTransfer(w, r, offset) {
+			return
+		}
+		w.Write([]byte(`{"code":0,"data":{}}`))
+	case "/file/finish/upload/":
+		s.mu.Lock()
+		s.finished++
+		s.mu.Unlock()
+		w.Write([]byte(`{"code":0,"data":{"upload_id":"up-1","file_id":"file-1"}}`))
+	case "/file/video/ad/upload/":
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		s.mu.Lock()
+		s.signature = body["video_signature"]
+		s.mu.Unlock()
+		if body["upload_type"] != "UPLOAD_BY_FILE_ID" || body["file_id"] != "file-1" {
+			w.Write([]byte(`{"code":40002,"message":"bad upload"}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"data":{"video_id":"vid-1"}}`))
+	case "/creative/asset/delete/":
+		var body CreativeAssetDeleteRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		s.mu.Lock()
+		s.deleted = append(s.deleted, body.AssetType+":"+strings.Join(body.AssetIDs, ","))
+		s.mu.Unlock()
+		w.Write([]byte(`{"code":0,"data":{"deleted_assets":[]}}`))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestStartVideoUpload(t *testing.T) {
+	video := bytes.Repeat([]byte("0123456789"), MinUploadChunkSize/4)
+	newClient := func(t *testing.T, api *chunkedUploadServer, middleware ...Middleware) *Client {
+		server := httptest.NewServer(api)
+		t.Cleanup(server.Close)
+		client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second, Middleware: middleware})
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		return client
+	}
+	request := func() *ChunkedVideoUploadRequest {
+		return &ChunkedVideoUploadRequest{
+			AdvertiserID: "123",
+			VideoName:    "spot",
+			VideoType:    "MP4",
+			Reader:       bytes.NewReader(video),
+			Size:         int64(len(video)),
+			ChunkSize:    MinUploadChunkSize,
+		}
+	}
+
+	t.Run("Completes", func(t *testing.T) {
+		api := &chunkedUploadServer{}
+		upload, err := newClient(t, api).StartVideoUpload(context.Background(), request())
+		if err != nil {
+			t.Fatalf("StartVideoUpload() error = %v", err)
+		}
+		data, err := upload.Wait()
+		if err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+		if data.VideoID != "vid-1" {
+			t.Errorf("VideoID = %q", data.VideoID)
+		}
+		if want := []string{"0", "1048576", "2097152"}; !reflect.DeepEqual(api.offsets, want) {
+			t.Errorf("chunk offsets = %v, want %v", api.offsets, want)
+		}
+		sum := md5.Sum(video)
+		if api.signature != hex.EncodeToString(sum[:]) {
+			t.Errorf("video_signature = %q", api.signature)
+		}
+		if sent, total := upload.Progress(); sent != total || total != int64(len(video)) {
+			t.Errorf("Progress() = %d, %d", sent, total)
+		}
+	})
+
+	t.Run("Abort", func(t *testing.T) {
+		transferring := make(chan struct{})
+		api := &chunkedUploadServer{onTransfer: func(w http.ResponseWriter, r *http.Request, offset string) bool {
+			if offset != "1048576" {
+				return false
+			}
+			close(transferring)
+			<-r.Context().Done()
+			return true
+		}}
+		upload, err := newClient(t, api).StartVideoUpload(context.Background(), request())
+		if err != nil {
+			t.Fatalf("StartVideoUpload() error = %v", err)
+		}
+		<-transferring
+		upload.Abort()
+
+		if _, err := upload.Wait(); !errors.Is(err, ErrUploadAborted) {
+			t.Errorf("Wait() error = %v, want ErrUploadAborted", err)
+		}
+		if sent, _ := upload.Progress(); sent != MinUploadChunkSize {
+			t.Errorf("sent = %d after aborting the second chunk", sent)
+		}
+		if len(api.offsets) != 2 || api.signature != "" || len(api.deleted) != 0 {
+			t.Errorf("calls after abort: offsets %v, signature %q, deleted %v", api.offsets, api.signature, api.deleted)
+		}
+		// The session is closed rather than left open on the server
+		if api.finished != 1 {
+			t.Errorf("upload session finished %d times after abort, want 1", api.finished)
+		}
+	})
+
+	t.Run("DeletesVideoRegisteringOnCancel", func(t *testing.T) {
+		// Cancel as the registration is sent, before its response arrives
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cancelOnRegister := func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				if strings.HasSuffix(r.URL.Path, "/file/video/ad/upload/") {
+					cancel()
+				}
+				return next.RoundTrip(r)
+			})
+		}
+		api := &chunkedUploadServer{}
+		upload, err := newClient(t, api, cancelOnRegister).StartVideoUpload(ctx, request())
+		if err != nil {
+			t.Fatalf("StartVideoUpload() error = %v", err)
+		}
+		if _, err := upload.Wait(); !errors.Is(err, context.Canceled) {
+			t.Errorf("Wait() error = %v, want context.Canceled", err)
+		}
+		if want := []string{"VIDEO:vid-1"}; !reflect.DeepEqual(api.deleted, want) {
+			t.Errorf("deleted = %v, want %v", api.deleted, want)
+		}
+	})
+
+	t.Run("DeletesVideoRegisteredAfterCancel", func(t *testing.T) {
+		// Cancel as the registration response arrives, after the video exists
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cancelOnRegister := func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				resp, err := next.RoundTrip(r)
+				if err == nil && strings.HasSuffix(r.URL.Path, "/file/video/ad/upload/") {
+					body, _ := io.ReadAll(resp.Body)
+					resp.Body.Close()
+					resp.Body = io.NopCloser(bytes.NewReader(body))
+					cancel()
+				}
+				return resp, err
+			})
+		}
+		api := &chunkedUploadServer{}
+		upload, err := newClient(t, api, cancelOnRegister).StartVideoUpload(ctx, request())
+		if err != nil {
+			t.Fatalf("StartVideoUpload() error = %v", err)
+		}
+		if _, err := upload.Wait(); !errors.Is(err, context.Canceled) {
+			t.Errorf("Wait() error = %v, want context.Canceled", err)
+		}
+		if want := []string{"VIDEO:vid-1"}; !reflect.DeepEqual(api.deleted, want) {
+			t.Errorf("deleted = %v, want %v", api.deleted, want)
+		}
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		client := newClient(t, &chunkedUploadServer{})
+		for _, req := range []*ChunkedVideoUploadRequest{
+			{Reader: bytes.NewReader(video), Size: 10},
+			{AdvertiserID: "123", Size: 10},
+			{AdvertiserID: "123", Reader: bytes.NewReader(video)},
+			{AdvertiserID: "123", Reader: bytes.NewReader(video), Size: 10, ChunkSize: 1024},
+		} {
+			if _, err := client.StartVideoUpload(context.Background(), req); err == nil {
+				t.Errorf("StartVideoUpload(%+v) should fail", req)
+			}
+		}
+	})
+}
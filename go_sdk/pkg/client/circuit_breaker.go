@@ -0,0 +1,265 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Circuit breaker defaults
+const (
+	DefaultCircuitFailureThreshold = 5
+	DefaultCircuitOpenTimeout      = 30 * time.Second
+)
+
+// CircuitState is the state of an endpoint group's circuit breaker
+type CircuitState string
+
+const (
+	// CircuitClosed lets requests through
+	CircuitClosed CircuitState = "closed"
+
+	// CircuitOpen rejects requests until the open timeout has passed
+	CircuitOpen CircuitState = "open"
+
+	// CircuitHalfOpen lets a limited number of probe requests through; a
+	// successful probe closes the circuit and a failed one opens it again
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// ErrCircuitOpen is matched by the errors of requests rejected by an open circuit
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError is returned for requests rejected by an open circuit
+// without being sent
+type CircuitOpenError struct {
+	Group string
+
+	// RetryAt is when the circuit lets a probe request through
+	RetryAt time.Time
+}
+
+// Error implements the error interface
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker for %s is open until %s", e.Group, e.RetryAt.Format(time.RFC3339))
+}
+
+// Is makes errors.Is(err, ErrCircuitOpen) match
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitBreakerConfig configures the per endpoint group circuit breakers.
+// A request fails the circuit when it ends in a transport error, a 5xx
+// response or a 5xxxx API code after all retries; rate limiting and client
+// errors do not count.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed requests that opens
+	// a circuit (defaults to DefaultCircuitFailureThreshold)
+	FailureThreshold int
+
+	// OpenTimeout is how long an open circuit rejects requests before letting
+	// a probe through (defaults to DefaultCircuitOpenTimeout)
+	OpenTimeout time.Duration
+
+	// HalfOpenRequests is the number of concurrent probes a half-open circuit
+	// lets through (defaults to 1)
+	HalfOpenRequests int
+
+	// Group maps an endpoint to its circuit. It defaults to the first segment
+	// of the endpoint path, e.g. report for /report/integrated/get/.
+	Group func(endpoint string) string
+}
+
+// CircuitBreakerStats reports the state of one endpoint group's circuit
+type CircuitBreakerStats struct {
+	State               CircuitState `json:"state"`
+	ConsecutiveFailures int          `json:"consecutive_failures"`
+	Opens               int64        `json:"opens"`
+	Rejected            int64        `json:"rejected"`
+	OpenedAt            *time.Time   `json:"opened_at,omitempty"`
+}
+
+// circuitBreakers holds the circuits of a client, created on first use
+type circuitBreakers struct {
+	config CircuitBreakerConfig
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// circuit is the breaker state of one endpoint group; guarded by circuitBreakers.mu
+type circuit struct {
+	state    CircuitState
+	failures int
+	probes   int
+	openedAt time.Time
+	opens    int64
+	rejected int64
+}
+
+// newCircuitBreakers applies the config defaults; a nil config disables the breakers
+func newCircuitBreakers(config *CircuitBreakerConfig) *circuitBreakers {
+	if config == nil {
+		return nil
+	}
+	b := &circuitBreakers{config: *config, circuits: make(map[string]*circuit)}
+	if b.config.FailureThreshold <= 0 {
+		b.config.FailureThreshold = DefaultCircuitFailureThreshold
+	}
+	if b.config.OpenTimeout <= 0 {
+		b.config.OpenTimeout = DefaultCircuitOpenTimeout
+	}
+	if b.config.HalfOpenRequests <= 0 {
+		b.config.HalfOpenRequests = 1
+	}
+	if b.config.Group == nil {
+		b.config.Group = endpointGroup
+	}
+	return b
+}
+
+// allow admits a request of group, moving an open circuit whose timeout has
+// passed to half-open. Admitted requests must be reported to done.
+func (b *circuitBreakers) allow(group string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cb := b.circuits[group]
+	if cb == nil {
+		cb = &circuit{state: CircuitClosed}
+		b.circuits[group] = cb
+	}
+
+	if cb.state == CircuitOpen {
+		retryAt := cb.openedAt.Add(b.config.OpenTimeout)
+		if time.Now().Before(retryAt) {
+			cb.rejected++
+			return &CircuitOpenError{Group: group, RetryAt: retryAt}
+		}
+		cb.state = CircuitHalfOpen
+		cb.probes = 0
+	}
+
+	if cb.state == CircuitHalfOpen {
+		if cb.probes >= b.config.HalfOpenRequests {
+			cb.rejected++
+			return &CircuitOpenError{Group: group, RetryAt: time.Now().Add(b.config.OpenTimeout)}
+		}
+		cb.probes++
+	}
+	return nil
+}
+
+// done records the outcome of an admitted request
+func (b *circuitBreakers) done(group string, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cb := b.circuits[group]
+	if cb == nil {
+		return
+	}
+	if cb.state == CircuitHalfOpen && cb.probes > 0 {
+		cb.probes--
+	}
+
+	if !failed {
+		cb.state = CircuitClosed
+		cb.failures = 0
+		return
+	}
+
+	cb.failures++
+	if cb.state == CircuitHalfOpen || (cb.state == CircuitClosed && cb.failures >= b.config.FailureThreshold) {
+		cb.state = CircuitOpen
+		cb.openedAt = time.Now()
+		cb.opens++
+	}
+}
+
+// stats snapshots the circuits
+func (b *circuitBreakers) stats() map[string]CircuitBreakerStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := make(map[string]CircuitBreakerStats, len(b.circuits))
+	for group, cb := range b.circuits {
+		s := CircuitBreakerStats{
+			State:               cb.state,
+			ConsecutiveFailures: cb.failures,
+			Opens:               cb.opens,
+			Rejected:            cb.rejected,
+		}
+		if cb.state == CircuitOpen && time.Since(cb.openedAt) >= b.config.OpenTimeout {
+			s.State = CircuitHalfOpen
+		}
+		if cb.state != CircuitClosed {
+			openedAt := cb.openedAt
+			s.OpenedAt = &openedAt
+		}
+		stats[group] = s
+	}
+	return stats
+}
+
+// endpointGroup returns the first segment of an endpoint path below the API
+// version, e.g. report for /open_api/v1.3/report/integrated/get/
+func endpointGroup(endpoint string) string {
+	path := endpointPath(endpoint)
+	if rest, ok := strings.CutPrefix(path, apiPathPrefix); ok {
+		path = ""
+		if i := strings.Index(rest, "/"); i >= 0 {
+			path = rest[i:]
+		}
+	}
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.Index(path, "/"); i >= 0 {
+		path = path[:i]
+	}
+	if path == "" {
+		return "default"
+	}
+	return path
+}
+
+// circuitFailure reports whether a request outcome counts against its
+// circuit. Requests canceled by the caller do not.
+func circuitFailure(ctx context.Context, resp *http.Response, err error, bodyLimit int64) bool {
+	if err != nil {
+		if ctx.Err() != nil {
+			return false
+		}
+		var httpErr models.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr.StatusCode >= 500
+		}
+		var apiErr *models.APIError
+		if errors.As(err, &apiErr) {
+			return serverErrorCode(apiErr.Code)
+		}
+		var urlErr *url.Error
+		return errors.As(err, &urlErr)
+	}
+
+	if resp.StatusCode >= 500 {
+		return true
+	}
+	if status, ok := peekResponseStatus(resp, bodyLimit); ok {
+		return serverErrorCode(strconv.Itoa(status.Code))
+	}
+	return false
+}
+
+// serverErrorCode reports whether an API code is a 5xxxx server error
+func serverErrorCode(code string) bool {
+	return len(code) == 5 && code[0] == '5'
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	var reportCalls, campaignCalls atomic.Int32
+	var reportDown atomic.Bool
+	reportDown.Store(true)
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "/report/") {
+			reportCalls.Add(1)
+			if reportDown.Load() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		} else {
+			campaignCalls.Add(1)
+		}
+		_, _ = w.Write([]byte(`{"code": 0, "message": "success"}`))
+	})
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		AccessToken: "test_token",
+		Timeout:     5 * time.Second,
+		RetryConfig: &RetryConfig{MaxRetries: 1, BackoffStrategy: NewConstantBackoff(time.Millisecond), RetryableStatusCodes: []int{503}},
+		CircuitBreaker: &CircuitBreakerConfig{
+			FailureThreshold: 2,
+			OpenTimeout:      50 * time.Millisecond,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	get := func(endpoint string) error {
+		resp, err := client.DoRequest(context.Background(), "GET", endpoint, nil, nil)
+		if err != nil {
+			return err
+		}
+		return client.ParseResponse(resp, &models.BaseResponse{})
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := get("/report/integrated/get/"); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("request %d: expected a server error, got %v", i, err)
+		}
+	}
+	if err := get("/report/integrated/get/"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected ErrCircuitOpen, got %v", err)
+	}
+	if got := reportCalls.Load(); got != 4 {
+		t.Errorf("Expected the open circuit to send no attempts, got %d report calls", got)
+	}
+	if err := get("/campaign/get/"); err != nil || campaignCalls.Load() != 1 {
+		t.Errorf("Expected other groups to be unaffected, got %v", err)
+	}
+
+	stats := client.Stats()
+	report := stats.CircuitBreakers["report"]
+	if report.State != CircuitOpen || report.Opens != 1 || report.Rejected != 1 || report.OpenedAt == nil {
+		t.Errorf("Unexpected report circuit: %+v", report)
+	}
+	if stats.CircuitBreakers["campaign"].State != CircuitClosed {
+		t.Errorf("Unexpected campaign circuit: %+v", stats.CircuitBreakers["campaign"])
+	}
+
+	rec := httptest.NewRecorder()
+	NewStatsHandler(client).ServeHTTP(rec, httptest.NewRequest("GET", "/stats?format=prometheus", nil))
+	if body := rec.Body.String(); !strings.Contains(body, `tiktok_sdk_circuit_breaker_open{group="report"} 1`) {
+		t.Errorf("Expected circuit breaker metric, got:\n%s", body)
+	}
+
+	// A failed probe opens the circuit again; a successful one closes it
+	time.Sleep(60 * time.Millisecond)
+	if err := get("/report/integrated/get/"); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected the probe to fail, got %v", err)
+	}
+	if err := get("/report/integrated/get/"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected the circuit to reopen, got %v", err)
+	}
+
+	reportDown.Store(false)
+	time.Sleep(60 * time.Millisecond)
+	if err := get("/report/integrated/get/"); err != nil {
+		t.Fatalf("Probe failed: %v", err)
+	}
+	if report := client.Stats().CircuitBreakers["report"]; report.State != CircuitClosed || report.ConsecutiveFailures != 0 || report.Opens != 2 {
+		t.Errorf("Unexpected report circuit after recovery: %+v", report)
+	}
+}
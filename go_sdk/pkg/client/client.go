@@ -1,16 +1,28 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
+	"net"
 	"net/http"
 	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/time/rate"
 )
 
@@ -20,6 +32,16 @@ type Client struct {
 	httpClient  *http.Client
 	rateLimiter *rate.Limiter
 	baseURL     *url.URL
+	stats       clientStats
+	breakers    *circuitBreakers
+
+	// transport is wrapped by middleware, outermost first, then by innermost
+	transport  http.RoundTripper
+	middleware []Middleware
+	innermost  []Middleware
+
+	// tokenStoreLocks serializes refreshes per TokenStore entry
+	tokenStoreLocks sync.Map
 
 	// API services
 	account        AccountService
@@ -36,16 +58,27 @@ type Client struct {
 	catalog        *CatalogService
 	dmp            *DMPService
 	pixel          *PixelService
+	identity       *IdentityService
+	smartPlus      *SmartPlusService
+	travel         *TravelService
+	events         *EventsService
+	app            *AppService
+	leadGen        *LeadGenService
+	blockedWord    *BlockedWordService
+	instantPage    *InstantPageService
+	splitTest      *SplitTestService
 	optimizer      OptimizerService
 	comment        CommentService
 	report         ReportService
 }
 
-// NewClient creates a new TikTok Business API client
+// NewClient creates a new TikTok Business API client. The client keeps a
+// copy of config, which is left unmodified.
 func NewClient(config *Config) (*Client, error) {
 	if config == nil {
 		config = DefaultConfig()
 	}
+	config = config.resolved()
 
 	if err := config.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid config: %w", err)
@@ -74,6 +107,16 @@ func NewClient(config *Config) (*Client, error) {
 		},
 	}
 
+	middleware := slices.Clone(config.Middleware)
+	if config.Debug {
+		middleware = append([]Middleware{LoggingMiddleware(nil)}, middleware...)
+	}
+	var innermost []Middleware
+	if config.Audit != nil {
+		// Innermost, so records match what is sent
+		innermost = append(innermost, AuditMiddleware(*config.Audit))
+	}
+
 	var rateLimiter *rate.Limiter
 	if config.RateLimit != nil {
 		rateLimiter = rate.NewLimiter(
@@ -85,9 +128,14 @@ func NewClient(config *Config) (*Client, error) {
 	client := &Client{
 		config:      config,
 		httpClient:  httpClient,
+		transport:   httpClient.Transport,
+		middleware:  middleware,
+		innermost:   innermost,
 		rateLimiter: rateLimiter,
 		baseURL:     baseURL,
+		breakers:    newCircuitBreakers(config.CircuitBreaker),
 	}
+	client.chainTransport()
 
 	// Initialize API services
 	client.initServices()
@@ -99,49 +147,137 @@ func (c *Client) initServices() {
 	// Initialize services with actual implementations
 	c.account = &accountService{client: c}
 	c.campaign = &campaignService{client: c}
+	c.ad = &adService{client: c}
 	c.tool = &toolService{client: c}
-	c.auth = &authService{client: c}
+	auth := &authService{client: c, cache: newTokenCache(0), config: &AuthConfig{
+		ClientID:     c.config.ClientID,
+		ClientSecret: c.config.ClientSecret,
+		BaseURL:      c.config.BaseURL,
+		Environment:  c.config.Environment,
+		APIVersion:   c.config.APIVersion,
+	}}
+	c.auth = auth
+	c.RegisterCacheStats("token_validation", auth.cache.stats)
 
 	// New expanded services
 	c.businessCenter = NewBusinessCenterService(c)
+	c.bc = c.businessCenter
 	c.catalog = NewCatalogService(c)
 	c.dmp = NewDMPService(c)
 	c.pixel = NewPixelService(c)
+	c.identity = NewIdentityService(c)
+	c.smartPlus = NewSmartPlusService(c)
+	c.travel = NewTravelService(c)
+	c.events = NewEventsService(c)
+	c.app = NewAppService(c)
+	c.leadGen = NewLeadGenService(c)
+	c.blockedWord = NewBlockedWordService(c)
+	c.instantPage = NewInstantPageService(c)
+	c.splitTest = NewSplitTestService(c)
 	c.creative = NewCreativeService(c)
 	c.optimizer = NewOptimizerService(c)
 	c.comment = NewCommentService(c)
 	c.report = NewReportService(c)
 
 	// Services not yet implemented - return clear error messages
-	c.ad = &notImplementedAdService{}
-	c.adGroup = &notImplementedAdGroupService{}
+	c.adGroup = &adGroupService{client: c}
 	c.audience = &notImplementedAudienceService{}
-	c.reporting = &notImplementedReportingService{}
-	c.bc = &notImplementedBCService{}
+	c.reporting = &reportingService{client: c}
 }
 
 // DoRequest performs an HTTP request with rate limiting and retry logic
 func (c *Client) DoRequest(ctx context.Context, method, endpoint string, body io.Reader, headers map[string]string) (*http.Response, error) {
+	start := time.Now()
+
+	// Reject requests to a failing endpoint group before they take rate
+	// limiter tokens or retries
+	var group string
+	if c.breakers != nil {
+		group = c.breakers.config.Group(endpoint)
+		if err := c.breakers.allow(group); err != nil {
+			c.stats.requests.Add(1)
+			c.stats.failures.Add(1)
+			return nil, err
+		}
+	}
+
+	var resp *http.Response
+	var err error
+	if c.config.TracerProvider == nil {
+		resp, err = c.doRequest(ctx, method, endpoint, body, headers)
+	} else {
+		var span trace.Span
+		ctx, span = c.startSpan(ctx, method, endpoint, body)
+		resp, err = c.doRequest(ctx, method, endpoint, body, headers)
+		endSpan(span, resp, err, c.responseBodyLimit(ctx))
+	}
+	if c.breakers != nil {
+		c.breakers.done(group, circuitFailure(ctx, resp, err, c.responseBodyLimit(ctx)))
+	}
+
+	if c.config.Metrics != nil {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		c.config.Metrics.ObserveRequest(method, endpointPath(endpoint), status, time.Since(start))
+	}
+	return resp, err
+}
+
+// doRequest performs the request traced by DoRequest
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, body io.Reader, headers map[string]string) (*http.Response, error) {
+	c.stats.requests.Add(1)
+
 	// Apply rate limiting
 	if c.rateLimiter != nil {
+		waitStart := time.Now()
 		if err := c.rateLimiter.Wait(ctx); err != nil {
+			c.stats.failures.Add(1)
 			return nil, fmt.Errorf("rate limit error: %w", err)
 		}
+		if waited := time.Since(waitStart); waited > time.Millisecond {
+			c.stats.waits.Add(1)
+			c.stats.waitNanos.Add(int64(waited))
+		}
+		if c.config.Metrics != nil {
+			c.config.Metrics.SetRateLimitTokens(c.rateLimiter.Tokens())
+		}
 	}
 
-	// Build full URL
-	fullURL := c.baseURL.ResolveReference(&url.URL{Path: endpoint})
+	// Build full URL; endpoints may be bare paths or URLs from BuildURL
+	// carrying a query string
+	ref, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	fullURL := c.baseURL.ResolveReference(ref)
+	fullURL.Path = apiPath(c.apiVersion(ctx), fullURL.Path)
 
 	// Create request
 	req, err := http.NewRequestWithContext(ctx, method, fullURL.String(), body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if rb, ok := body.(rewindableBody); ok && rb.rewindable() {
+		req.GetBody = rb.rewind
+	}
+	// A body that cannot be rewound can only be sent once
+	replayable := body == nil || req.GetBody != nil
 
 	// Set default headers
 	req.Header.Set("User-Agent", c.config.UserAgent)
-	if c.config.AccessToken != "" {
-		req.Header.Set("Access-Token", c.config.AccessToken)
+	token := c.config.AccessToken
+	source := c.tokenSource(ctx, headers)
+	refreshable := source != nil
+	if refreshable {
+		if token, err = source.Token(ctx); err != nil {
+			c.stats.failures.Add(1)
+			return nil, fmt.Errorf("failed to get access token: %w", err)
+		}
+	}
+	if token != "" {
+		req.Header.Set("Access-Token", token)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
@@ -150,30 +286,113 @@ func (c *Client) DoRequest(ctx context.Context, method, endpoint string, body io
 		req.Header.Set(key, value)
 	}
 
-	// Perform request with retry logic
+	// Perform request with retry logic; without a RetryConfig retries are immediate
 	maxRetries := 3
+	var backoff BackoffStrategy
 	if c.config.RetryConfig != nil {
 		maxRetries = c.config.RetryConfig.MaxRetries
+		backoff = c.config.RetryConfig.backoff()
 	}
+	if ctx.Value(noRetryKey{}) != nil || !replayable {
+		maxRetries = 0
+	}
+
+	bodyLimit := c.responseBodyLimit(ctx)
+	streamed := ctx.Value(streamResponseKey{}) != nil
+	idempotent := !isNonIdempotentRequest(method, fullURL.Path) || (c.config.RetryConfig != nil && c.config.RetryConfig.RetryCreates)
 
 	var lastErr error
+	var delay time.Duration
+	refreshed := false
 	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 && backoff != nil {
+			delay = backoff.Backoff(attempt, delay)
+			if err := sleepContext(ctx, delay); err != nil {
+				c.stats.failures.Add(1)
+				return nil, fmt.Errorf("retry interrupted: %w (last error: %v)", err, lastErr)
+			}
+		}
+
+		// Rewind the request body so retries resend the full payload
+		if (attempt > 0 || refreshed) && req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, fmt.Errorf("failed to reset request body: %w", err)
+			}
+		}
+
+		c.stats.attempts.Add(1)
+		if attempt > 0 {
+			c.stats.retries.Add(1)
+		}
+
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
 			lastErr = err
+			// A request that may have reached the API is only repeated when idempotent
+			if !idempotent && !requestNotSent(err) {
+				c.stats.failures.Add(1)
+				return nil, fmt.Errorf("request failed and is not safe to repeat: %w", err)
+			}
 			continue
 		}
 
-		// Check if we should retry based on status code
-		if c.shouldRetry(resp.StatusCode) && attempt < maxRetries {
+		// Refresh a rejected access token once and resend; this is not a retry
+		if refreshable && replayable && !refreshed && tokenRejected(resp, bodyLimit) {
+			refreshed = true
+			if token, err = source.Refresh(ctx, token); err != nil {
+				c.stats.failures.Add(1)
+				return nil, err
+			}
+			req.Header.Set("Access-Token", token)
+			attempt--
+			continue
+		}
+
+		// Check if we should retry based on status code; a request that is
+		// not idempotent is only retried when it was throttled unprocessed
+		if c.shouldRetry(resp.StatusCode) && attempt < maxRetries && (idempotent || resp.StatusCode == http.StatusTooManyRequests) {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, int64(c.maxErrorBodyBytes())+1))
 			_ = resp.Body.Close()
-			lastErr = fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
+			lastErr = c.newHTTPError(resp, body)
 			continue
 		}
 
+		// Re-issue requests whose JSON body was truncated in transit; a
+		// request that is not idempotent has already taken effect and is not
+		// repeated
+		if resp.StatusCode < 300 {
+			if err := verifyJSONBody(resp, bodyLimit, streamed); err != nil {
+				var tooLarge models.ResponseTooLargeError
+				if errors.As(err, &tooLarge) || !idempotent {
+					c.stats.failures.Add(1)
+					return nil, err
+				}
+				lastErr = err
+				continue
+			}
+		}
+
+		// Retry transient API response codes; the last attempt's response is
+		// returned for the caller to parse
+		if attempt < maxRetries {
+			if status, ok := peekResponseStatus(resp, bodyLimit); ok && c.shouldRetryCode(status.Code, idempotent) {
+				_ = resp.Body.Close()
+				code := strconv.Itoa(status.Code)
+				lastErr = &models.APIError{
+					Code:            code,
+					Message:         status.Message,
+					RequestID:       status.RequestID,
+					HTTPStatusCode:  resp.StatusCode,
+					SuggestedAction: models.SuggestedActionFor(code, resp.StatusCode),
+				}
+				continue
+			}
+		}
+
 		return resp, nil
 	}
 
+	c.stats.failures.Add(1)
 	return nil, fmt.Errorf("request failed after %d attempts: %w", maxRetries+1, lastErr)
 }
 
@@ -181,41 +400,369 @@ func (c *Client) DoRequest(ctx context.Context, method, endpoint string, body io
 func (c *Client) ParseResponse(resp *http.Response, v interface{}) error {
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
-
 	// Check for API errors
 	if resp.StatusCode >= 400 {
+		limit := c.maxErrorBodyBytes()
+		if limit < maxAPIErrorBodyBytes {
+			limit = maxAPIErrorBodyBytes
+		}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, int64(limit)+1))
+		if err != nil {
+			return fmt.Errorf("failed to read error response body: %w", err)
+		}
+
 		var apiErr models.APIError
 		if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Code != "" {
+			apiErr.HTTPStatusCode = resp.StatusCode
+			apiErr.SuggestedAction = models.SuggestedActionFor(apiErr.Code, resp.StatusCode)
 			return &apiErr
 		}
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		return c.newHTTPError(resp, body)
+	}
+
+	limit := c.maxResponseBytes()
+	body, fits, err := bufferBody(resp, limit)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if !fits {
+		return models.NewResponseTooLargeError(resp.StatusCode, limit, resp.ContentLength)
 	}
 
 	// Parse successful response
 	if err := json.Unmarshal(body, v); err != nil {
+		if !json.Valid(body) {
+			return models.NewTruncatedResponseError(resp.StatusCode, len(body), resp.ContentLength, err)
+		}
 		return fmt.Errorf("failed to parse response: %w", err)
 	}
 
 	return nil
 }
 
-// BuildURL builds a URL with query parameters
-func (c *Client) BuildURL(endpoint string, params map[string]interface{}) string {
+// maxAPIErrorBodyBytes bounds how much of an error body is read when looking
+// for a structured API error
+const maxAPIErrorBodyBytes = 64 << 10
+
+// maxErrorBodyBytes returns the configured error body excerpt size
+func (c *Client) maxErrorBodyBytes() int {
+	switch {
+	case c.config.MaxErrorBodyBytes < 0:
+		return 0
+	case c.config.MaxErrorBodyBytes == 0:
+		return DefaultMaxErrorBodyBytes
+	default:
+		return c.config.MaxErrorBodyBytes
+	}
+}
+
+// maxResponseBytes returns the configured response body cap, negative when
+// bodies are not capped
+func (c *Client) maxResponseBytes() int64 {
+	switch {
+	case c.config == nil:
+		return DefaultMaxResponseBytes
+	case c.config.MaxResponseBytes < 0:
+		return -1
+	case c.config.MaxResponseBytes == 0:
+		return DefaultMaxResponseBytes
+	default:
+		return c.config.MaxResponseBytes
+	}
+}
+
+// streamThresholdBytes returns the configured download streaming threshold
+func (c *Client) streamThresholdBytes() int64 {
+	if c.config.StreamThresholdBytes == 0 {
+		return DefaultStreamThresholdBytes
+	}
+	return c.config.StreamThresholdBytes
+}
+
+// streamResponseKey marks download requests whose large bodies are streamed
+type streamResponseKey struct{}
+
+// responseBodyLimit returns how much of a JSON response body a request reads
+// into memory: download requests stream bodies over the threshold instead
+func (c *Client) responseBodyLimit(ctx context.Context) int64 {
+	if ctx.Value(streamResponseKey{}) != nil {
+		return c.streamThresholdBytes()
+	}
+	return c.maxResponseBytes()
+}
+
+// bufferBody reads a response body of at most limit bytes into memory,
+// leaving it readable, and reports whether it fit. A longer body is left
+// streaming from the start, and not read again by later calls; a negative
+// limit reads any body.
+func bufferBody(resp *http.Response, limit int64) ([]byte, bool, error) {
+	if _, streaming := resp.Body.(readCloser); streaming {
+		return nil, false, nil
+	}
+	if limit >= 0 && resp.ContentLength > limit {
+		return nil, false, nil
+	}
+
+	reader := io.Reader(resp.Body)
+	if limit >= 0 {
+		reader = io.LimitReader(resp.Body, limit+1)
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		_ = resp.Body.Close()
+		return body, false, err
+	}
+	if limit >= 0 && int64(len(body)) > limit {
+		resp.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), resp.Body), Closer: resp.Body}
+		return nil, false, nil
+	}
+
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return body, true, nil
+}
+
+// readCloser joins a reader with the closer of the body it reads from
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// newHTTPError builds an HTTPError holding an excerpt of the response body
+func (c *Client) newHTTPError(resp *http.Response, body []byte) models.HTTPError {
+	contentType := resp.Header.Get("Content-Type")
+	excerpt, truncated := errorBodyExcerpt(contentType, body, c.maxErrorBodyBytes())
+	return models.NewHTTPError(resp.StatusCode, resp.Status, contentType, excerpt, truncated)
+}
+
+// errorBodyExcerpt renders at most limit bytes of an error body. JSON is
+// compacted, text is kept as is and binary content is only summarized.
+func errorBodyExcerpt(contentType string, body []byte, limit int) (string, bool) {
+	if limit <= 0 || len(body) == 0 {
+		return "", false
+	}
+
+	truncated := len(body) > limit
+	if truncated {
+		body = body[:limit]
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case strings.Contains(mediaType, "json"):
+		var compact bytes.Buffer
+		if !truncated && json.Compact(&compact, body) == nil {
+			return compact.String(), false
+		}
+	case strings.HasPrefix(mediaType, "text/"), strings.Contains(mediaType, "xml"):
+	case mediaType == "" && utf8.Valid(body):
+	default:
+		if mediaType == "" {
+			mediaType = "binary data"
+		}
+		return fmt.Sprintf("<%d bytes of %s>", len(body), mediaType), truncated
+	}
+
+	return strings.ToValidUTF8(strings.TrimSpace(string(body)), ""), truncated
+}
+
+// verifyJSONBody buffers a JSON response body and reports a
+// TruncatedResponseError when the body is incomplete or malformed. A body
+// over limit is left streaming when stream is set, and otherwise reported as
+// a ResponseTooLargeError.
+func verifyJSONBody(resp *http.Response, limit int64, stream bool) error {
+	if !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return nil
+	}
+
+	body, fits, err := bufferBody(resp, limit)
+	switch {
+	case err != nil:
+		return models.NewTruncatedResponseError(resp.StatusCode, len(body), resp.ContentLength, err)
+	case !fits && stream:
+		return nil
+	case !fits:
+		_ = resp.Body.Close()
+		return models.NewResponseTooLargeError(resp.StatusCode, limit, resp.ContentLength)
+	case !json.Valid(body):
+		return models.NewTruncatedResponseError(resp.StatusCode, len(body), resp.ContentLength, nil)
+	}
+	return nil
+}
+
+// responseStatus is the status envelope of an API response body
+type responseStatus struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+}
+
+// peekResponseStatus decodes the status envelope of a successful JSON
+// response of at most limit bytes, leaving the body readable
+func peekResponseStatus(resp *http.Response, limit int64) (responseStatus, bool) {
+	var status responseStatus
+	if resp.StatusCode >= 300 || !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return status, false
+	}
+
+	body, fits, err := bufferBody(resp, limit)
+	if err != nil {
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return status, false
+	}
+	if !fits || json.Unmarshal(body, &status) != nil {
+		return status, false
+	}
+	return status, true
+}
+
+// MaxQueryValueLength is the longest query parameter value BuildURL accepts
+const MaxQueryValueLength = 16 * 1024
+
+// BuildURL builds a URL with query parameters. Strings and numbers are sent
+// as they are, slices, maps and structs as JSON, and fmt.Stringer values via
+// String. Nil values, values containing control characters or invalid UTF-8,
+// and values longer than MaxQueryValueLength are rejected with a
+// ValidationError naming the parameter.
+func (c *Client) BuildURL(endpoint string, params map[string]interface{}) (string, error) {
 	u := c.baseURL.ResolveReference(&url.URL{Path: endpoint})
 
 	if len(params) > 0 {
 		q := u.Query()
 		for key, value := range params {
-			q.Set(key, fmt.Sprintf("%v", value))
+			if key == "" {
+				return "", models.NewValidationError("params", "query parameter name is empty")
+			}
+			formatted, err := formatQueryValue(key, value)
+			if err != nil {
+				return "", err
+			}
+			q.Set(key, formatted)
 		}
 		u.RawQuery = q.Encode()
 	}
 
-	return u.String()
+	return u.String(), nil
+}
+
+// formatQueryValue renders a query parameter value and checks that it can
+// be sent as is
+func formatQueryValue(key string, value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			break
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() || ((v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil()) {
+		return "", models.NewValidationError(key, fmt.Sprintf("%s is nil", key))
+	}
+
+	var formatted string
+	switch x := v.Interface().(type) {
+	case string:
+		formatted = x
+	case fmt.Stringer:
+		formatted = x.String()
+	default:
+		switch v.Kind() {
+		case reflect.Bool:
+			formatted = strconv.FormatBool(v.Bool())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			formatted = strconv.FormatInt(v.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			formatted = strconv.FormatUint(v.Uint(), 10)
+		case reflect.Float32, reflect.Float64:
+			// Plain decimal notation; %v switches to exponents for large values
+			formatted = strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())
+		case reflect.String:
+			formatted = v.String()
+		case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+			if v.Kind() == reflect.Slice && v.IsNil() {
+				return "", models.NewValidationError(key, fmt.Sprintf("%s is nil", key))
+			}
+			data, err := json.Marshal(v.Interface())
+			if err != nil {
+				return "", models.NewValidationError(key, fmt.Sprintf("%s cannot be encoded: %v", key, err))
+			}
+			formatted = string(data)
+		default:
+			return "", models.NewValidationError(key, fmt.Sprintf("%s has unsupported type %s", key, v.Type()))
+		}
+	}
+
+	if len(formatted) > MaxQueryValueLength {
+		return "", models.NewValidationError(key, fmt.Sprintf("%s is %d bytes, longer than the %d byte limit", key, len(formatted), MaxQueryValueLength))
+	}
+	if !utf8.ValidString(formatted) {
+		return "", models.NewValidationError(key, fmt.Sprintf("%s is not valid UTF-8", key))
+	}
+	for _, r := range formatted {
+		if unicode.IsControl(r) {
+			return "", models.NewValidationError(key, fmt.Sprintf("%s contains control character %U", key, r))
+		}
+	}
+	return formatted, nil
+}
+
+// shouldRetryCode determines if a request should be retried based on the API
+// response code. Requests that are not idempotent are only retried when they
+// were rejected unprocessed.
+func (c *Client) shouldRetryCode(code int, idempotent bool) bool {
+	retryableCodes := DefaultRetryableAPICodes
+	if c.config.RetryConfig != nil && c.config.RetryConfig.RetryableAPICodes != nil {
+		retryableCodes = c.config.RetryConfig.RetryableAPICodes
+	}
+
+	if !slices.Contains(retryableCodes, code) {
+		return false
+	}
+	return idempotent || slices.Contains(RejectedAPICodes, code)
+}
+
+// nonIdempotentActions are the final path segments of POST endpoints that
+// repeating would apply twice: creating or copying entities, moving funds or
+// assets, uploading files, posting comments, inviting members and tracking
+// events
+var nonIdempotentActions = map[string]bool{
+	"add":      true,
+	"apply":    true,
+	"copy":     true,
+	"create":   true,
+	"edit":     true,
+	"invite":   true,
+	"post":     true,
+	"share":    true,
+	"track":    true,
+	"transfer": true,
+	"upload":   true,
+}
+
+// isNonIdempotentRequest reports whether repeating a request after it took
+// effect would apply it twice, e.g. create a duplicate or move funds again
+func isNonIdempotentRequest(method, path string) bool {
+	if method != http.MethodPost {
+		return false
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	return nonIdempotentActions[segments[len(segments)-1]]
+}
+
+// requestNotSent reports whether a transport error happened before the
+// request was sent, such as a refused connection, so it is safe to repeat
+func requestNotSent(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// noRetryKey marks requests that must not be retried
+type noRetryKey struct{}
+
+// WithoutRetry returns a context whose requests are attempted only once, for
+// calls that are not safe to repeat
+func WithoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
 }
 
 // shouldRetry determines if a request should be retried based on status code
@@ -293,6 +840,51 @@ func (c *Client) Pixel() *PixelService {
 	return c.pixel
 }
 
+// Identity returns the Identity API service
+func (c *Client) Identity() *IdentityService {
+	return c.identity
+}
+
+// SmartPlus returns the Smart+ campaign API service
+func (c *Client) SmartPlus() *SmartPlusService {
+	return c.smartPlus
+}
+
+// Travel returns the travel intent signal API service
+func (c *Client) Travel() *TravelService {
+	return c.travel
+}
+
+// Events returns the pixel web events API service
+func (c *Client) Events() *EventsService {
+	return c.events
+}
+
+// App returns the app management and app events API service
+func (c *Client) App() *AppService {
+	return c.app
+}
+
+// LeadGen returns the instant form and lead download API service
+func (c *Client) LeadGen() *LeadGenService {
+	return c.leadGen
+}
+
+// BlockedWord returns the comment blocked word API service
+func (c *Client) BlockedWord() *BlockedWordService {
+	return c.blockedWord
+}
+
+// InstantPage returns the instant page API service
+func (c *Client) InstantPage() *InstantPageService {
+	return c.instantPage
+}
+
+// SplitTest returns the split test (A/B experiment) API service
+func (c *Client) SplitTest() *SplitTestService {
+	return c.splitTest
+}
+
 // Optimizer returns the Optimizer API service
 func (c *Client) Optimizer() OptimizerService {
 	return c.optimizer
@@ -329,6 +921,16 @@ func (c *Client) SetTimeout(timeout time.Duration) {
 	c.httpClient.Timeout = timeout
 }
 
+// LocalizeError renders an error returned by the SDK in Config.Language, for
+// showing to operators who do not read English
+func (c *Client) LocalizeError(err error) string {
+	translator := c.config.Translator
+	if translator == nil {
+		translator = models.DefaultTranslator()
+	}
+	return models.LocalizeError(err, c.config.Language, translator)
+}
+
 // BuildQueryParams builds query parameters from a map
 func (c *Client) BuildQueryParams(params map[string]interface{}) string {
 	if len(params) == 0 {
@@ -2,10 +2,19 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
 )
 
 func TestNewClient(t *testing.T) {
@@ -105,6 +114,84 @@ func TestClient_DoRequest(t *testing.T) {
 	}
 }
 
+func TestClient_DoRequestRetriesTruncatedJSON(t *testing.T) {
+	calls := 0
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			_, _ = w.Write([]byte(`{"code": 0, "message": "succ`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code": 0, "message": "success", "request_id": "test123"}`))
+	})
+
+	client := newTestClient(t, server)
+
+	resp, err := client.DoRequest(context.Background(), "POST", "/test", strings.NewReader(`{"a":1}`), nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+
+	var out models.BaseResponse
+	if err := client.ParseResponse(resp, &out); err != nil {
+		t.Fatalf("ParseResponse failed: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+	if out.RequestID != "test123" {
+		t.Errorf("Expected request_id test123, got %s", out.RequestID)
+	}
+}
+
+func TestClient_ParseResponseTruncatedBody(t *testing.T) {
+	client := &Client{}
+	resp := &http.Response{
+		StatusCode:    http.StatusOK,
+		ContentLength: 64,
+		Body:          io.NopCloser(strings.NewReader(`{"code": 0, "mess`)),
+	}
+
+	var out models.BaseResponse
+	err := client.ParseResponse(resp, &out)
+
+	var truncated models.TruncatedResponseError
+	if !errors.As(err, &truncated) {
+		t.Fatalf("Expected TruncatedResponseError, got %v", err)
+	}
+	if truncated.BytesRead != 17 || truncated.ContentLength != 64 {
+		t.Errorf("Unexpected diagnostics: read %d of %d", truncated.BytesRead, truncated.ContentLength)
+	}
+	if !truncated.IsRetryable() {
+		t.Error("Truncated responses should be retryable")
+	}
+}
+
+func TestClient_ErrorBodyCapture(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("<html>blocked by edge firewall rule 1234</html>"))
+	})
+
+	client := newTestClient(t, server, func(c *Config) { c.MaxErrorBodyBytes = 16 })
+
+	resp, err := client.DoRequest(context.Background(), "GET", "/test", nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+
+	err = client.ParseResponse(resp, &struct{}{})
+	var httpErr models.HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("Expected models.HTTPError, got %T: %v", err, err)
+	}
+	if httpErr.StatusCode != http.StatusForbidden || httpErr.Body != "<html>blocked by" || !httpErr.Truncated {
+		t.Errorf("Unexpected HTTPError: %+v", httpErr)
+	}
+}
+
 func TestClient_BuildQueryParams(t *testing.T) {
 	client := &Client{}
 
@@ -211,6 +298,16 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "unknown environment",
+			config: &Config{
+				Environment: "staging",
+				BaseURL:     "https://api.example.com",
+				AccessToken: "token",
+				Timeout:     30 * time.Second,
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -223,9 +320,277 @@ func TestConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestClient_RetryTransientAPICodes(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if calls.Add(1) == 1 {
+			w.Write([]byte(`{"code":40100,"message":"Requests made too frequently"}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"message":"OK"}`))
+	})
+
+	client := newTestClient(t, server, func(c *Config) {
+		c.RetryConfig = &RetryConfig{MaxRetries: 2, BackoffStrategy: NewConstantBackoff(time.Millisecond)}
+	})
+
+	var result models.BaseResponse
+	resp, err := client.DoRequest(context.Background(), "GET", "/test", nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+	if err := client.ParseResponse(resp, &result); err != nil || result.Code != 0 || calls.Load() != 2 {
+		t.Errorf("Expected success after one retry, got code %d after %d calls (err %v)", result.Code, calls.Load(), err)
+	}
+
+	// Requests marked WithoutRetry return the first response
+	calls.Store(0)
+	resp, err = client.DoRequest(WithoutRetry(context.Background()), "POST", "/test", strings.NewReader("{}"), nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+	if err := client.ParseResponse(resp, &result); err != nil || result.Code != 40100 || calls.Load() != 1 {
+		t.Errorf("Expected code 40100 without retry, got code %d after %d calls (err %v)", result.Code, calls.Load(), err)
+	}
+}
+
+func TestClient_RetryCreates(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		switch r.URL.Query().Get("reply") {
+		case "truncated":
+			writeJSON(w, `{"code":0,"data":{"campaign_id":"c`)
+		case "throttled":
+			writeJSON(w, `{"code":40100,"message":"Requests made too frequently"}`)
+		case "unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "too_many":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "reset":
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+		default:
+			writeJSON(w, `{"code":50000,"message":"Internal error"}`)
+		}
+	})
+	retry := &RetryConfig{MaxRetries: 2, BackoffStrategy: NewConstantBackoff(time.Millisecond)}
+	client := newTestClient(t, server, func(c *Config) { c.RetryConfig = retry })
+
+	tests := []struct {
+		name      string
+		method    string
+		endpoint  string
+		wantCalls int32
+		wantErr   bool
+	}{
+		{"create internal error", "POST", "/campaign/create/", 1, false},
+		{"create truncated", "POST", "/campaign/create/?reply=truncated", 1, true},
+		{"create throttled", "POST", "/campaign/create/?reply=throttled", 3, false},
+		{"create unavailable", "POST", "/campaign/create/?reply=unavailable", 1, false},
+		{"create throttled status", "POST", "/campaign/create/?reply=too_many", 3, false},
+		{"create connection reset", "POST", "/campaign/create/?reply=reset", 1, true},
+		{"fund transfer internal error", "POST", "/bc/transfer/", 1, false},
+		{"fund transfer unavailable", "POST", "/bc/transfer/?reply=unavailable", 1, false},
+		{"campaign copy internal error", "POST", "/campaign/copy/", 1, false},
+		{"ad group copy unavailable", "POST", "/adgroup/copy/?reply=unavailable", 1, false},
+		{"video upload internal error", "POST", "/file/video/ad/upload/", 1, false},
+		{"comment post connection reset", "POST", "/comment/post/?reply=reset", 1, true},
+		{"update internal error", "POST", "/campaign/update/", 3, false},
+		{"update unavailable", "POST", "/campaign/update/?reply=unavailable", 3, false},
+		{"update connection reset", "POST", "/campaign/update/?reply=reset", 3, true},
+		{"get truncated", "GET", "/campaign/create/?reply=truncated", 3, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls.Store(0)
+			resp, err := client.DoRequest(context.Background(), tt.method, tt.endpoint, strings.NewReader("{}"), nil)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if calls.Load() != tt.wantCalls || (err != nil) != tt.wantErr {
+				t.Errorf("DoRequest() made %d calls, error = %v; want %d calls", calls.Load(), err, tt.wantCalls)
+			}
+		})
+	}
+
+	// RetryCreates opts creates back into retries
+	retry.RetryCreates = true
+	calls.Store(0)
+	if _, err := client.DoRequest(context.Background(), "POST", "/campaign/create/?reply=truncated", strings.NewReader("{}"), nil); err == nil || calls.Load() != 3 {
+		t.Errorf("DoRequest() made %d calls, error = %v; want 3 calls", calls.Load(), err)
+	}
+	calls.Store(0)
+	resp, err := client.DoRequest(context.Background(), "POST", "/bc/transfer/?reply=unavailable", strings.NewReader("{}"), nil)
+	if err != nil || calls.Load() != 3 {
+		t.Fatalf("DoRequest() made %d calls, error = %v; want 3 calls", calls.Load(), err)
+	}
+	resp.Body.Close()
+
+	// A create that never reached the API is retried
+	var dialed atomic.Int32
+	unreachable := newTestClient(t, server, func(c *Config) {
+		c.RetryConfig = &RetryConfig{MaxRetries: 2, BackoffStrategy: NewConstantBackoff(time.Millisecond)}
+		c.Middleware = []Middleware{func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				dialed.Add(1)
+				return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+			})
+		}}
+	})
+	if _, err := unreachable.DoRequest(context.Background(), "POST", "/campaign/create/", strings.NewReader("{}"), nil); err == nil || dialed.Load() != 3 {
+		t.Errorf("DoRequest() dialed %d times, error = %v; want 3 dials", dialed.Load(), err)
+	}
+}
+
 // Helper function to check if a string contains a substring
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr ||
 		(len(s) > len(substr) && (s[:len(substr)] == substr || s[len(s)-len(substr):] == substr)) ||
 		(len(s) > len(substr)+1 && s[1:len(substr)+1] == substr))
 }
+
+func TestClient_LocalizeError(t *testing.T) {
+	client, err := NewClient(&Config{BaseURL: "https://example.com", AccessToken: "test_token", Language: models.LanguageJapanese, Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	validation := (&CampaignStatusUpdateRequest{AdvertiserID: "123"}).Validate()
+	if got := client.LocalizeError(validation); got != "フィールド「campaign_ids」の検証エラー: campaign_ids は必須です" {
+		t.Errorf("validation error = %q", got)
+	}
+
+	apiErr := fmt.Errorf("failed to get campaigns: %w", models.NewAPIError("40105", "Access token is invalid", "req1", 200))
+	if got := client.LocalizeError(apiErr); got != "TikTok API エラー [40105]: アクセストークンが無効です (Access token is invalid) (request_id: req1)" {
+		t.Errorf("API error = %q", got)
+	}
+
+	client.config.Language = "zh-CN"
+	if got := client.LocalizeError(models.NewValidationError("page_size", "page size cannot exceed 1000")); got != "字段“page_size”校验失败：每页数量不能超过 1000" {
+		t.Errorf("zh-CN validation error = %q", got)
+	}
+
+	catalog := models.DefaultMessageCatalog()
+	catalog.Add("de", "%s is required", "%s ist erforderlich")
+	catalog.Add("de", "validation error for field '%s': %s", "Validierungsfehler im Feld '%s': %s")
+	client.config.Language = "de-AT"
+	client.config.Translator = catalog
+	if got := client.LocalizeError(models.NewValidationError("name", "name is required")); got != "Validierungsfehler im Feld 'name': name ist erforderlich" {
+		t.Errorf("custom catalog error = %q", got)
+	}
+
+	client.config.Language = ""
+	if got := client.LocalizeError(validation); got != validation.Error() {
+		t.Errorf("English error = %q", got)
+	}
+}
+
+func TestClient_BuildURL(t *testing.T) {
+	client, err := NewClient(&Config{BaseURL: "https://example.com", AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	got, err := client.BuildURL("/campaign/get/", map[string]interface{}{
+		"advertiser_id": "123",
+		"page":          2,
+		"budget":        1500000.5,
+		"ids":           []string{"a", "b"},
+		"filtering":     map[string]string{"name": "a&b"},
+		"enabled":       true,
+	})
+	if err != nil {
+		t.Fatalf("BuildURL() error = %v", err)
+	}
+	query, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("BuildURL() built an unparsable URL %q", got)
+	}
+	want := map[string]string{
+		"advertiser_id": "123",
+		"page":          "2",
+		"budget":        "1500000.5",
+		"ids":           `["a","b"]`,
+		"filtering":     `{"name":"a\u0026b"}`,
+		"enabled":       "true",
+	}
+	for key, value := range want {
+		if q := query.Query().Get(key); q != value {
+			t.Errorf("%s = %q, want %q", key, q, value)
+		}
+	}
+
+	var nilPointer *string
+	invalid := map[string]map[string]interface{}{
+		"nil":           {"advertiser_id": nil},
+		"nil pointer":   {"advertiser_id": nilPointer},
+		"nil slice":     {"ids": []string(nil)},
+		"control":       {"advertiser_id": "123\n456"},
+		"invalid utf-8": {"advertiser_id": "\xff"},
+		"too long":      {"filtering": strings.Repeat("x", MaxQueryValueLength+1)},
+		"empty name":    {"": "x"},
+		"unsupported":   {"callback": func() {}},
+	}
+	for name, params := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.BuildURL("/campaign/get/", params)
+			var validationErr models.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Errorf("BuildURL() error = %v, want a ValidationError", err)
+			}
+		})
+	}
+}
+
+func TestClient_MaxResponseBytes(t *testing.T) {
+	var requests atomic.Int32
+	report := `[` + strings.Repeat(`{"stat_time_day":"2024-01-01","spend":"1.00"},`, 20) + `{}]`
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/campaign/get/":
+			w.Write([]byte(`{"code":0,"data":[` + strings.Repeat(`{"campaign_id":"c1"},`, 20) + `{}]}`))
+		case "/open_api/v1.3/adgroup/get/":
+			// Chunked, so the size is only known once read
+			w.Write([]byte(`{"code":0,"data":[`))
+			w.(http.Flusher).Flush()
+			w.Write([]byte(strings.Repeat(`{"adgroup_id":"g1"},`, 20) + `{}]}`))
+		case "/open_api/v1.3/report/task/download/":
+			w.Write([]byte(report))
+		}
+	})
+
+	client := newTestClient(t, server, func(c *Config) {
+		c.RetryConfig = &RetryConfig{MaxRetries: 2, BackoffStrategy: NewConstantBackoff(time.Millisecond)}
+		c.MaxResponseBytes = 200
+		c.StreamThresholdBytes = 100
+	})
+
+	var tooLarge models.ResponseTooLargeError
+	_, err := client.Campaign().Get(context.Background(), &CampaignGetRequest{AdvertiserID: "123"})
+	if !errors.As(err, &tooLarge) || tooLarge.Limit != 200 || tooLarge.ContentLength <= 200 {
+		t.Errorf("Campaign().Get() error = %v, want ResponseTooLargeError with content length", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("oversized response was requested %d times, want 1", n)
+	}
+
+	_, err = client.AdGroup().Get(context.Background(), &AdGroupGetRequest{AdvertiserID: "123"})
+	if !errors.As(err, &tooLarge) {
+		t.Errorf("AdGroup().Get() error = %v, want ResponseTooLargeError", err)
+	}
+
+	// Download endpoints stream oversized JSON as the file
+	body, err := client.Reporting().DownloadAsyncReport(context.Background(), &AsyncReportStatusRequest{AdvertiserID: "123", TaskID: "t1"})
+	if err != nil {
+		t.Fatalf("DownloadAsyncReport() error = %v", err)
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil || string(data) != report {
+		t.Errorf("downloaded %d bytes (%v), want the %d byte report", len(data), err, len(report))
+	}
+}
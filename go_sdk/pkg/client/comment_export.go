@@ -0,0 +1,302 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// CommentSink receives batches of new comments exported from an advertiser
+type CommentSink interface {
+	Deliver(ctx context.Context, advertiserID string, comments []CommentInfo) error
+}
+
+// CommentSinkFunc adapts a function to the CommentSink interface
+type CommentSinkFunc func(ctx context.Context, advertiserID string, comments []CommentInfo) error
+
+// Deliver calls f(ctx, advertiserID, comments)
+func (f CommentSinkFunc) Deliver(ctx context.Context, advertiserID string, comments []CommentInfo) error {
+	return f(ctx, advertiserID, comments)
+}
+
+// CommentMessageWriter is a queue producer such as a Kafka writer wrapper
+type CommentMessageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...[]byte) error
+}
+
+// CommentEnvelope is the payload delivered to webhook and queue sinks
+type CommentEnvelope struct {
+	AdvertiserID string        `json:"advertiser_id"`
+	Comments     []CommentInfo `json:"comments"`
+}
+
+// NewWebhookCommentSink creates a sink that POSTs each batch as JSON to a URL
+func NewWebhookCommentSink(url string, httpClient *http.Client) CommentSink {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return CommentSinkFunc(func(ctx context.Context, advertiserID string, comments []CommentInfo) error {
+		body, err := json.Marshal(CommentEnvelope{AdvertiserID: advertiserID, Comments: comments})
+		if err != nil {
+			return fmt.Errorf("failed to marshal comments: %w", err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to create webhook request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to deliver comments to webhook: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= 300 {
+			return fmt.Errorf("webhook returned HTTP %d", resp.StatusCode)
+		}
+		return nil
+	})
+}
+
+// NewChannelCommentSink creates a sink that sends each comment on a channel
+func NewChannelCommentSink(ch chan<- CommentInfo) CommentSink {
+	return CommentSinkFunc(func(ctx context.Context, advertiserID string, comments []CommentInfo) error {
+		for _, comment := range comments {
+			select {
+			case ch <- comment:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
+
+// NewQueueCommentSink creates a sink that writes one JSON message per comment
+func NewQueueCommentSink(writer CommentMessageWriter) CommentSink {
+	return CommentSinkFunc(func(ctx context.Context, advertiserID string, comments []CommentInfo) error {
+		msgs := make([][]byte, 0, len(comments))
+		for _, comment := range comments {
+			msg, err := json.Marshal(CommentEnvelope{AdvertiserID: advertiserID, Comments: []CommentInfo{comment}})
+			if err != nil {
+				return fmt.Errorf("failed to marshal comment: %w", err)
+			}
+			msgs = append(msgs, msg)
+		}
+		return writer.WriteMessages(ctx, msgs...)
+	})
+}
+
+// CommentCheckpoint records the newest comments already delivered for an advertiser
+type CommentCheckpoint struct {
+	// LastCreateTime is the create_time of the newest delivered comment
+	LastCreateTime models.Time `json:"last_create_time"`
+
+	// DeliveredIDs holds the comment IDs delivered at LastCreateTime, so
+	// comments sharing that timestamp are not re-delivered
+	DeliveredIDs []string `json:"delivered_ids,omitempty"`
+}
+
+// CommentCheckpointStore persists export checkpoints across restarts
+type CommentCheckpointStore interface {
+	Load(ctx context.Context, advertiserID string) (*CommentCheckpoint, error)
+	Save(ctx context.Context, advertiserID string, checkpoint *CommentCheckpoint) error
+}
+
+// NewFileCommentCheckpointStore creates a checkpoint store backed by a JSON
+// file. It is a CommentCheckpointStore over NewFileStore.
+func NewFileCommentCheckpointStore(path string) CommentCheckpointStore {
+	return NewCommentCheckpointStore(NewFileStore(path))
+}
+
+// memoryCommentCheckpointStore keeps checkpoints in process memory
+type memoryCommentCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]*CommentCheckpoint
+}
+
+// NewMemoryCommentCheckpointStore creates an in-memory checkpoint store
+func NewMemoryCommentCheckpointStore() CommentCheckpointStore {
+	return &memoryCommentCheckpointStore{checkpoints: make(map[string]*CommentCheckpoint)}
+}
+
+// Load returns the checkpoint for an advertiser, or an empty checkpoint
+func (m *memoryCommentCheckpointStore) Load(ctx context.Context, advertiserID string) (*CommentCheckpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if cp, ok := m.checkpoints[advertiserID]; ok {
+		copied := *cp
+		return &copied, nil
+	}
+	return &CommentCheckpoint{}, nil
+}
+
+// Save records the checkpoint for an advertiser
+func (m *memoryCommentCheckpointStore) Save(ctx context.Context, advertiserID string, checkpoint *CommentCheckpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	copied := *checkpoint
+	m.checkpoints[advertiserID] = &copied
+	return nil
+}
+
+// CommentExporterConfig configures a CommentExporter
+type CommentExporterConfig struct {
+	AdvertiserIDs []string
+	Sink          CommentSink
+
+	// Checkpoints defaults to an in-memory store; use a persistent store
+	// such as NewFileCommentCheckpointStore or NewCommentCheckpointStore to
+	// survive restarts
+	Checkpoints CommentCheckpointStore
+
+	// PollInterval is the delay between export rounds (defaults to 1 minute)
+	PollInterval time.Duration
+
+	// PageSize is the number of comments fetched per page (defaults to 100)
+	PageSize int
+}
+
+// CommentExporter continuously pages new comments and pushes them to a sink
+type CommentExporter struct {
+	client *Client
+	config CommentExporterConfig
+}
+
+// NewCommentExporter creates a new CommentExporter
+func NewCommentExporter(client *Client, config CommentExporterConfig) (*CommentExporter, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+	if len(config.AdvertiserIDs) == 0 {
+		return nil, fmt.Errorf("at least one advertiser_id is required")
+	}
+	if config.Sink == nil {
+		return nil, fmt.Errorf("sink is required")
+	}
+	if config.Checkpoints == nil {
+		config.Checkpoints = NewMemoryCommentCheckpointStore()
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = time.Minute
+	}
+	if config.PageSize <= 0 {
+		config.PageSize = 100
+	}
+
+	return &CommentExporter{client: client, config: config}, nil
+}
+
+// Run exports new comments every PollInterval until the context is cancelled
+func (e *CommentExporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce performs a single export round across all configured advertisers
+func (e *CommentExporter) RunOnce(ctx context.Context) error {
+	for _, advertiserID := range e.config.AdvertiserIDs {
+		if err := e.exportAdvertiser(ctx, advertiserID); err != nil {
+			return fmt.Errorf("failed to export comments for advertiser %s: %w", advertiserID, err)
+		}
+	}
+	return nil
+}
+
+// exportAdvertiser delivers comments newer than the advertiser's checkpoint
+func (e *CommentExporter) exportAdvertiser(ctx context.Context, advertiserID string) error {
+	checkpoint, err := e.config.Checkpoints.Load(ctx, advertiserID)
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+
+	delivered := make(map[string]bool, len(checkpoint.DeliveredIDs))
+	for _, id := range checkpoint.DeliveredIDs {
+		delivered[id] = true
+	}
+
+	req := &CommentListRequest{AdvertiserID: advertiserID, Size: e.config.PageSize}
+	if !checkpoint.LastCreateTime.IsZero() {
+		req.StartDate = checkpoint.LastCreateTime.UTC().Format("2006-01-02")
+	}
+
+	var fresh []CommentInfo
+	for page := 1; ; page++ {
+		req.Page = page
+		resp, err := e.client.Comment().ListComments(ctx, req)
+		if err != nil {
+			return err
+		}
+		if resp.Code != 0 {
+			return models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+
+		for _, comment := range resp.Data.Comments {
+			if comment.CreateTime.Before(checkpoint.LastCreateTime.Time) {
+				continue
+			}
+			if comment.CreateTime.Equal(checkpoint.LastCreateTime.Time) && delivered[comment.CommentID] {
+				continue
+			}
+			fresh = append(fresh, comment)
+		}
+
+		if len(resp.Data.Comments) < e.config.PageSize {
+			break
+		}
+	}
+
+	if len(fresh) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(fresh, func(i, j int) bool {
+		return fresh[i].CreateTime.Before(fresh[j].CreateTime.Time)
+	})
+
+	if err := e.config.Sink.Deliver(ctx, advertiserID, fresh); err != nil {
+		return fmt.Errorf("failed to deliver comments: %w", err)
+	}
+
+	return e.config.Checkpoints.Save(ctx, advertiserID, advanceCommentCheckpoint(checkpoint, fresh))
+}
+
+// advanceCommentCheckpoint moves a checkpoint past a sorted batch of delivered comments
+func advanceCommentCheckpoint(checkpoint *CommentCheckpoint, delivered []CommentInfo) *CommentCheckpoint {
+	newest := delivered[len(delivered)-1].CreateTime
+
+	next := &CommentCheckpoint{LastCreateTime: newest}
+	if newest.Equal(checkpoint.LastCreateTime.Time) {
+		next.DeliveredIDs = append(next.DeliveredIDs, checkpoint.DeliveredIDs...)
+	}
+	for _, comment := range delivered {
+		if comment.CreateTime.Equal(newest.Time) {
+			next.DeliveredIDs = append(next.DeliveredIDs, comment.CommentID)
+		}
+	}
+	return next
+}
@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestCommentExporter_RunOnce(t *testing.T) {
+	var mu sync.Mutex
+	var startDates []string
+	comments := []string{
+		`{"comment_id":"c2","comment_text":"second","create_time":"2024-03-02 10:00:00"}`,
+		`{"comment_id":"c1","comment_text":"first","create_time":"2024-03-01 10:00:00"}`,
+		`{"comment_id":"c3","comment_text":"third","create_time":"2024-03-02 10:00:00"}`,
+	}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/comment/list/" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		page, _ := strconv.Atoi(q.Get("page"))
+		mu.Lock()
+		defer mu.Unlock()
+		if page == 1 {
+			startDates = append(startDates, q.Get("start_date"))
+		}
+		start := min((page-1)*2, len(comments))
+		end := min(start+2, len(comments))
+		writeJSON(w, `{"code":0,"data":{"comments":[`+strings.Join(comments[start:end], ",")+`]}}`)
+	})
+
+	var batches [][]string
+	sink := CommentSinkFunc(func(ctx context.Context, advertiserID string, delivered []CommentInfo) error {
+		var ids []string
+		for _, comment := range delivered {
+			ids = append(ids, comment.CommentID)
+		}
+		batches = append(batches, ids)
+		return nil
+	})
+	checkpoints := NewMemoryCommentCheckpointStore()
+	exporter, err := NewCommentExporter(newTestClient(t, server), CommentExporterConfig{
+		AdvertiserIDs: []string{"123"},
+		Sink:          sink,
+		Checkpoints:   checkpoints,
+		PageSize:      2,
+	})
+	if err != nil {
+		t.Fatalf("NewCommentExporter() error = %v", err)
+	}
+
+	if err := exporter.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	checkpoint, _ := checkpoints.Load(context.Background(), "123")
+	if strings.Join(checkpoint.DeliveredIDs, ",") != "c2,c3" || checkpoint.LastCreateTime.Format("2006-01-02 15:04:05") != "2024-03-02 10:00:00" {
+		t.Errorf("checkpoint = %+v", checkpoint)
+	}
+
+	// A comment sharing the checkpoint timestamp is delivered once
+	mu.Lock()
+	comments = append(comments[:2:2], `{"comment_id":"c4","comment_text":"fourth","create_time":"2024-03-02 10:00:00"}`, comments[2])
+	mu.Unlock()
+	if err := exporter.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	// A round without new comments delivers nothing
+	if err := exporter.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+
+	want := [][]string{{"c1", "c2", "c3"}, {"c4"}}
+	if len(batches) != len(want) || strings.Join(batches[0], ",") != "c1,c2,c3" || strings.Join(batches[1], ",") != "c4" {
+		t.Errorf("batches = %v, want %v", batches, want)
+	}
+	if len(startDates) != 3 || startDates[0] != "" || startDates[1] != "2024-03-02" {
+		t.Errorf("start dates = %v", startDates)
+	}
+}
+
+func TestCommentExporter_RunOnceErrors(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("advertiser_id") == "denied" {
+			writeJSON(w, `{"code":40001,"message":"No permission","request_id":"req-1"}`)
+			return
+		}
+		writeJSON(w, `{"code":0,"data":{"comments":[{"comment_id":"c1","create_time":"2024-03-01 10:00:00"}]}}`)
+	})
+	client := newTestClient(t, server)
+	checkpoints := NewMemoryCommentCheckpointStore()
+	failing := CommentSinkFunc(func(ctx context.Context, advertiserID string, comments []CommentInfo) error {
+		return errors.New("sink unavailable")
+	})
+
+	exporter, _ := NewCommentExporter(client, CommentExporterConfig{AdvertiserIDs: []string{"denied"}, Sink: failing, Checkpoints: checkpoints})
+	err := exporter.RunOnce(context.Background())
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "40001" {
+		t.Errorf("RunOnce() error = %v, want API error 40001", err)
+	}
+
+	// A failed delivery leaves the checkpoint in place for the next round
+	exporter, _ = NewCommentExporter(client, CommentExporterConfig{AdvertiserIDs: []string{"123"}, Sink: failing, Checkpoints: checkpoints})
+	if err := exporter.RunOnce(context.Background()); err == nil || !strings.Contains(err.Error(), "sink unavailable") {
+		t.Errorf("RunOnce() error = %v", err)
+	}
+	if checkpoint, _ := checkpoints.Load(context.Background(), "123"); !checkpoint.LastCreateTime.IsZero() {
+		t.Errorf("checkpoint advanced after a failed delivery: %+v", checkpoint)
+	}
+
+	if _, err := NewCommentExporter(client, CommentExporterConfig{AdvertiserIDs: []string{"123"}}); err == nil {
+		t.Error("NewCommentExporter() accepted a config without a sink")
+	}
+}
+
+func TestCommentSinks(t *testing.T) {
+	comments := []CommentInfo{{CommentID: "c1", CommentText: "first"}, {CommentID: "c2", CommentText: "second"}}
+
+	var received CommentEnvelope
+	fail := false
+	webhook := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Content-Type = %q", r.Header.Get("Content-Type"))
+		}
+		json.NewDecoder(r.Body).Decode(&received)
+	})
+	sink := NewWebhookCommentSink(webhook.URL, nil)
+	if err := sink.Deliver(context.Background(), "123", comments); err != nil {
+		t.Fatalf("webhook Deliver() error = %v", err)
+	}
+	if received.AdvertiserID != "123" || len(received.Comments) != 2 {
+		t.Errorf("webhook received %+v", received)
+	}
+	fail = true
+	if err := sink.Deliver(context.Background(), "123", comments); err == nil || !strings.Contains(err.Error(), "HTTP 502") {
+		t.Errorf("webhook Deliver() error = %v", err)
+	}
+
+	writer := &recordingMessageWriter{}
+	if err := NewQueueCommentSink(writer).Deliver(context.Background(), "123", comments); err != nil {
+		t.Fatalf("queue Deliver() error = %v", err)
+	}
+	if len(writer.msgs) != 2 || !strings.Contains(string(writer.msgs[1]), `"comment_id":"c2"`) {
+		t.Errorf("queue messages = %q", writer.msgs)
+	}
+
+	ch := make(chan CommentInfo, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := NewChannelCommentSink(ch).Deliver(ctx, "123", comments); !errors.Is(err, context.Canceled) {
+		t.Errorf("channel Deliver() error = %v, want context.Canceled once the channel is full", err)
+	}
+}
+
+// recordingMessageWriter records the messages written to a queue
+type recordingMessageWriter struct {
+	msgs [][]byte
+}
+
+func (w *recordingMessageWriter) WriteMessages(ctx context.Context, msgs ...[]byte) error {
+	w.msgs = append(w.msgs, msgs...)
+	return nil
+}
+
+func TestFileCommentCheckpointStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	ctx := context.Background()
+
+	store := NewFileCommentCheckpointStore(path)
+	if checkpoint, err := store.Load(ctx, "123"); err != nil || !checkpoint.LastCreateTime.IsZero() {
+		t.Fatalf("Load() of a missing file = %+v, %v", checkpoint, err)
+	}
+	var saved CommentCheckpoint
+	json.Unmarshal([]byte(`{"last_create_time":"2024-03-02 10:00:00","delivered_ids":["c2"]}`), &saved)
+	if err := store.Save(ctx, "123", &saved); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	checkpoint, err := NewFileCommentCheckpointStore(path).Load(ctx, "123")
+	if err != nil || !checkpoint.LastCreateTime.Equal(saved.LastCreateTime.Time) || strings.Join(checkpoint.DeliveredIDs, ",") != "c2" {
+		t.Errorf("reloaded checkpoint = %+v, %v", checkpoint, err)
+	}
+}
@@ -0,0 +1,321 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Comment webhook event types
+const (
+	CommentEventCreated = "comment.create"
+	CommentEventUpdated = "comment.update"
+	CommentEventDeleted = "comment.delete"
+)
+
+// Comment moderation actions
+const (
+	CommentActionHide   = "HIDE"
+	CommentActionDelete = "DELETE"
+)
+
+// CommentSignatureHeader carries the signature of webhook deliveries
+const CommentSignatureHeader = "TikTok-Signature"
+
+// DefaultWebhookTolerance is how old a signed webhook delivery may be
+const DefaultWebhookTolerance = 5 * time.Minute
+
+// maxWebhookBodyBytes bounds the webhook payloads read by CommentModerator
+const maxWebhookBodyBytes = 1 << 20
+
+// CommentWebhookComment is the comment a webhook event is about
+type CommentWebhookComment struct {
+	CommentID       string      `json:"comment_id"`
+	ParentCommentID string      `json:"parent_comment_id,omitempty"`
+	VideoID         string      `json:"video_id"`
+	AdID            string      `json:"ad_id,omitempty"`
+	Text            string      `json:"text"`
+	UserID          string      `json:"user_id,omitempty"`
+	UserName        string      `json:"user_name,omitempty"`
+	Status          string      `json:"status,omitempty"`
+	CreateTime      models.Time `json:"create_time,omitempty"`
+}
+
+// CommentWebhookEvent is a decoded comment webhook delivery
+type CommentWebhookEvent struct {
+	Event        string      `json:"event"`
+	ClientKey    string      `json:"client_key,omitempty"`
+	AdvertiserID string      `json:"advertiser_id"`
+	CreateTime   models.Time `json:"create_time"`
+
+	// Comment is decoded from the event's content, which TikTok delivers as
+	// a JSON-encoded string
+	Comment CommentWebhookComment `json:"-"`
+}
+
+// DecodeCommentWebhook decodes a comment webhook payload. The content may be
+// a JSON-encoded string or an inline object.
+func DecodeCommentWebhook(body []byte) (*CommentWebhookEvent, error) {
+	var payload struct {
+		CommentWebhookEvent
+		Content json.RawMessage `json:"content"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook: %w", err)
+	}
+	if payload.Event == "" {
+		return nil, fmt.Errorf("webhook has no event type")
+	}
+
+	content := []byte(payload.Content)
+	var encoded string
+	if err := json.Unmarshal(content, &encoded); err == nil {
+		content = []byte(encoded)
+	}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &payload.Comment); err != nil {
+			return nil, fmt.Errorf("failed to decode webhook content: %w", err)
+		}
+	}
+
+	event := payload.CommentWebhookEvent
+	return &event, nil
+}
+
+// VerifyWebhookSignature checks a TikTok-Signature header of the form
+// t=<unix time>,s=<hex HMAC-SHA256 of "<t>.<body>"> against the app secret,
+// rejecting deliveries older than tolerance
+func VerifyWebhookSignature(header string, body []byte, secret string, tolerance time.Duration) error {
+	var timestamp, signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "s":
+			signature = value
+		}
+	}
+	if timestamp == "" || signature == "" {
+		return fmt.Errorf("malformed webhook signature")
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("malformed webhook timestamp: %w", err)
+	}
+	if tolerance > 0 {
+		age := time.Since(time.Unix(seconds, 0))
+		if age > tolerance || age < -tolerance {
+			return fmt.Errorf("webhook timestamp is outside the %s tolerance", tolerance)
+		}
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return fmt.Errorf("webhook signature mismatch")
+	}
+	return nil
+}
+
+// CommentRule hides or deletes comments matching any of its keywords or its
+// pattern
+type CommentRule struct {
+	Name string
+
+	// Keywords match case-insensitively anywhere in the comment
+	Keywords []string
+
+	// Pattern, if set, is matched against the comment text
+	Pattern *regexp.Regexp
+
+	// Action is CommentActionHide or CommentActionDelete
+	Action string
+}
+
+// matches reports whether the rule applies to a comment text
+func (r *CommentRule) matches(text string) bool {
+	lower := strings.ToLower(text)
+	for _, keyword := range r.Keywords {
+		if keyword != "" && strings.Contains(lower, strings.ToLower(keyword)) {
+			return true
+		}
+	}
+	return r.Pattern != nil && r.Pattern.MatchString(text)
+}
+
+// CommentModeratorConfig configures a CommentModerator
+type CommentModeratorConfig struct {
+	// Rules are evaluated in order; the first match decides the action
+	Rules []CommentRule
+
+	// WebhookSecret, if set, is used to verify the signature of webhook
+	// deliveries received by ServeHTTP
+	WebhookSecret string
+
+	// WebhookTolerance bounds the age of signed deliveries (defaults to
+	// DefaultWebhookTolerance)
+	WebhookTolerance time.Duration
+
+	// DryRun decides actions without calling the API
+	DryRun bool
+
+	// OnDecision, if set, is called with every comment a rule matched
+	OnDecision func(CommentModerationResult)
+}
+
+// CommentModerationResult is the outcome of moderating one comment
+type CommentModerationResult struct {
+	AdvertiserID string
+	Comment      CommentWebhookComment
+
+	// Rule and Action are empty when no rule matched
+	Rule   string
+	Action string
+
+	// Applied is set once the action succeeded; it stays false on dry runs
+	Applied bool
+	Err     error
+}
+
+// CommentModerator applies moderation rules to comments received by webhook,
+// hiding or deleting matching comments through the comment API
+type CommentModerator struct {
+	comments CommentService
+	config   CommentModeratorConfig
+}
+
+// NewCommentModerator creates a new CommentModerator
+func NewCommentModerator(client *Client, config CommentModeratorConfig) (*CommentModerator, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+	for i, rule := range config.Rules {
+		if rule.Action != CommentActionHide && rule.Action != CommentActionDelete {
+			return nil, fmt.Errorf("rule %d (%s): action must be %s or %s", i, rule.Name, CommentActionHide, CommentActionDelete)
+		}
+		if len(rule.Keywords) == 0 && rule.Pattern == nil {
+			return nil, fmt.Errorf("rule %d (%s): keywords or pattern is required", i, rule.Name)
+		}
+	}
+	if config.WebhookTolerance <= 0 {
+		config.WebhookTolerance = DefaultWebhookTolerance
+	}
+	return &CommentModerator{comments: client.Comment(), config: config}, nil
+}
+
+// Evaluate returns the first rule matching a comment text, or nil
+func (m *CommentModerator) Evaluate(text string) *CommentRule {
+	for i := range m.config.Rules {
+		if m.config.Rules[i].matches(text) {
+			return &m.config.Rules[i]
+		}
+	}
+	return nil
+}
+
+// HandleEvent moderates the comment of a created or updated comment event and
+// applies the matching rule's action. Other events are ignored. The returned
+// error is the action's failure, also recorded in the result.
+func (m *CommentModerator) HandleEvent(ctx context.Context, event *CommentWebhookEvent) (*CommentModerationResult, error) {
+	result := &CommentModerationResult{AdvertiserID: event.AdvertiserID, Comment: event.Comment}
+	if event.Event != CommentEventCreated && event.Event != CommentEventUpdated {
+		return result, nil
+	}
+	if event.Comment.Text == "" || strings.EqualFold(event.Comment.Status, "DELETED") {
+		return result, nil
+	}
+
+	rule := m.Evaluate(event.Comment.Text)
+	if rule == nil {
+		return result, nil
+	}
+	result.Rule = rule.Name
+	result.Action = rule.Action
+
+	if !m.config.DryRun {
+		result.Err = m.apply(ctx, event.AdvertiserID, event.Comment.CommentID, rule.Action)
+		result.Applied = result.Err == nil
+	}
+	if m.config.OnDecision != nil {
+		m.config.OnDecision(*result)
+	}
+	return result, result.Err
+}
+
+// apply hides or deletes a comment
+func (m *CommentModerator) apply(ctx context.Context, advertiserID, commentID, action string) error {
+	if advertiserID == "" || commentID == "" {
+		return fmt.Errorf("webhook event has no advertiser_id or comment_id")
+	}
+
+	var code int
+	var message string
+	if action == CommentActionDelete {
+		resp, err := m.comments.DeleteComment(ctx, &CommentDeleteRequest{AdvertiserID: advertiserID, CommentID: commentID})
+		if err != nil {
+			return err
+		}
+		code, message = resp.Code, resp.Message
+	} else {
+		resp, err := m.comments.UpdateCommentStatus(ctx, &CommentStatusUpdateRequest{AdvertiserID: advertiserID, CommentID: commentID, Status: "HIDDEN"})
+		if err != nil {
+			return err
+		}
+		code, message = resp.Code, resp.Message
+	}
+	if code != 0 {
+		return fmt.Errorf("API error: %s", message)
+	}
+	return nil
+}
+
+// ServeHTTP receives comment webhook deliveries. Deliveries with a bad
+// signature are rejected with 401 and undecodable ones with 400; a failed
+// action answers 500 so the delivery is retried.
+func (m *CommentModerator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodyBytes))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	if m.config.WebhookSecret != "" {
+		err := VerifyWebhookSignature(r.Header.Get(CommentSignatureHeader), body, m.config.WebhookSecret, m.config.WebhookTolerance)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	event, err := DecodeCommentWebhook(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := m.HandleEvent(r.Context(), event); err != nil {
+		http.Error(w, "moderation failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
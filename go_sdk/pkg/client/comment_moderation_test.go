@@ -0,0 +1,84 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCommentModerator(t *testing.T) {
+	var calls []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, strings.TrimPrefix(r.URL.Path, "/open_api/v1.3")+" "+body["comment_id"]+" "+body["status"])
+		writeJSON(w, `{"code":0,"data":{}}`)
+	})
+
+	client := newTestClient(t, server)
+
+	var decisions []CommentModerationResult
+	moderator, err := NewCommentModerator(client, CommentModeratorConfig{
+		Rules: []CommentRule{
+			{Name: "scam", Pattern: regexp.MustCompile(`(?i)https?://\S+`), Action: CommentActionDelete},
+			{Name: "profanity", Keywords: []string{"darn"}, Action: CommentActionHide},
+		},
+		WebhookSecret: "secret",
+		OnDecision:    func(result CommentModerationResult) { decisions = append(decisions, result) },
+	})
+	if err != nil {
+		t.Fatalf("NewCommentModerator() error = %v", err)
+	}
+
+	deliver := func(body string, signature string) int {
+		req := httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+		req.Header.Set(CommentSignatureHeader, signature)
+		rec := httptest.NewRecorder()
+		moderator.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	sign := func(body string) string {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(timestamp + "." + body))
+		return "t=" + timestamp + ",s=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	hide := `{"event":"comment.create","advertiser_id":"123","create_time":1700000000,"content":"{\"comment_id\":\"c1\",\"video_id\":\"v1\",\"text\":\"Darn this ad\"}"}`
+	remove := `{"event":"comment.update","advertiser_id":"123","content":{"comment_id":"c2","text":"win at http://scam.example"}}`
+	clean := `{"event":"comment.create","advertiser_id":"123","content":{"comment_id":"c3","text":"love it"}}`
+	deleted := `{"event":"comment.delete","advertiser_id":"123","content":{"comment_id":"c4","text":"darn"}}`
+
+	for _, body := range []string{hide, remove, clean, deleted} {
+		if code := deliver(body, sign(body)); code != http.StatusOK {
+			t.Errorf("delivery answered %d for %s", code, body)
+		}
+	}
+	want := []string{"/comment/status/update/ c1 HIDDEN", "/comment/delete/ c2 "}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+	if len(decisions) != 2 || decisions[0].Rule != "profanity" || !decisions[0].Applied || decisions[1].Action != CommentActionDelete {
+		t.Errorf("decisions = %+v", decisions)
+	}
+
+	if code := deliver(hide, "t=1700000000,s=00"); code != http.StatusUnauthorized {
+		t.Errorf("badly signed delivery answered %d", code)
+	}
+	if code := deliver(`{"content":{}}`, sign(`{"content":{}}`)); code != http.StatusBadRequest {
+		t.Errorf("delivery without event answered %d", code)
+	}
+
+	if _, err := NewCommentModerator(client, CommentModeratorConfig{Rules: []CommentRule{{Name: "empty", Action: CommentActionHide}}}); err == nil {
+		t.Error("NewCommentModerator() accepted a rule without keywords or pattern")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
 )
 
 // commentService handles Comment related operations
@@ -43,7 +45,10 @@ func (s *commentService) ListComments(ctx context.Context, req *CommentListReque
 		params["end_date"] = req.EndDate
 	}
 
-	url := s.client.BuildURL("/comment/list/", params)
+	url, err := s.client.BuildURL("/comment/list/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -73,7 +78,10 @@ func (s *commentService) PostComment(ctx context.Context, req *CommentPostReques
 		return nil, fmt.Errorf("video_id is required")
 	}
 
-	url := s.client.BuildURL("/comment/post/", nil)
+	url, err := s.client.BuildURL("/comment/post/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -105,7 +113,10 @@ func (s *commentService) DeleteComment(ctx context.Context, req *CommentDeleteRe
 		return nil, fmt.Errorf("comment_id is required")
 	}
 
-	url := s.client.BuildURL("/comment/delete/", nil)
+	url, err := s.client.BuildURL("/comment/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -140,7 +151,10 @@ func (s *commentService) UpdateCommentStatus(ctx context.Context, req *CommentSt
 		return nil, fmt.Errorf("status is required")
 	}
 
-	url := s.client.BuildURL("/comment/status/update/", nil)
+	url, err := s.client.BuildURL("/comment/status/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -174,7 +188,10 @@ func (s *commentService) GetCommentReference(ctx context.Context, req *CommentRe
 		params["video_id"] = req.VideoID
 	}
 
-	url := s.client.BuildURL("/comment/reference/", params)
+	url, err := s.client.BuildURL("/comment/reference/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -201,7 +218,10 @@ func (s *commentService) CreateCommentTask(ctx context.Context, req *CommentTask
 		return nil, fmt.Errorf("task_type is required")
 	}
 
-	url := s.client.BuildURL("/comment/task/create/", nil)
+	url, err := s.client.BuildURL("/comment/task/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -232,7 +252,10 @@ func (s *commentService) CheckCommentTask(ctx context.Context, req *CommentTaskC
 		"task_id":       req.TaskID,
 	}
 
-	url := s.client.BuildURL("/comment/task/check/", params)
+	url, err := s.client.BuildURL("/comment/task/check/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -275,15 +298,15 @@ type CommentListData struct {
 }
 
 type CommentInfo struct {
-	CommentID   string `json:"comment_id"`
-	VideoID     string `json:"video_id"`
-	CommentText string `json:"comment_text"`
-	Status      string `json:"status"`
-	CreateTime  string `json:"create_time"`
-	UpdateTime  string `json:"update_time"`
-	UserName    string `json:"user_name,omitempty"`
-	LikeCount   int    `json:"like_count,omitempty"`
-	ReplyCount  int    `json:"reply_count,omitempty"`
+	CommentID   string      `json:"comment_id"`
+	VideoID     string      `json:"video_id"`
+	CommentText string      `json:"comment_text"`
+	Status      string      `json:"status"`
+	CreateTime  models.Time `json:"create_time"`
+	UpdateTime  models.Time `json:"update_time"`
+	UserName    string      `json:"user_name,omitempty"`
+	LikeCount   int         `json:"like_count,omitempty"`
+	ReplyCount  int         `json:"reply_count,omitempty"`
 }
 
 type CommentPostRequest struct {
@@ -301,9 +324,9 @@ type CommentPostResponse struct {
 }
 
 type CommentPostData struct {
-	CommentID  string `json:"comment_id"`
-	Status     string `json:"status"`
-	CreateTime string `json:"create_time"`
+	CommentID  string      `json:"comment_id"`
+	Status     string      `json:"status"`
+	CreateTime models.Time `json:"create_time"`
 }
 
 type CommentDeleteRequest struct {
@@ -338,9 +361,9 @@ type CommentStatusUpdateResponse struct {
 }
 
 type CommentStatusUpdateData struct {
-	CommentID  string `json:"comment_id"`
-	Status     string `json:"status"`
-	UpdateTime string `json:"update_time"`
+	CommentID  string      `json:"comment_id"`
+	Status     string      `json:"status"`
+	UpdateTime models.Time `json:"update_time"`
 }
 
 type CommentReferenceRequest struct {
@@ -392,10 +415,10 @@ type CommentTaskResponse struct {
 }
 
 type CommentTaskData struct {
-	TaskID     string `json:"task_id"`
-	TaskType   string `json:"task_type"`
-	Status     string `json:"status"`
-	CreateTime string `json:"create_time"`
+	TaskID     string      `json:"task_id"`
+	TaskType   string      `json:"task_type"`
+	Status     string      `json:"status"`
+	CreateTime models.Time `json:"create_time"`
 }
 
 type CommentTaskCheckRequest struct {
@@ -411,14 +434,14 @@ type CommentTaskCheckResponse struct {
 }
 
 type CommentTaskCheckData struct {
-	TaskID       string `json:"task_id"`
-	TaskType     string `json:"task_type"`
-	Status       string `json:"status"` // PENDING, PROCESSING, COMPLETED, FAILED
-	Progress     int    `json:"progress"` // 0-100
-	ProcessedCount int  `json:"processed_count"`
-	TotalCount     int  `json:"total_count"`
-	CreateTime     string `json:"create_time"`
-	UpdateTime     string `json:"update_time"`
-	CompleteTime   string `json:"complete_time,omitempty"`
-	ErrorMessage   string `json:"error_message,omitempty"`
+	TaskID         string      `json:"task_id"`
+	TaskType       string      `json:"task_type"`
+	Status         string      `json:"status"`   // PENDING, PROCESSING, COMPLETED, FAILED
+	Progress       int         `json:"progress"` // 0-100
+	ProcessedCount int         `json:"processed_count"`
+	TotalCount     int         `json:"total_count"`
+	CreateTime     models.Time `json:"create_time"`
+	UpdateTime     models.Time `json:"update_time"`
+	CompleteTime   string      `json:"complete_time,omitempty"`
+	ErrorMessage   string      `json:"error_message,omitempty"`
 }
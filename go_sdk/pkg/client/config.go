@@ -2,16 +2,35 @@ package client
 
 import (
 	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Config holds the configuration for the TikTok Business API client
 type Config struct {
+	// Environment selects production or sandbox. It picks the base URL when
+	// BaseURL is empty or left at the production default.
+	Environment Environment
+
 	// BaseURL is the base URL for the TikTok Business API
 	BaseURL string
 
+	// APIVersion is the API version requested by all services, DefaultAPIVersion
+	// when empty. WithAPIVersion overrides it for a single call.
+	APIVersion APIVersion
+
 	// AccessToken is the OAuth 2.0 access token for authentication
 	AccessToken string
 
+	// TokenSource, if set, supplies access tokens in place of AccessToken and
+	// is asked for a new token when the API rejects the current one
+	TokenSource TokenSource
+
+	// TokenStore holds per-advertiser tokens, used for requests whose context
+	// comes from WithAdvertiserToken
+	TokenStore TokenStore
+
 	// ClientID is the OAuth 2.0 client ID
 	ClientID string
 
@@ -27,19 +46,118 @@ type Config struct {
 	// RateLimit configures rate limiting
 	RateLimit *RateLimitConfig
 
+	// CircuitBreaker, if set, stops sending requests to an endpoint group,
+	// such as report, after repeated failures so a failing subsystem does not
+	// hold up the rest of the client
+	CircuitBreaker *CircuitBreakerConfig
+
 	// UserAgent is the User-Agent header to send with requests
 	UserAgent string
 
+	// MaxErrorBodyBytes caps how much of a non-2xx response body is kept in
+	// returned errors. Zero uses DefaultMaxErrorBodyBytes; a negative value
+	// disables capture.
+	MaxErrorBodyBytes int
+
+	// MaxResponseBytes caps how much of a response body is read into memory
+	// for decoding; larger bodies fail with a models.ResponseTooLargeError
+	// instead of exhausting memory. Zero uses DefaultMaxResponseBytes; a
+	// negative value disables the cap.
+	MaxResponseBytes int64
+
+	// StreamThresholdBytes is the size above which a JSON response of a
+	// download endpoint, such as a report file, is streamed to the caller as
+	// the file rather than read as an envelope. Zero uses
+	// DefaultStreamThresholdBytes.
+	StreamThresholdBytes int64
+
+	// Middleware wraps the HTTP transport, outermost first
+	Middleware []Middleware
+
+	// Audit, if set, records every create, update and delete request to an
+	// audit sink, after any Middleware has modified it
+	Audit *AuditConfig
+
+	// TracerProvider, if set, records an OpenTelemetry span for every API call
+	TracerProvider trace.TracerProvider
+
+	// Metrics, if set, receives request counts, latencies and rate limiter
+	// tokens, e.g. a PrometheusCollector
+	Metrics MetricsCollector
+
+	// CheckStatusTransitions, if set, makes UpdateStatus look up the current
+	// status of the campaigns, ad groups or ads first and fail without
+	// updating any when the operation cannot apply, such as enabling a
+	// deleted campaign, or when any of them is not found. It costs one extra
+	// call per 1000 IDs updated.
+	CheckStatusTransitions bool
+
+	// Language is the language LocalizeError renders errors in, English when empty
+	Language models.Language
+
+	// Translator renders error messages in Language. Nil uses the built-in
+	// catalog, models.DefaultTranslator.
+	Translator models.Translator
+
 	// Debug enables debug logging
 	Debug bool
 }
 
+// Environment is a TikTok Business API environment
+type Environment string
+
+const (
+	// EnvironmentProduction is the live API, used when no environment is set
+	EnvironmentProduction Environment = "production"
+
+	// EnvironmentSandbox is the sandbox API for testing with sandbox advertisers
+	EnvironmentSandbox Environment = "sandbox"
+)
+
+// Base URLs of the API environments
+const (
+	ProductionBaseURL = "https://business-api.tiktok.com"
+	SandboxBaseURL    = "https://sandbox-ads.tiktok.com"
+)
+
+// BaseURL returns the API base URL of the environment
+func (e Environment) BaseURL() string {
+	if e == EnvironmentSandbox {
+		return SandboxBaseURL
+	}
+	return ProductionBaseURL
+}
+
+// AuthorizationURL returns the base URL of the OAuth authorization page. The
+// sandbox has no authorization page, so sandbox apps authorize against
+// production.
+func (e Environment) AuthorizationURL() string {
+	return ProductionBaseURL
+}
+
+// valid reports whether e is a known environment
+func (e Environment) valid() bool {
+	return e == "" || e == EnvironmentProduction || e == EnvironmentSandbox
+}
+
+// resolved returns a copy of the config whose BaseURL points at the
+// configured environment unless a custom URL has been set
+func (c *Config) resolved() *Config {
+	resolved := *c
+	if resolved.BaseURL == "" || resolved.BaseURL == ProductionBaseURL {
+		resolved.BaseURL = resolved.Environment.BaseURL()
+	}
+	return &resolved
+}
+
 // RetryConfig configures retry behavior for failed requests
 type RetryConfig struct {
 	// MaxRetries is the maximum number of retry attempts
 	MaxRetries int
 
-	// BackoffStrategy defines the backoff strategy
+	// BackoffStrategy computes the delay before each retry. A BackoffPreset
+	// or nil, meaning ExponentialBackoff, is tuned by the fields below; other
+	// strategies carry their own settings.
 	BackoffStrategy BackoffStrategy
 
 	// InitialDelay is the initial delay before the first retry
@@ -53,6 +171,31 @@ type RetryConfig struct {
 
 	// RetryableStatusCodes defines which HTTP status codes should trigger a retry
 	RetryableStatusCodes []int
+
+	// RetryableAPICodes defines which API response codes should trigger a
+	// retry. Nil uses DefaultRetryableAPICodes; an empty slice disables them.
+	RetryableAPICodes []int
+
+	// RetryCreates also retries requests that are not idempotent, such as
+	// creates, copies, fund transfers and uploads, when they may have taken
+	// effect: on a 5xx status, a connection error after the request was sent,
+	// an internal error code or a truncated response. A retry can then apply
+	// the request twice, so by default these requests are only retried when
+	// rejected unprocessed: throttled with a 429 status or a RejectedAPICodes
+	// code, or failing to connect.
+	RetryCreates bool
+}
+
+// DefaultRetryableAPICodes are the transient API response codes retried by default
+var DefaultRetryableAPICodes = []int{
+	40100, // requests too frequent
+	50000, // internal error
+}
+
+// RejectedAPICodes are the retryable API response codes of requests rejected
+// before they were processed, which are safe to retry for any request
+var RejectedAPICodes = []int{
+	40100, // requests too frequent
 }
 
 // RateLimitConfig configures rate limiting
@@ -64,29 +207,25 @@ type RateLimitConfig struct {
 	BurstSize int
 }
 
-// BackoffStrategy defines the backoff strategy for retries
-type BackoffStrategy int
+// DefaultMaxErrorBodyBytes is the default size of error body excerpts
+const DefaultMaxErrorBodyBytes = 4 << 10
 
-const (
-	// LinearBackoff increases delay linearly
-	LinearBackoff BackoffStrategy = iota
-
-	// ExponentialBackoff increases delay exponentially
-	ExponentialBackoff
+// DefaultMaxResponseBytes is the default cap on response bodies read into memory
+const DefaultMaxResponseBytes = 64 << 20
 
-	// FixedBackoff uses a fixed delay
-	FixedBackoff
-)
+// DefaultStreamThresholdBytes is the default size above which download
+// responses are streamed
+const DefaultStreamThresholdBytes = 1 << 20
 
 // DefaultConfig returns a default configuration
 func DefaultConfig() *Config {
 	return &Config{
-		BaseURL:   "https://business-api.tiktok.com",
+		BaseURL:   ProductionBaseURL,
 		Timeout:   30 * time.Second,
 		UserAgent: "tiktok-business-api-go-sdk/1.0.0",
 		RetryConfig: &RetryConfig{
 			MaxRetries:      3,
-			BackoffStrategy: ExponentialBackoff,
+			BackoffStrategy: ExponentialJitterBackoff,
 			InitialDelay:    1 * time.Second,
 			MaxDelay:        30 * time.Second,
 			Multiplier:      2.0,
@@ -107,11 +246,19 @@ func DefaultConfig() *Config {
 
 // Validate validates the configuration
 func (c *Config) Validate() error {
+	if !c.Environment.valid() {
+		return ErrInvalidConfig{Field: "Environment", Message: "unknown environment " + string(c.Environment)}
+	}
+
+	if !c.APIVersion.valid() {
+		return ErrInvalidConfig{Field: "APIVersion", Message: "unsupported API version " + string(c.APIVersion)}
+	}
+
 	if c.BaseURL == "" {
 		return ErrInvalidConfig{Field: "BaseURL", Message: "base URL is required"}
 	}
 
-	if c.AccessToken == "" && (c.ClientID == "" || c.ClientSecret == "") {
+	if c.AccessToken == "" && c.TokenSource == nil && c.TokenStore == nil && (c.ClientID == "" || c.ClientSecret == "") {
 		return ErrInvalidConfig{
 			Field:   "Authentication",
 			Message: "either access token or client credentials are required",
@@ -127,16 +274,20 @@ func (c *Config) Validate() error {
 			return ErrInvalidConfig{Field: "RetryConfig.MaxRetries", Message: "max retries cannot be negative"}
 		}
 
-		if c.RetryConfig.InitialDelay <= 0 {
-			return ErrInvalidConfig{Field: "RetryConfig.InitialDelay", Message: "initial delay must be positive"}
-		}
+		// Delay settings only tune presets; custom strategies carry their own
+		_, preset := c.RetryConfig.BackoffStrategy.(BackoffPreset)
+		if preset || c.RetryConfig.BackoffStrategy == nil {
+			if c.RetryConfig.InitialDelay <= 0 {
+				return ErrInvalidConfig{Field: "RetryConfig.InitialDelay", Message: "initial delay must be positive"}
+			}
 
-		if c.RetryConfig.MaxDelay <= 0 {
-			return ErrInvalidConfig{Field: "RetryConfig.MaxDelay", Message: "max delay must be positive"}
-		}
+			if c.RetryConfig.MaxDelay <= 0 {
+				return ErrInvalidConfig{Field: "RetryConfig.MaxDelay", Message: "max delay must be positive"}
+			}
 
-		if c.RetryConfig.Multiplier <= 0 {
-			return ErrInvalidConfig{Field: "RetryConfig.Multiplier", Message: "multiplier must be positive"}
+			if c.RetryConfig.Multiplier <= 0 {
+				return ErrInvalidConfig{Field: "RetryConfig.Multiplier", Message: "multiplier must be positive"}
+			}
 		}
 	}
 
@@ -150,6 +301,14 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.StreamThresholdBytes < 0 {
+		return ErrInvalidConfig{Field: "StreamThresholdBytes", Message: "stream threshold cannot be negative"}
+	}
+
+	if c.Audit != nil && c.Audit.Sink == nil {
+		return ErrInvalidConfig{Field: "Audit.Sink", Message: "audit sink is required"}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_Environment(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     Environment
+		baseURL string
+		want    string
+	}{
+		{name: "default", want: ProductionBaseURL},
+		{name: "production", env: EnvironmentProduction, baseURL: ProductionBaseURL, want: ProductionBaseURL},
+		{name: "sandbox", env: EnvironmentSandbox, want: SandboxBaseURL},
+		{name: "sandbox over default base URL", env: EnvironmentSandbox, baseURL: ProductionBaseURL, want: SandboxBaseURL},
+		{name: "custom base URL kept", env: EnvironmentSandbox, baseURL: "https://proxy.example.com", want: "https://proxy.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{
+				Environment: tt.env,
+				BaseURL:     tt.baseURL,
+				AccessToken: "test_token",
+				Timeout:     5 * time.Second,
+			}
+			client, err := NewClient(config)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			if got := client.baseURL.String(); got != tt.want {
+				t.Errorf("base URL = %s, want %s", got, tt.want)
+			}
+			if config.BaseURL != tt.baseURL {
+				t.Errorf("NewClient() changed the caller's base URL to %s", config.BaseURL)
+			}
+		})
+	}
+
+	// A config without a base URL can be reused for another environment
+	config := &Config{Environment: EnvironmentSandbox, AccessToken: "test_token", Timeout: 5 * time.Second}
+	if _, err := NewClient(config); err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	config.Environment = EnvironmentProduction
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if got := client.baseURL.String(); got != ProductionBaseURL {
+		t.Errorf("reused config base URL = %s, want %s", got, ProductionBaseURL)
+	}
+
+	// Sandbox apps still authorize on the production host
+	auth := NewAuthService(&AuthConfig{ClientID: "app", Environment: EnvironmentSandbox})
+	if got := auth.GetAuthorizationURL(nil); !strings.HasPrefix(got, ProductionBaseURL+"/open_api/") {
+		t.Errorf("sandbox authorization URL = %s, want production host", got)
+	}
+}
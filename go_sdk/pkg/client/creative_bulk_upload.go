@@ -0,0 +1,335 @@
+package client
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // register GIF decoder for creative validation
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Creative upload limits checked before uploading
+const (
+	CreativeImageMaxBytes = 500 * 1024
+	CreativeVideoMaxBytes = 500 * 1024 * 1024
+)
+
+// Creative asset kinds in a manifest
+const (
+	CreativeKindImage = "image"
+	CreativeKindVideo = "video"
+)
+
+// creativeFileTypes maps file extensions to asset kinds and upload types
+var creativeFileTypes = map[string]struct{ kind, uploadType string }{
+	".jpg":  {CreativeKindImage, "JPG"},
+	".jpeg": {CreativeKindImage, "JPG"},
+	".png":  {CreativeKindImage, "PNG"},
+	".gif":  {CreativeKindImage, "GIF"},
+	".mp4":  {CreativeKindVideo, "MP4"},
+	".mov":  {CreativeKindVideo, "MOV"},
+	".avi":  {CreativeKindVideo, "AVI"},
+}
+
+// CreativeManifestEntry describes one asset of a bulk upload. In an input
+// manifest only File is required; uploads fill in Kind and AssetID, or Error.
+type CreativeManifestEntry struct {
+	File    string `json:"file"`
+	Name    string `json:"name,omitempty"`
+	Kind    string `json:"kind,omitempty"`
+	AssetID string `json:"asset_id,omitempty"`
+	Error   string `json:"error,omitempty"`
+
+	// Duplicate marks assets whose content was already uploaded
+	Duplicate bool `json:"duplicate,omitempty"`
+}
+
+// CreativeManifest lists the assets of a bulk upload and their IDs
+type CreativeManifest struct {
+	AdvertiserID string                  `json:"advertiser_id,omitempty"`
+	Assets       []CreativeManifestEntry `json:"assets"`
+}
+
+// BulkCreativeUploadRequest represents the request for uploading a directory of creatives
+type BulkCreativeUploadRequest struct {
+	AdvertiserID string
+
+	// Source is a directory or a .zip archive of images and videos
+	Source string
+
+	// ManifestFile names the optional manifest within Source (defaults to
+	// manifest.json). When present, only the listed files are uploaded,
+	// using the names it gives.
+	ManifestFile string
+
+	// Concurrency caps parallel uploads (defaults to 4)
+	Concurrency int
+
+	// Deduplicator, if set, skips assets whose content is already in the
+	// advertiser's library and reports their existing IDs
+	Deduplicator *AssetDeduplicator
+
+	// KeepOnCancel keeps the assets uploaded before ctx was cancelled
+	// instead of deleting them
+	KeepOnCancel bool
+}
+
+// UploadCreativeDirectory validates and uploads every image and video in a
+// directory or zip archive and returns a manifest of the resulting asset IDs.
+// Assets failing validation or upload are reported in the manifest rather
+// than failing the whole upload. When ctx is cancelled no further uploads
+// start, the assets this upload created are deleted unless KeepOnCancel is
+// set, and the manifest is returned with the cancellation error.
+func (c *Client) UploadCreativeDirectory(ctx context.Context, req *BulkCreativeUploadRequest) (*CreativeManifest, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.Source == "" {
+		return nil, fmt.Errorf("source is required")
+	}
+
+	fsys, closer, err := openCreativeSource(req.Source)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	manifestFile := req.ManifestFile
+	if manifestFile == "" {
+		manifestFile = "manifest.json"
+	}
+	entries, err := creativeEntries(fsys, manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := range entries {
+		wg.Add(1)
+		go func(entry *CreativeManifestEntry) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				entry.Error = context.Cause(ctx).Error()
+				return
+			}
+			defer func() { <-sem }()
+
+			if err := c.uploadCreativeFile(ctx, fsys, req, entry); err != nil {
+				entry.Error = err.Error()
+			}
+		}(&entries[i])
+	}
+	wg.Wait()
+
+	manifest := &CreativeManifest{AdvertiserID: req.AdvertiserID, Assets: entries}
+	if ctx.Err() == nil {
+		return manifest, nil
+	}
+	if !req.KeepOnCancel {
+		if err := c.deleteCancelledCreatives(ctx, manifest); err != nil {
+			return manifest, fmt.Errorf("creative upload cancelled: %w; %v", context.Cause(ctx), err)
+		}
+	}
+	return manifest, fmt.Errorf("creative upload cancelled: %w", context.Cause(ctx))
+}
+
+// deleteCancelledCreatives deletes the assets a cancelled upload created,
+// leaving duplicates that were already in the library
+func (c *Client) deleteCancelledCreatives(ctx context.Context, manifest *CreativeManifest) error {
+	assets := make(map[string][]string)
+	var created []*CreativeManifestEntry
+	for i := range manifest.Assets {
+		entry := &manifest.Assets[i]
+		if entry.AssetID == "" || entry.Duplicate {
+			continue
+		}
+		assetType := strings.ToUpper(entry.Kind)
+		assets[assetType] = append(assets[assetType], entry.AssetID)
+		created = append(created, entry)
+	}
+	if len(created) == 0 {
+		return nil
+	}
+
+	cleanupCtx, cancel := cleanupContext(ctx)
+	defer cancel()
+	if err := c.deleteUploadedAssets(cleanupCtx, manifest.AdvertiserID, assets); err != nil {
+		return err
+	}
+	for _, entry := range created {
+		entry.AssetID = ""
+		entry.Error = "deleted after the upload was cancelled"
+	}
+	return nil
+}
+
+// WriteJSON writes the manifest as indented JSON
+func (m *CreativeManifest) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(m)
+}
+
+// openCreativeSource opens a directory or zip archive as a file system
+func openCreativeSource(source string) (fs.FS, io.Closer, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open creative source: %w", err)
+	}
+	if info.IsDir() {
+		return os.DirFS(source), io.NopCloser(nil), nil
+	}
+
+	archive, err := zip.OpenReader(source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open creative archive: %w", err)
+	}
+	return archive, archive, nil
+}
+
+// creativeEntries reads the manifest, or lists every creative file when there is none
+func creativeEntries(fsys fs.FS, manifestFile string) ([]CreativeManifestEntry, error) {
+	data, err := fs.ReadFile(fsys, manifestFile)
+	switch {
+	case err == nil:
+		var manifest CreativeManifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", manifestFile, err)
+		}
+		if len(manifest.Assets) == 0 {
+			return nil, fmt.Errorf("%s lists no assets", manifestFile)
+		}
+		return manifest.Assets, nil
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("failed to read %s: %w", manifestFile, err)
+	}
+
+	var entries []CreativeManifestEntry
+	err = fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			// Skip hidden directories such as __MACOSX in archives
+			if name != "." && (strings.HasPrefix(d.Name(), ".") || strings.HasPrefix(d.Name(), "__")) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if _, ok := creativeFileTypes[strings.ToLower(path.Ext(name))]; ok && !strings.HasPrefix(d.Name(), ".") {
+			entries = append(entries, CreativeManifestEntry{File: name})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list creative source: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("creative source contains no images or videos")
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].File < entries[j].File })
+	return entries, nil
+}
+
+// uploadCreativeFile validates and uploads one asset, recording its ID on the entry
+func (c *Client) uploadCreativeFile(ctx context.Context, fsys fs.FS, req *BulkCreativeUploadRequest, entry *CreativeManifestEntry) error {
+	fileType, ok := creativeFileTypes[strings.ToLower(path.Ext(entry.File))]
+	if !ok {
+		return fmt.Errorf("unsupported file type %q", path.Ext(entry.File))
+	}
+	entry.Kind = fileType.kind
+	if entry.Name == "" {
+		entry.Name = strings.TrimSuffix(path.Base(entry.File), path.Ext(entry.File))
+	}
+
+	data, err := fs.ReadFile(fsys, entry.File)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	if err := validateCreativeFile(fileType.kind, data); err != nil {
+		return err
+	}
+
+	if fileType.kind == CreativeKindImage {
+		upload := &ImageUploadRequest{
+			AdvertiserID: req.AdvertiserID,
+			ImageData:    data,
+			ImageName:    entry.Name,
+			ImageType:    fileType.uploadType,
+		}
+		if req.Deduplicator != nil {
+			entry.AssetID, entry.Duplicate, err = req.Deduplicator.UploadImage(ctx, upload)
+			return err
+		}
+		resp, err := c.Creative().UploadImage(ctx, upload)
+		if err != nil {
+			return err
+		}
+		if resp.Code != 0 {
+			return fmt.Errorf("API error: %s", resp.Message)
+		}
+		entry.AssetID = resp.Data.ImageID
+		return nil
+	}
+
+	upload := &VideoUploadRequest{
+		AdvertiserID: req.AdvertiserID,
+		VideoData:    data,
+		VideoName:    entry.Name,
+		VideoType:    fileType.uploadType,
+	}
+	if req.Deduplicator != nil {
+		entry.AssetID, entry.Duplicate, err = req.Deduplicator.UploadVideo(ctx, upload)
+		return err
+	}
+	resp, err := c.Creative().UploadVideo(ctx, upload)
+	if err != nil {
+		return err
+	}
+	if resp.Code != 0 {
+		return fmt.Errorf("API error: %s", resp.Message)
+	}
+	entry.AssetID = resp.Data.VideoID
+	return nil
+}
+
+// validateCreativeFile checks an asset against the upload specs
+func validateCreativeFile(kind string, data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("file is empty")
+	}
+
+	if kind == CreativeKindVideo {
+		if len(data) > CreativeVideoMaxBytes {
+			return fmt.Errorf("video cannot exceed %d bytes", CreativeVideoMaxBytes)
+		}
+		return nil
+	}
+
+	if len(data) > CreativeImageMaxBytes {
+		return fmt.Errorf("image cannot exceed %d bytes", CreativeImageMaxBytes)
+	}
+	if _, _, err := image.DecodeConfig(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("invalid image: %w", err)
+	}
+	return nil
+}
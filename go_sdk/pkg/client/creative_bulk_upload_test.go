@@ -0,0 +1,133 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_UploadCreativeDirectory(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/file/image/ad/upload/":
+			w.Write([]byte(`{"code":0,"data":{"image_id":"img-1"}}`))
+		case "/open_api/v1.3/file/video/ad/upload/":
+			w.Write([]byte(`{"code":0,"data":{"video_id":"vid-1"}}`))
+		}
+	})
+
+	dir := t.TempDir()
+	var banner bytes.Buffer
+	if err := pngEncode(&banner); err != nil {
+		t.Fatalf("Failed to encode image: %v", err)
+	}
+	files := map[string][]byte{
+		"banner.png":  banner.Bytes(),
+		"spot.mp4":    []byte("video bytes"),
+		"broken.png":  []byte("not an image"),
+		"notes.txt":   []byte("ignored"),
+		".hidden.png": banner.Bytes(),
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	manifest, err := client.UploadCreativeDirectory(context.Background(), &BulkCreativeUploadRequest{AdvertiserID: "123", Source: dir})
+	if err != nil {
+		t.Fatalf("UploadCreativeDirectory() error = %v", err)
+	}
+
+	got := make(map[string]CreativeManifestEntry)
+	for _, entry := range manifest.Assets {
+		got[entry.File] = entry
+	}
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 assets, got %+v", manifest.Assets)
+	}
+	if got["banner.png"].AssetID != "img-1" || got["spot.mp4"].AssetID != "vid-1" || got["spot.mp4"].Name != "spot" {
+		t.Errorf("Unexpected uploads: %+v", manifest.Assets)
+	}
+	if got["broken.png"].Error == "" || got["broken.png"].AssetID != "" {
+		t.Errorf("Expected broken.png to fail validation, got %+v", got["broken.png"])
+	}
+}
+
+// pngEncode writes a small PNG image
+func pngEncode(w io.Writer) error {
+	return png.Encode(w, image.NewRGBA(image.Rect(0, 0, 4, 4)))
+}
+
+func TestUploadCreativeDirectoryCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var uploads atomic.Int32
+	var deleted []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/file/image/ad/upload/", "/open_api/v1.3/file/video/ad/upload/":
+			// The first upload completes; the second is cancelled midway
+			io.Copy(io.Discard, r.Body)
+			if uploads.Add(1) > 1 {
+				cancel()
+				<-r.Context().Done()
+				return
+			}
+			w.Write([]byte(`{"code":0,"data":{"image_id":"img-1","video_id":"vid-1"}}`))
+		case "/open_api/v1.3/creative/asset/delete/":
+			var body CreativeAssetDeleteRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			deleted = append(deleted, body.AssetType+":"+strings.Join(body.AssetIDs, ","))
+			w.Write([]byte(`{"code":0,"data":{"deleted_assets":[]}}`))
+		}
+	})
+
+	dir := t.TempDir()
+	var banner bytes.Buffer
+	if err := pngEncode(&banner); err != nil {
+		t.Fatalf("Failed to encode image: %v", err)
+	}
+	for name, data := range map[string][]byte{"banner.png": banner.Bytes(), "spot.mp4": []byte("video bytes")} {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	manifest, err := client.UploadCreativeDirectory(ctx, &BulkCreativeUploadRequest{AdvertiserID: "123", Source: dir, Concurrency: 1})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("UploadCreativeDirectory() error = %v, want context.Canceled", err)
+	}
+	if len(deleted) != 1 || (deleted[0] != "IMAGE:img-1" && deleted[0] != "VIDEO:vid-1") {
+		t.Errorf("deleted = %v, want the completed upload", deleted)
+	}
+	for _, entry := range manifest.Assets {
+		if entry.AssetID != "" || entry.Error == "" {
+			t.Errorf("entry after cancel = %+v", entry)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// CreativeFatigueRequest represents the request for detecting fatigued creatives
+type CreativeFatigueRequest struct {
+	AdvertiserID string
+
+	// AdGroupIDs limits the analysis to these ad groups; all ads with
+	// impressions in the window are analyzed when empty
+	AdGroupIDs []string
+
+	// StartDate and EndDate bound the daily history analyzed (YYYY-MM-DD)
+	StartDate string
+	EndDate   string
+
+	// Window is the number of days in each rolling window (defaults to 3)
+	Window int
+
+	// MinCTRDrop is the relative decline from the best window's CTR that
+	// flags fatigue (defaults to 0.2 for 20%)
+	MinCTRDrop float64
+
+	// MinFrequencyRise is the relative rise in frequency since the best
+	// window needed to attribute a CTR decline to fatigue (defaults to 0.1)
+	MinFrequencyRise float64
+
+	// MinImpressions is the number of impressions a window needs to count
+	// (defaults to 1000), keeping noisy low-volume days from flagging ads
+	MinImpressions float64
+}
+
+// CreativeFatigue holds the decay signals of one ad's creative
+type CreativeFatigue struct {
+	AdID      string `json:"ad_id"`
+	AdName    string `json:"ad_name,omitempty"`
+	AdGroupID string `json:"adgroup_id"`
+	Days      int    `json:"days"`
+
+	// PeakCTR is the CTR of the best rolling window and CurrentCTR that of
+	// the latest one, as fractions of impressions
+	PeakCTR    float64 `json:"peak_ctr"`
+	CurrentCTR float64 `json:"current_ctr"`
+
+	// PeakFrequency and CurrentFrequency are the average frequencies of the
+	// same windows
+	PeakFrequency    float64 `json:"peak_frequency"`
+	CurrentFrequency float64 `json:"current_frequency"`
+
+	// CTRDrop is the relative decline from PeakCTR and FrequencyRise the
+	// relative rise from PeakFrequency
+	CTRDrop       float64 `json:"ctr_drop"`
+	FrequencyRise float64 `json:"frequency_rise"`
+
+	// Score ranks fatigue from 0 to 1: the CTR drop, scaled down while the
+	// frequency rise is below the threshold
+	Score float64 `json:"score"`
+
+	// Fatigued flags creatives to refresh: CTR fell by at least MinCTRDrop
+	// while frequency rose by at least MinFrequencyRise
+	Fatigued bool `json:"fatigued"`
+
+	// Error explains why the creative could not be assessed
+	Error string `json:"error,omitempty"`
+}
+
+// AdGroupFatigue ranks the creatives of an ad group from most to least fatigued
+type AdGroupFatigue struct {
+	AdGroupID string            `json:"adgroup_id"`
+	Fatigued  int               `json:"fatigued"`
+	Creatives []CreativeFatigue `json:"creatives"`
+}
+
+// CreativeFatigueReport is the result of a fatigue analysis
+type CreativeFatigueReport struct {
+	Window   int              `json:"window"`
+	AdGroups []AdGroupFatigue `json:"adgroups"`
+}
+
+// CreativeFatigueAnalyzer detects creatives whose click-through rate decays
+// as the same audience sees them more often
+type CreativeFatigueAnalyzer struct {
+	client *Client
+}
+
+// NewCreativeFatigueAnalyzer creates a new CreativeFatigueAnalyzer
+func NewCreativeFatigueAnalyzer(client *Client) *CreativeFatigueAnalyzer {
+	return &CreativeFatigueAnalyzer{client: client}
+}
+
+// creativeDay is one ad day of the report
+type creativeDay struct {
+	date                      string
+	impressions, clicks, freq float64
+}
+
+// Analyze pulls daily CTR and frequency per ad, compares the latest rolling
+// window against the window with the best CTR, and ranks each ad group's
+// creatives by fatigue. A CTR decline only counts as fatigue when frequency
+// rose with it; a decline at steady frequency points elsewhere, such as
+// seasonality or a targeting change.
+func (a *CreativeFatigueAnalyzer) Analyze(ctx context.Context, req *CreativeFatigueRequest) (*CreativeFatigueReport, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.StartDate == "" || req.EndDate == "" {
+		return nil, fmt.Errorf("start_date and end_date are required")
+	}
+	options := *req
+	if options.Window == 0 {
+		options.Window = 3
+	}
+	if options.Window < 1 {
+		return nil, fmt.Errorf("window must be at least 1 day")
+	}
+	if options.MinCTRDrop == 0 {
+		options.MinCTRDrop = 0.2
+	}
+	if options.MinCTRDrop <= 0 || options.MinCTRDrop >= 1 {
+		return nil, fmt.Errorf("min_ctr_drop must be between 0 and 1")
+	}
+	if options.MinFrequencyRise == 0 {
+		options.MinFrequencyRise = 0.1
+	}
+	if options.MinFrequencyRise < 0 {
+		return nil, fmt.Errorf("min_frequency_rise cannot be negative")
+	}
+	if options.MinImpressions == 0 {
+		options.MinImpressions = 1000
+	}
+
+	days, ads, err := a.history(ctx, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]*AdGroupFatigue)
+	for id, ad := range ads {
+		creative := assessCreative(days[id], &options)
+		creative.AdID = id
+		creative.AdName = ad.AdName
+		creative.AdGroupID = ad.AdGroupID
+
+		group := groups[ad.AdGroupID]
+		if group == nil {
+			group = &AdGroupFatigue{AdGroupID: ad.AdGroupID}
+			groups[ad.AdGroupID] = group
+		}
+		group.Creatives = append(group.Creatives, creative)
+		if creative.Fatigued {
+			group.Fatigued++
+		}
+	}
+
+	report := &CreativeFatigueReport{Window: options.Window}
+	for _, group := range groups {
+		sort.Slice(group.Creatives, func(i, j int) bool {
+			if group.Creatives[i].Score != group.Creatives[j].Score {
+				return group.Creatives[i].Score > group.Creatives[j].Score
+			}
+			return group.Creatives[i].AdID < group.Creatives[j].AdID
+		})
+		report.AdGroups = append(report.AdGroups, *group)
+	}
+	sort.Slice(report.AdGroups, func(i, j int) bool {
+		return report.AdGroups[i].AdGroupID < report.AdGroups[j].AdGroupID
+	})
+	return report, nil
+}
+
+// history reports daily CTR inputs and frequency per ad over the window
+func (a *CreativeFatigueAnalyzer) history(ctx context.Context, req *CreativeFatigueRequest) (map[string][]creativeDay, map[string]ReportingMetrics, error) {
+	var filters []ReportingFilter
+	if len(req.AdGroupIDs) > 0 {
+		ids, err := json.Marshal(req.AdGroupIDs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal adgroup_ids: %w", err)
+		}
+		filters = append(filters, ReportingFilter{FieldName: "adgroup_ids", FilterType: "IN", FilterValue: string(ids)})
+	}
+
+	days := make(map[string][]creativeDay)
+	ads := make(map[string]ReportingMetrics)
+	for page := 1; ; page++ {
+		resp, err := a.client.Reporting().GetIntegratedReport(ctx, &ReportingRequest{
+			AdvertiserID: req.AdvertiserID,
+			ReportType:   models.ReportTypeBasic,
+			DataLevel:    models.DataLevelAd,
+			Dimensions:   []models.Dimension{models.DimensionAdID, models.DimensionStatTimeDay},
+			Metrics:      []models.Metric{models.MetricAdName, models.MetricAdGroupID, models.MetricImpressions, models.MetricClicks, models.MetricFrequency},
+			Filters:      filters,
+			StartDate:    req.StartDate,
+			EndDate:      req.EndDate,
+			Page:         page,
+			PageSize:     1000,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get ad performance: %w", err)
+		}
+		for _, row := range resp.Data.List {
+			id := row.Dimensions.AdID
+			if row.Metrics.Impressions <= 0 {
+				continue
+			}
+			ad := ads[id]
+			if row.Metrics.AdName != "" {
+				ad.AdName = row.Metrics.AdName
+			}
+			if row.Metrics.AdGroupID != "" {
+				ad.AdGroupID = row.Metrics.AdGroupID
+			} else if row.Dimensions.AdGroupID != "" {
+				ad.AdGroupID = row.Dimensions.AdGroupID
+			}
+			ads[id] = ad
+			days[id] = append(days[id], creativeDay{
+				date:        row.Dimensions.StatTimeDay,
+				impressions: float64(row.Metrics.Impressions),
+				clicks:      float64(row.Metrics.Clicks),
+				freq:        float64(row.Metrics.Frequency),
+			})
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			return days, ads, nil
+		}
+	}
+}
+
+// assessCreative compares the latest rolling window of an ad with its best one
+func assessCreative(days []creativeDay, req *CreativeFatigueRequest) CreativeFatigue {
+	creative := CreativeFatigue{Days: len(days)}
+	if len(days) <= req.Window {
+		creative.Error = fmt.Sprintf("only %d days with impressions, need more than the %d day window", len(days), req.Window)
+		return creative
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].date < days[j].date })
+
+	type window struct{ ctr, freq float64 }
+	var windows []window
+	for end := req.Window; end <= len(days); end++ {
+		var impressions, clicks, weightedFreq float64
+		for _, day := range days[end-req.Window : end] {
+			impressions += day.impressions
+			clicks += day.clicks
+			weightedFreq += day.freq * day.impressions
+		}
+		if impressions < req.MinImpressions {
+			continue
+		}
+		windows = append(windows, window{ctr: clicks / impressions, freq: weightedFreq / impressions})
+	}
+	if len(windows) < 2 {
+		creative.Error = fmt.Sprintf("fewer than two windows reached %g impressions", req.MinImpressions)
+		return creative
+	}
+
+	peak := windows[0]
+	for _, w := range windows[1:] {
+		if w.ctr > peak.ctr {
+			peak = w
+		}
+	}
+	current := windows[len(windows)-1]
+
+	creative.PeakCTR = peak.ctr
+	creative.CurrentCTR = current.ctr
+	creative.PeakFrequency = peak.freq
+	creative.CurrentFrequency = current.freq
+	if peak.ctr > 0 {
+		creative.CTRDrop = 1 - current.ctr/peak.ctr
+	}
+	if peak.freq > 0 {
+		creative.FrequencyRise = current.freq/peak.freq - 1
+	}
+
+	rise := 1.0
+	if req.MinFrequencyRise > 0 {
+		rise = math.Min(1, math.Max(0, creative.FrequencyRise)/req.MinFrequencyRise)
+	}
+	creative.Score = math.Max(0, creative.CTRDrop) * rise
+	creative.Fatigued = creative.CTRDrop >= req.MinCTRDrop && creative.FrequencyRise >= req.MinFrequencyRise
+	return creative
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestCreativeFatigueAnalyzer_Analyze(t *testing.T) {
+	var rows []string
+	for day := 0; day < 10; day++ {
+		date := fmt.Sprintf("2024-01-%02d", day+1)
+		rows = append(rows,
+			// a1 tires: CTR halves while frequency climbs
+			fmt.Sprintf(`{"dimensions":{"ad_id":"a1","stat_time_day":"%s"},"metrics":{"ad_name":"Hook A","adgroup_id":"g1","impressions":"10000","clicks":"%d","frequency":"%.2f"}}`,
+				date, 200-10*day, 1.2+0.2*float64(day)),
+			// a2 holds its CTR
+			fmt.Sprintf(`{"dimensions":{"ad_id":"a2","stat_time_day":"%s"},"metrics":{"adgroup_id":"g1","impressions":"10000","clicks":"150","frequency":"%.2f"}}`,
+				date, 1.2+0.2*float64(day)),
+			// a3 loses CTR at steady frequency, which is not fatigue
+			fmt.Sprintf(`{"dimensions":{"ad_id":"a3","stat_time_day":"%s"},"metrics":{"adgroup_id":"g2","impressions":"10000","clicks":"%d","frequency":"1.5"}}`,
+				date, 200-10*day))
+	}
+	rows = append(rows, `{"dimensions":{"ad_id":"a4","stat_time_day":"2024-01-10"},"metrics":{"adgroup_id":"g2","impressions":"5000","clicks":"50","frequency":"1.1"}}`)
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("data_level"); got != string(models.DataLevelAd) {
+			t.Errorf("data_level = %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"code":0,"data":{"list":[%s],"page_info":{"page":1,"total_page":1}}}`, strings.Join(rows, ","))
+	})
+
+	client := newTestClient(t, server)
+
+	report, err := NewCreativeFatigueAnalyzer(client).Analyze(context.Background(), &CreativeFatigueRequest{
+		AdvertiserID: "123",
+		StartDate:    "2024-01-01",
+		EndDate:      "2024-01-10",
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if len(report.AdGroups) != 2 || report.AdGroups[0].AdGroupID != "g1" {
+		t.Fatalf("adgroups = %+v", report.AdGroups)
+	}
+
+	g1 := report.AdGroups[0]
+	if g1.Fatigued != 1 || g1.Creatives[0].AdID != "a1" || g1.Creatives[1].AdID != "a2" {
+		t.Fatalf("g1 = %+v", g1)
+	}
+	a1 := g1.Creatives[0]
+	if !a1.Fatigued || a1.AdName != "Hook A" || math.Abs(a1.PeakCTR-0.019) > 1e-9 || math.Abs(a1.CTRDrop-(1-0.012/0.019)) > 1e-9 {
+		t.Errorf("a1 = %+v", a1)
+	}
+	if a1.FrequencyRise < 0.9 || a1.Score <= g1.Creatives[1].Score {
+		t.Errorf("a1 signals = %+v", a1)
+	}
+	if a2 := g1.Creatives[1]; a2.Fatigued || a2.CTRDrop != 0 {
+		t.Errorf("a2 = %+v", a2)
+	}
+
+	g2 := report.AdGroups[1]
+	if g2.Fatigued != 0 || len(g2.Creatives) != 2 {
+		t.Fatalf("g2 = %+v", g2)
+	}
+	for _, creative := range g2.Creatives {
+		switch creative.AdID {
+		case "a3":
+			if creative.CTRDrop < 0.2 || creative.Score != 0 {
+				t.Errorf("a3 = %+v", creative)
+			}
+		case "a4":
+			if creative.Error == "" {
+				t.Errorf("a4 = %+v, want an error for too little history", creative)
+			}
+		}
+	}
+}
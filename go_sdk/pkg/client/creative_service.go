@@ -1,10 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
 )
 
 // creativeService handles Creative related operations
@@ -33,11 +36,11 @@ type CreativePortfolioResponse struct {
 }
 
 type CreativePortfolioData struct {
-	PortfolioID string `json:"portfolio_id"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	CreateTime  string `json:"create_time"`
-	UpdateTime  string `json:"update_time"`
+	PortfolioID string      `json:"portfolio_id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	CreateTime  models.Time `json:"create_time"`
+	UpdateTime  models.Time `json:"update_time"`
 }
 
 // CreativePortfolioGetRequest represents the request for getting a creative portfolio
@@ -202,6 +205,53 @@ type ShareableLinkInfo struct {
 	ExpiryTime  string `json:"expiry_time"`
 }
 
+// VideoCaptionGenerateRequest represents the request for auto-generating video captions
+type VideoCaptionGenerateRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	VideoIDs     []string `json:"video_ids"`
+	Languages    []string `json:"languages,omitempty"`
+	Format       string   `json:"format,omitempty"` // SRT, VTT
+}
+
+// VideoCaptionGenerateResponse represents the response for auto-generating video captions
+type VideoCaptionGenerateResponse struct {
+	Code      int                      `json:"code"`
+	Message   string                   `json:"message"`
+	RequestID string                   `json:"request_id"`
+	Data      VideoCaptionGenerateData `json:"data"`
+}
+
+// VideoCaptionGenerateData lists the caption tasks created per video
+type VideoCaptionGenerateData struct {
+	Captions []VideoCaptionInfo `json:"captions"`
+}
+
+// VideoCaptionGetRequest represents the request for retrieving video captions
+type VideoCaptionGetRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	VideoIDs     []string `json:"video_ids"`
+	Language     string   `json:"language,omitempty"`
+}
+
+// VideoCaptionGetResponse represents the response for retrieving video captions
+type VideoCaptionGetResponse struct {
+	Code      int                      `json:"code"`
+	Message   string                   `json:"message"`
+	RequestID string                   `json:"request_id"`
+	Data      VideoCaptionGenerateData `json:"data"`
+}
+
+// VideoCaptionInfo represents a caption for a single video and language
+type VideoCaptionInfo struct {
+	CaptionID    string `json:"caption_id"`
+	VideoID      string `json:"video_id"`
+	Language     string `json:"language"`
+	Status       string `json:"status"` // PROCESSING, SUCCESS, FAILED
+	Format       string `json:"format,omitempty"`
+	CaptionURL   string `json:"caption_url,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
 // UploadImage uploads an image creative (interface method)
 func (s *creativeService) UploadImage(ctx context.Context, req *ImageUploadRequest) (*ImageUploadResponse, error) {
 	if req == nil {
@@ -211,14 +261,19 @@ func (s *creativeService) UploadImage(ctx context.Context, req *ImageUploadReque
 		return nil, fmt.Errorf("advertiser_id is required")
 	}
 
-	url := s.client.BuildURL("/creative/image/upload/", nil)
-	
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	fields := map[string]string{
+		"advertiser_id": req.AdvertiserID,
+		"upload_type":   "UPLOAD_BY_FILE",
+		"file_name":     req.ImageName,
+	}
+	file := MultipartFile{
+		FieldName:      "image_file",
+		FileName:       uploadFileName(req.ImageName, req.ImageType),
+		Reader:         bytes.NewReader(req.ImageData),
+		SignatureField: "image_signature",
 	}
 
-	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	resp, err := s.client.DoMultipartRequest(ctx, "/file/image/ad/upload/", fields, []MultipartFile{file}, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload image: %w", err)
 	}
@@ -240,14 +295,19 @@ func (s *creativeService) UploadVideo(ctx context.Context, req *VideoUploadReque
 		return nil, fmt.Errorf("advertiser_id is required")
 	}
 
-	url := s.client.BuildURL("/creative/video/upload/", nil)
-	
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	fields := map[string]string{
+		"advertiser_id": req.AdvertiserID,
+		"upload_type":   "UPLOAD_BY_FILE",
+		"file_name":     req.VideoName,
+	}
+	file := MultipartFile{
+		FieldName:      "video_file",
+		FileName:       uploadFileName(req.VideoName, req.VideoType),
+		Reader:         bytes.NewReader(req.VideoData),
+		SignatureField: "video_signature",
 	}
 
-	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	resp, err := s.client.DoMultipartRequest(ctx, "/file/video/ad/upload/", fields, []MultipartFile{file}, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload video: %w", err)
 	}
@@ -280,7 +340,10 @@ func (s *creativeService) GetCreatives(ctx context.Context, req *CreativeGetRequ
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/creative/get/", params)
+	url, err := s.client.BuildURL("/creative/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -307,7 +370,10 @@ func (s *creativeService) UpdateCreative(ctx context.Context, req *CreativeUpdat
 		return nil, fmt.Errorf("creative_id is required")
 	}
 
-	url := s.client.BuildURL("/creative/update/", nil)
+	url, err := s.client.BuildURL("/creative/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -339,7 +405,10 @@ func (s *creativeService) CreatePortfolio(ctx context.Context, req *CreativePort
 		return nil, fmt.Errorf("name is required")
 	}
 
-	url := s.client.BuildURL("/creative/portfolio/create/", nil)
+	url, err := s.client.BuildURL("/creative/portfolio/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -370,7 +439,10 @@ func (s *creativeService) GetPortfolio(ctx context.Context, req *CreativePortfol
 		"creative_portfolio_id": req.CreativePortfolioID,
 	}
 
-	url := s.client.BuildURL("/creative/portfolio/get/", params)
+	url, err := s.client.BuildURL("/creative/portfolio/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -402,7 +474,10 @@ func (s *creativeService) ListPortfolios(ctx context.Context, req *CreativePortf
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/creative/portfolio/list/", params)
+	url, err := s.client.BuildURL("/creative/portfolio/list/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -432,7 +507,10 @@ func (s *creativeService) ShareAssets(ctx context.Context, req *CreativeAssetSha
 		return nil, fmt.Errorf("target_advertiser_id is required")
 	}
 
-	url := s.client.BuildURL("/creative/asset/share/", nil)
+	url, err := s.client.BuildURL("/creative/asset/share/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -464,7 +542,10 @@ func (s *creativeService) DeleteAssets(ctx context.Context, req *CreativeAssetDe
 		return nil, fmt.Errorf("asset_ids is required")
 	}
 
-	url := s.client.BuildURL("/creative/asset/delete/", nil)
+	url, err := s.client.BuildURL("/creative/asset/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -496,7 +577,10 @@ func (s *creativeService) EditImage(ctx context.Context, req *CreativeImageEditR
 		return nil, fmt.Errorf("image_id is required")
 	}
 
-	url := s.client.BuildURL("/creative/image/edit/", nil)
+	url, err := s.client.BuildURL("/creative/image/edit/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -528,7 +612,10 @@ func (s *creativeService) GenerateSmartText(ctx context.Context, req *CreativeSm
 		return nil, fmt.Errorf("prompt is required")
 	}
 
-	url := s.client.BuildURL("/creative/smart_text/generate/", nil)
+	url, err := s.client.BuildURL("/creative/smart_text/generate/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -560,7 +647,10 @@ func (s *creativeService) CreateShareableLink(ctx context.Context, req *Creative
 		return nil, fmt.Errorf("asset_ids is required")
 	}
 
-	url := s.client.BuildURL("/creative/shareable_link/create/", nil)
+	url, err := s.client.BuildURL("/creative/shareable_link/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -579,3 +669,94 @@ func (s *creativeService) CreateShareableLink(ctx context.Context, req *Creative
 
 	return &response, nil
 }
+
+// GenerateVideoCaptions requests auto-generated captions for uploaded videos
+func (s *creativeService) GenerateVideoCaptions(ctx context.Context, req *VideoCaptionGenerateRequest) (*VideoCaptionGenerateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.VideoIDs) == 0 {
+		return nil, fmt.Errorf("video_ids is required")
+	}
+
+	url, err := s.client.BuildURL("/file/video/caption/create/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate video captions: %w", err)
+	}
+
+	var response VideoCaptionGenerateResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetVideoCaptions retrieves caption generation status and caption files
+func (s *creativeService) GetVideoCaptions(ctx context.Context, req *VideoCaptionGetRequest) (*VideoCaptionGetResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.VideoIDs) == 0 {
+		return nil, fmt.Errorf("video_ids is required")
+	}
+
+	videoIDs, err := json.Marshal(req.VideoIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal video_ids: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+		"video_ids":     string(videoIDs),
+	}
+	if req.Language != "" {
+		params["language"] = req.Language
+	}
+
+	url, err := s.client.BuildURL("/file/video/caption/get/", params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get video captions: %w", err)
+	}
+
+	var response VideoCaptionGetResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DownloadVideoCaption downloads a generated caption file
+func (s *creativeService) DownloadVideoCaption(ctx context.Context, caption *VideoCaptionInfo) ([]byte, error) {
+	if caption == nil {
+		return nil, fmt.Errorf("caption cannot be nil")
+	}
+	if caption.Status != "SUCCESS" || caption.CaptionURL == "" {
+		return nil, fmt.Errorf("caption %s is not ready (status %s)", caption.CaptionID, caption.Status)
+	}
+
+	data, err := s.client.downloadFile(ctx, caption.CaptionURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download video caption: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCreativeService_VideoCaptions(t *testing.T) {
+	var generated VideoCaptionGenerateRequest
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/file/video/caption/create/":
+			json.NewDecoder(r.Body).Decode(&generated)
+			writeJSON(w, `{"code":0,"data":{"captions":[{"caption_id":"cap1","video_id":"v1","language":"en","status":"PROCESSING"}]}}`)
+		case "/open_api/v1.3/file/video/caption/get/":
+			q := r.URL.Query()
+			if q.Get("video_ids") != `["v1"]` || q.Get("language") != "en" {
+				t.Errorf("query = %v", q)
+			}
+			writeJSON(w, `{"code":0,"data":{"captions":[{"caption_id":"cap1","video_id":"v1","language":"en","status":"SUCCESS","format":"SRT","caption_url":"http://`+r.Host+`/captions/cap1.srt"}]}}`)
+		case "/captions/cap1.srt":
+			if r.Header.Get("Access-Token") != "" {
+				t.Error("caption download sent the access token")
+			}
+			w.Write([]byte("1\n00:00:00,000 --> 00:00:02,000\nHello\n"))
+		case "/captions/missing.srt":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	created, err := client.Creative().GenerateVideoCaptions(ctx, &VideoCaptionGenerateRequest{AdvertiserID: "123", VideoIDs: []string{"v1"}, Languages: []string{"en"}, Format: "SRT"})
+	if err != nil || len(created.Data.Captions) != 1 || created.Data.Captions[0].Status != "PROCESSING" {
+		t.Fatalf("GenerateVideoCaptions() = %+v, %v", created, err)
+	}
+	if generated.AdvertiserID != "123" || generated.Format != "SRT" || len(generated.Languages) != 1 {
+		t.Errorf("generate body = %+v", generated)
+	}
+
+	// A caption still processing cannot be downloaded
+	if _, err := client.Creative().DownloadVideoCaption(ctx, &created.Data.Captions[0]); err == nil || !strings.Contains(err.Error(), "not ready") {
+		t.Errorf("DownloadVideoCaption() of a processing caption error = %v", err)
+	}
+
+	captions, err := client.Creative().GetVideoCaptions(ctx, &VideoCaptionGetRequest{AdvertiserID: "123", VideoIDs: []string{"v1"}, Language: "en"})
+	if err != nil || len(captions.Data.Captions) != 1 {
+		t.Fatalf("GetVideoCaptions() = %+v, %v", captions, err)
+	}
+	data, err := client.Creative().DownloadVideoCaption(ctx, &captions.Data.Captions[0])
+	if err != nil || !strings.Contains(string(data), "Hello") {
+		t.Errorf("DownloadVideoCaption() = %q, %v", data, err)
+	}
+
+	missing := VideoCaptionInfo{CaptionID: "cap2", Status: "SUCCESS", CaptionURL: server.URL + "/captions/missing.srt"}
+	if _, err := client.Creative().DownloadVideoCaption(ctx, &missing); err == nil || !strings.Contains(err.Error(), "HTTP 404") {
+		t.Errorf("DownloadVideoCaption() of a missing file error = %v", err)
+	}
+	if _, err := client.Creative().GenerateVideoCaptions(ctx, &VideoCaptionGenerateRequest{AdvertiserID: "123"}); err == nil {
+		t.Error("GenerateVideoCaptions() accepted a request without videos")
+	}
+	if _, err := client.Creative().GetVideoCaptions(ctx, &VideoCaptionGetRequest{VideoIDs: []string{"v1"}}); err == nil {
+		t.Error("GetVideoCaptions() accepted a request without an advertiser")
+	}
+}
@@ -0,0 +1,509 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Reference kinds reported by the CrossAccountCopier
+const (
+	ReferenceKindPixel    = "PIXEL"
+	ReferenceKindAudience = "AUDIENCE"
+	ReferenceKindIdentity = "IDENTITY"
+	ReferenceKindVideo    = "VIDEO"
+	ReferenceKindImage    = "IMAGE"
+)
+
+// referencePageSize is the page size used to list reference assets
+const referencePageSize = 100
+
+// Fields that the API populates and that must not be sent on create
+var copyReadOnlyFields = []string{
+	"advertiser_id", "campaign_id", "adgroup_id", "ad_id",
+	"create_time", "modify_time", "operation_status",
+	"primary_status", "secondary_status", "status",
+	"is_new_structure", "is_smart_performance_campaign",
+}
+
+// CrossAccountCopyRequest represents the request for copying campaign trees between advertisers
+type CrossAccountCopyRequest struct {
+	SourceAdvertiserID string
+	TargetAdvertiserID string
+	CampaignIDs        []string
+
+	// NameSuffix is appended to copied campaign, ad group and ad names
+	NameSuffix string
+
+	// KeepOperationStatus creates copies with the operation status of their
+	// source; by default every copy is created disabled
+	KeepOperationStatus bool
+}
+
+// UnmappedReference describes a source reference with no matching target asset
+type UnmappedReference struct {
+	Kind       string `json:"kind"`
+	SourceID   string `json:"source_id"`
+	SourceName string `json:"source_name,omitempty"`
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"entity_id"`
+}
+
+// CrossAccountCopyReport summarizes the result of a cross-account copy
+type CrossAccountCopyReport struct {
+	CampaignIDs map[string]string   `json:"campaign_ids"`
+	AdGroupIDs  map[string]string   `json:"adgroup_ids"`
+	AdIDs       map[string]string   `json:"ad_ids"`
+	Unmapped    []UnmappedReference `json:"unmapped,omitempty"`
+	Errors      []string            `json:"errors,omitempty"`
+}
+
+// CrossAccountCopier recreates campaign trees from one advertiser under another
+type CrossAccountCopier struct {
+	client *Client
+}
+
+// NewCrossAccountCopier creates a new CrossAccountCopier
+func NewCrossAccountCopier(client *Client) *CrossAccountCopier {
+	return &CrossAccountCopier{client: client}
+}
+
+// copyTarget describes how copied entities are created under the target
+type copyTarget struct {
+	advertiserID string
+	nameSuffix   string
+	keepStatus   bool
+}
+
+// referenceMap resolves source asset IDs to target asset IDs by name
+type referenceMap struct {
+	names   map[string]string // source ID -> source name
+	targets map[string]string // source ID -> target ID
+}
+
+// resolve returns the target ID for a source ID
+func (r *referenceMap) resolve(sourceID string) (string, bool) {
+	id, ok := r.targets[sourceID]
+	return id, ok
+}
+
+// newReferenceMap pairs source and target assets that share a name
+func newReferenceMap(source, target map[string]string) *referenceMap {
+	byName := make(map[string]string, len(target))
+	for id, name := range target {
+		byName[name] = id
+	}
+
+	ref := &referenceMap{names: source, targets: make(map[string]string)}
+	for id, name := range source {
+		if targetID, ok := byName[name]; ok {
+			ref.targets[id] = targetID
+		}
+	}
+	return ref
+}
+
+// Copy reads each campaign with its ad groups and ads from the source
+// advertiser and recreates them under the target advertiser, disabled unless
+// KeepOperationStatus is set. Pixels, custom audiences, identities, videos
+// and images are remapped to same-named target assets; any reference that
+// cannot be remapped is dropped and listed in the report.
+func (c *CrossAccountCopier) Copy(ctx context.Context, req *CrossAccountCopyRequest) (*CrossAccountCopyReport, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.SourceAdvertiserID == "" || req.TargetAdvertiserID == "" {
+		return nil, fmt.Errorf("source and target advertiser_id are required")
+	}
+	if len(req.CampaignIDs) == 0 {
+		return nil, fmt.Errorf("campaign_ids are required")
+	}
+
+	refs, err := c.buildReferenceMaps(ctx, req.SourceAdvertiserID, req.TargetAdvertiserID)
+	if err != nil {
+		return nil, err
+	}
+
+	target := copyTarget{
+		advertiserID: req.TargetAdvertiserID,
+		nameSuffix:   req.NameSuffix,
+		keepStatus:   req.KeepOperationStatus,
+	}
+	report := &CrossAccountCopyReport{
+		CampaignIDs: make(map[string]string),
+		AdGroupIDs:  make(map[string]string),
+		AdIDs:       make(map[string]string),
+	}
+
+	campaigns, err := c.listRaw(ctx, "/campaign/get/", req.SourceAdvertiserID, "campaign_ids", req.CampaignIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source campaigns: %w", err)
+	}
+
+	for _, campaign := range campaigns {
+		sourceID := rawString(campaign, "campaign_id")
+		entity := prepareCopy(campaign, target, "campaign_name")
+
+		targetID, err := c.createRaw(ctx, "/campaign/create/", entity, "campaign_id")
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("campaign %s: %v", sourceID, err))
+			continue
+		}
+		report.CampaignIDs[sourceID] = targetID
+
+		c.copyAdGroups(ctx, req.SourceAdvertiserID, target, refs, sourceID, targetID, report)
+	}
+
+	return report, nil
+}
+
+// copyAdGroups copies the ad groups and ads beneath a campaign
+func (c *CrossAccountCopier) copyAdGroups(ctx context.Context, sourceAdvertiserID string, target copyTarget, refs map[string]*referenceMap, sourceCampaignID, targetCampaignID string, report *CrossAccountCopyReport) {
+	adGroups, err := c.listRaw(ctx, "/adgroup/get/", sourceAdvertiserID, "campaign_ids", []string{sourceCampaignID})
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("campaign %s ad groups: %v", sourceCampaignID, err))
+		return
+	}
+
+	for _, adGroup := range adGroups {
+		targetID, ok := c.createAdGroup(ctx, refs, target, adGroup, targetCampaignID, report)
+		if ok {
+			c.copyAds(ctx, sourceAdvertiserID, target, refs, rawString(adGroup, "adgroup_id"), targetID, report)
+		}
+	}
+}
+
+// copyAds copies the ads beneath an ad group
+func (c *CrossAccountCopier) copyAds(ctx context.Context, sourceAdvertiserID string, target copyTarget, refs map[string]*referenceMap, sourceAdGroupID, targetAdGroupID string, report *CrossAccountCopyReport) {
+	ads, err := c.listRaw(ctx, "/ad/get/", sourceAdvertiserID, "adgroup_ids", []string{sourceAdGroupID})
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("ad group %s ads: %v", sourceAdGroupID, err))
+		return
+	}
+
+	for _, ad := range ads {
+		c.createAd(ctx, refs, target, ad, targetAdGroupID, report)
+	}
+}
+
+// createAdGroup recreates a source ad group under the target campaign,
+// remapping its pixel and audiences, and records the new ID in the report
+func (c *CrossAccountCopier) createAdGroup(ctx context.Context, refs map[string]*referenceMap, target copyTarget, adGroup map[string]interface{}, targetCampaignID string, report *CrossAccountCopyReport) (string, bool) {
+	sourceID := rawString(adGroup, "adgroup_id")
+	entity := prepareCopy(adGroup, target, "adgroup_name")
+	entity["campaign_id"] = targetCampaignID
+
+	report.Unmapped = append(report.Unmapped, remapScalar(entity, "pixel_id", refs[ReferenceKindPixel], ReferenceKindPixel, "ADGROUP", sourceID)...)
+	report.Unmapped = append(report.Unmapped, remapList(entity, "audience_ids", refs[ReferenceKindAudience], ReferenceKindAudience, "ADGROUP", sourceID)...)
+	report.Unmapped = append(report.Unmapped, remapList(entity, "excluded_audience_ids", refs[ReferenceKindAudience], ReferenceKindAudience, "ADGROUP", sourceID)...)
+
+	targetID, err := c.createRaw(ctx, "/adgroup/create/", entity, "adgroup_id")
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("ad group %s: %v", sourceID, err))
+		return "", false
+	}
+	report.AdGroupIDs[sourceID] = targetID
+	return targetID, true
+}
+
+// createAd recreates a source ad under the target ad group, remapping its
+// identity, pixel, videos and images
+func (c *CrossAccountCopier) createAd(ctx context.Context, refs map[string]*referenceMap, target copyTarget, ad map[string]interface{}, targetAdGroupID string, report *CrossAccountCopyReport) {
+	sourceID := rawString(ad, "ad_id")
+	creative := prepareCopy(ad, target, "ad_name")
+	status := creative["operation_status"]
+	delete(creative, "campaign_id")
+	delete(creative, "advertiser_id")
+	delete(creative, "operation_status")
+
+	report.Unmapped = append(report.Unmapped, remapScalar(creative, "identity_id", refs[ReferenceKindIdentity], ReferenceKindIdentity, "AD", sourceID)...)
+	report.Unmapped = append(report.Unmapped, remapScalar(creative, "tracking_pixel_id", refs[ReferenceKindPixel], ReferenceKindPixel, "AD", sourceID)...)
+	report.Unmapped = append(report.Unmapped, remapScalar(creative, "video_id", refs[ReferenceKindVideo], ReferenceKindVideo, "AD", sourceID)...)
+	report.Unmapped = append(report.Unmapped, remapList(creative, "image_ids", refs[ReferenceKindImage], ReferenceKindImage, "AD", sourceID)...)
+
+	entity := map[string]interface{}{
+		"advertiser_id":    target.advertiserID,
+		"adgroup_id":       targetAdGroupID,
+		"operation_status": status,
+		"creatives":        []interface{}{creative},
+	}
+
+	targetID, err := c.createRaw(ctx, "/ad/create/", entity, "ad_ids")
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("ad %s: %v", sourceID, err))
+		return
+	}
+	report.AdIDs[sourceID] = targetID
+}
+
+// buildReferenceMaps pairs pixels, audiences, identities, videos and images
+// across advertisers by name
+func (c *CrossAccountCopier) buildReferenceMaps(ctx context.Context, sourceID, targetID string) (map[string]*referenceMap, error) {
+	kinds := []string{ReferenceKindPixel, ReferenceKindAudience, ReferenceKindIdentity, ReferenceKindVideo, ReferenceKindImage}
+
+	refs := make(map[string]*referenceMap, len(kinds))
+	for _, kind := range kinds {
+		source, err := c.referenceNames(ctx, kind, sourceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load source %s references: %w", strings.ToLower(kind), err)
+		}
+		target, err := c.referenceNames(ctx, kind, targetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load target %s references: %w", strings.ToLower(kind), err)
+		}
+		refs[kind] = newReferenceMap(source, target)
+	}
+	return refs, nil
+}
+
+// pixelNames returns pixel names keyed by pixel ID
+func (c *CrossAccountCopier) pixelNames(ctx context.Context, advertiserID string) (map[string]string, error) {
+	names := make(map[string]string)
+	for page := 1; ; page++ {
+		resp, err := c.client.Pixel().List(ctx, &PixelGetRequest{AdvertiserID: advertiserID, Page: page, Size: referencePageSize})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+
+		for _, pixel := range resp.Data {
+			names[pixel.PixelID] = pixel.PixelName
+		}
+		// The pixel list has no page info; a short page is the last one
+		if len(resp.Data) < referencePageSize {
+			return names, nil
+		}
+	}
+}
+
+// audienceNames returns custom audience names keyed by audience ID
+func (c *CrossAccountCopier) audienceNames(ctx context.Context, advertiserID string) (map[string]string, error) {
+	names := make(map[string]string)
+	for page := 1; ; page++ {
+		resp, err := c.client.DMP().ListCustomAudiences(ctx, &CustomAudienceListRequest{AdvertiserID: advertiserID, Page: page, Size: referencePageSize})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+
+		for _, audience := range resp.Data {
+			names[audience.AudienceID] = audience.AudienceName
+		}
+		// The audience list has no page info; a short page is the last one
+		if len(resp.Data) < referencePageSize {
+			return names, nil
+		}
+	}
+}
+
+// identityNames returns identity display names keyed by identity ID
+func (c *CrossAccountCopier) identityNames(ctx context.Context, advertiserID string) (map[string]string, error) {
+	names := make(map[string]string)
+	for page := 1; ; page++ {
+		resp, err := c.client.Identity().List(ctx, &IdentityGetRequest{AdvertiserID: advertiserID, Page: page, PageSize: referencePageSize})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+
+		for _, identity := range resp.Data.IdentityList {
+			names[identity.IdentityID] = identity.DisplayName
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			return names, nil
+		}
+	}
+}
+
+// rawEnvelope is the common response envelope decoded without typed models
+type rawEnvelope struct {
+	Code      int             `json:"code"`
+	Message   string          `json:"message"`
+	RequestID string          `json:"request_id"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// listRaw pages through a get endpoint and returns each entity as a generic
+// map, filtered to ids unless ids is empty
+func (c *CrossAccountCopier) listRaw(ctx context.Context, endpoint, advertiserID, filterKey string, ids []string) ([]map[string]interface{}, error) {
+	var filtering []byte
+	if len(ids) > 0 {
+		var err error
+		if filtering, err = json.Marshal(map[string][]string{filterKey: ids}); err != nil {
+			return nil, fmt.Errorf("failed to marshal filtering: %w", err)
+		}
+	}
+
+	var entities []map[string]interface{}
+	for page := 1; ; page++ {
+		params := map[string]interface{}{
+			"advertiser_id": advertiserID,
+			"page":          page,
+			"page_size":     100,
+		}
+		if filtering != nil {
+			params["filtering"] = string(filtering)
+		}
+		url, err := c.client.BuildURL(endpoint, params)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.client.DoRequest(ctx, "GET", url, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var envelope rawEnvelope
+		if err := c.client.ParseResponse(resp, &envelope); err != nil {
+			return nil, err
+		}
+		if envelope.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", envelope.Code), envelope.Message, envelope.RequestID, resp.StatusCode)
+		}
+
+		var data struct {
+			List     []map[string]interface{} `json:"list"`
+			PageInfo models.PaginationInfo    `json:"page_info"`
+		}
+		if err := json.Unmarshal(envelope.Data, &data); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		entities = append(entities, data.List...)
+		if page >= data.PageInfo.TotalPage {
+			return entities, nil
+		}
+	}
+}
+
+// createRaw posts a generic entity to a create endpoint and returns the new ID
+func (c *CrossAccountCopier) createRaw(ctx context.Context, endpoint string, entity map[string]interface{}, idKey string) (string, error) {
+	body, err := json.Marshal(entity)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var envelope rawEnvelope
+	if err := c.client.ParseResponse(resp, &envelope); err != nil {
+		return "", err
+	}
+	if envelope.Code != 0 {
+		return "", models.NewAPIError(fmt.Sprintf("%d", envelope.Code), envelope.Message, envelope.RequestID, resp.StatusCode)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(envelope.Data, &data); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	switch id := data[idKey].(type) {
+	case string:
+		return id, nil
+	case []interface{}:
+		if len(id) > 0 {
+			return fmt.Sprintf("%v", id[0]), nil
+		}
+	}
+	return "", fmt.Errorf("response did not include %s", idKey)
+}
+
+// prepareCopy clones an entity for creation under the target advertiser,
+// disabled unless the target keeps the source status
+func prepareCopy(entity map[string]interface{}, target copyTarget, nameKey string) map[string]interface{} {
+	clone := make(map[string]interface{}, len(entity))
+	for k, v := range entity {
+		clone[k] = v
+	}
+	for _, field := range copyReadOnlyFields {
+		delete(clone, field)
+	}
+
+	clone["advertiser_id"] = target.advertiserID
+	clone["operation_status"] = string(models.OperationStatusDisable)
+	if status := rawString(entity, "operation_status"); target.keepStatus && status != "" {
+		clone["operation_status"] = status
+	}
+	if name, ok := clone[nameKey].(string); ok && target.nameSuffix != "" {
+		clone[nameKey] = name + target.nameSuffix
+	}
+	return clone
+}
+
+// remapScalar replaces a single reference field, dropping it when unmappable
+func remapScalar(entity map[string]interface{}, field string, refs *referenceMap, kind, entityType, entityID string) []UnmappedReference {
+	sourceID := rawString(entity, field)
+	if sourceID == "" {
+		return nil
+	}
+
+	if targetID, ok := refs.resolve(sourceID); ok {
+		entity[field] = targetID
+		return nil
+	}
+
+	delete(entity, field)
+	return []UnmappedReference{{
+		Kind:       kind,
+		SourceID:   sourceID,
+		SourceName: refs.names[sourceID],
+		EntityType: entityType,
+		EntityID:   entityID,
+	}}
+}
+
+// remapList replaces each ID in a reference list, dropping unmappable IDs
+func remapList(entity map[string]interface{}, field string, refs *referenceMap, kind, entityType, entityID string) []UnmappedReference {
+	values, ok := entity[field].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var unmapped []UnmappedReference
+	mapped := make([]string, 0, len(values))
+	for _, value := range values {
+		sourceID := fmt.Sprintf("%v", value)
+		if targetID, ok := refs.resolve(sourceID); ok {
+			mapped = append(mapped, targetID)
+			continue
+		}
+		unmapped = append(unmapped, UnmappedReference{
+			Kind:       kind,
+			SourceID:   sourceID,
+			SourceName: refs.names[sourceID],
+			EntityType: entityType,
+			EntityID:   entityID,
+		})
+	}
+
+	entity[field] = mapped
+	return unmapped
+}
+
+// rawString reads a string-like field from a generic entity
+func rawString(entity map[string]interface{}, field string) string {
+	switch v := entity[field].(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%.0f", v)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
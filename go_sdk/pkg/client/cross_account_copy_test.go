@@ -0,0 +1,262 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestCrossAccountCopier_ExportImport(t *testing.T) {
+	var mu sync.Mutex
+	var created []map[string]interface{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		source := r.URL.Query().Get("advertiser_id") == "src"
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/campaign/get/"):
+			_, _ = w.Write([]byte(`{"code":0,"data":{"list":[{"campaign_id":"c1","advertiser_id":"src","campaign_name":"Spring","objective_type":"TRAFFIC","budget":100,"status":"ENABLE"}],"page_info":{"total_page":1}}}`))
+		case strings.HasSuffix(r.URL.Path, "/adgroup/get/"):
+			_, _ = w.Write([]byte(`{"code":0,"data":{"list":[{"adgroup_id":"g1","campaign_id":"c1","adgroup_name":"US","pixel_id":"px1","audience_ids":["au1","au2"]}],"page_info":{"total_page":1}}}`))
+		case strings.HasSuffix(r.URL.Path, "/ad/get/"):
+			_, _ = w.Write([]byte(`{"code":0,"data":{"list":[{"ad_id":"a1","adgroup_id":"g1","ad_name":"Video","identity_id":"id1","video_id":"v1"}],"page_info":{"total_page":1}}}`))
+		case strings.HasSuffix(r.URL.Path, "/pixel/list/"):
+			if source {
+				_, _ = w.Write([]byte(`{"code":0,"data":[{"pixel_id":"px1","pixel_name":"Main"},{"pixel_id":"px9","pixel_name":"Unused"}]}`))
+			} else {
+				_, _ = w.Write([]byte(`{"code":0,"data":[{"pixel_id":"px-t","pixel_name":"Main"}]}`))
+			}
+		case strings.HasSuffix(r.URL.Path, "/dmp/custom_audience/list/"):
+			if source {
+				_, _ = w.Write([]byte(`{"code":0,"data":[{"audience_id":"au1","audience_name":"Buyers"},{"audience_id":"au2","audience_name":"Visitors"}]}`))
+			} else {
+				_, _ = w.Write([]byte(`{"code":0,"data":[{"audience_id":"au-t","audience_name":"Buyers"}]}`))
+			}
+		case strings.HasSuffix(r.URL.Path, "/identity/get/"):
+			id := "id-t"
+			if source {
+				id = "id1"
+			}
+			fmt.Fprintf(w, `{"code":0,"data":{"identity_list":[{"identity_id":"%s","display_name":"Brand"}]}}`, id)
+		case strings.HasSuffix(r.URL.Path, "/creative/get/"):
+			id := "v-t"
+			if source {
+				id = "v1"
+			}
+			fmt.Fprintf(w, `{"code":0,"data":{"creatives":[{"creative_id":"%s","creative_name":"spring.mp4"}],"page_info":{"total_page":1}}}`, id)
+		case strings.HasSuffix(r.URL.Path, "/create/"):
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			created = append(created, body)
+			mu.Unlock()
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/campaign/create/"):
+				_, _ = w.Write([]byte(`{"code":0,"data":{"campaign_id":"c-new"}}`))
+			case strings.HasSuffix(r.URL.Path, "/adgroup/create/"):
+				_, _ = w.Write([]byte(`{"code":0,"data":{"adgroup_id":"g-new"}}`))
+			default:
+				_, _ = w.Write([]byte(`{"code":0,"data":{"ad_ids":["a-new"]}}`))
+			}
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+	copier := NewCrossAccountCopier(client)
+
+	snapshot, err := copier.Export(context.Background(), "src")
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if len(snapshot.Campaigns) != 1 || len(snapshot.Campaigns[0].AdGroups) != 1 || len(snapshot.Campaigns[0].AdGroups[0].Ads) != 1 {
+		t.Fatalf("snapshot tree = %+v", snapshot.Campaigns)
+	}
+	if _, ok := snapshot.References[ReferenceKindPixel]["px9"]; ok || snapshot.References[ReferenceKindVideo]["v1"] != "spring.mp4" {
+		t.Errorf("references = %v", snapshot.References)
+	}
+
+	var buf bytes.Buffer
+	if err := snapshot.WriteYAML(&buf); err != nil {
+		t.Fatalf("WriteYAML() error = %v", err)
+	}
+	restored, err := ReadCampaignSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("ReadCampaignSnapshot() error = %v", err)
+	}
+
+	report, err := copier.Import(context.Background(), restored, &SnapshotImportRequest{TargetAdvertiserID: "dst", NameSuffix: " (copy)"})
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if len(report.Errors) != 0 || report.CampaignIDs["c1"] != "c-new" || report.AdGroupIDs["g1"] != "g-new" || report.AdIDs["a1"] != "a-new" {
+		t.Fatalf("report = %+v", report)
+	}
+	if len(report.Unmapped) != 1 || report.Unmapped[0].SourceID != "au2" || report.Unmapped[0].SourceName != "Visitors" {
+		t.Errorf("unmapped = %+v", report.Unmapped)
+	}
+
+	if len(created) != 3 {
+		t.Fatalf("created %d entities", len(created))
+	}
+	campaign, adGroup := created[0], created[1]
+	if campaign["advertiser_id"] != "dst" || campaign["campaign_name"] != "Spring (copy)" || campaign["campaign_id"] != nil || campaign["status"] != nil {
+		t.Errorf("campaign create = %v", campaign)
+	}
+	if adGroup["campaign_id"] != "c-new" || adGroup["pixel_id"] != "px-t" || fmt.Sprint(adGroup["audience_ids"]) != "[au-t]" {
+		t.Errorf("adgroup create = %v", adGroup)
+	}
+	creative := created[2]["creatives"].([]interface{})[0].(map[string]interface{})
+	if created[2]["adgroup_id"] != "g-new" || creative["identity_id"] != "id-t" || creative["video_id"] != "v-t" {
+		t.Errorf("ad create = %v", created[2])
+	}
+}
+
+// newCrossAccountCopyClient creates a client of a server with a source
+// advertiser "src", whose references span several pages, and a target
+// advertiser "dst". Create bodies are recorded by path.
+func newCrossAccountCopyClient(t *testing.T, created map[string]map[string]interface{}, mu *sync.Mutex) *Client {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		source := query.Get("advertiser_id") == "src"
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/campaign/get/"):
+			writeJSON(w, `{"code":0,"data":{"list":[{"campaign_id":"c1","campaign_name":"Spring","operation_status":"ENABLE"}],"page_info":{"total_page":1}}}`)
+		case strings.HasSuffix(r.URL.Path, "/adgroup/get/"):
+			writeJSON(w, `{"code":0,"data":{"list":[{"adgroup_id":"g1","campaign_id":"c1","adgroup_name":"US","operation_status":"ENABLE","pixel_id":"px1","audience_ids":["au1","au2"]}],"page_info":{"total_page":1}}}`)
+		case strings.HasSuffix(r.URL.Path, "/ad/get/"):
+			writeJSON(w, `{"code":0,"data":{"list":[{"ad_id":"a1","adgroup_id":"g1","ad_name":"Video","operation_status":"ENABLE","identity_id":"id1","video_id":"v1","image_ids":["im1"]}],"page_info":{"total_page":1}}}`)
+		case strings.HasSuffix(r.URL.Path, "/pixel/list/"):
+			switch {
+			case !source:
+				writeJSON(w, `{"code":0,"data":[{"pixel_id":"px-t","pixel_name":"Main"}]}`)
+			case query.Get("page") == "1":
+				// A full page, so the pixel on the next page must be fetched
+				pixels := make([]string, referencePageSize)
+				for i := range pixels {
+					pixels[i] = fmt.Sprintf(`{"pixel_id":"f%d","pixel_name":"Filler %d"}`, i, i)
+				}
+				writeJSON(w, `{"code":0,"data":[`+strings.Join(pixels, ",")+`]}`)
+			default:
+				writeJSON(w, `{"code":0,"data":[{"pixel_id":"px1","pixel_name":"Main"}]}`)
+			}
+		case strings.HasSuffix(r.URL.Path, "/dmp/custom_audience/list/"):
+			if source {
+				writeJSON(w, `{"code":0,"data":[{"audience_id":"au1","audience_name":"Buyers"},{"audience_id":"au2","audience_name":"Visitors"}]}`)
+			} else {
+				writeJSON(w, `{"code":0,"data":[{"audience_id":"au-t","audience_name":"Buyers"}]}`)
+			}
+		case strings.HasSuffix(r.URL.Path, "/identity/get/"):
+			switch {
+			case !source:
+				writeJSON(w, `{"code":0,"data":{"identity_list":[{"identity_id":"id-t","display_name":"Brand"}],"page_info":{"total_page":1}}}`)
+			case query.Get("page") == "1":
+				writeJSON(w, `{"code":0,"data":{"identity_list":[{"identity_id":"id0","display_name":"Other"}],"page_info":{"total_page":2}}}`)
+			default:
+				writeJSON(w, `{"code":0,"data":{"identity_list":[{"identity_id":"id1","display_name":"Brand"}],"page_info":{"total_page":2}}}`)
+			}
+		case strings.HasSuffix(r.URL.Path, "/creative/get/"):
+			switch {
+			case query.Get("creative_type") == ReferenceKindImage && source:
+				writeJSON(w, `{"code":0,"data":{"creatives":[{"creative_id":"im1","creative_name":"banner.png"}],"page_info":{"total_page":1}}}`)
+			case query.Get("creative_type") == ReferenceKindImage:
+				writeJSON(w, `{"code":0,"data":{"creatives":[],"page_info":{"total_page":1}}}`)
+			case source:
+				writeJSON(w, `{"code":0,"data":{"creatives":[{"creative_id":"v1","creative_name":"spring.mp4"}],"page_info":{"total_page":1}}}`)
+			default:
+				writeJSON(w, `{"code":0,"data":{"creatives":[{"creative_id":"v-t","creative_name":"spring.mp4"}],"page_info":{"total_page":1}}}`)
+			}
+		case strings.HasSuffix(r.URL.Path, "/create/"):
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			created[r.URL.Path] = body
+			mu.Unlock()
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/campaign/create/"):
+				writeJSON(w, `{"code":0,"data":{"campaign_id":"c-new"}}`)
+			case strings.HasSuffix(r.URL.Path, "/adgroup/create/"):
+				writeJSON(w, `{"code":0,"data":{"adgroup_id":"g-new"}}`)
+			default:
+				writeJSON(w, `{"code":0,"data":{"ad_ids":["a-new"]}}`)
+			}
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	return newTestClient(t, server)
+}
+
+func TestCrossAccountCopier_Copy(t *testing.T) {
+	var mu sync.Mutex
+	created := make(map[string]map[string]interface{})
+	copier := NewCrossAccountCopier(newCrossAccountCopyClient(t, created, &mu))
+	req := &CrossAccountCopyRequest{SourceAdvertiserID: "src", TargetAdvertiserID: "dst", CampaignIDs: []string{"c1"}, NameSuffix: " (copy)"}
+
+	report, err := copier.Copy(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if report.CampaignIDs["c1"] != "c-new" || report.AdGroupIDs["g1"] != "g-new" || report.AdIDs["a1"] != "a-new" || len(report.Errors) > 0 {
+		t.Fatalf("report = %+v", report)
+	}
+
+	campaign := created["/open_api/v1.3/campaign/create/"]
+	if campaign["operation_status"] != "DISABLE" || campaign["advertiser_id"] != "dst" || campaign["campaign_name"] != "Spring (copy)" {
+		t.Errorf("campaign create = %v", campaign)
+	}
+	adGroup := created["/open_api/v1.3/adgroup/create/"]
+	if adGroup["operation_status"] != "DISABLE" || adGroup["pixel_id"] != "px-t" || fmt.Sprint(adGroup["audience_ids"]) != "[au-t]" {
+		t.Errorf("ad group create = %v", adGroup)
+	}
+	ad := created["/open_api/v1.3/ad/create/"]
+	creative := ad["creatives"].([]interface{})[0].(map[string]interface{})
+	if ad["operation_status"] != "DISABLE" || creative["operation_status"] != nil {
+		t.Errorf("ad create status = %v, creative status = %v", ad["operation_status"], creative["operation_status"])
+	}
+	if creative["identity_id"] != "id-t" || creative["video_id"] != "v-t" || fmt.Sprint(creative["image_ids"]) != "[]" {
+		t.Errorf("ad creative = %v", creative)
+	}
+
+	unmapped := make(map[string]string)
+	for _, ref := range report.Unmapped {
+		unmapped[ref.Kind] = ref.SourceID + " " + ref.SourceName
+	}
+	if len(report.Unmapped) != 2 || unmapped[ReferenceKindAudience] != "au2 Visitors" || unmapped[ReferenceKindImage] != "im1 banner.png" {
+		t.Errorf("unmapped = %+v", report.Unmapped)
+	}
+
+	req.KeepOperationStatus = true
+	if _, err := copier.Copy(context.Background(), req); err != nil {
+		t.Fatalf("Copy() keeping status error = %v", err)
+	}
+	for path, body := range created {
+		if body["operation_status"] != "ENABLE" {
+			t.Errorf("%s operation_status = %v, want the source status", path, body["operation_status"])
+		}
+	}
+}
+
+func TestCrossAccountCopier_CopyReferenceError(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/pixel/list/") {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		writeJSON(w, `{"code":40001,"message":"No permission","request_id":"req1"}`)
+	})
+	copier := NewCrossAccountCopier(newTestClient(t, server))
+
+	_, err := copier.Copy(context.Background(), &CrossAccountCopyRequest{SourceAdvertiserID: "src", TargetAdvertiserID: "dst", CampaignIDs: []string{"c1"}})
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "40001" {
+		t.Errorf("Copy() error = %v, want API error 40001", err)
+	}
+}
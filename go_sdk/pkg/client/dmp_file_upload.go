@@ -0,0 +1,127 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+// Custom audience file calculate types, naming the identifier on each line
+// of an uploaded file
+const (
+	CalculateTypeEmailSHA256 = "EMAIL_SHA256"
+	CalculateTypePhoneSHA256 = "PHONE_SHA256"
+	CalculateTypeIDFASHA256  = "IDFA_SHA256"
+	CalculateTypeGAIDSHA256  = "GAID_SHA256"
+)
+
+// CustomAudienceFileMaxBytes is the largest custom audience file the API
+// accepts; larger uploads are split into several files
+const CustomAudienceFileMaxBytes = 50 * 1024 * 1024
+
+// audienceIdentifierNormalizers put raw identifiers into the form TikTok
+// hashes them in, reporting false for values that are not valid identifiers
+var audienceIdentifierNormalizers = map[string]func(value, countryCode string) (string, bool){
+	CalculateTypeEmailSHA256: func(value, _ string) (string, bool) {
+		email := utils.NormalizeEmail(value)
+		return email, strings.Contains(email, "@")
+	},
+	CalculateTypePhoneSHA256: utils.NormalizePhoneE164,
+	CalculateTypeIDFASHA256: func(value, _ string) (string, bool) {
+		return strings.ToUpper(value), true
+	},
+	CalculateTypeGAIDSHA256: func(value, _ string) (string, bool) {
+		return strings.ToLower(value), true
+	},
+}
+
+// CustomAudienceFileUploadResult lists the files a custom audience upload
+// was split into
+type CustomAudienceFileUploadResult struct {
+	// FileIDs has the file_id of each uploaded chunk, in order
+	FileIDs []string `json:"file_ids"`
+
+	// Identifiers counts the unique hashed identifiers uploaded
+	Identifiers int `json:"identifiers"`
+
+	// Skipped counts lines that were blank, duplicates or not valid
+	// identifiers
+	Skipped int `json:"skipped"`
+}
+
+// hashAudienceFile normalizes and SHA-256 hashes the identifier on each line
+// of data, leaving lines that are already digests as they are. Duplicates
+// and invalid lines are dropped.
+func hashAudienceFile(data []byte, calculateType, countryCode string) (hashed []string, skipped int, err error) {
+	normalize := audienceIdentifierNormalizers[calculateType]
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		value := strings.TrimSpace(scanner.Text())
+		if digest := strings.ToLower(value); utils.IsSHA256Hex(digest) {
+			value = digest
+		} else {
+			normalized, ok := normalize(value, countryCode)
+			if !ok || normalized == "" {
+				skipped++
+				continue
+			}
+			value = utils.HashSHA256(normalized)
+		}
+		if seen[value] {
+			skipped++
+			continue
+		}
+		seen[value] = true
+		hashed = append(hashed, value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read file_data: %w", err)
+	}
+	return hashed, skipped, nil
+}
+
+// chunkAudienceFile joins hashed identifiers into files of at most maxBytes
+func chunkAudienceFile(hashed []string, maxBytes int) [][]byte {
+	var chunks [][]byte
+	var chunk bytes.Buffer
+	for _, value := range hashed {
+		if chunk.Len()+len(value)+1 > maxBytes {
+			chunks = append(chunks, bytes.Clone(chunk.Bytes()))
+			chunk.Reset()
+		}
+		chunk.WriteString(value)
+		chunk.WriteByte('\n')
+	}
+	if chunk.Len() > 0 {
+		chunks = append(chunks, chunk.Bytes())
+	}
+	return chunks
+}
+
+// Validate checks the advertiser, calculate type, file and chunk size
+func (r *CustomAudienceFileUploadRequest) Validate() error {
+	if r.AdvertiserID == "" {
+		return models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+	if _, ok := audienceIdentifierNormalizers[r.CalculateType]; !ok {
+		return models.NewValidationError("calculate_type", fmt.Sprintf("unsupported calculate_type %q; use EMAIL_SHA256, PHONE_SHA256, IDFA_SHA256 or GAID_SHA256", r.CalculateType))
+	}
+	if len(r.FileData) == 0 {
+		return models.NewValidationError("file_data", "file_data is required")
+	}
+	if r.FileType != "" && r.FileType != "TXT" && r.FileType != "CSV" {
+		return models.NewValidationError("file_type", "file_type must be TXT or CSV")
+	}
+	if r.ChunkBytes != 0 && (r.ChunkBytes < 65 || r.ChunkBytes > CustomAudienceFileMaxBytes) {
+		// 65 bytes fit one hex SHA-256 digest and its newline
+		return models.NewValidationError("chunk_bytes", fmt.Sprintf("chunk_bytes must be between 65 and %d", CustomAudienceFileMaxBytes))
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+func TestUploadCustomAudienceFile(t *testing.T) {
+	var uploads []string
+	var files [][]byte
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("ParseMultipartForm() error = %v", err)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("FormFile() error = %v", err)
+		}
+		data, _ := io.ReadAll(file)
+		if sum := md5.Sum(data); r.FormValue("file_signature") != hex.EncodeToString(sum[:]) {
+			t.Errorf("file_signature = %s", r.FormValue("file_signature"))
+		}
+		files = append(files, data)
+		uploads = append(uploads, r.FormValue("calculate_type")+" "+header.Filename)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"code":0,"data":{"file_id":"f%d"}}`, len(files))
+	})
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	emails := "email\n Jane@Example.com \njane@example.com\n\n" + utils.HashSHA256("bob@example.com") + "\nnot-an-email\n"
+	result, err := client.DMP().UploadCustomAudienceFile(ctx, &CustomAudienceFileUploadRequest{
+		AdvertiserID:  "123",
+		CalculateType: CalculateTypeEmailSHA256,
+		FileData:      []byte(emails),
+		FileName:      "customers.txt",
+	})
+	if err != nil {
+		t.Fatalf("UploadCustomAudienceFile() error = %v", err)
+	}
+	if !reflect.DeepEqual(result.FileIDs, []string{"f1"}) || result.Identifiers != 2 || result.Skipped != 4 {
+		t.Errorf("result = %+v", result)
+	}
+	if want := utils.HashSHA256("jane@example.com") + "\n" + utils.HashSHA256("bob@example.com") + "\n"; string(files[0]) != want {
+		t.Errorf("file = %q, want %q", files[0], want)
+	}
+
+	uploads, files = nil, nil
+	result, err = client.DMP().UploadCustomAudienceFile(ctx, &CustomAudienceFileUploadRequest{
+		AdvertiserID:     "123",
+		CalculateType:    CalculateTypePhoneSHA256,
+		FileData:         []byte("+1 (555) 010-2000\n555-010-2001\n555-010-2002\n"),
+		PhoneCountryCode: "1",
+		ChunkBytes:       130,
+	})
+	if err != nil {
+		t.Fatalf("UploadCustomAudienceFile(phones) error = %v", err)
+	}
+	if !reflect.DeepEqual(result.FileIDs, []string{"f1", "f2"}) || result.Identifiers != 3 {
+		t.Errorf("result = %+v", result)
+	}
+	if want := []string{"PHONE_SHA256 custom_audience_1.txt", "PHONE_SHA256 custom_audience_2.txt"}; !reflect.DeepEqual(uploads, want) {
+		t.Errorf("uploads = %v, want %v", uploads, want)
+	}
+	if !strings.HasPrefix(string(files[0]), utils.HashSHA256("+15550102000")+"\n") || len(files[1]) != 65 {
+		t.Errorf("files = %q", files)
+	}
+
+	for name, req := range map[string]*CustomAudienceFileUploadRequest{
+		"calculate type": {AdvertiserID: "123", CalculateType: "EMAIL", FileData: []byte("a@b.c")},
+		"chunk size":     {AdvertiserID: "123", CalculateType: CalculateTypeEmailSHA256, FileData: []byte("a@b.c"), ChunkBytes: 10},
+		"no identifiers": {AdvertiserID: "123", CalculateType: CalculateTypePhoneSHA256, FileData: []byte("555-0100\n")},
+	} {
+		var validationErr models.ValidationError
+		if _, err := client.DMP().UploadCustomAudienceFile(ctx, req); !errors.As(err, &validationErr) {
+			t.Errorf("%s: error = %v, want validation error", name, err)
+		}
+	}
+}
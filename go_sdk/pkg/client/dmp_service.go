@@ -1,20 +1,27 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
 )
 
 // DMPService handles Data Management Platform operations (Custom Audiences)
 type DMPService struct {
-	client *Client
+	client      *Client
+	memberStore AudienceMemberStore
 }
 
 // NewDMPService creates a new DMPService
 func NewDMPService(client *Client) *DMPService {
-	return &DMPService{client: client}
+	return &DMPService{
+		client:      client,
+		memberStore: NewMemoryAudienceMemberStore(),
+	}
 }
 
 // CustomAudienceCreateRequest represents the request for creating a custom audience
@@ -98,19 +105,19 @@ type CustomAudienceDeleteRequest struct {
 
 // CustomAudienceData represents custom audience information
 type CustomAudienceData struct {
-	AudienceID      string                 `json:"audience_id"`
-	AudienceName    string                 `json:"audience_name"`
-	AudienceType    string                 `json:"audience_type"`
-	Description     string                 `json:"description"`
-	Size            int64                  `json:"size"`
-	Status          string                 `json:"status"`
-	RetentionDays   int                    `json:"retention_days"`
-	ShareToBC       bool                   `json:"share_to_bc"`
-	CreateTime      string                 `json:"create_time"`
-	UpdateTime      string                 `json:"update_time"`
-	LastSyncTime    string                 `json:"last_sync_time"`
-	Rules           []AudienceRule         `json:"rules"`
-	CustomData      map[string]interface{} `json:"custom_data"`
+	AudienceID    string                 `json:"audience_id"`
+	AudienceName  string                 `json:"audience_name"`
+	AudienceType  string                 `json:"audience_type"`
+	Description   string                 `json:"description"`
+	Size          int64                  `json:"size"`
+	Status        string                 `json:"status"`
+	RetentionDays int                    `json:"retention_days"`
+	ShareToBC     bool                   `json:"share_to_bc"`
+	CreateTime    models.Time            `json:"create_time"`
+	UpdateTime    models.Time            `json:"update_time"`
+	LastSyncTime  string                 `json:"last_sync_time"`
+	Rules         []AudienceRule         `json:"rules"`
+	CustomData    map[string]interface{} `json:"custom_data"`
 }
 
 // CustomAudienceResponse represents the response from custom audience operations
@@ -152,7 +159,10 @@ func (s *DMPService) CreateCustomAudience(ctx context.Context, req *CustomAudien
 		return nil, fmt.Errorf("audience_type is required")
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/create/", nil)
+	url, err := s.client.BuildURL("/dmp/custom_audience/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -191,7 +201,10 @@ func (s *DMPService) GetCustomAudience(ctx context.Context, req *CustomAudienceG
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/get/", params)
+	url, err := s.client.BuildURL("/dmp/custom_audience/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -219,7 +232,10 @@ func (s *DMPService) UpdateCustomAudience(ctx context.Context, req *CustomAudien
 		return nil, fmt.Errorf("audience_id is required")
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/update/", nil)
+	url, err := s.client.BuildURL("/dmp/custom_audience/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -252,7 +268,10 @@ func (s *DMPService) DeleteCustomAudience(ctx context.Context, req *CustomAudien
 		return nil, fmt.Errorf("audience_id is required")
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/delete/", nil)
+	url, err := s.client.BuildURL("/dmp/custom_audience/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -302,7 +321,10 @@ func (s *DMPService) CreateLookalikeAudience(ctx context.Context, req *Lookalike
 		return nil, fmt.Errorf("country_code is required")
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/lookalike/create/", nil)
+	url, err := s.client.BuildURL("/dmp/custom_audience/lookalike/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -342,7 +364,10 @@ func (s *DMPService) ListCustomAudiences(ctx context.Context, req *CustomAudienc
 		params["audience_type"] = req.AudienceType
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/list/", params)
+	url, err := s.client.BuildURL("/dmp/custom_audience/list/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -357,39 +382,77 @@ func (s *DMPService) ListCustomAudiences(ctx context.Context, req *CustomAudienc
 	return &response, nil
 }
 
-// UploadCustomAudienceFile uploads a file for custom audience creation
-func (s *DMPService) UploadCustomAudienceFile(ctx context.Context, req *CustomAudienceFileUploadRequest) (*CustomAudienceFileUploadResponse, error) {
+// UploadCustomAudienceFile normalizes and SHA-256 hashes the identifiers in
+// a customer file and uploads them, split into files of at most
+// ChunkBytes. Apply each returned file ID to add its identifiers to an
+// audience. When a chunk fails, the file IDs uploaded before it are
+// returned with the error.
+func (s *DMPService) UploadCustomAudienceFile(ctx context.Context, req *CustomAudienceFileUploadRequest) (*CustomAudienceFileUploadResult, error) {
 	if req == nil {
 		return nil, fmt.Errorf("request cannot be nil")
 	}
-	if req.AdvertiserID == "" {
-		return nil, fmt.Errorf("advertiser_id is required")
-	}
-	if len(req.FileData) == 0 {
-		return nil, fmt.Errorf("file_data is required")
-	}
-	if req.FileType == "" {
-		return nil, fmt.Errorf("file_type is required")
-	}
-
-	url := s.client.BuildURL("/dmp/custom_audience/file/upload/", nil)
-	
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	if err := req.Validate(); err != nil {
+		return nil, err
 	}
 
-	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	hashed, skipped, err := hashAudienceFile(req.FileData, req.CalculateType, req.PhoneCountryCode)
 	if err != nil {
-		return nil, fmt.Errorf("failed to upload custom audience file: %w", err)
-	}
-
-	var response CustomAudienceFileUploadResponse
-	if err := s.client.ParseResponse(resp, &response); err != nil {
 		return nil, err
 	}
-
-	return &response, nil
+	if len(hashed) == 0 {
+		return nil, models.NewValidationError("file_data", fmt.Sprintf("file_data has no valid %s identifiers", req.CalculateType))
+	}
+	chunkBytes := req.ChunkBytes
+	if chunkBytes == 0 {
+		chunkBytes = CustomAudienceFileMaxBytes
+	}
+	fileType := req.FileType
+	if fileType == "" {
+		fileType = "TXT"
+	}
+
+	base := req.FileName
+	if ext := "." + strings.ToLower(fileType); strings.HasSuffix(strings.ToLower(base), ext) {
+		base = base[:len(base)-len(ext)]
+	}
+	if base == "" {
+		base = "custom_audience"
+	}
+
+	result := &CustomAudienceFileUploadResult{Identifiers: len(hashed), Skipped: skipped}
+	chunks := chunkAudienceFile(hashed, chunkBytes)
+	for i, chunk := range chunks {
+		name := base
+		if len(chunks) > 1 {
+			name = fmt.Sprintf("%s_%d", base, i+1)
+		}
+		fields := map[string]string{
+			"advertiser_id":  req.AdvertiserID,
+			"calculate_type": req.CalculateType,
+		}
+		file := MultipartFile{
+			FieldName:      "file",
+			FileName:       uploadFileName(name, fileType),
+			ContentType:    "text/plain",
+			Reader:         bytes.NewReader(chunk),
+			SignatureField: "file_signature",
+		}
+
+		resp, err := s.client.DoMultipartRequest(ctx, "/dmp/custom_audience/file/upload/", fields, []MultipartFile{file}, nil)
+		if err != nil {
+			return result, fmt.Errorf("failed to upload custom audience file (chunk %d of %d): %w", i+1, len(chunks), err)
+		}
+		var response CustomAudienceFileUploadResponse
+		if err := s.client.ParseResponse(resp, &response); err != nil {
+			return result, err
+		}
+		if response.Code != 0 {
+			return result, models.NewAPIError(fmt.Sprintf("%d", response.Code), response.Message, response.RequestID, 0)
+		}
+		result.FileIDs = append(result.FileIDs, response.Data.FileID)
+	}
+
+	return result, nil
 }
 
 // ApplyCustomAudience applies custom audience data
@@ -404,7 +467,10 @@ func (s *DMPService) ApplyCustomAudience(ctx context.Context, req *CustomAudienc
 		return nil, fmt.Errorf("custom_audience_id is required")
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/apply/", nil)
+	url, err := s.client.BuildURL("/dmp/custom_audience/apply/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -439,7 +505,10 @@ func (s *DMPService) ShareCustomAudience(ctx context.Context, req *CustomAudienc
 		return nil, fmt.Errorf("target_advertiser_id is required")
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/share/", nil)
+	url, err := s.client.BuildURL("/dmp/custom_audience/share/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -471,7 +540,10 @@ func (s *DMPService) CreateSavedAudience(ctx context.Context, req *SavedAudience
 		return nil, fmt.Errorf("audience_name is required")
 	}
 
-	url := s.client.BuildURL("/dmp/saved_audience/create/", nil)
+	url, err := s.client.BuildURL("/dmp/saved_audience/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -508,7 +580,10 @@ func (s *DMPService) ListSavedAudiences(ctx context.Context, req *SavedAudienceL
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/dmp/saved_audience/list/", params)
+	url, err := s.client.BuildURL("/dmp/saved_audience/list/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -535,7 +610,10 @@ func (s *DMPService) DeleteSavedAudience(ctx context.Context, req *SavedAudience
 		return nil, fmt.Errorf("saved_audience_id is required")
 	}
 
-	url := s.client.BuildURL("/dmp/saved_audience/delete/", nil)
+	url, err := s.client.BuildURL("/dmp/saved_audience/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -573,7 +651,10 @@ func (s *DMPService) GetCustomAudienceApplyLog(ctx context.Context, req *CustomA
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/apply/log/", params)
+	url, err := s.client.BuildURL("/dmp/custom_audience/apply/log/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -600,7 +681,10 @@ func (s *DMPService) UpdateLookalikeAudience(ctx context.Context, req *Lookalike
 		return nil, fmt.Errorf("custom_audience_id is required")
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/lookalike/update/", nil)
+	url, err := s.client.BuildURL("/dmp/custom_audience/lookalike/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -635,7 +719,10 @@ func (s *DMPService) CreateCustomAudienceRule(ctx context.Context, req *CustomAu
 		return nil, fmt.Errorf("rules is required")
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/rule/create/", nil)
+	url, err := s.client.BuildURL("/dmp/custom_audience/rule/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -670,7 +757,10 @@ func (s *DMPService) CancelCustomAudienceShare(ctx context.Context, req *CustomA
 		return nil, fmt.Errorf("target_advertiser_id is required")
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/share/cancel/", nil)
+	url, err := s.client.BuildURL("/dmp/custom_audience/share/cancel/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -710,7 +800,10 @@ func (s *DMPService) GetCustomAudienceShareLog(ctx context.Context, req *CustomA
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/dmp/custom_audience/share/log/", params)
+	url, err := s.client.BuildURL("/dmp/custom_audience/share/log/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -734,10 +827,21 @@ type CustomAudienceListRequest struct {
 }
 
 type CustomAudienceFileUploadRequest struct {
-	AdvertiserID string `json:"advertiser_id"`
-	FileData     []byte `json:"file_data"`
-	FileType     string `json:"file_type"` // CSV, TXT
-	FileName     string `json:"file_name"`
+	AdvertiserID  string `json:"advertiser_id"`
+	CalculateType string `json:"calculate_type"` // EMAIL_SHA256, PHONE_SHA256, IDFA_SHA256, GAID_SHA256
+
+	// FileData has one identifier per line, raw or already SHA-256 hashed
+	FileData []byte `json:"-"`
+	FileType string `json:"file_type,omitempty"` // TXT (default), CSV
+	FileName string `json:"file_name,omitempty"`
+
+	// PhoneCountryCode is the calling code of phone numbers without a
+	// leading +, e.g. 1; such numbers are skipped when it is empty
+	PhoneCountryCode string `json:"-"`
+
+	// ChunkBytes caps the size of each uploaded file (defaults to
+	// CustomAudienceFileMaxBytes)
+	ChunkBytes int `json:"-"`
 }
 
 type CustomAudienceFileUploadResponse struct {
@@ -809,12 +913,12 @@ type SavedAudienceResponse struct {
 }
 
 type SavedAudienceData struct {
-	SavedAudienceID string `json:"saved_audience_id"`
-	AudienceName    string `json:"audience_name"`
-	Description     string `json:"description"`
-	Status          string `json:"status"`
-	CreateTime      string `json:"create_time"`
-	UpdateTime      string `json:"update_time"`
+	SavedAudienceID string      `json:"saved_audience_id"`
+	AudienceName    string      `json:"audience_name"`
+	Description     string      `json:"description"`
+	Status          string      `json:"status"`
+	CreateTime      models.Time `json:"create_time"`
+	UpdateTime      models.Time `json:"update_time"`
 }
 
 type SavedAudienceListRequest struct {
@@ -953,13 +1057,13 @@ type CustomAudienceShareLogData struct {
 }
 
 type CustomAudienceShareLogEntry struct {
-	LogID              string `json:"log_id"`
-	ShareID            string `json:"share_id"`
-	CustomAudienceID   string `json:"custom_audience_id"`
-	TargetAdvertiserID string `json:"target_advertiser_id"`
-	ShareType          string `json:"share_type"`
-	Status             string `json:"status"`
-	ShareTime          string `json:"share_time"`
-	UpdateTime         string `json:"update_time"`
-	Message            string `json:"message,omitempty"`
+	LogID              string      `json:"log_id"`
+	ShareID            string      `json:"share_id"`
+	CustomAudienceID   string      `json:"custom_audience_id"`
+	TargetAdvertiserID string      `json:"target_advertiser_id"`
+	ShareType          string      `json:"share_type"`
+	Status             string      `json:"status"`
+	ShareTime          string      `json:"share_time"`
+	UpdateTime         models.Time `json:"update_time"`
+	Message            string      `json:"message,omitempty"`
 }
@@ -0,0 +1,475 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultEntityCacheMaxAge is how old an EntityCache may get before
+// RefreshIfStale refreshes it
+const DefaultEntityCacheMaxAge = 5 * time.Minute
+
+// entityCachePageSize is the page size used when listing entities for the cache
+const entityCachePageSize = 1000
+
+// EntityCacheConfig configures an EntityCache
+type EntityCacheConfig struct {
+	AdvertiserID string
+
+	// MaxAge is how old the cache may get before RefreshIfStale refreshes it
+	// (defaults to DefaultEntityCacheMaxAge)
+	MaxAge time.Duration
+
+	// SkipAds and SkipAudiences leave those entities out, for accounts where
+	// listing them on every refresh is too costly
+	SkipAds       bool
+	SkipAudiences bool
+
+	// OnRefresh, if set, is called after every refresh made by Run
+	OnRefresh func(*EntityCacheRefresh, error)
+}
+
+// EntityCacheDelta counts the entities of one kind a refresh changed
+type EntityCacheDelta struct {
+	Added   int `json:"added"`
+	Updated int `json:"updated"`
+	Removed int `json:"removed"`
+}
+
+// Changed reports whether the refresh changed any entity of the kind
+func (d EntityCacheDelta) Changed() bool {
+	return d.Added+d.Updated+d.Removed > 0
+}
+
+// EntityCacheRefresh reports what a refresh changed
+type EntityCacheRefresh struct {
+	Time      time.Time        `json:"time"`
+	Campaigns EntityCacheDelta `json:"campaigns"`
+	AdGroups  EntityCacheDelta `json:"adgroups"`
+	Ads       EntityCacheDelta `json:"ads"`
+	Audiences EntityCacheDelta `json:"audiences"`
+}
+
+// Changed reports whether the refresh changed any entity
+func (r *EntityCacheRefresh) Changed() bool {
+	return r.Campaigns.Changed() || r.AdGroups.Changed() || r.Ads.Changed() || r.Audiences.Changed()
+}
+
+// EntityCache keeps an advertiser's campaigns, ad groups, ads and custom
+// audiences in memory, indexed by ID and name, so orchestration code can look
+// entities up without an API call each time. The cache is empty until the
+// first Refresh. A refresh lists the entities and applies only the
+// differences, reporting what was added, updated and removed; a failed
+// refresh leaves the cache as it was. Lookups are safe for concurrent use
+// with refreshes.
+type EntityCache struct {
+	client *Client
+	config EntityCacheConfig
+
+	mu          sync.RWMutex
+	campaigns   *entityIndex[CampaignInfo]
+	adGroups    *entityIndex[AdGroupInfo]
+	ads         *entityIndex[AdInfo]
+	audiences   *entityIndex[CustomAudienceData]
+	lastRefresh time.Time
+
+	refreshMu sync.Mutex
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// NewEntityCache creates an empty EntityCache. Its lookup counters are
+// reported in the client's stats as entities_<advertiser ID>.
+func NewEntityCache(client *Client, config EntityCacheConfig) (*EntityCache, error) {
+	if config.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if config.MaxAge <= 0 {
+		config.MaxAge = DefaultEntityCacheMaxAge
+	}
+	cache := &EntityCache{
+		client:    client,
+		config:    config,
+		campaigns: newCampaignIndex(nil),
+		adGroups:  newAdGroupIndex(nil),
+		ads:       newAdIndex(nil),
+		audiences: newAudienceIndex(nil),
+	}
+	client.RegisterCacheStats("entities_"+config.AdvertiserID, cache.CacheStats)
+	return cache, nil
+}
+
+// Refresh lists the advertiser's entities and applies the changes since the
+// last refresh
+func (e *EntityCache) Refresh(ctx context.Context) (*EntityCacheRefresh, error) {
+	e.refreshMu.Lock()
+	defer e.refreshMu.Unlock()
+
+	advertiserID := e.config.AdvertiserID
+	campaigns, err := e.client.listCampaigns(ctx, advertiserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list campaigns: %w", err)
+	}
+	adGroups, err := e.client.listAdGroups(ctx, advertiserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ad groups: %w", err)
+	}
+	var ads []AdInfo
+	if !e.config.SkipAds {
+		if ads, err = e.client.listAds(ctx, advertiserID); err != nil {
+			return nil, fmt.Errorf("failed to list ads: %w", err)
+		}
+	}
+	var audiences []CustomAudienceData
+	if !e.config.SkipAudiences {
+		if audiences, err = e.client.listCustomAudiences(ctx, advertiserID); err != nil {
+			return nil, fmt.Errorf("failed to list custom audiences: %w", err)
+		}
+	}
+
+	nextCampaigns, nextAdGroups := newCampaignIndex(campaigns), newAdGroupIndex(adGroups)
+	nextAds, nextAudiences := newAdIndex(ads), newAudienceIndex(audiences)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	refresh := &EntityCacheRefresh{
+		Time:      time.Now(),
+		Campaigns: e.campaigns.delta(nextCampaigns),
+		AdGroups:  e.adGroups.delta(nextAdGroups),
+		Ads:       e.ads.delta(nextAds),
+		Audiences: e.audiences.delta(nextAudiences),
+	}
+	e.campaigns, e.adGroups, e.ads, e.audiences = nextCampaigns, nextAdGroups, nextAds, nextAudiences
+	e.lastRefresh = refresh.Time
+	return refresh, nil
+}
+
+// RefreshIfStale refreshes the cache when it is older than MaxAge or has
+// never been refreshed, and returns nil otherwise
+func (e *EntityCache) RefreshIfStale(ctx context.Context) (*EntityCacheRefresh, error) {
+	if last := e.LastRefresh(); !last.IsZero() && time.Since(last) < e.config.MaxAge {
+		return nil, nil
+	}
+	return e.Refresh(ctx)
+}
+
+// Run refreshes the cache every interval, or MaxAge when interval is zero,
+// until ctx is canceled. Failed refreshes are reported to OnRefresh and
+// retried at the next interval.
+func (e *EntityCache) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = e.config.MaxAge
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		refresh, err := e.Refresh(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if e.config.OnRefresh != nil {
+			e.config.OnRefresh(refresh, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// LastRefresh returns the time of the last successful refresh, or the zero
+// time before the first
+func (e *EntityCache) LastRefresh() time.Time {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.lastRefresh
+}
+
+// Campaign returns the cached campaign with the ID
+func (e *EntityCache) Campaign(campaignID string) (CampaignInfo, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	item, ok := e.campaigns.get(campaignID)
+	e.count(ok)
+	return item, ok
+}
+
+// FindCampaignByName returns the campaign with the name. When several share
+// it, the most recently modified is returned.
+func (e *EntityCache) FindCampaignByName(name string) (CampaignInfo, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	item, ok := e.campaigns.findByName(name, "")
+	e.count(ok)
+	return item, ok
+}
+
+// Campaigns returns every cached campaign, ordered by ID
+func (e *EntityCache) Campaigns() []CampaignInfo {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.campaigns.list("")
+}
+
+// AdGroup returns the cached ad group with the ID
+func (e *EntityCache) AdGroup(adGroupID string) (AdGroupInfo, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	item, ok := e.adGroups.get(adGroupID)
+	e.count(ok)
+	return item, ok
+}
+
+// FindAdGroupByName returns the ad group with the name within the campaign,
+// or within any campaign when campaignID is empty. When several share it, the
+// most recently modified is returned.
+func (e *EntityCache) FindAdGroupByName(campaignID, name string) (AdGroupInfo, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	item, ok := e.adGroups.findByName(name, campaignID)
+	e.count(ok)
+	return item, ok
+}
+
+// AdGroups returns the cached ad groups of a campaign, or all of them when
+// campaignID is empty, ordered by ID
+func (e *EntityCache) AdGroups(campaignID string) []AdGroupInfo {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.adGroups.list(campaignID)
+}
+
+// Ad returns the cached ad with the ID
+func (e *EntityCache) Ad(adID string) (AdInfo, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	item, ok := e.ads.get(adID)
+	e.count(ok)
+	return item, ok
+}
+
+// FindAdByName returns the ad with the name within the ad group, or within
+// any ad group when adGroupID is empty. When several share it, the most
+// recently modified is returned.
+func (e *EntityCache) FindAdByName(adGroupID, name string) (AdInfo, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	item, ok := e.ads.findByName(name, adGroupID)
+	e.count(ok)
+	return item, ok
+}
+
+// Ads returns the cached ads of an ad group, or all of them when adGroupID
+// is empty, ordered by ID
+func (e *EntityCache) Ads(adGroupID string) []AdInfo {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.ads.list(adGroupID)
+}
+
+// Audience returns the cached custom audience with the ID
+func (e *EntityCache) Audience(audienceID string) (CustomAudienceData, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	item, ok := e.audiences.get(audienceID)
+	e.count(ok)
+	return item, ok
+}
+
+// FindAudienceByName returns the custom audience with the name. When several
+// share it, the most recently updated is returned.
+func (e *EntityCache) FindAudienceByName(name string) (CustomAudienceData, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	item, ok := e.audiences.findByName(name, "")
+	e.count(ok)
+	return item, ok
+}
+
+// CacheStats returns the cache's lookup counters. Hits and misses count
+// lookups by ID and name; Size is the number of cached entities.
+func (e *EntityCache) CacheStats() CacheStats {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	size := len(e.campaigns.byID) + len(e.adGroups.byID) + len(e.ads.byID) + len(e.audiences.byID)
+	return CacheStats{Hits: e.hits.Load(), Misses: e.misses.Load(), Size: size}
+}
+
+// count records the outcome of a lookup
+func (e *EntityCache) count(found bool) {
+	if found {
+		e.hits.Add(1)
+	} else {
+		e.misses.Add(1)
+	}
+}
+
+// entityIndex indexes one kind of entity by ID, name and parent
+type entityIndex[T any] struct {
+	byID     map[string]T
+	byName   map[string][]string // IDs, most recently modified first
+	parentOf func(T) string
+}
+
+// newEntityIndex indexes items using the accessors of their kind
+func newEntityIndex[T any](items []T, id, name, parent func(T) string, modified func(T) time.Time) *entityIndex[T] {
+	index := &entityIndex[T]{
+		byID:     make(map[string]T, len(items)),
+		byName:   make(map[string][]string),
+		parentOf: parent,
+	}
+	for _, item := range items {
+		index.byID[id(item)] = item
+	}
+	for itemID, item := range index.byID {
+		index.byName[name(item)] = append(index.byName[name(item)], itemID)
+	}
+	for _, ids := range index.byName {
+		sort.Slice(ids, func(i, j int) bool {
+			a, b := modified(index.byID[ids[i]]), modified(index.byID[ids[j]])
+			if !a.Equal(b) {
+				return a.After(b)
+			}
+			return ids[i] < ids[j]
+		})
+	}
+	return index
+}
+
+func newCampaignIndex(items []CampaignInfo) *entityIndex[CampaignInfo] {
+	return newEntityIndex(items,
+		func(c CampaignInfo) string { return c.CampaignID },
+		func(c CampaignInfo) string { return c.CampaignName },
+		func(c CampaignInfo) string { return "" },
+		func(c CampaignInfo) time.Time { return c.ModifyTime.Time })
+}
+
+func newAdGroupIndex(items []AdGroupInfo) *entityIndex[AdGroupInfo] {
+	return newEntityIndex(items,
+		func(g AdGroupInfo) string { return g.AdGroupID },
+		func(g AdGroupInfo) string { return g.AdGroupName },
+		func(g AdGroupInfo) string { return g.CampaignID },
+		func(g AdGroupInfo) time.Time { return g.ModifyTime.Time })
+}
+
+func newAdIndex(items []AdInfo) *entityIndex[AdInfo] {
+	return newEntityIndex(items,
+		func(a AdInfo) string { return a.AdID },
+		func(a AdInfo) string { return a.AdName },
+		func(a AdInfo) string { return a.AdGroupID },
+		func(a AdInfo) time.Time { return a.ModifyTime.Time })
+}
+
+func newAudienceIndex(items []CustomAudienceData) *entityIndex[CustomAudienceData] {
+	return newEntityIndex(items,
+		func(a CustomAudienceData) string { return a.AudienceID },
+		func(a CustomAudienceData) string { return a.AudienceName },
+		func(a CustomAudienceData) string { return "" },
+		func(a CustomAudienceData) time.Time { return a.UpdateTime.Time })
+}
+
+func (x *entityIndex[T]) get(id string) (T, bool) {
+	item, ok := x.byID[id]
+	return item, ok
+}
+
+// findByName returns the first entity with the name, under parent when set
+func (x *entityIndex[T]) findByName(name, parent string) (T, bool) {
+	for _, id := range x.byName[name] {
+		item := x.byID[id]
+		if parent == "" || x.parentOf(item) == parent {
+			return item, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// list returns the entities under parent, or all when parent is empty, by ID
+func (x *entityIndex[T]) list(parent string) []T {
+	ids := make([]string, 0, len(x.byID))
+	for id, item := range x.byID {
+		if parent == "" || x.parentOf(item) == parent {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	items := make([]T, len(ids))
+	for i, id := range ids {
+		items[i] = x.byID[id]
+	}
+	return items
+}
+
+// delta counts the entities next adds, changes and drops
+func (x *entityIndex[T]) delta(next *entityIndex[T]) EntityCacheDelta {
+	var d EntityCacheDelta
+	for id, item := range next.byID {
+		previous, ok := x.byID[id]
+		switch {
+		case !ok:
+			d.Added++
+		case !reflect.DeepEqual(previous, item):
+			d.Updated++
+		}
+	}
+	for id := range x.byID {
+		if _, ok := next.byID[id]; !ok {
+			d.Removed++
+		}
+	}
+	return d
+}
+
+// listCampaigns pages through all campaigns of an advertiser
+func (c *Client) listCampaigns(ctx context.Context, advertiserID string) ([]CampaignInfo, error) {
+	var campaigns []CampaignInfo
+	for page := 1; ; page++ {
+		resp, err := c.Campaign().Get(ctx, &CampaignGetRequest{AdvertiserID: advertiserID, Page: page, PageSize: entityCachePageSize})
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, resp.Data...)
+		if page >= resp.PageInfo.TotalPage {
+			return campaigns, nil
+		}
+	}
+}
+
+// listAdGroups pages through all ad groups of an advertiser
+func (c *Client) listAdGroups(ctx context.Context, advertiserID string) ([]AdGroupInfo, error) {
+	var adGroups []AdGroupInfo
+	for page := 1; ; page++ {
+		resp, err := c.AdGroup().Get(ctx, &AdGroupGetRequest{AdvertiserID: advertiserID, Page: page, PageSize: entityCachePageSize})
+		if err != nil {
+			return nil, err
+		}
+		adGroups = append(adGroups, resp.Data...)
+		if page >= resp.PageInfo.TotalPage {
+			return adGroups, nil
+		}
+	}
+}
+
+// listAds pages through all ads of an advertiser
+func (c *Client) listAds(ctx context.Context, advertiserID string) ([]AdInfo, error) {
+	var ads []AdInfo
+	for page := 1; ; page++ {
+		resp, err := c.Ad().Get(ctx, &AdGetRequest{AdvertiserID: advertiserID, Page: page, PageSize: entityCachePageSize})
+		if err != nil {
+			return nil, err
+		}
+		ads = append(ads, resp.Data...)
+		if page >= resp.PageInfo.TotalPage {
+			return ads, nil
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestEntityCache(t *testing.T) {
+	var mu sync.Mutex
+	campaigns := `[{"campaign_id":"c1","campaign_name":"Spring","modify_time":"2026-03-01 00:00:00"},
+		{"campaign_id":"c2","campaign_name":"Spring","modify_time":"2026-04-01 00:00:00"},
+		{"campaign_id":"c3","campaign_name":"Old"}]`
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/campaign/get/"):
+			fmt.Fprintf(w, `{"code":0,"data":%s,"page_info":{"page":1,"total_page":1}}`, campaigns)
+		case strings.HasSuffix(r.URL.Path, "/adgroup/get/"):
+			_, _ = w.Write([]byte(`{"code":0,"data":[{"adgroup_id":"g1","adgroup_name":"US","campaign_id":"c1"},{"adgroup_id":"g2","adgroup_name":"US","campaign_id":"c2"}],"page_info":{"page":1,"total_page":1}}`))
+		case strings.HasSuffix(r.URL.Path, "/ad/get/"):
+			_, _ = w.Write([]byte(`{"code":0,"data":[{"ad_id":"a1","ad_name":"Video","adgroup_id":"g2"}],"page_info":{"page":1,"total_page":1}}`))
+		case strings.HasSuffix(r.URL.Path, "/dmp/custom_audience/list/"):
+			_, _ = w.Write([]byte(`{"code":0,"data":[{"audience_id":"aud1","audience_name":"Buyers"}]}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+	if _, err := NewEntityCache(client, EntityCacheConfig{}); err == nil {
+		t.Error("NewEntityCache() without advertiser_id succeeded")
+	}
+	cache, err := NewEntityCache(client, EntityCacheConfig{AdvertiserID: "123"})
+	if err != nil {
+		t.Fatalf("NewEntityCache() error = %v", err)
+	}
+	if _, ok := cache.FindCampaignByName("Spring"); ok {
+		t.Error("lookup succeeded before the first refresh")
+	}
+
+	refresh, err := cache.RefreshIfStale(context.Background())
+	if err != nil {
+		t.Fatalf("RefreshIfStale() error = %v", err)
+	}
+	if refresh == nil || refresh.Campaigns.Added != 3 || refresh.AdGroups.Added != 2 || refresh.Ads.Added != 1 || refresh.Audiences.Added != 1 {
+		t.Fatalf("first refresh = %+v", refresh)
+	}
+	if refresh, _ := cache.RefreshIfStale(context.Background()); refresh != nil {
+		t.Error("RefreshIfStale() refreshed a fresh cache")
+	}
+
+	if campaign, ok := cache.FindCampaignByName("Spring"); !ok || campaign.CampaignID != "c2" {
+		t.Errorf("FindCampaignByName() = %s, %v, want the most recently modified c2", campaign.CampaignID, ok)
+	}
+	if adGroup, ok := cache.FindAdGroupByName("c1", "US"); !ok || adGroup.AdGroupID != "g1" {
+		t.Errorf("FindAdGroupByName(c1) = %s, %v", adGroup.AdGroupID, ok)
+	}
+	if ad, ok := cache.FindAdByName("g1", "Video"); ok {
+		t.Errorf("FindAdByName(g1) found %s in another ad group", ad.AdID)
+	}
+	if audience, ok := cache.FindAudienceByName("Buyers"); !ok || audience.AudienceID != "aud1" {
+		t.Errorf("FindAudienceByName() = %s, %v", audience.AudienceID, ok)
+	}
+	if ads := cache.Ads("g2"); len(ads) != 1 {
+		t.Errorf("Ads(g2) = %v", ads)
+	}
+
+	mu.Lock()
+	campaigns = `[{"campaign_id":"c1","campaign_name":"Spring","modify_time":"2026-05-01 00:00:00"},
+		{"campaign_id":"c2","campaign_name":"Spring","modify_time":"2026-04-01 00:00:00"},
+		{"campaign_id":"c4","campaign_name":"New"}]`
+	mu.Unlock()
+
+	refresh, err = cache.Refresh(context.Background())
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if want := (EntityCacheDelta{Added: 1, Updated: 1, Removed: 1}); refresh.Campaigns != want || refresh.AdGroups.Changed() {
+		t.Errorf("second refresh = %+v", refresh)
+	}
+	if campaign, ok := cache.FindCampaignByName("Spring"); !ok || campaign.CampaignID != "c1" {
+		t.Errorf("FindCampaignByName() after refresh = %s", campaign.CampaignID)
+	}
+	if _, ok := cache.Campaign("c3"); ok {
+		t.Error("removed campaign still cached")
+	}
+}
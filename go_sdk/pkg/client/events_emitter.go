@@ -0,0 +1,407 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Event emitter defaults
+const (
+	DefaultEmitterFlushInterval = 5 * time.Second
+	DefaultEmitterMaxAttempts   = 10
+)
+
+// EventBatch is a sealed batch of events awaiting delivery
+type EventBatch struct {
+	ID          string        `json:"id"`
+	Events      []WebEvent    `json:"events"`
+	CreatedAt   time.Time     `json:"created_at"`
+	Attempts    int           `json:"attempts"`
+	NextAttempt time.Time     `json:"next_attempt"`
+	RetryDelay  time.Duration `json:"retry_delay_ns"`
+	LastError   string        `json:"last_error,omitempty"`
+}
+
+// EventBatchStore persists batches until they are delivered. Batches are
+// saved before the first delivery attempt and deleted only once the Events
+// API accepts them, so a store surviving restarts gives at-least-once
+// delivery. Implementations must be safe for concurrent use.
+type EventBatchStore interface {
+	// Put saves a new batch or replaces a batch with the same ID
+	Put(ctx context.Context, batch *EventBatch) error
+
+	// List returns the stored batches, oldest first
+	List(ctx context.Context) ([]*EventBatch, error)
+
+	// Delete removes a batch
+	Delete(ctx context.Context, id string) error
+}
+
+// memoryEventBatchStore keeps batches in process memory
+type memoryEventBatchStore struct {
+	mu      sync.Mutex
+	batches map[string]*EventBatch
+}
+
+// NewMemoryEventBatchStore creates an in-memory EventBatchStore. Pending
+// batches are lost when the process exits.
+func NewMemoryEventBatchStore() EventBatchStore {
+	return &memoryEventBatchStore{batches: make(map[string]*EventBatch)}
+}
+
+// Put saves a new batch or replaces a batch with the same ID
+func (m *memoryEventBatchStore) Put(ctx context.Context, batch *EventBatch) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := *batch
+	m.batches[batch.ID] = &stored
+	return nil
+}
+
+// List returns the stored batches, oldest first
+func (m *memoryEventBatchStore) List(ctx context.Context) ([]*EventBatch, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	batches := make([]*EventBatch, 0, len(m.batches))
+	for _, batch := range m.batches {
+		stored := *batch
+		batches = append(batches, &stored)
+	}
+	sort.Slice(batches, func(i, j int) bool {
+		if !batches[i].CreatedAt.Equal(batches[j].CreatedAt) {
+			return batches[i].CreatedAt.Before(batches[j].CreatedAt)
+		}
+		return batches[i].ID < batches[j].ID
+	})
+	return batches, nil
+}
+
+// Delete removes a batch
+func (m *memoryEventBatchStore) Delete(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.batches, id)
+	return nil
+}
+
+// EventEmitterConfig configures an EventEmitter
+type EventEmitterConfig struct {
+	PixelCode     string
+	TestEventCode string
+
+	// BatchSize is the number of events that triggers a flush (defaults to
+	// and cannot exceed MaxEventsPerRequest)
+	BatchSize int
+
+	// FlushInterval is the longest an event is buffered before its batch is
+	// sealed and sent (defaults to DefaultEmitterFlushInterval)
+	FlushInterval time.Duration
+
+	// MaxAttempts is the number of delivery attempts after which a batch is
+	// dropped (defaults to DefaultEmitterMaxAttempts; negative retries forever)
+	MaxAttempts int
+
+	// Backoff computes the delay before retrying a failed batch (defaults to
+	// ExponentialJitterBackoff)
+	Backoff BackoffStrategy
+
+	// Store persists sealed batches (defaults to NewMemoryEventBatchStore)
+	Store EventBatchStore
+}
+
+// EventEmitterMetrics is a snapshot of an emitter's delivery counters
+type EventEmitterMetrics struct {
+	Emitted        int64 // events accepted by Emit
+	Delivered      int64 // events accepted by the Events API
+	Dropped        int64 // events abandoned after MaxAttempts
+	Buffered       int64 // events not yet sealed into a batch
+	PendingBatches int64 // sealed batches awaiting delivery
+	Attempts       int64 // batch delivery attempts
+	Failures       int64 // failed batch delivery attempts
+	LastError      string
+	LastDelivery   time.Time
+}
+
+// EventEmitter buffers server-side web events and reports them to the Events
+// API in batches, flushed when BatchSize events are buffered or every
+// FlushInterval. Sealed batches are kept in an EventBatchStore and retried
+// with backoff until delivered or MaxAttempts is reached. Events still
+// buffered in memory are only persisted once their batch is sealed, so call
+// Close before exiting.
+type EventEmitter struct {
+	client *Client
+	config EventEmitterConfig
+
+	mu     sync.Mutex
+	buffer []WebEvent
+	seq    uint64
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	flushSignal chan struct{}
+	deliverMu   sync.Mutex // serializes delivery passes
+
+	emitted, delivered, dropped, attempts, failures atomic.Int64
+	pending                                         atomic.Int64
+	lastError                                       atomic.Value // string
+	lastDelivery                                    atomic.Value // time.Time
+}
+
+// NewEventEmitter creates an EventEmitter reporting to the given pixel
+func NewEventEmitter(client *Client, config EventEmitterConfig) (*EventEmitter, error) {
+	if config.PixelCode == "" {
+		return nil, fmt.Errorf("pixel_code is required")
+	}
+	if config.BatchSize <= 0 || config.BatchSize > MaxEventsPerRequest {
+		config.BatchSize = MaxEventsPerRequest
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = DefaultEmitterFlushInterval
+	}
+	if config.MaxAttempts == 0 {
+		config.MaxAttempts = DefaultEmitterMaxAttempts
+	}
+	if config.Backoff == nil {
+		config.Backoff = ExponentialJitterBackoff
+	}
+	if config.Store == nil {
+		config.Store = NewMemoryEventBatchStore()
+	}
+
+	return &EventEmitter{
+		client:      client,
+		config:      config,
+		flushSignal: make(chan struct{}, 1),
+	}, nil
+}
+
+// Emit buffers an event. It seals a batch when BatchSize events are buffered
+// and, once started, wakes the background goroutine to deliver it.
+func (e *EventEmitter) Emit(ctx context.Context, event WebEvent) error {
+	if event.Event == "" {
+		return fmt.Errorf("event name is required")
+	}
+	if event.EventTime.IsZero() {
+		event.EventTime = time.Now()
+	}
+
+	e.mu.Lock()
+	e.buffer = append(e.buffer, event)
+	e.emitted.Add(1)
+	full := len(e.buffer) >= e.config.BatchSize
+	var err error
+	if full {
+		err = e.sealLocked(ctx)
+	}
+	e.mu.Unlock()
+
+	if full && err == nil {
+		select {
+		case e.flushSignal <- struct{}{}:
+		default:
+		}
+	}
+	return err
+}
+
+// Start launches the background goroutine flushing every FlushInterval. It
+// runs until Close is called or ctx is canceled. Batches left in the store by
+// a previous process are delivered on the first flush.
+func (e *EventEmitter) Start(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.cancel != nil {
+		return fmt.Errorf("event emitter already started")
+	}
+	batches, err := e.config.Store.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load pending batches: %w", err)
+	}
+	e.pending.Store(int64(len(batches)))
+
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.done = make(chan struct{})
+	go e.run(ctx, e.done)
+	return nil
+}
+
+// Flush seals the buffered events into a batch and attempts delivery of every
+// stored batch, including those still waiting out their backoff. It returns
+// the first delivery error.
+func (e *EventEmitter) Flush(ctx context.Context) error {
+	e.mu.Lock()
+	err := e.sealLocked(ctx)
+	e.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return e.deliver(ctx, true)
+}
+
+// Close stops the background goroutine and flushes the remaining events.
+// Batches that still fail stay in the store for the next process.
+func (e *EventEmitter) Close(ctx context.Context) error {
+	e.mu.Lock()
+	cancel, done := e.cancel, e.done
+	e.cancel, e.done = nil, nil
+	e.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+	return e.Flush(ctx)
+}
+
+// Metrics returns a snapshot of the delivery counters
+func (e *EventEmitter) Metrics() EventEmitterMetrics {
+	e.mu.Lock()
+	buffered := int64(len(e.buffer))
+	e.mu.Unlock()
+
+	metrics := EventEmitterMetrics{
+		Emitted:        e.emitted.Load(),
+		Delivered:      e.delivered.Load(),
+		Dropped:        e.dropped.Load(),
+		Buffered:       buffered,
+		PendingBatches: e.pending.Load(),
+		Attempts:       e.attempts.Load(),
+		Failures:       e.failures.Load(),
+	}
+	if lastError, ok := e.lastError.Load().(string); ok {
+		metrics.LastError = lastError
+	}
+	if lastDelivery, ok := e.lastDelivery.Load().(time.Time); ok {
+		metrics.LastDelivery = lastDelivery
+	}
+	return metrics
+}
+
+// run flushes on every tick and whenever Emit seals a batch
+func (e *EventEmitter) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(e.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.mu.Lock()
+			err := e.sealLocked(ctx)
+			e.mu.Unlock()
+			if err != nil {
+				e.lastError.Store(err.Error())
+				continue
+			}
+		case <-e.flushSignal:
+		}
+		_ = e.deliver(ctx, false)
+	}
+}
+
+// sealLocked moves the buffered events into a stored batch. The buffer is
+// kept when the store fails, so no event is lost. e.mu must be held.
+func (e *EventEmitter) sealLocked(ctx context.Context) error {
+	if len(e.buffer) == 0 {
+		return nil
+	}
+	e.seq++
+	now := time.Now()
+	batch := &EventBatch{
+		ID:          fmt.Sprintf("%d-%d", now.UnixNano(), e.seq),
+		Events:      e.buffer,
+		CreatedAt:   now,
+		NextAttempt: now,
+	}
+	if err := e.config.Store.Put(ctx, batch); err != nil {
+		return fmt.Errorf("failed to store event batch: %w", err)
+	}
+	e.buffer = nil
+	e.pending.Add(1)
+	return nil
+}
+
+// deliver sends the stored batches that are due, or all of them when force
+// is set, and returns the first error
+func (e *EventEmitter) deliver(ctx context.Context, force bool) error {
+	e.deliverMu.Lock()
+	defer e.deliverMu.Unlock()
+
+	batches, err := e.config.Store.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list event batches: %w", err)
+	}
+	e.pending.Store(int64(len(batches)))
+
+	var firstErr error
+	now := time.Now()
+	for _, batch := range batches {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !force && now.Before(batch.NextAttempt) {
+			continue
+		}
+		if err := e.send(ctx, batch); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// send makes one delivery attempt of a batch and updates the store
+func (e *EventEmitter) send(ctx context.Context, batch *EventBatch) error {
+	e.attempts.Add(1)
+	batch.Attempts++
+
+	resp, err := e.client.Events().Track(ctx, &TrackEventsRequest{
+		PixelCode:     e.config.PixelCode,
+		Events:        batch.Events,
+		TestEventCode: e.config.TestEventCode,
+	})
+	if err == nil && resp.Code != 0 {
+		err = fmt.Errorf("API error: %s", resp.Message)
+	}
+
+	if err == nil {
+		if err := e.config.Store.Delete(ctx, batch.ID); err != nil {
+			return fmt.Errorf("batch %s delivered but not removed from the store: %w", batch.ID, err)
+		}
+		e.pending.Add(-1)
+		e.delivered.Add(int64(len(batch.Events)))
+		e.lastDelivery.Store(time.Now())
+		return nil
+	}
+
+	e.failures.Add(1)
+	e.lastError.Store(err.Error())
+	err = fmt.Errorf("batch %s (attempt %d): %w", batch.ID, batch.Attempts, err)
+
+	if e.config.MaxAttempts > 0 && batch.Attempts >= e.config.MaxAttempts {
+		if deleteErr := e.config.Store.Delete(ctx, batch.ID); deleteErr != nil {
+			return fmt.Errorf("%w; failed to drop batch: %v", err, deleteErr)
+		}
+		e.pending.Add(-1)
+		e.dropped.Add(int64(len(batch.Events)))
+		return err
+	}
+
+	batch.RetryDelay = e.config.Backoff.Backoff(batch.Attempts, batch.RetryDelay)
+	batch.NextAttempt = time.Now().Add(batch.RetryDelay)
+	batch.LastError = err.Error()
+	if putErr := e.config.Store.Put(ctx, batch); putErr != nil {
+		return fmt.Errorf("%w; failed to update batch: %v", err, putErr)
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEventEmitter(t *testing.T) {
+	var requests atomic.Int32
+	var delivered atomic.Int64
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data []webEventPayload `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if requests.Add(1) == 1 {
+			w.Write([]byte(`{"code":40002,"message":"invalid event"}`))
+			return
+		}
+		delivered.Add(int64(len(body.Data)))
+		w.Write([]byte(`{"code":0,"message":"OK"}`))
+	})
+
+	client := newTestClient(t, server)
+	if _, err := NewEventEmitter(client, EventEmitterConfig{}); err == nil {
+		t.Error("NewEventEmitter() accepted a config without a pixel code")
+	}
+
+	store := NewMemoryEventBatchStore()
+	emitter, err := NewEventEmitter(client, EventEmitterConfig{
+		PixelCode:     "PIXEL",
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+		Backoff:       NewConstantBackoff(10 * time.Millisecond),
+		Store:         store,
+	})
+	if err != nil {
+		t.Fatalf("NewEventEmitter() error = %v", err)
+	}
+	if err := emitter.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		if err := emitter.Emit(ctx, WebEvent{Event: EventAddToCart, EventID: fmt.Sprint(i)}); err != nil {
+			t.Fatalf("Emit() error = %v", err)
+		}
+	}
+	if err := emitter.Emit(ctx, WebEvent{}); err == nil {
+		t.Error("Emit() accepted an event without a name")
+	}
+
+	// The first batch is rejected once and stays in the store until Close
+	// retries it.
+	deadline := time.Now().Add(2 * time.Second)
+	for delivered.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if metrics := emitter.Metrics(); metrics.Emitted != 5 || metrics.Buffered != 1 || metrics.Failures != 1 || metrics.LastError == "" {
+		t.Errorf("metrics before Close = %+v", metrics)
+	}
+
+	if err := emitter.Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	metrics := emitter.Metrics()
+	if delivered.Load() != 5 || metrics.Delivered != 5 || metrics.PendingBatches != 0 || metrics.Buffered != 0 || metrics.Dropped != 0 {
+		t.Errorf("delivered = %d, metrics = %+v", delivered.Load(), metrics)
+	}
+	if batches, _ := store.List(ctx); len(batches) != 0 {
+		t.Errorf("Expected an empty store, got %d batches", len(batches))
+	}
+}
@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+// MaxEventsPerRequest is the largest batch of events accepted per call
+const MaxEventsPerRequest = 1000
+
+// Standard web event names
+const (
+	EventViewContent          = "ViewContent"
+	EventClickButton          = "ClickButton"
+	EventSearch               = "Search"
+	EventAddToWishlist        = "AddToWishlist"
+	EventAddToCart            = "AddToCart"
+	EventInitiateCheckout     = "InitiateCheckout"
+	EventAddPaymentInfo       = "AddPaymentInfo"
+	EventCompletePayment      = "CompletePayment"
+	EventPlaceAnOrder         = "PlaceAnOrder"
+	EventContact              = "Contact"
+	EventDownload             = "Download"
+	EventSubmitForm           = "SubmitForm"
+	EventCompleteRegistration = "CompleteRegistration"
+	EventSubscribe            = "Subscribe"
+)
+
+// EventsService handles the Events API for pixel web events
+type EventsService struct {
+	client *Client
+}
+
+// NewEventsService creates a new EventsService
+func NewEventsService(client *Client) *EventsService {
+	return &EventsService{client: client}
+}
+
+// EventUser identifies who triggered an event. Email, phone and external ID
+// may be given in plain text; they are normalized and SHA-256 hashed before
+// submission, and values that are already digests are sent unchanged.
+type EventUser struct {
+	Email      string `json:"email,omitempty"`
+	Phone      string `json:"phone,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+	IP         string `json:"ip,omitempty"`
+	UserAgent  string `json:"user_agent,omitempty"`
+	TTClid     string `json:"ttclid,omitempty"` // click ID from the landing page URL
+	TTP        string `json:"ttp,omitempty"`    // _ttp cookie
+	Locale     string `json:"locale,omitempty"`
+}
+
+// Hashed returns the user with email, phone and external ID hashed
+func (u EventUser) Hashed() EventUser {
+	u.Email = utils.HashEmail(u.Email)
+	u.Phone = utils.HashPhone(u.Phone)
+	u.ExternalID = utils.HashExternalID(u.ExternalID)
+	return u
+}
+
+// EventPage describes the page an event happened on
+type EventPage struct {
+	URL      string `json:"url,omitempty"`
+	Referrer string `json:"referrer,omitempty"`
+}
+
+// EventContent is an item an event refers to
+type EventContent struct {
+	ContentID   string  `json:"content_id,omitempty"`
+	ContentType string  `json:"content_type,omitempty"` // product, product_group
+	ContentName string  `json:"content_name,omitempty"`
+	Quantity    int     `json:"quantity,omitempty"`
+	Price       float64 `json:"price,omitempty"`
+	Brand       string  `json:"brand,omitempty"`
+}
+
+// EventProperties holds the commerce details of an event
+type EventProperties struct {
+	Currency    string         `json:"currency,omitempty"`
+	Value       float64        `json:"value,omitempty"`
+	ContentType string         `json:"content_type,omitempty"`
+	Contents    []EventContent `json:"contents,omitempty"`
+	OrderID     string         `json:"order_id,omitempty"`
+	Query       string         `json:"query,omitempty"`
+	Description string         `json:"description,omitempty"`
+}
+
+// WebEvent is a single pixel web event
+type WebEvent struct {
+	Event      string
+	EventTime  time.Time // defaults to now
+	EventID    string    // deduplicates against browser pixel events
+	User       EventUser
+	Page       *EventPage
+	Properties *EventProperties
+}
+
+// TrackEventsRequest represents the request for reporting web events
+type TrackEventsRequest struct {
+	PixelCode string
+	Events    []WebEvent
+
+	// TestEventCode routes events to the Test Events tool instead of
+	// attribution
+	TestEventCode string
+}
+
+// TrackEventsResponse represents the response from reporting web events
+type TrackEventsResponse struct {
+	Code      int             `json:"code"`
+	Message   string          `json:"message"`
+	RequestID string          `json:"request_id"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// webEventPayload is the wire format of a web event
+type webEventPayload struct {
+	Event      string           `json:"event"`
+	EventTime  int64            `json:"event_time"`
+	EventID    string           `json:"event_id,omitempty"`
+	User       EventUser        `json:"user"`
+	Page       *EventPage       `json:"page,omitempty"`
+	Properties *EventProperties `json:"properties,omitempty"`
+}
+
+// Validate checks the pixel code, batch size and event names
+func (r *TrackEventsRequest) Validate() error {
+	if r.PixelCode == "" {
+		return fmt.Errorf("pixel_code is required")
+	}
+	if len(r.Events) == 0 {
+		return fmt.Errorf("events is required")
+	}
+	if len(r.Events) > MaxEventsPerRequest {
+		return fmt.Errorf("events cannot exceed %d per request", MaxEventsPerRequest)
+	}
+	for i, event := range r.Events {
+		if event.Event == "" {
+			return fmt.Errorf("event %d: event name is required", i)
+		}
+	}
+	return nil
+}
+
+// Track hashes and reports up to MaxEventsPerRequest web events
+func (s *EventsService) Track(ctx context.Context, req *TrackEventsRequest) (*TrackEventsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	payloads := make([]webEventPayload, 0, len(req.Events))
+	for _, event := range req.Events {
+		eventTime := event.EventTime
+		if eventTime.IsZero() {
+			eventTime = now
+		}
+		payloads = append(payloads, webEventPayload{
+			Event:      event.Event,
+			EventTime:  eventTime.Unix(),
+			EventID:    event.EventID,
+			User:       event.User.Hashed(),
+			Page:       event.Page,
+			Properties: event.Properties,
+		})
+	}
+
+	body := map[string]interface{}{
+		"event_source":    "web",
+		"event_source_id": req.PixelCode,
+		"data":            payloads,
+	}
+	if req.TestEventCode != "" {
+		body["test_event_code"] = req.TestEventCode
+	}
+
+	url, err := s.client.BuildURL("/event/track/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(data)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to track events: %w", err)
+	}
+
+	var response TrackEventsResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// TrackAll reports any number of events in batches of MaxEventsPerRequest,
+// stopping at the first failed batch. The responses of the batches sent are
+// returned along with any error.
+func (s *EventsService) TrackAll(ctx context.Context, req *TrackEventsRequest) ([]*TrackEventsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if len(req.Events) == 0 {
+		return nil, fmt.Errorf("events is required")
+	}
+
+	var responses []*TrackEventsResponse
+	for start := 0; start < len(req.Events); start += MaxEventsPerRequest {
+		end := min(start+MaxEventsPerRequest, len(req.Events))
+		batch := *req
+		batch.Events = req.Events[start:end]
+
+		resp, err := s.Track(ctx, &batch)
+		if err != nil {
+			return responses, fmt.Errorf("batch of events %d-%d: %w", start, end-1, err)
+		}
+		responses = append(responses, resp)
+		if resp.Code != 0 {
+			return responses, fmt.Errorf("batch of events %d-%d: API error: %s", start, end-1, resp.Message)
+		}
+	}
+	return responses, nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+func TestEventsService_TrackAll(t *testing.T) {
+	var batchSizes []int
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/event/track/" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		var body struct {
+			EventSourceID string            `json:"event_source_id"`
+			TestEventCode string            `json:"test_event_code"`
+			Data          []webEventPayload `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body.EventSourceID != "PIXEL" || body.TestEventCode != "TEST123" {
+			t.Errorf("event_source_id = %q, test_event_code = %q", body.EventSourceID, body.TestEventCode)
+		}
+		user := body.Data[0].User
+		if user.Email != utils.HashSHA256("jane@example.com") || user.IP != "203.0.113.7" {
+			t.Errorf("user = %+v, want hashed email and plain IP", user)
+		}
+		batchSizes = append(batchSizes, len(body.Data))
+		writeJSON(w, `{"code":0,"message":"OK"}`)
+	})
+
+	client := newTestClient(t, server)
+
+	events := make([]WebEvent, 1500)
+	for i := range events {
+		events[i] = WebEvent{
+			Event:      EventCompletePayment,
+			User:       EventUser{Email: " Jane@Example.com ", IP: "203.0.113.7"},
+			Properties: &EventProperties{Currency: "USD", Value: 10},
+		}
+	}
+	req := &TrackEventsRequest{PixelCode: "PIXEL", Events: events, TestEventCode: "TEST123"}
+
+	if _, err := client.Events().Track(context.Background(), req); err == nil {
+		t.Error("Track() accepted more than MaxEventsPerRequest events")
+	}
+
+	responses, err := client.Events().TrackAll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("TrackAll() error = %v", err)
+	}
+	if len(responses) != 2 || len(batchSizes) != 2 || batchSizes[0] != 1000 || batchSizes[1] != 500 {
+		t.Errorf("batches = %v, want [1000 500]", batchSizes)
+	}
+}
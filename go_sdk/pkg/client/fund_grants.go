@@ -0,0 +1,70 @@
+package client
+
+import (
+	"sort"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// FundGrantUsage traces how a coupon or grant was consumed
+type FundGrantUsage struct {
+	GrantID  string
+	FundType string
+	Currency string
+
+	Granted  float64
+	Consumed float64
+	Expired  float64
+	Adjusted float64
+	Refunded float64
+
+	// Remaining is what is left of the grant after the traced transactions
+	Remaining float64
+
+	GrantTime  models.Time
+	ExpireTime models.Time
+}
+
+// SummarizeFundGrants groups fund transactions by grant, sorted by GrantID.
+// Consumed and Expired are reported as positive amounts; transactions without
+// a grant ID are skipped.
+func SummarizeFundGrants(transactions []AdvertiserFundTransaction) []FundGrantUsage {
+	grants := make(map[string]*FundGrantUsage)
+	for _, tx := range transactions {
+		if tx.GrantID == "" {
+			continue
+		}
+		usage, ok := grants[tx.GrantID]
+		if !ok {
+			usage = &FundGrantUsage{GrantID: tx.GrantID, FundType: tx.FundType, Currency: tx.Currency}
+			grants[tx.GrantID] = usage
+		}
+
+		switch tx.TransactionType {
+		case FundTransactionGrant:
+			usage.Granted += tx.Amount
+			usage.GrantTime = tx.CreateTime
+		case FundTransactionConsume:
+			usage.Consumed -= tx.Amount
+		case FundTransactionExpire:
+			usage.Expired -= tx.Amount
+		case FundTransactionAdjustment:
+			usage.Adjusted += tx.Amount
+		case FundTransactionRefund:
+			usage.Refunded += tx.Amount
+		}
+		usage.Remaining += tx.Amount
+		if !tx.ExpireTime.IsZero() {
+			usage.ExpireTime = tx.ExpireTime
+		}
+	}
+
+	summary := make([]FundGrantUsage, 0, len(grants))
+	for _, usage := range grants {
+		summary = append(summary, *usage)
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		return summary[i].GrantID < summary[j].GrantID
+	})
+	return summary
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestServer starts a server running handler, closed when the test ends
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestClient creates a client of server with a test access token.
+// configure adjusts the config before the client is created.
+func newTestClient(t *testing.T, server *httptest.Server, configure ...func(*Config)) *Client {
+	t.Helper()
+	config := &Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second}
+	for _, fn := range configure {
+		fn(config)
+	}
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+// writeJSON writes body as a JSON response
+func writeJSON(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(body))
+}
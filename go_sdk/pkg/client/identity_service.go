@@ -0,0 +1,435 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/jpeg" // register JPEG decoder for avatar validation
+	_ "image/png"  // register PNG decoder for avatar validation
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Avatar image constraints for custom identities
+const (
+	IdentityAvatarMinDimension = 98
+	IdentityAvatarMaxBytes     = 50 * 1024
+	IdentityDisplayNameMaxLen  = 40
+)
+
+// IdentityService handles ad identity operations
+type IdentityService struct {
+	client *Client
+}
+
+// NewIdentityService creates a new IdentityService
+func NewIdentityService(client *Client) *IdentityService {
+	return &IdentityService{client: client}
+}
+
+// IdentityGetRequest represents the request for listing identities
+type IdentityGetRequest struct {
+	AdvertiserID string              `json:"advertiser_id"`
+	IdentityType models.IdentityType `json:"identity_type,omitempty"`
+	Page         int                 `json:"page,omitempty"`
+	PageSize     int                 `json:"page_size,omitempty"`
+}
+
+// IdentityCreateRequest represents the request for creating a custom identity
+type IdentityCreateRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	DisplayName  string `json:"display_name"`
+	ImageURI     string `json:"image_uri,omitempty"`
+}
+
+// IdentityUpdateRequest represents the request for updating a custom identity
+type IdentityUpdateRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	IdentityID   string `json:"identity_id"`
+	DisplayName  string `json:"display_name,omitempty"`
+	ImageURI     string `json:"image_uri,omitempty"`
+}
+
+// IdentityAvatarUploadRequest represents the request for uploading an identity avatar
+type IdentityAvatarUploadRequest struct {
+	AdvertiserID string
+	ImageData    []byte
+	ImageName    string
+}
+
+// IdentityData represents identity information
+type IdentityData struct {
+	IdentityID             string              `json:"identity_id"`
+	IdentityType           models.IdentityType `json:"identity_type"`
+	DisplayName            string              `json:"display_name"`
+	ProfileImage           string              `json:"profile_image,omitempty"`
+	IdentityAuthorizedBCID string              `json:"identity_authorized_bc_id,omitempty"`
+	CanPullVideo           bool                `json:"can_pull_video,omitempty"`
+	CanPushVideo           bool                `json:"can_push_video,omitempty"`
+}
+
+// IdentityListResponse represents the response for listing identities
+type IdentityListResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		IdentityList []IdentityData `json:"identity_list"`
+		PageInfo     struct {
+			Page        int `json:"page"`
+			PageSize    int `json:"page_size"`
+			TotalNumber int `json:"total_number"`
+			TotalPage   int `json:"total_page"`
+		} `json:"page_info"`
+	} `json:"data"`
+}
+
+// IdentityResponse represents the response from identity create and update operations
+type IdentityResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		IdentityID string `json:"identity_id"`
+	} `json:"data"`
+}
+
+// IdentityVideoInfoRequest represents the request for the details of a post
+// published by an identity
+type IdentityVideoInfoRequest struct {
+	AdvertiserID           string              `json:"advertiser_id"`
+	IdentityType           models.IdentityType `json:"identity_type"`
+	IdentityID             string              `json:"identity_id"`
+	IdentityAuthorizedBCID string              `json:"identity_authorized_bc_id,omitempty"`
+	ItemID                 string              `json:"item_id"`
+}
+
+// IdentityVideoAuthInfo describes whether a post may be promoted as a Spark Ad
+type IdentityVideoAuthInfo struct {
+	AdAuthStatus  string `json:"ad_auth_status"` // AUTHORIZED, UNAUTHORIZED
+	AuthStartTime string `json:"auth_start_time,omitempty"`
+	AuthEndTime   string `json:"auth_end_time,omitempty"`
+}
+
+// IdentityVideoDetail represents a post published by an identity
+type IdentityVideoDetail struct {
+	ItemID    string                `json:"item_id"`
+	Text      string                `json:"text"`
+	Status    string                `json:"status"`
+	AuthInfo  IdentityVideoAuthInfo `json:"auth_info"`
+	VideoInfo struct {
+		VideoID   string  `json:"video_id,omitempty"`
+		PosterURL string  `json:"poster_url,omitempty"`
+		Duration  float64 `json:"duration,omitempty"`
+		Width     int     `json:"width,omitempty"`
+		Height    int     `json:"height,omitempty"`
+	} `json:"video_info"`
+}
+
+// IdentityVideoInfoResponse represents the response for a post's details
+type IdentityVideoInfoResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		VideoDetail IdentityVideoDetail `json:"video_detail"`
+	} `json:"data"`
+}
+
+// List retrieves the identities available to an advertiser
+func (s *IdentityService) List(ctx context.Context, req *IdentityGetRequest) (*IdentityListResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+	}
+	if req.IdentityType != "" {
+		params["identity_type"] = req.IdentityType
+	}
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+	if req.PageSize > 0 {
+		params["page_size"] = req.PageSize
+	}
+
+	url, err := s.client.BuildURL("/identity/get/", params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identities: %w", err)
+	}
+
+	var response IdentityListResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Create creates a custom identity used on non-Spark ads
+func (s *IdentityService) Create(ctx context.Context, req *IdentityCreateRequest) (*IdentityResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return nil, models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+	if err := ValidateIdentityDisplayName(req.DisplayName); err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, "/identity/create/", req, "create identity")
+}
+
+// Update updates the display name or avatar of a custom identity
+func (s *IdentityService) Update(ctx context.Context, req *IdentityUpdateRequest) (*IdentityResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return nil, models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+	if req.IdentityID == "" {
+		return nil, models.NewValidationError("identity_id", "identity_id is required")
+	}
+	if req.DisplayName == "" && req.ImageURI == "" {
+		return nil, models.NewValidationError("display_name", "display_name or image_uri is required")
+	}
+	if req.DisplayName != "" {
+		if err := ValidateIdentityDisplayName(req.DisplayName); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.post(ctx, "/identity/update/", req, "update identity")
+}
+
+// UploadAvatar validates an avatar image against the identity image spec and
+// uploads it, returning the image ID to use as ImageURI
+func (s *IdentityService) UploadAvatar(ctx context.Context, req *IdentityAvatarUploadRequest) (string, error) {
+	if req == nil {
+		return "", fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return "", models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+
+	format, err := ValidateIdentityAvatar(req.ImageData)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.client.Creative().UploadImage(ctx, &ImageUploadRequest{
+		AdvertiserID: req.AdvertiserID,
+		ImageData:    req.ImageData,
+		ImageName:    req.ImageName,
+		ImageType:    strings.ToUpper(format),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload avatar: %w", err)
+	}
+	if resp.Code != 0 {
+		return "", fmt.Errorf("failed to upload avatar: %w", models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0))
+	}
+
+	return resp.Data.ImageID, nil
+}
+
+// SetDisplayName changes the display name of a custom identity
+func (s *IdentityService) SetDisplayName(ctx context.Context, advertiserID, identityID, displayName string) (*IdentityResponse, error) {
+	return s.Update(ctx, &IdentityUpdateRequest{
+		AdvertiserID: advertiserID,
+		IdentityID:   identityID,
+		DisplayName:  displayName,
+	})
+}
+
+// GetVideoInfo retrieves a post published by an identity, including whether
+// it is authorized for promotion
+func (s *IdentityService) GetVideoInfo(ctx context.Context, req *IdentityVideoInfoRequest) (*IdentityVideoInfoResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.IdentityType == models.IdentityTypeBCAuthTT && req.IdentityAuthorizedBCID == "" {
+		return nil, models.NewValidationError("identity_authorized_bc_id", "identity_authorized_bc_id is required for BC_AUTH_TT identities")
+	}
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+		"identity_type": string(req.IdentityType),
+		"identity_id":   req.IdentityID,
+		"item_id":       req.ItemID,
+	}
+	if req.IdentityAuthorizedBCID != "" {
+		params["identity_authorized_bc_id"] = req.IdentityAuthorizedBCID
+	}
+
+	url, err := s.client.BuildURL("/identity/video/info/", params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity video info: %w", err)
+	}
+
+	var response IdentityVideoInfoResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// SparkAdCreative resolves a TikTok post, given as an item ID or post URL,
+// into the creative fields of a Spark Ad. The post must be authorized for
+// promotion; the returned creative can be completed with ad text and a call
+// to action before it is added to an AdCreateRequest.
+func (s *IdentityService) SparkAdCreative(ctx context.Context, advertiserID string, identity *IdentityData, post string) (*AdCreative, error) {
+	if identity == nil {
+		return nil, models.NewValidationError("identity", "identity is required")
+	}
+	if identity.IdentityType == models.IdentityTypeCustomizedUser {
+		return nil, models.NewValidationError("identity_type", "spark ads cannot use CUSTOMIZED_USER identities")
+	}
+	itemID, err := ParseTikTokItemID(post)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GetVideoInfo(ctx, &IdentityVideoInfoRequest{
+		AdvertiserID:           advertiserID,
+		IdentityType:           identity.IdentityType,
+		IdentityID:             identity.IdentityID,
+		IdentityAuthorizedBCID: identity.IdentityAuthorizedBCID,
+		ItemID:                 itemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+	}
+	if status := resp.Data.VideoDetail.AuthInfo.AdAuthStatus; status != PostAuthStatusAuthorized {
+		return nil, models.NewValidationError("item_id", fmt.Sprintf("post %s is not authorized for promotion (ad_auth_status %s)", itemID, status))
+	}
+
+	return &AdCreative{
+		AdFormat:               "SINGLE_VIDEO",
+		IdentityID:             identity.IdentityID,
+		IdentityType:           string(identity.IdentityType),
+		IdentityAuthorizedBCID: identity.IdentityAuthorizedBCID,
+		TikTokItemID:           itemID,
+	}, nil
+}
+
+// post marshals a request body and posts it to an identity endpoint
+func (s *IdentityService) post(ctx context.Context, endpoint string, req interface{}, action string) (*IdentityResponse, error) {
+	url, err := s.client.BuildURL(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	var response IdentityResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// ValidateIdentityDisplayName validates a custom identity display name
+func ValidateIdentityDisplayName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return models.NewValidationError("display_name", "display_name is required")
+	}
+	if len([]rune(name)) > IdentityDisplayNameMaxLen {
+		return models.NewValidationError("display_name", fmt.Sprintf("display_name cannot exceed %d characters", IdentityDisplayNameMaxLen))
+	}
+	return nil
+}
+
+// ValidateIdentityAvatar checks that avatar image data is a square JPEG or
+// PNG within the size limits, returning the detected format
+func ValidateIdentityAvatar(data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", models.NewValidationError("image_data", "image_data is required")
+	}
+	if len(data) > IdentityAvatarMaxBytes {
+		return "", models.NewValidationError("image_data", fmt.Sprintf("avatar image cannot exceed %d bytes", IdentityAvatarMaxBytes))
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", models.NewValidationError("image_data", fmt.Sprintf("avatar image must be JPEG or PNG: %v", err))
+	}
+	if cfg.Width != cfg.Height {
+		return "", models.NewValidationError("image_data", fmt.Sprintf("avatar image must be square, got %dx%d", cfg.Width, cfg.Height))
+	}
+	if cfg.Width < IdentityAvatarMinDimension {
+		return "", models.NewValidationError("image_data", fmt.Sprintf("avatar image must be at least %dx%d", IdentityAvatarMinDimension, IdentityAvatarMinDimension))
+	}
+
+	return format, nil
+}
+
+// tiktokItemIDPattern matches the numeric item ID in a TikTok post path
+var tiktokItemIDPattern = regexp.MustCompile(`/(?:video|photo)/(\d+)`)
+
+// ParseTikTokItemID returns the item ID of a TikTok post given either the ID
+// itself or a post URL such as https://www.tiktok.com/@user/video/7234567890123456789
+func ParseTikTokItemID(post string) (string, error) {
+	post = strings.TrimSpace(post)
+	if post == "" {
+		return "", models.NewValidationError("item_id", "item_id is required")
+	}
+	if isDigits(post) {
+		return post, nil
+	}
+
+	u, err := url.Parse(post)
+	if err != nil || u.Host == "" {
+		return "", models.NewValidationError("item_id", fmt.Sprintf("invalid TikTok post %q", post))
+	}
+	match := tiktokItemIDPattern.FindStringSubmatch(u.Path)
+	if match == nil {
+		return "", models.NewValidationError("item_id", fmt.Sprintf("no item ID in TikTok post URL %q", post))
+	}
+	return match[1], nil
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,219 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestIdentityService_SparkAdCreative(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/identity/video/info/" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("identity_type") != "BC_AUTH_TT" || q.Get("identity_authorized_bc_id") != "bc1" {
+			t.Errorf("query = %v", q)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if q.Get("item_id") == "7234567890123456789" {
+			w.Write([]byte(`{"code":0,"data":{"video_detail":{"item_id":"7234567890123456789","auth_info":{"ad_auth_status":"AUTHORIZED"}}}}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"data":{"video_detail":{"auth_info":{"ad_auth_status":"UNAUTHORIZED"}}}}`))
+	})
+
+	client := newTestClient(t, server)
+
+	identity := &IdentityData{IdentityID: "id1", IdentityType: models.IdentityTypeBCAuthTT, IdentityAuthorizedBCID: "bc1"}
+	creative, err := client.Identity().SparkAdCreative(context.Background(), "123", identity, "https://www.tiktok.com/@brand/video/7234567890123456789?lang=en")
+	if err != nil {
+		t.Fatalf("SparkAdCreative() error = %v", err)
+	}
+	if creative.TikTokItemID != "7234567890123456789" || creative.IdentityType != "BC_AUTH_TT" || creative.IdentityAuthorizedBCID != "bc1" {
+		t.Errorf("creative = %+v", creative)
+	}
+
+	// Every rejection is a typed validation error naming its field
+	var validation models.ValidationError
+	for _, tt := range []struct {
+		name       string
+		advertiser string
+		identity   *IdentityData
+		post       string
+		field      string
+	}{
+		{"unauthorized post", "123", identity, "7000000000000000001", "item_id"},
+		{"customized user", "123", &IdentityData{IdentityID: "id2", IdentityType: models.IdentityTypeCustomizedUser}, "7234567890123456789", "identity_type"},
+		{"no identity", "123", nil, "7234567890123456789", "identity"},
+		{"no item ID", "123", identity, "https://www.tiktok.com/@brand", "item_id"},
+		{"no advertiser", "", identity, "7234567890123456789", "advertiser_id"},
+		{"no BC", "123", &IdentityData{IdentityID: "id1", IdentityType: models.IdentityTypeBCAuthTT}, "7234567890123456789", "identity_authorized_bc_id"},
+	} {
+		_, err := client.Identity().SparkAdCreative(context.Background(), tt.advertiser, tt.identity, tt.post)
+		if !errors.As(err, &validation) || validation.Field != tt.field {
+			t.Errorf("%s: SparkAdCreative() error = %v, want a validation error for %s", tt.name, err, tt.field)
+		}
+	}
+}
+
+// blankPNG encodes a blank PNG of the given size
+func blankPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestIdentityService(t *testing.T) {
+	var created, updated map[string]interface{}
+	var uploads int
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/identity/get/":
+			q := r.URL.Query()
+			if q.Get("advertiser_id") != "123" || q.Get("identity_type") != "CUSTOMIZED_USER" || q.Get("page_size") != "50" {
+				t.Errorf("query = %v", q)
+			}
+			writeJSON(w, `{"code":0,"data":{"identity_list":[{"identity_id":"id1","identity_type":"CUSTOMIZED_USER","display_name":"Brand"}],"page_info":{"page":1,"total_page":1}}}`)
+		case "/open_api/v1.3/identity/create/":
+			json.NewDecoder(r.Body).Decode(&created)
+			writeJSON(w, `{"code":0,"data":{"identity_id":"id2"}}`)
+		case "/open_api/v1.3/identity/update/":
+			json.NewDecoder(r.Body).Decode(&updated)
+			writeJSON(w, `{"code":0,"data":{"identity_id":"id1"}}`)
+		case "/open_api/v1.3/file/image/ad/upload/":
+			uploads++
+			if r.FormValue("advertiser_id") != "123" {
+				t.Errorf("upload advertiser_id = %q", r.FormValue("advertiser_id"))
+			}
+			if uploads > 1 {
+				writeJSON(w, `{"code":40002,"message":"Image size is invalid","request_id":"req-1"}`)
+				return
+			}
+			writeJSON(w, `{"code":0,"data":{"image_id":"img1"}}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	list, err := client.Identity().List(ctx, &IdentityGetRequest{AdvertiserID: "123", IdentityType: models.IdentityTypeCustomizedUser, PageSize: 50})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(list.Data.IdentityList) != 1 || list.Data.IdentityList[0].DisplayName != "Brand" {
+		t.Errorf("identities = %+v", list.Data.IdentityList)
+	}
+
+	imageID, err := client.Identity().UploadAvatar(ctx, &IdentityAvatarUploadRequest{AdvertiserID: "123", ImageData: blankPNG(t, 100, 100), ImageName: "avatar.png"})
+	if err != nil || imageID != "img1" {
+		t.Fatalf("UploadAvatar() = %q, %v", imageID, err)
+	}
+	resp, err := client.Identity().Create(ctx, &IdentityCreateRequest{AdvertiserID: "123", DisplayName: "Brand", ImageURI: imageID})
+	if err != nil || resp.Data.IdentityID != "id2" {
+		t.Fatalf("Create() = %+v, %v", resp, err)
+	}
+	if created["display_name"] != "Brand" || created["image_uri"] != "img1" {
+		t.Errorf("create body = %v", created)
+	}
+	if _, err := client.Identity().SetDisplayName(ctx, "123", "id1", "Brand Official"); err != nil {
+		t.Fatalf("SetDisplayName() error = %v", err)
+	}
+	if _, ok := updated["image_uri"]; ok || updated["identity_id"] != "id1" || updated["display_name"] != "Brand Official" {
+		t.Errorf("update body = %v", updated)
+	}
+
+	_, err = client.Identity().UploadAvatar(ctx, &IdentityAvatarUploadRequest{AdvertiserID: "123", ImageData: blankPNG(t, 100, 100)})
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "40002" {
+		t.Errorf("UploadAvatar() rejected upload error = %v", err)
+	}
+
+	// Invalid requests are rejected before reaching the API
+	invalid := []struct {
+		name string
+		call func() error
+	}{
+		{"create without display name", func() error {
+			_, err := client.Identity().Create(ctx, &IdentityCreateRequest{AdvertiserID: "123", DisplayName: "  "})
+			return err
+		}},
+		{"create with long display name", func() error {
+			_, err := client.Identity().Create(ctx, &IdentityCreateRequest{AdvertiserID: "123", DisplayName: strings.Repeat("a", IdentityDisplayNameMaxLen+1)})
+			return err
+		}},
+		{"update without changes", func() error {
+			_, err := client.Identity().Update(ctx, &IdentityUpdateRequest{AdvertiserID: "123", IdentityID: "id1"})
+			return err
+		}},
+		{"update without identity", func() error {
+			_, err := client.Identity().Update(ctx, &IdentityUpdateRequest{AdvertiserID: "123", DisplayName: "Brand"})
+			return err
+		}},
+		{"list without advertiser", func() error {
+			_, err := client.Identity().List(ctx, &IdentityGetRequest{})
+			return err
+		}},
+		{"video info of BC identity without BC", func() error {
+			_, err := client.Identity().GetVideoInfo(ctx, &IdentityVideoInfoRequest{AdvertiserID: "123", IdentityID: "id1", IdentityType: models.IdentityTypeBCAuthTT, ItemID: "1"})
+			return err
+		}},
+	}
+	for _, tt := range invalid {
+		if err := tt.call(); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+	if uploads != 2 {
+		t.Errorf("uploads = %d, want 2", uploads)
+	}
+}
+
+func TestValidateIdentityAvatar(t *testing.T) {
+	var jpegData bytes.Buffer
+	if err := jpeg.Encode(&jpegData, image.NewGray(image.Rect(0, 0, 120, 120)), nil); err != nil {
+		t.Fatalf("jpeg.Encode() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		data       []byte
+		wantFormat string
+		wantErr    string
+	}{
+		{name: "png", data: blankPNG(t, IdentityAvatarMinDimension, IdentityAvatarMinDimension), wantFormat: "png"},
+		{name: "jpeg", data: jpegData.Bytes(), wantFormat: "jpeg"},
+		{name: "empty", wantErr: "image_data is required"},
+		{name: "too large", data: make([]byte, IdentityAvatarMaxBytes+1), wantErr: "cannot exceed"},
+		{name: "not an image", data: []byte("GIF89a"), wantErr: "must be JPEG or PNG"},
+		{name: "not square", data: blankPNG(t, 120, 100), wantErr: "must be square"},
+		{name: "too small", data: blankPNG(t, 64, 64), wantErr: "at least 98x98"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := ValidateIdentityAvatar(tt.data)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("ValidateIdentityAvatar() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || format != tt.wantFormat {
+				t.Errorf("ValidateIdentityAvatar() = %q, %v; want %q", format, err, tt.wantFormat)
+			}
+		})
+	}
+}
@@ -39,11 +39,8 @@ func TestNoPlaceholdersOrTodos(t *testing.T) {
 		"this service is not yet implemented in the current SDK",     // Professional error message
 		"Type definitions for services not yet fully implemented",    // Documentation comment
 		"Services not yet implemented - return clear error messages", // Documentation comment
-		"notImplementedAdService",                                    // Service type name
-		"notImplementedAdGroupService",                               // Service type name
 		"notImplementedAudienceService",                              // Service type name
 		"notImplementedCreativeService",                              // Service type name
-		"notImplementedReportingService",                             // Service type name
 		"notImplementedBCService",                                    // Service type name
 	}
 
@@ -126,6 +123,18 @@ func TestAllServicesHaveProperImplementations(t *testing.T) {
 		if client.Creative() == nil {
 			t.Error("Creative service should not be nil")
 		}
+
+		if client.Ad() == nil {
+			t.Error("Ad service should not be nil")
+		}
+
+		if client.AdGroup() == nil {
+			t.Error("AdGroup service should not be nil")
+		}
+
+		if client.Reporting() == nil {
+			t.Error("Reporting service should not be nil")
+		}
 	})
 
 	// Test that not-yet-implemented services return proper errors
@@ -143,36 +152,11 @@ func TestAllServicesHaveProperImplementations(t *testing.T) {
 
 		ctx := context.Background()
 
-		// Test Ad service returns proper error
-		_, err = client.Ad().Create(ctx, &AdCreateRequest{})
-		if err != ErrServiceNotImplemented {
-			t.Errorf("Ad service should return ErrServiceNotImplemented, got: %v", err)
-		}
-
-		// Test AdGroup service returns proper error
-		_, err = client.AdGroup().Create(ctx, &AdGroupCreateRequest{})
-		if err != ErrServiceNotImplemented {
-			t.Errorf("AdGroup service should return ErrServiceNotImplemented, got: %v", err)
-		}
-
 		// Test Audience service returns proper error
 		_, err = client.Audience().CreateCustomAudience(ctx, &CustomAudienceCreateRequest{})
 		if err != ErrServiceNotImplemented {
 			t.Errorf("Audience service should return ErrServiceNotImplemented, got: %v", err)
 		}
-
-
-		// Test Reporting service returns proper error
-		_, err = client.Reporting().GetBasicReports(ctx, &ReportingRequest{})
-		if err != ErrServiceNotImplemented {
-			t.Errorf("Reporting service should return ErrServiceNotImplemented, got: %v", err)
-		}
-
-		// Test BC service returns proper error
-		_, err = client.BC().GetBusinessCenters(ctx)
-		if err != ErrServiceNotImplemented {
-			t.Errorf("BC service should return ErrServiceNotImplemented, got: %v", err)
-		}
 	})
 }
 
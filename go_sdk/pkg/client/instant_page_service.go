@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// InstantPageStatus is the publishing status of an instant page
+type InstantPageStatus string
+
+const (
+	InstantPageStatusEditing   InstantPageStatus = "EDITING"
+	InstantPageStatusPublished InstantPageStatus = "PUBLISHED"
+	InstantPageStatusDeleted   InstantPageStatus = "DELETED"
+)
+
+// InstantPageBusinessType is the kind of instant page
+type InstantPageBusinessType string
+
+const (
+	InstantPageTypeInstantPage InstantPageBusinessType = "TIKTOK_INSTANT_PAGE"
+	InstantPageTypeLeadGen     InstantPageBusinessType = "LEAD_GEN"
+	InstantPageTypeStorefront  InstantPageBusinessType = "STORE_FEED"
+	InstantPageTypeAppProfile  InstantPageBusinessType = "APP_PROFILE_PAGE"
+	InstantPageTypePopupForm   InstantPageBusinessType = "POP_UP_FORM"
+)
+
+// InstantPageService handles TikTok Instant Pages, the full-screen landing
+// experiences that open inside TikTok
+type InstantPageService struct {
+	client *Client
+}
+
+// NewInstantPageService creates a new InstantPageService
+func NewInstantPageService(client *Client) *InstantPageService {
+	return &InstantPageService{client: client}
+}
+
+// InstantPage represents an instant page
+type InstantPage struct {
+	PageID       string                  `json:"page_id"`
+	Title        string                  `json:"title"`
+	Status       InstantPageStatus       `json:"status"`
+	BusinessType InstantPageBusinessType `json:"business_type"`
+	ThumbnailURL string                  `json:"thumbnail,omitempty"`
+	CreateTime   models.Time             `json:"create_time,omitempty"`
+	UpdateTime   models.Time             `json:"update_time,omitempty"`
+}
+
+// InstantPageFiltering narrows the pages returned by List
+type InstantPageFiltering struct {
+	PageIDs       []string                  `json:"page_ids,omitempty"`
+	Status        InstantPageStatus         `json:"status,omitempty"`
+	BusinessTypes []InstantPageBusinessType `json:"business_types,omitempty"`
+	Title         string                    `json:"title,omitempty"`
+}
+
+// InstantPageListRequest represents the request for listing instant pages
+type InstantPageListRequest struct {
+	AdvertiserID string                `json:"advertiser_id"`
+	Filtering    *InstantPageFiltering `json:"filtering,omitempty"`
+	Page         int                   `json:"page,omitempty"`
+	PageSize     int                   `json:"page_size,omitempty"`
+}
+
+// InstantPageListResponse represents the response for listing instant pages
+type InstantPageListResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		List     []InstantPage `json:"list"`
+		PageInfo struct {
+			Page        int `json:"page"`
+			PageSize    int `json:"page_size"`
+			TotalNumber int `json:"total_number"`
+			TotalPage   int `json:"total_page"`
+		} `json:"page_info"`
+	} `json:"data"`
+}
+
+// InstantPagePreviewResponse represents the response for a page preview link
+type InstantPagePreviewResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		PreviewURL string `json:"preview_url"`
+		QRCodeURL  string `json:"qr_code_url,omitempty"`
+		ExpireTime string `json:"expire_time,omitempty"`
+	} `json:"data"`
+}
+
+// List retrieves the instant pages of an advertiser
+func (s *InstantPageService) List(ctx context.Context, req *InstantPageListRequest) (*InstantPageListResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+	}
+	if req.Filtering != nil {
+		filtering, err := json.Marshal(req.Filtering)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal filtering: %w", err)
+		}
+		params["filtering"] = string(filtering)
+	}
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+	if req.PageSize > 0 {
+		params["page_size"] = req.PageSize
+	}
+
+	url, err := s.client.BuildURL("/page/get/", params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instant pages: %w", err)
+	}
+
+	var response InstantPageListResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Get retrieves a single instant page
+func (s *InstantPageService) Get(ctx context.Context, advertiserID, pageID string) (*InstantPage, error) {
+	if pageID == "" {
+		return nil, fmt.Errorf("page_id is required")
+	}
+
+	resp, err := s.List(ctx, &InstantPageListRequest{
+		AdvertiserID: advertiserID,
+		Filtering:    &InstantPageFiltering{PageIDs: []string{pageID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, fmt.Errorf("API error: %s", resp.Message)
+	}
+
+	for i := range resp.Data.List {
+		if resp.Data.List[i].PageID == pageID {
+			return &resp.Data.List[i], nil
+		}
+	}
+	return nil, fmt.Errorf("instant page not found: %s", pageID)
+}
+
+// GetPreviewURL returns a temporary link for previewing a page on a device
+func (s *InstantPageService) GetPreviewURL(ctx context.Context, advertiserID, pageID string) (*InstantPagePreviewResponse, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if pageID == "" {
+		return nil, fmt.Errorf("page_id is required")
+	}
+
+	url, err := s.client.BuildURL("/page/preview/", map[string]interface{}{
+		"advertiser_id": advertiserID,
+		"page_id":       pageID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instant page preview: %w", err)
+	}
+
+	var response InstantPagePreviewResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Publishable reports whether ads can reference the page
+func (p *InstantPage) Publishable() bool {
+	return p.Status == InstantPageStatusPublished
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestInstantPageService(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/page/get/":
+			if got := r.URL.Query().Get("filtering"); got != `{"page_ids":["p1"]}` {
+				t.Errorf("filtering = %q", got)
+			}
+			w.Write([]byte(`{"code":0,"data":{"list":[{"page_id":"p1","title":"Spring sale","status":"PUBLISHED","business_type":"TIKTOK_INSTANT_PAGE"}]}}`))
+		case "/open_api/v1.3/page/preview/":
+			if got := r.URL.Query().Get("page_id"); got != "p1" {
+				t.Errorf("page_id = %q", got)
+			}
+			w.Write([]byte(`{"code":0,"data":{"preview_url":"https://example.com/preview/p1"}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	page, err := client.InstantPage().Get(context.Background(), "123", "p1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if page.Status != InstantPageStatusPublished || !page.Publishable() {
+		t.Errorf("page = %+v", page)
+	}
+
+	preview, err := client.InstantPage().GetPreviewURL(context.Background(), "123", "p1")
+	if err != nil {
+		t.Fatalf("GetPreviewURL() error = %v", err)
+	}
+	if preview.Data.PreviewURL != "https://example.com/preview/p1" {
+		t.Errorf("preview_url = %q", preview.Data.PreviewURL)
+	}
+
+	if _, err := client.InstantPage().List(context.Background(), &InstantPageListRequest{}); err == nil {
+		t.Error("List() accepted a request without advertiser_id")
+	}
+}
@@ -24,6 +24,26 @@ type AccountService interface {
 
 	// GetAdvertiserFund retrieves advertiser fund information
 	GetAdvertiserFund(ctx context.Context, req *GetAdvertiserFundRequest) (*GetAdvertiserFundResponse, error)
+
+	// GetAdvertiserFundTransactions retrieves a page of fund grants, consumptions,
+	// expirations and adjustments
+	GetAdvertiserFundTransactions(ctx context.Context, req *AdvertiserFundTransactionGetRequest) (*AdvertiserFundTransactionGetResponse, error)
+
+	// GetAllAdvertiserFundTransactions pages through every fund transaction
+	// matching the request
+	GetAllAdvertiserFundTransactions(ctx context.Context, req *AdvertiserFundTransactionGetRequest) ([]AdvertiserFundTransaction, error)
+
+	// GetAdvertiserUsers lists the users with access to an advertiser and their roles
+	GetAdvertiserUsers(ctx context.Context, req *AdvertiserUserGetRequest) (*AdvertiserUserGetResponse, error)
+
+	// AddAdvertiserUser invites a user to an advertiser with a role
+	AddAdvertiserUser(ctx context.Context, req *AdvertiserUserAddRequest) (*AdvertiserUserResponse, error)
+
+	// UpdateAdvertiserUserRole changes the role of an advertiser user
+	UpdateAdvertiserUserRole(ctx context.Context, req *AdvertiserUserRoleUpdateRequest) (*AdvertiserUserResponse, error)
+
+	// RemoveAdvertiserUser revokes a user's access to an advertiser
+	RemoveAdvertiserUser(ctx context.Context, req *AdvertiserUserRemoveRequest) (*AdvertiserUserResponse, error)
 }
 
 // CampaignService defines the interface for campaign-related operations
@@ -42,6 +62,15 @@ type CampaignService interface {
 
 	// UpdateStatus updates campaign status
 	UpdateStatus(ctx context.Context, req *CampaignStatusUpdateRequest) (*CampaignStatusUpdateResponse, error)
+
+	// ListDeleted retrieves campaigns that are in the deleted state
+	ListDeleted(ctx context.Context, req *CampaignListDeletedRequest) (*CampaignGetResponse, error)
+
+	// Restore restores deleted campaigns to the disabled state
+	Restore(ctx context.Context, req *CampaignRestoreRequest) (*CampaignStatusUpdateResponse, error)
+
+	// Copy duplicates campaigns, optionally with their ad groups and ads
+	Copy(ctx context.Context, req *CampaignCopyRequest) (*CampaignCopyResponse, error)
 }
 
 // AdService defines the interface for ad-related operations
@@ -60,6 +89,12 @@ type AdService interface {
 
 	// UpdateStatus updates ad status
 	UpdateStatus(ctx context.Context, req *AdStatusUpdateRequest) (*AdStatusUpdateResponse, error)
+
+	// ListDeleted retrieves ads that are in the deleted state
+	ListDeleted(ctx context.Context, req *AdListDeletedRequest) (*AdGetResponse, error)
+
+	// Restore restores deleted ads to the disabled state
+	Restore(ctx context.Context, req *AdRestoreRequest) (*AdStatusUpdateResponse, error)
 }
 
 // AdGroupService defines the interface for ad group-related operations
@@ -78,6 +113,15 @@ type AdGroupService interface {
 
 	// UpdateStatus updates ad group status
 	UpdateStatus(ctx context.Context, req *AdGroupStatusUpdateRequest) (*AdGroupStatusUpdateResponse, error)
+
+	// ListDeleted retrieves ad groups that are in the deleted state
+	ListDeleted(ctx context.Context, req *AdGroupListDeletedRequest) (*AdGroupGetResponse, error)
+
+	// Restore restores deleted ad groups to the disabled state
+	Restore(ctx context.Context, req *AdGroupRestoreRequest) (*AdGroupStatusUpdateResponse, error)
+
+	// Copy duplicates ad groups, optionally with their ads
+	Copy(ctx context.Context, req *AdGroupCopyRequest) (*AdGroupCopyResponse, error)
 }
 
 // AudienceService defines the interface for audience-related operations
@@ -111,24 +155,57 @@ type CreativeService interface {
 
 	// UpdateCreative updates creative information
 	UpdateCreative(ctx context.Context, req *CreativeUpdateRequest) (*CreativeUpdateResponse, error)
+
+	// GenerateVideoCaptions requests auto-generated captions for uploaded videos
+	GenerateVideoCaptions(ctx context.Context, req *VideoCaptionGenerateRequest) (*VideoCaptionGenerateResponse, error)
+
+	// GetVideoCaptions retrieves caption generation status and caption files
+	GetVideoCaptions(ctx context.Context, req *VideoCaptionGetRequest) (*VideoCaptionGetResponse, error)
+
+	// DownloadVideoCaption downloads a generated caption file
+	DownloadVideoCaption(ctx context.Context, caption *VideoCaptionInfo) ([]byte, error)
+
+	// DeleteAssets deletes images or videos from the asset library
+	DeleteAssets(ctx context.Context, req *CreativeAssetDeleteRequest) (*CreativeAssetDeleteResponse, error)
 }
 
 // ReportingService defines the interface for reporting operations
 type ReportingService interface {
+	// GetIntegratedReport retrieves a synchronous integrated report
+	GetIntegratedReport(ctx context.Context, req *ReportingRequest) (*ReportingResponse, error)
+
 	// GetBasicReports retrieves basic performance reports
 	GetBasicReports(ctx context.Context, req *ReportingRequest) (*ReportingResponse, error)
 
 	// GetAudienceReports retrieves audience reports
 	GetAudienceReports(ctx context.Context, req *AudienceReportingRequest) (*AudienceReportingResponse, error)
 
+	// GetSearchTermReport retrieves the search queries that triggered search ads
+	GetSearchTermReport(ctx context.Context, req *SearchTermReportRequest) (*SearchTermReport, error)
+
+	// GetAudienceInsights retrieves performance broken down by audience
+	// demographics and interests
+	GetAudienceInsights(ctx context.Context, req *AudienceInsightsRequest) (*AudienceInsights, error)
+
+	// GetSKANReport retrieves SKAdNetwork postback metrics of iOS 14 campaigns
+	GetSKANReport(ctx context.Context, req *SKANReportRequest) (*SKANReport, error)
+
+	// GetAttributionAnalytics retrieves click-through and view-through
+	// conversions of ad groups with their attribution windows
+	GetAttributionAnalytics(ctx context.Context, req *AttributionAnalyticsRequest) (*AttributionAnalytics, error)
+
+	// GetCampaignPacing retrieves the intraday spend curve of campaigns with
+	// their projected end-of-day spend against budget
+	GetCampaignPacing(ctx context.Context, req *CampaignPacingRequest) (*PacingReport, error)
+
 	// CreateAsyncReport creates an asynchronous report
 	CreateAsyncReport(ctx context.Context, req *AsyncReportRequest) (*AsyncReportResponse, error)
 
 	// GetAsyncReportStatus checks the status of an asynchronous report
-	GetAsyncReportStatus(ctx context.Context, taskID string) (*AsyncReportStatusResponse, error)
+	GetAsyncReportStatus(ctx context.Context, req *AsyncReportStatusRequest) (*AsyncReportStatusResponse, error)
 
-	// DownloadAsyncReport downloads the results of an asynchronous report
-	DownloadAsyncReport(ctx context.Context, taskID string) (io.ReadCloser, error)
+	// DownloadAsyncReport streams the results of a completed asynchronous report
+	DownloadAsyncReport(ctx context.Context, req *AsyncReportStatusRequest) (io.ReadCloser, error)
 }
 
 // ToolService defines the interface for tool-related operations
@@ -150,27 +227,33 @@ type ToolService interface {
 
 	// GetDeviceModels retrieves device models for targeting
 	GetDeviceModels(ctx context.Context, req *DeviceModelsRequest) (*DeviceModelsResponse, error)
+
+	// GetTargetingInfo retrieves details for targeting IDs such as locations
+	GetTargetingInfo(ctx context.Context, req *TargetingInfoRequest) (*TargetingInfoResponse, error)
 }
 
 // BCService defines the interface for Business Center operations
 type BCService interface {
-	// GetBusinessCenters retrieves business centers
-	GetBusinessCenters(ctx context.Context) (*BusinessCentersResponse, error)
+	// GetBusinessCenters retrieves the business centers the token can access
+	GetBusinessCenters(ctx context.Context) (*BCListResponse, error)
 
 	// GetBusinessCenterInfo retrieves business center information
-	GetBusinessCenterInfo(ctx context.Context, bcID string) (*BusinessCenterInfo, error)
+	GetBusinessCenterInfo(ctx context.Context, bcID string) (*BCResponse, error)
 
 	// GetAdvertisersInBC retrieves advertisers in a business center
-	GetAdvertisersInBC(ctx context.Context, bcID string) (*BCAdvertisersResponse, error)
+	GetAdvertisersInBC(ctx context.Context, bcID string) (*BCAssetResponse, error)
 
 	// TransferAdvertiser transfers an advertiser to a business center
 	TransferAdvertiser(ctx context.Context, req *TransferAdvertiserRequest) (*TransferAdvertiserResponse, error)
+
+	// Transfer transfers funds between accounts of a business center
+	Transfer(ctx context.Context, req *BCTransferRequest) (*BCTransferResponse, error)
 }
 
 // AuthService defines the interface for authentication operations
 type AuthService interface {
 	// GetAuthorizationURL generates an OAuth authorization URL
-	GetAuthorizationURL(scopes []string) string
+	GetAuthorizationURL(scopes []OAuthScope) string
 
 	// GetAccessToken exchanges an authorization code for an access token
 	GetAccessToken(ctx context.Context, code string) (*TokenResponse, error)
@@ -181,6 +264,9 @@ type AuthService interface {
 	// ValidateToken validates an access token
 	ValidateToken(ctx context.Context, token string) (*TokenValidationResponse, error)
 
+	// InvalidateToken drops any cached validation result for a token
+	InvalidateToken(token string)
+
 	// RevokeToken revokes an access token
 	RevokeToken(ctx context.Context, token string) error
 }
@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// inventoryPageSize is the page size used when listing inventory assets
+const inventoryPageSize = 100
+
+// AssetSummary counts the assets of one kind by status and type
+type AssetSummary struct {
+	Total    int            `json:"total"`
+	ByStatus map[string]int `json:"by_status,omitempty"`
+	ByType   map[string]int `json:"by_type,omitempty"`
+
+	// Unused counts assets with no recorded usage: pixels that never fired,
+	// empty audiences and catalogs without products
+	Unused int `json:"unused"`
+}
+
+// InventorySummary summarizes each section of an inventory report
+type InventorySummary struct {
+	Pixels     AssetSummary `json:"pixels"`
+	Audiences  AssetSummary `json:"audiences"`
+	Catalogs   AssetSummary `json:"catalogs"`
+	Identities AssetSummary `json:"identities"`
+}
+
+// InventoryReport is a data governance snapshot of the pixels, audiences,
+// catalogs and identities owned by or available to an advertiser
+type InventoryReport struct {
+	AdvertiserID string               `json:"advertiser_id"`
+	Pixels       []PixelData          `json:"pixels"`
+	Audiences    []CustomAudienceData `json:"audiences"`
+	Catalogs     []CatalogData        `json:"catalogs"`
+	Identities   []IdentityData       `json:"identities"`
+	Summary      InventorySummary     `json:"summary"`
+
+	// Errors maps a section that could not be listed to its error; the
+	// section is left empty rather than failing the whole report
+	Errors map[string]string `json:"errors,omitempty"`
+
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// GetInventoryReport lists every pixel, audience, catalog and identity of an
+// advertiser concurrently and summarizes their status and usage
+func (c *Client) GetInventoryReport(ctx context.Context, advertiserID string) (*InventoryReport, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	report := &InventoryReport{AdvertiserID: advertiserID}
+	sections := map[string]func() error{
+		"pixels": func() (err error) {
+			report.Pixels, err = c.listPixels(ctx, advertiserID)
+			return err
+		},
+		"audiences": func() (err error) {
+			report.Audiences, err = c.listCustomAudiences(ctx, advertiserID)
+			return err
+		},
+		"catalogs": func() (err error) {
+			report.Catalogs, err = c.listCatalogs(ctx, advertiserID)
+			return err
+		},
+		"identities": func() (err error) {
+			report.Identities, err = c.listIdentities(ctx, advertiserID)
+			return err
+		},
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, list := range sections {
+		wg.Add(1)
+		go func(name string, list func() error) {
+			defer wg.Done()
+			if err := list(); err != nil {
+				mu.Lock()
+				if report.Errors == nil {
+					report.Errors = make(map[string]string)
+				}
+				report.Errors[name] = err.Error()
+				mu.Unlock()
+			}
+		}(name, list)
+	}
+	wg.Wait()
+
+	if len(report.Errors) == len(sections) {
+		return nil, fmt.Errorf("failed to list advertiser inventory: %v", report.Errors)
+	}
+
+	for _, pixel := range report.Pixels {
+		report.Summary.Pixels.add(pixel.Status, pixel.PixelMode, pixel.LastFireTime == "" && pixel.EventCount == 0)
+	}
+	for _, audience := range report.Audiences {
+		report.Summary.Audiences.add(audience.Status, audience.AudienceType, audience.Size == 0)
+	}
+	for _, catalog := range report.Catalogs {
+		report.Summary.Catalogs.add(catalog.Status, catalog.CatalogType, catalog.ProductCount == 0)
+	}
+	for _, identity := range report.Identities {
+		report.Summary.Identities.add("", string(identity.IdentityType), false)
+	}
+
+	report.GeneratedAt = time.Now().UTC()
+	return report, nil
+}
+
+// add counts one asset in the summary
+func (s *AssetSummary) add(status, assetType string, unused bool) {
+	s.Total++
+	if status != "" {
+		if s.ByStatus == nil {
+			s.ByStatus = make(map[string]int)
+		}
+		s.ByStatus[status]++
+	}
+	if assetType != "" {
+		if s.ByType == nil {
+			s.ByType = make(map[string]int)
+		}
+		s.ByType[assetType]++
+	}
+	if unused {
+		s.Unused++
+	}
+}
+
+// listPixels pages through all pixels of an advertiser
+func (c *Client) listPixels(ctx context.Context, advertiserID string) ([]PixelData, error) {
+	var pixels []PixelData
+	for page := 1; ; page++ {
+		resp, err := c.Pixel().List(ctx, &PixelGetRequest{AdvertiserID: advertiserID, Page: page, Size: inventoryPageSize})
+		if err != nil {
+			return nil, err
+		}
+		pixels = append(pixels, resp.Data...)
+		if len(resp.Data) < inventoryPageSize {
+			return pixels, nil
+		}
+	}
+}
+
+// listCustomAudiences pages through all custom audiences of an advertiser
+func (c *Client) listCustomAudiences(ctx context.Context, advertiserID string) ([]CustomAudienceData, error) {
+	var audiences []CustomAudienceData
+	for page := 1; ; page++ {
+		resp, err := c.DMP().ListCustomAudiences(ctx, &CustomAudienceListRequest{AdvertiserID: advertiserID, Page: page, Size: inventoryPageSize})
+		if err != nil {
+			return nil, err
+		}
+		audiences = append(audiences, resp.Data...)
+		if len(resp.Data) < inventoryPageSize {
+			return audiences, nil
+		}
+	}
+}
+
+// listCatalogs pages through all catalogs of an advertiser
+func (c *Client) listCatalogs(ctx context.Context, advertiserID string) ([]CatalogData, error) {
+	var catalogs []CatalogData
+	for page := 1; ; page++ {
+		resp, err := c.Catalog().Get(ctx, &CatalogGetRequest{AdvertiserID: advertiserID, Page: page, Size: inventoryPageSize})
+		if err != nil {
+			return nil, err
+		}
+		catalogs = append(catalogs, resp.Data...)
+		if len(resp.Data) < inventoryPageSize {
+			return catalogs, nil
+		}
+	}
+}
+
+// listIdentities pages through all identities available to an advertiser
+func (c *Client) listIdentities(ctx context.Context, advertiserID string) ([]IdentityData, error) {
+	var identities []IdentityData
+	for page := 1; ; page++ {
+		resp, err := c.Identity().List(ctx, &IdentityGetRequest{AdvertiserID: advertiserID, Page: page, PageSize: inventoryPageSize})
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, resp.Data.IdentityList...)
+		if page >= resp.Data.PageInfo.TotalPage {
+			return identities, nil
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_GetInventoryReport(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/pixel/list/":
+			w.Write([]byte(`{"code":0,"data":[{"pixel_id":"p1","status":"ACTIVE","event_count":10},{"pixel_id":"p2","status":"ACTIVE"}]}`))
+		case "/open_api/v1.3/dmp/custom_audience/list/":
+			w.Write([]byte(`{"code":0,"data":[{"audience_id":"a1","audience_type":"CUSTOMER_FILE","status":"READY","size":5000}]}`))
+		case "/open_api/v1.3/catalog/get/":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"code":"FORBIDDEN","message":"no catalog permission"}`))
+		case "/open_api/v1.3/identity/get/":
+			w.Write([]byte(`{"code":0,"data":{"identity_list":[{"identity_id":"i1","identity_type":"TT_USER"}],"page_info":{"page":1,"total_page":1}}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	report, err := client.GetInventoryReport(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("GetInventoryReport() error = %v", err)
+	}
+
+	if report.Summary.Pixels.Total != 2 || report.Summary.Pixels.Unused != 1 || report.Summary.Pixels.ByStatus["ACTIVE"] != 2 {
+		t.Errorf("Unexpected pixel summary: %+v", report.Summary.Pixels)
+	}
+	if report.Summary.Audiences.Total != 1 || report.Summary.Audiences.Unused != 0 {
+		t.Errorf("Unexpected audience summary: %+v", report.Summary.Audiences)
+	}
+	if report.Summary.Identities.ByType["TT_USER"] != 1 {
+		t.Errorf("Unexpected identity summary: %+v", report.Summary.Identities)
+	}
+	if _, ok := report.Errors["catalogs"]; !ok || len(report.Errors) != 1 {
+		t.Errorf("Expected only a catalogs error, got %v", report.Errors)
+	}
+}
@@ -0,0 +1,265 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Labelable entity types
+const (
+	LabelEntityCampaign = "CAMPAIGN"
+	LabelEntityAdGroup  = "ADGROUP"
+	LabelEntityAd       = "AD"
+)
+
+// LabeledEntity identifies an entity and the labels attached to it
+type LabeledEntity struct {
+	EntityType   string            `json:"entity_type"`
+	AdvertiserID string            `json:"advertiser_id"`
+	EntityID     string            `json:"entity_id"`
+	Labels       map[string]string `json:"labels"`
+}
+
+// key returns the store key for the entity
+func (e LabeledEntity) key() string {
+	return e.EntityType + "/" + e.AdvertiserID + "/" + e.EntityID
+}
+
+// LabelStore persists labels keyed by entity, since the API has no label support
+type LabelStore interface {
+	// Get returns the labels of an entity, or nil when it has none
+	Get(ctx context.Context, entity LabeledEntity) (map[string]string, error)
+
+	// Put replaces the labels of an entity; empty labels remove the entity
+	Put(ctx context.Context, entity LabeledEntity) error
+
+	// List returns every labeled entity
+	List(ctx context.Context) ([]LabeledEntity, error)
+}
+
+// memoryLabelStore keeps labels in process memory
+type memoryLabelStore struct {
+	mu       sync.RWMutex
+	entities map[string]LabeledEntity
+}
+
+// NewMemoryLabelStore creates an in-memory LabelStore
+func NewMemoryLabelStore() LabelStore {
+	return &memoryLabelStore{entities: make(map[string]LabeledEntity)}
+}
+
+// Get returns the labels of an entity
+func (m *memoryLabelStore) Get(ctx context.Context, entity LabeledEntity) (map[string]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return copyLabels(m.entities[entity.key()].Labels), nil
+}
+
+// Put replaces the labels of an entity
+func (m *memoryLabelStore) Put(ctx context.Context, entity LabeledEntity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	putLabeledEntity(m.entities, entity)
+	return nil
+}
+
+// List returns every labeled entity
+func (m *memoryLabelStore) List(ctx context.Context) ([]LabeledEntity, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return sortedLabeledEntities(m.entities), nil
+}
+
+// NewFileLabelStore creates a LabelStore backed by a JSON file. It is a
+// LabelStore over NewFileStore, so the file can be shared with the other
+// features kept in a Store.
+func NewFileLabelStore(path string) LabelStore {
+	return NewLabelStore(NewFileStore(path))
+}
+
+// Labeler attaches key/value labels to campaigns, ad groups and ads
+type Labeler struct {
+	client *Client
+	store  LabelStore
+}
+
+// NewLabeler creates a new Labeler; a nil store defaults to an in-memory store
+func NewLabeler(client *Client, store LabelStore) *Labeler {
+	if store == nil {
+		store = NewMemoryLabelStore()
+	}
+	return &Labeler{client: client, store: store}
+}
+
+// SetLabels merges labels into an entity's existing labels
+func (l *Labeler) SetLabels(ctx context.Context, entityType, advertiserID, entityID string, labels map[string]string) error {
+	entity, err := l.entity(entityType, advertiserID, entityID)
+	if err != nil {
+		return err
+	}
+
+	current, err := l.store.Get(ctx, entity)
+	if err != nil {
+		return fmt.Errorf("failed to load labels: %w", err)
+	}
+
+	entity.Labels = copyLabels(current)
+	if entity.Labels == nil {
+		entity.Labels = make(map[string]string, len(labels))
+	}
+	for k, v := range labels {
+		entity.Labels[k] = v
+	}
+
+	return l.store.Put(ctx, entity)
+}
+
+// RemoveLabels removes label keys from an entity
+func (l *Labeler) RemoveLabels(ctx context.Context, entityType, advertiserID, entityID string, keys ...string) error {
+	entity, err := l.entity(entityType, advertiserID, entityID)
+	if err != nil {
+		return err
+	}
+
+	current, err := l.store.Get(ctx, entity)
+	if err != nil {
+		return fmt.Errorf("failed to load labels: %w", err)
+	}
+
+	entity.Labels = copyLabels(current)
+	for _, k := range keys {
+		delete(entity.Labels, k)
+	}
+
+	return l.store.Put(ctx, entity)
+}
+
+// GetLabels returns the labels attached to an entity
+func (l *Labeler) GetLabels(ctx context.Context, entityType, advertiserID, entityID string) (map[string]string, error) {
+	entity, err := l.entity(entityType, advertiserID, entityID)
+	if err != nil {
+		return nil, err
+	}
+	return l.store.Get(ctx, entity)
+}
+
+// Find returns entities whose labels contain every key/value in selector.
+// An empty entityType or advertiserID matches all types or advertisers.
+func (l *Labeler) Find(ctx context.Context, entityType, advertiserID string, selector map[string]string) ([]LabeledEntity, error) {
+	all, err := l.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	var matches []LabeledEntity
+	for _, entity := range all {
+		if entityType != "" && entity.EntityType != entityType {
+			continue
+		}
+		if advertiserID != "" && entity.AdvertiserID != advertiserID {
+			continue
+		}
+		if matchesLabels(entity.Labels, selector) {
+			matches = append(matches, entity)
+		}
+	}
+	return matches, nil
+}
+
+// FindCampaigns returns the campaigns of an advertiser matching a label selector
+func (l *Labeler) FindCampaigns(ctx context.Context, advertiserID string, selector map[string]string) ([]CampaignInfo, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	matches, err := l.Find(ctx, LabelEntityCampaign, advertiserID, selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, entity := range matches {
+		ids = append(ids, entity.EntityID)
+	}
+
+	resp, err := l.client.Campaign().Get(ctx, &CampaignGetRequest{
+		AdvertiserID: advertiserID,
+		Filtering:    &CampaignFiltering{CampaignIDs: ids},
+		PageSize:     len(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+	}
+	return resp.Data, nil
+}
+
+// entity validates and builds an entity reference
+func (l *Labeler) entity(entityType, advertiserID, entityID string) (LabeledEntity, error) {
+	switch entityType {
+	case LabelEntityCampaign, LabelEntityAdGroup, LabelEntityAd:
+	default:
+		return LabeledEntity{}, fmt.Errorf("entity_type must be CAMPAIGN, ADGROUP or AD")
+	}
+	if advertiserID == "" {
+		return LabeledEntity{}, fmt.Errorf("advertiser_id is required")
+	}
+	if entityID == "" {
+		return LabeledEntity{}, fmt.Errorf("entity_id is required")
+	}
+	return LabeledEntity{EntityType: entityType, AdvertiserID: advertiserID, EntityID: entityID}, nil
+}
+
+// matchesLabels reports whether labels contain every key/value in selector
+func matchesLabels(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// putLabeledEntity stores an entity, dropping it when it has no labels
+func putLabeledEntity(entities map[string]LabeledEntity, entity LabeledEntity) {
+	if len(entity.Labels) == 0 {
+		delete(entities, entity.key())
+		return
+	}
+	entity.Labels = copyLabels(entity.Labels)
+	entities[entity.key()] = entity
+}
+
+// sortedLabeledEntities returns entities ordered by key
+func sortedLabeledEntities(entities map[string]LabeledEntity) []LabeledEntity {
+	list := make([]LabeledEntity, 0, len(entities))
+	for _, entity := range entities {
+		list = append(list, entity)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].key() < list[j].key() })
+	return list
+}
+
+// copyLabels returns a copy of a label map
+func copyLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+	copied := make(map[string]string, len(labels))
+	for k, v := range labels {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestLabeler(t *testing.T) {
+	stores := map[string]func(t *testing.T) LabelStore{
+		"memory": func(t *testing.T) LabelStore { return NewMemoryLabelStore() },
+		"file":   func(t *testing.T) LabelStore { return NewFileLabelStore(filepath.Join(t.TempDir(), "labels.json")) },
+	}
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			labeler := NewLabeler(nil, newStore(t))
+			ctx := context.Background()
+
+			if err := labeler.SetLabels(ctx, LabelEntityCampaign, "123", "c1", map[string]string{"team": "growth", "season": "spring"}); err != nil {
+				t.Fatalf("SetLabels() error = %v", err)
+			}
+			if err := labeler.SetLabels(ctx, LabelEntityCampaign, "123", "c1", map[string]string{"season": "summer"}); err != nil {
+				t.Fatalf("SetLabels() error = %v", err)
+			}
+			if err := labeler.SetLabels(ctx, LabelEntityCampaign, "456", "c2", map[string]string{"team": "growth"}); err != nil {
+				t.Fatalf("SetLabels() error = %v", err)
+			}
+			if err := labeler.SetLabels(ctx, LabelEntityAd, "123", "a1", map[string]string{"team": "growth"}); err != nil {
+				t.Fatalf("SetLabels() error = %v", err)
+			}
+
+			labels, err := labeler.GetLabels(ctx, LabelEntityCampaign, "123", "c1")
+			if err != nil || len(labels) != 2 || labels["team"] != "growth" || labels["season"] != "summer" {
+				t.Errorf("GetLabels() = %v, %v; want merged labels", labels, err)
+			}
+
+			matches, err := labeler.Find(ctx, LabelEntityCampaign, "", map[string]string{"team": "growth"})
+			if err != nil || len(matches) != 2 || matches[0].EntityID != "c1" || matches[1].EntityID != "c2" {
+				t.Errorf("Find() = %+v, %v", matches, err)
+			}
+			if matches, _ := labeler.Find(ctx, "", "123", map[string]string{"team": "growth", "season": "summer"}); len(matches) != 1 {
+				t.Errorf("Find() with two labels = %+v", matches)
+			}
+
+			// Removing the last label forgets the entity
+			if err := labeler.RemoveLabels(ctx, LabelEntityAd, "123", "a1", "team"); err != nil {
+				t.Fatalf("RemoveLabels() error = %v", err)
+			}
+			if matches, _ := labeler.Find(ctx, LabelEntityAd, "", nil); len(matches) != 0 {
+				t.Errorf("Find() after removing every label = %+v", matches)
+			}
+
+			if err := labeler.SetLabels(ctx, "CREATIVE", "123", "x1", map[string]string{"a": "b"}); err == nil {
+				t.Error("SetLabels() accepted an unknown entity type")
+			}
+			if _, err := labeler.GetLabels(ctx, LabelEntityAdGroup, "123", ""); err == nil {
+				t.Error("GetLabels() accepted an empty entity ID")
+			}
+		})
+	}
+}
+
+func TestLabeler_FindCampaigns(t *testing.T) {
+	var filtering string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/campaign/get/" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("advertiser_id") == "denied" {
+			writeJSON(w, `{"code":40001,"message":"No permission","request_id":"req-1"}`)
+			return
+		}
+		filtering = r.URL.Query().Get("filtering")
+		writeJSON(w, `{"code":0,"data":[{"campaign_id":"c1","campaign_name":"Spring"}]}`)
+	})
+	labeler := NewLabeler(newTestClient(t, server), nil)
+	ctx := context.Background()
+
+	labeler.SetLabels(ctx, LabelEntityCampaign, "123", "c1", map[string]string{"team": "growth"})
+	labeler.SetLabels(ctx, LabelEntityCampaign, "denied", "c9", map[string]string{"team": "growth"})
+
+	campaigns, err := labeler.FindCampaigns(ctx, "123", map[string]string{"team": "growth"})
+	if err != nil || len(campaigns) != 1 || campaigns[0].CampaignName != "Spring" {
+		t.Fatalf("FindCampaigns() = %+v, %v", campaigns, err)
+	}
+	if filtering != `{"campaign_ids":["c1"]}` {
+		t.Errorf("filtering = %s", filtering)
+	}
+
+	// No matching labels needs no request
+	filtering = ""
+	if campaigns, err := labeler.FindCampaigns(ctx, "123", map[string]string{"team": "brand"}); err != nil || campaigns != nil || filtering != "" {
+		t.Errorf("FindCampaigns() without matches = %+v, %v", campaigns, err)
+	}
+
+	_, err = labeler.FindCampaigns(ctx, "denied", map[string]string{"team": "growth"})
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "40001" {
+		t.Errorf("FindCampaigns() error = %v, want API error 40001", err)
+	}
+}
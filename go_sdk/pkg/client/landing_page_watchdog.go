@@ -0,0 +1,397 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Landing page failure reasons
+const (
+	LandingPageUnreachable      = "UNREACHABLE"
+	LandingPageBadStatus        = "BAD_STATUS"
+	LandingPageTooManyRedirects = "TOO_MANY_REDIRECTS"
+	LandingPageContentMismatch  = "CONTENT_MISMATCH"
+)
+
+// PauseLevel selects what the watchdog pauses when a landing page breaks
+type PauseLevel int
+
+const (
+	// PauseAds disables only the ads pointing at the broken page
+	PauseAds PauseLevel = iota
+
+	// PauseAdGroups disables the ad groups containing those ads
+	PauseAdGroups
+)
+
+// maxLandingPageBody caps how much of a page is read for content assertions
+const maxLandingPageBody = 1 << 20
+
+// errTooManyRedirects stops a check that exceeds MaxRedirects
+var errTooManyRedirects = errors.New("too many redirects")
+
+// LandingPageIncident describes a broken landing page and the ads using it
+type LandingPageIncident struct {
+	AdvertiserID string
+	URL          string
+	Reason       string
+	StatusCode   int
+	Redirects    int
+	Message      string
+	AdIDs        []string
+	AdGroupIDs   []string
+
+	// Failures counts the consecutive rounds in which the page was broken
+	Failures int
+
+	// Paused is set when the ads or ad groups were disabled
+	Paused bool
+
+	// PauseErr holds the error from pausing, if any
+	PauseErr error
+}
+
+// LandingPageWatchdogConfig configures a LandingPageWatchdog
+type LandingPageWatchdogConfig struct {
+	AdvertiserIDs []string
+
+	// Interval is the delay between check rounds (defaults to 15 minutes)
+	Interval time.Duration
+
+	// MaxRedirects is the longest redirect chain accepted (defaults to 5)
+	MaxRedirects int
+
+	// FailureThreshold is the number of consecutive rounds a page must be
+	// broken before its ads are paused and Notify is called (defaults to 2)
+	FailureThreshold int
+
+	// Retries is the number of times a check that fails with a network
+	// error or a server error is repeated within a round (defaults to 2;
+	// a negative value disables retries)
+	Retries int
+
+	// RetryDelay is the wait before repeating a check (defaults to 5s)
+	RetryDelay time.Duration
+
+	// RequiredContent lists strings every landing page must contain, such as
+	// a product name or checkout button label
+	RequiredContent []string
+
+	// PauseLevel selects whether ads or their ad groups are paused
+	PauseLevel PauseLevel
+
+	// NotifyOnly reports broken pages without pausing anything
+	NotifyOnly bool
+
+	// Notify is called once for each page that reaches FailureThreshold
+	Notify func(ctx context.Context, incident LandingPageIncident)
+
+	// HTTPClient fetches landing pages (defaults to a client with a 15s timeout)
+	HTTPClient *http.Client
+
+	// UserAgent is sent with landing page requests
+	UserAgent string
+}
+
+// LandingPageWatchdog periodically checks the landing pages of active ads
+// and pauses the ads whose pages are broken
+type LandingPageWatchdog struct {
+	client *Client
+	config LandingPageWatchdogConfig
+
+	mu       sync.Mutex
+	failures map[string]int // consecutive failed rounds by advertiser/URL
+}
+
+// NewLandingPageWatchdog creates a new LandingPageWatchdog
+func NewLandingPageWatchdog(client *Client, config LandingPageWatchdogConfig) (*LandingPageWatchdog, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+	if len(config.AdvertiserIDs) == 0 {
+		return nil, fmt.Errorf("at least one advertiser_id is required")
+	}
+	if config.NotifyOnly && config.Notify == nil {
+		return nil, fmt.Errorf("notify is required in notify-only mode")
+	}
+	if config.Interval <= 0 {
+		config.Interval = 15 * time.Minute
+	}
+	if config.MaxRedirects <= 0 {
+		config.MaxRedirects = 5
+	}
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = 2
+	}
+	if config.Retries < 0 {
+		config.Retries = 0
+	} else if config.Retries == 0 {
+		config.Retries = 2
+	}
+	if config.RetryDelay <= 0 {
+		config.RetryDelay = 5 * time.Second
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: 15 * time.Second}
+	}
+
+	return &LandingPageWatchdog{client: client, config: config, failures: make(map[string]int)}, nil
+}
+
+// Run checks landing pages every Interval until the context is cancelled
+func (w *LandingPageWatchdog) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce checks the landing pages of all active ads once and returns the
+// incidents found. A page is paused and notified once it has been broken
+// for FailureThreshold consecutive rounds; pages already reported are paused
+// again if needed but not re-notified.
+func (w *LandingPageWatchdog) RunOnce(ctx context.Context) ([]LandingPageIncident, error) {
+	var incidents []LandingPageIncident
+	for _, advertiserID := range w.config.AdvertiserIDs {
+		found, err := w.checkAdvertiser(ctx, advertiserID)
+		incidents = append(incidents, found...)
+		if err != nil {
+			return incidents, fmt.Errorf("failed to check landing pages for advertiser %s: %w", advertiserID, err)
+		}
+	}
+	return incidents, nil
+}
+
+// checkAdvertiser checks each distinct landing page of an advertiser's
+// active ads
+func (w *LandingPageWatchdog) checkAdvertiser(ctx context.Context, advertiserID string) ([]LandingPageIncident, error) {
+	ads, err := activeAds(ctx, w.client, advertiserID)
+	if err != nil {
+		return nil, err
+	}
+
+	byURL := make(map[string][]AdInfo)
+	for _, ad := range ads {
+		if ad.LandingPageURL != "" {
+			byURL[ad.LandingPageURL] = append(byURL[ad.LandingPageURL], ad)
+		}
+	}
+	urls := make([]string, 0, len(byURL))
+	for url := range byURL {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	var incidents []LandingPageIncident
+	for _, url := range urls {
+		if ctx.Err() != nil {
+			return incidents, ctx.Err()
+		}
+
+		key := advertiserID + "/" + url
+		incident := w.checkPageWithRetry(ctx, url)
+		if ctx.Err() != nil {
+			// A cancelled check says nothing about the page
+			return incidents, ctx.Err()
+		}
+
+		w.mu.Lock()
+		if incident == nil {
+			delete(w.failures, key)
+		} else {
+			w.failures[key]++
+		}
+		failures := w.failures[key]
+		w.mu.Unlock()
+		if incident == nil {
+			continue
+		}
+
+		incident.AdvertiserID = advertiserID
+		incident.Failures = failures
+		adGroups := make(map[string]bool)
+		for _, ad := range byURL[url] {
+			incident.AdIDs = append(incident.AdIDs, ad.AdID)
+			if !adGroups[ad.AdGroupID] {
+				adGroups[ad.AdGroupID] = true
+				incident.AdGroupIDs = append(incident.AdGroupIDs, ad.AdGroupID)
+			}
+		}
+
+		// A page that just broke may be a blip; it is only acted on once
+		// it stays broken for FailureThreshold rounds
+		if failures >= w.config.FailureThreshold {
+			if !w.config.NotifyOnly {
+				incident.PauseErr = w.pause(ctx, advertiserID, incident)
+				incident.Paused = incident.PauseErr == nil
+			}
+			if failures == w.config.FailureThreshold && w.config.Notify != nil {
+				w.config.Notify(ctx, *incident)
+			}
+		}
+		incidents = append(incidents, *incident)
+	}
+	return incidents, nil
+}
+
+// activeAds pages through the enabled ads of an advertiser
+func activeAds(ctx context.Context, client *Client, advertiserID string) ([]AdInfo, error) {
+	var ads []AdInfo
+	for page := 1; ; page++ {
+		resp, err := client.Ad().Get(ctx, &AdGetRequest{
+			AdvertiserID: advertiserID,
+			Page:         page,
+			PageSize:     100,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+		for _, ad := range resp.Data {
+			if ad.OperationStatus == "ENABLE" {
+				ads = append(ads, ad)
+			}
+		}
+		if page >= resp.PageInfo.TotalPage {
+			return ads, nil
+		}
+	}
+}
+
+// checkPageWithRetry checks a landing page, repeating checks that fail with
+// a network or server error, which are often transient
+func (w *LandingPageWatchdog) checkPageWithRetry(ctx context.Context, url string) *LandingPageIncident {
+	incident := w.checkPage(ctx, url)
+	for attempt := 0; attempt < w.config.Retries && incident != nil && isTransientPageFailure(incident); attempt++ {
+		timer := time.NewTimer(w.config.RetryDelay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return incident
+		case <-timer.C:
+		}
+		incident = w.checkPage(ctx, url)
+	}
+	return incident
+}
+
+// isTransientPageFailure reports whether a failed check may pass if repeated
+func isTransientPageFailure(incident *LandingPageIncident) bool {
+	return incident.Reason == LandingPageUnreachable || incident.StatusCode >= 500
+}
+
+// checkPage fetches a landing page and returns an incident if it is broken
+func (w *LandingPageWatchdog) checkPage(ctx context.Context, url string) *LandingPageIncident {
+	redirects := 0
+	httpClient := *w.config.HTTPClient
+	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		redirects = len(via)
+		if len(via) > w.config.MaxRedirects {
+			return errTooManyRedirects
+		}
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return &LandingPageIncident{URL: url, Reason: LandingPageUnreachable, Message: err.Error()}
+	}
+	if w.config.UserAgent != "" {
+		req.Header.Set("User-Agent", w.config.UserAgent)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		if errors.Is(err, errTooManyRedirects) {
+			return &LandingPageIncident{
+				URL:       url,
+				Reason:    LandingPageTooManyRedirects,
+				Redirects: redirects,
+				Message:   fmt.Sprintf("more than %d redirects", w.config.MaxRedirects),
+			}
+		}
+		return &LandingPageIncident{URL: url, Reason: LandingPageUnreachable, Redirects: redirects, Message: err.Error()}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return &LandingPageIncident{
+			URL:        url,
+			Reason:     LandingPageBadStatus,
+			StatusCode: resp.StatusCode,
+			Redirects:  redirects,
+			Message:    fmt.Sprintf("HTTP %d", resp.StatusCode),
+		}
+	}
+
+	if len(w.config.RequiredContent) > 0 {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxLandingPageBody))
+		if err != nil {
+			return &LandingPageIncident{URL: url, Reason: LandingPageUnreachable, StatusCode: resp.StatusCode, Redirects: redirects, Message: err.Error()}
+		}
+		for _, content := range w.config.RequiredContent {
+			if !strings.Contains(string(body), content) {
+				return &LandingPageIncident{
+					URL:        url,
+					Reason:     LandingPageContentMismatch,
+					StatusCode: resp.StatusCode,
+					Redirects:  redirects,
+					Message:    fmt.Sprintf("page does not contain %q", content),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// pause disables the ads or ad groups of an incident
+func (w *LandingPageWatchdog) pause(ctx context.Context, advertiserID string, incident *LandingPageIncident) error {
+	if w.config.PauseLevel == PauseAdGroups {
+		resp, err := w.client.AdGroup().UpdateStatus(ctx, &AdGroupStatusUpdateRequest{
+			AdvertiserID: advertiserID,
+			AdGroupIDs:   incident.AdGroupIDs,
+			Operation:    "DISABLE",
+		})
+		if err != nil {
+			return err
+		}
+		if resp.Code != 0 {
+			return fmt.Errorf("API error: %s", resp.Message)
+		}
+		return nil
+	}
+
+	resp, err := w.client.Ad().UpdateStatus(ctx, &AdStatusUpdateRequest{
+		AdvertiserID: advertiserID,
+		AdIDs:        incident.AdIDs,
+		Operation:    "DISABLE",
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Code != 0 {
+		return fmt.Errorf("API error: %s", resp.Message)
+	}
+	return nil
+}
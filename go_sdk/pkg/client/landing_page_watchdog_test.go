@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestLandingPageWatchdog(t *testing.T) {
+	pages := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.Write([]byte("<button>Buy now</button>"))
+		case "/gone":
+			http.NotFound(w, r)
+		case "/changed":
+			w.Write([]byte("<p>Out of stock</p>"))
+		default:
+			// Redirect loop: /loop -> /loop/ -> /loop// ...
+			http.Redirect(w, r, r.URL.Path+"/", http.StatusFound)
+		}
+	})
+
+	var paused []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/ad/get/":
+			fmt.Fprintf(w, `{"code":0,"data":[
+				{"ad_id":"1","adgroup_id":"g1","operation_status":"ENABLE","landing_page_url":"%[1]s/ok"},
+				{"ad_id":"2","adgroup_id":"g1","operation_status":"ENABLE","landing_page_url":"%[1]s/gone"},
+				{"ad_id":"3","adgroup_id":"g2","operation_status":"ENABLE","landing_page_url":"%[1]s/changed"},
+				{"ad_id":"4","adgroup_id":"g2","operation_status":"ENABLE","landing_page_url":"%[1]s/loop"},
+				{"ad_id":"5","adgroup_id":"g3","operation_status":"DISABLE","landing_page_url":"%[1]s/gone"}
+			],"page_info":{"page":1,"total_page":1}}`, pages.URL)
+		case "/open_api/v1.3/ad/status/update/":
+			var req AdStatusUpdateRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Operation != "DISABLE" {
+				t.Errorf("operation_status = %q, want DISABLE", req.Operation)
+			}
+			paused = append(paused, req.AdIDs...)
+			w.Write([]byte(`{"code":0,"data":{}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	var notified []string
+	watchdog, err := NewLandingPageWatchdog(client, LandingPageWatchdogConfig{
+		AdvertiserIDs:   []string{"123"},
+		MaxRedirects:    3,
+		RequiredContent: []string{"Buy now"},
+		Notify: func(ctx context.Context, incident LandingPageIncident) {
+			notified = append(notified, incident.Reason)
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewLandingPageWatchdog() error = %v", err)
+	}
+
+	// A page broken for a single round is reported but left running
+	incidents, err := watchdog.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	for _, incident := range incidents {
+		if incident.Failures != 1 || incident.Paused {
+			t.Errorf("first round incident for %s = %d failures, paused %v", incident.URL, incident.Failures, incident.Paused)
+		}
+	}
+	if len(incidents) != 3 || len(paused) != 0 || len(notified) != 0 {
+		t.Fatalf("first round = %d incidents, paused %v, notified %v", len(incidents), paused, notified)
+	}
+
+	incidents, err = watchdog.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	reasons := make(map[string]string)
+	for _, incident := range incidents {
+		reasons[incident.AdIDs[0]] = incident.Reason
+		if !incident.Paused {
+			t.Errorf("incident for %s not paused: %v", incident.URL, incident.PauseErr)
+		}
+	}
+	want := map[string]string{"2": LandingPageBadStatus, "3": LandingPageContentMismatch, "4": LandingPageTooManyRedirects}
+	if len(reasons) != len(want) {
+		t.Fatalf("incidents = %v, want %v", reasons, want)
+	}
+	for adID, reason := range want {
+		if reasons[adID] != reason {
+			t.Errorf("ad %s reason = %q, want %q", adID, reasons[adID], reason)
+		}
+	}
+	sort.Strings(paused)
+	if strings.Join(paused, ",") != "2,3,4" {
+		t.Errorf("paused ads = %v, want [2 3 4]", paused)
+	}
+
+	// Pages already reported are not notified again
+	if _, err := watchdog.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	if len(notified) != 3 {
+		t.Errorf("notifications = %v, want 3", notified)
+	}
+
+	if _, err := NewLandingPageWatchdog(client, LandingPageWatchdogConfig{AdvertiserIDs: []string{"123"}, NotifyOnly: true}); err == nil {
+		t.Error("NewLandingPageWatchdog() accepted notify-only mode without Notify")
+	}
+}
+
+func TestLandingPageWatchdog_RetriesTransientFailures(t *testing.T) {
+	var hits atomic.Int32
+	pages := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		// The first check of each round hits a restarting server
+		if hits.Add(1)%2 == 1 {
+			http.Error(w, "restarting", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	})
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/ad/get/" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		writeJSON(w, `{"code":0,"data":[{"ad_id":"1","adgroup_id":"g1","operation_status":"ENABLE","landing_page_url":"`+pages.URL+`/"}],"page_info":{"page":1,"total_page":1}}`)
+	})
+
+	watchdog, err := NewLandingPageWatchdog(newTestClient(t, server), LandingPageWatchdogConfig{AdvertiserIDs: []string{"123"}, RetryDelay: time.Millisecond})
+	if err != nil {
+		t.Fatalf("NewLandingPageWatchdog() error = %v", err)
+	}
+	for round := 1; round <= 3; round++ {
+		incidents, err := watchdog.RunOnce(context.Background())
+		if err != nil || len(incidents) != 0 {
+			t.Fatalf("round %d = %+v, %v, want the retried check to pass", round, incidents, err)
+		}
+	}
+	if n := hits.Load(); n != 6 {
+		t.Errorf("page fetched %d times, want 6", n)
+	}
+
+	// Without retries the first failed check is reported
+	watchdog, err = NewLandingPageWatchdog(newTestClient(t, server), LandingPageWatchdogConfig{AdvertiserIDs: []string{"123"}, Retries: -1})
+	if err != nil {
+		t.Fatalf("NewLandingPageWatchdog() error = %v", err)
+	}
+	hits.Store(0)
+	incidents, err := watchdog.RunOnce(context.Background())
+	if err != nil || len(incidents) != 1 || incidents[0].Reason != LandingPageBadStatus || incidents[0].StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("RunOnce() without retries = %+v, %v", incidents, err)
+	}
+}
+
+func TestLandingPageWatchdog_AdListError(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"code":40001,"message":"No permission","request_id":"req1"}`)
+	})
+
+	watchdog, err := NewLandingPageWatchdog(newTestClient(t, server), LandingPageWatchdogConfig{AdvertiserIDs: []string{"123"}})
+	if err != nil {
+		t.Fatalf("NewLandingPageWatchdog() error = %v", err)
+	}
+	_, err = watchdog.RunOnce(context.Background())
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "40001" {
+		t.Errorf("RunOnce() error = %v, want API error 40001", err)
+	}
+}
@@ -0,0 +1,531 @@
+package client
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Lead download task statuses
+const (
+	LeadTaskProcessing = "PROCESSING"
+	LeadTaskSucceeded  = "SUCCEEDED"
+	LeadTaskFailed     = "FAILED"
+)
+
+// Instant form question types
+const (
+	LeadFieldName     = "NAME"
+	LeadFieldEmail    = "EMAIL"
+	LeadFieldPhone    = "PHONE_NUMBER"
+	LeadFieldGender   = "GENDER"
+	LeadFieldCity     = "CITY"
+	LeadFieldZipCode  = "ZIP_CODE"
+	LeadFieldCustom   = "CUSTOM_QUESTION"
+	LeadFieldMultiple = "MULTIPLE_CHOICE"
+)
+
+// leadTimeLayout is the layout of lead task windows and lead create times
+const leadTimeLayout = "2006-01-02 15:04:05"
+
+// LeadGenService handles instant forms and lead downloads
+type LeadGenService struct {
+	client *Client
+}
+
+// NewLeadGenService creates a new LeadGenService
+func NewLeadGenService(client *Client) *LeadGenService {
+	return &LeadGenService{client: client}
+}
+
+// LeadFormQuestion is a question on an instant form
+type LeadFormQuestion struct {
+	FieldType string   `json:"field_type"`
+	Label     string   `json:"label,omitempty"` // required for custom and multiple choice questions
+	Required  bool     `json:"required,omitempty"`
+	Options   []string `json:"options,omitempty"`
+}
+
+// LeadFormCreateRequest represents the request for creating an instant form
+type LeadFormCreateRequest struct {
+	AdvertiserID     string             `json:"advertiser_id"`
+	FormName         string             `json:"form_name"`
+	Title            string             `json:"title,omitempty"`
+	Description      string             `json:"description,omitempty"`
+	Questions        []LeadFormQuestion `json:"questions"`
+	PrivacyPolicyURL string             `json:"privacy_policy_url"`
+	ThankYouMessage  string             `json:"thank_you_message,omitempty"`
+	WebsiteURL       string             `json:"website_url,omitempty"`
+}
+
+// LeadForm represents an instant form
+type LeadForm struct {
+	FormID           string             `json:"form_id"`
+	FormName         string             `json:"form_name"`
+	Status           string             `json:"status"`
+	Title            string             `json:"title,omitempty"`
+	Questions        []LeadFormQuestion `json:"questions,omitempty"`
+	PrivacyPolicyURL string             `json:"privacy_policy_url,omitempty"`
+	CreateTime       models.Time        `json:"create_time,omitempty"`
+}
+
+// LeadFormResponse represents the response from creating an instant form
+type LeadFormResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		FormID string `json:"form_id"`
+	} `json:"data"`
+}
+
+// LeadFormGetRequest represents the request for listing instant forms
+type LeadFormGetRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	FormIDs      []string `json:"form_ids,omitempty"`
+	Page         int      `json:"page,omitempty"`
+	PageSize     int      `json:"page_size,omitempty"`
+}
+
+// LeadFormListResponse represents the response for listing instant forms
+type LeadFormListResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		List     []LeadForm `json:"list"`
+		PageInfo struct {
+			Page        int `json:"page"`
+			PageSize    int `json:"page_size"`
+			TotalNumber int `json:"total_number"`
+			TotalPage   int `json:"total_page"`
+		} `json:"page_info"`
+	} `json:"data"`
+}
+
+// LeadTaskCreateRequest represents the request for a lead download task.
+// StartTime and EndTime use the "2006-01-02 15:04:05" layout in UTC.
+type LeadTaskCreateRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	FormID       string `json:"form_id"`
+	StartTime    string `json:"start_time,omitempty"`
+	EndTime      string `json:"end_time,omitempty"`
+}
+
+// LeadTaskResponse represents the response from creating or checking a lead
+// download task
+type LeadTaskResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		TaskID       string `json:"task_id"`
+		Status       string `json:"status"`
+		ErrorMessage string `json:"error_message,omitempty"`
+	} `json:"data"`
+}
+
+// CreateForm creates an instant form
+func (s *LeadGenService) CreateForm(ctx context.Context, req *LeadFormCreateRequest) (*LeadFormResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.FormName == "" {
+		return nil, fmt.Errorf("form_name is required")
+	}
+	if req.PrivacyPolicyURL == "" {
+		return nil, fmt.Errorf("privacy_policy_url is required")
+	}
+	if len(req.Questions) == 0 {
+		return nil, fmt.Errorf("at least one question is required")
+	}
+	for i, question := range req.Questions {
+		if question.FieldType == "" {
+			return nil, fmt.Errorf("question %d: field_type is required", i)
+		}
+		if (question.FieldType == LeadFieldCustom || question.FieldType == LeadFieldMultiple) && question.Label == "" {
+			return nil, fmt.Errorf("question %d: label is required for %s", i, question.FieldType)
+		}
+		if question.FieldType == LeadFieldMultiple && len(question.Options) < 2 {
+			return nil, fmt.Errorf("question %d: multiple choice questions need at least 2 options", i)
+		}
+	}
+
+	var response LeadFormResponse
+	if err := s.post(ctx, "/lead/form/create/", req, &response, "create lead form"); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetForms lists the instant forms of an advertiser
+func (s *LeadGenService) GetForms(ctx context.Context, req *LeadFormGetRequest) (*LeadFormListResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+	}
+	if len(req.FormIDs) > 0 {
+		formIDs, err := json.Marshal(req.FormIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal form_ids: %w", err)
+		}
+		params["form_ids"] = string(formIDs)
+	}
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+	if req.PageSize > 0 {
+		params["page_size"] = req.PageSize
+	}
+
+	url, err := s.client.BuildURL("/lead/form/get/", params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lead forms: %w", err)
+	}
+
+	var response LeadFormListResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CreateLeadTask starts a task that prepares the leads of a form for download
+func (s *LeadGenService) CreateLeadTask(ctx context.Context, req *LeadTaskCreateRequest) (*LeadTaskResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.FormID == "" {
+		return nil, fmt.Errorf("form_id is required")
+	}
+
+	var response LeadTaskResponse
+	if err := s.post(ctx, "/lead/task/create/", req, &response, "create lead task"); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// CheckLeadTask returns the status of a lead download task
+func (s *LeadGenService) CheckLeadTask(ctx context.Context, advertiserID, taskID string) (*LeadTaskResponse, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if taskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+
+	url, err := s.client.BuildURL("/lead/task/check/", map[string]interface{}{
+		"advertiser_id": advertiserID,
+		"task_id":       taskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check lead task: %w", err)
+	}
+
+	var response LeadTaskResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DownloadLeads streams the CSV file of a completed lead task. The caller
+// must close the returned reader; NewLeadReader decodes it.
+func (s *LeadGenService) DownloadLeads(ctx context.Context, advertiserID, taskID string) (io.ReadCloser, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if taskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+
+	url, err := s.client.BuildURL("/lead/task/download/", map[string]interface{}{
+		"advertiser_id": advertiserID,
+		"task_id":       taskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download leads: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, s.client.ParseResponse(resp, nil)
+	}
+	if strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		// A JSON body instead of the file is an error envelope
+		var envelope models.BaseResponse
+		if err := s.client.ParseResponse(resp, &envelope); err != nil {
+			return nil, err
+		}
+		return nil, models.NewAPIError(strconv.Itoa(envelope.Code), envelope.Message, envelope.RequestID, resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+// WaitForLeadTask polls a lead task with exponential backoff until it
+// succeeds, fails or ctx is done
+func (s *LeadGenService) WaitForLeadTask(ctx context.Context, advertiserID, taskID string, poll AsyncReportPollConfig) (*LeadTaskResponse, error) {
+	poll = poll.withDefaults()
+	interval := poll.InitialInterval
+
+	for {
+		status, err := s.CheckLeadTask(ctx, advertiserID, taskID)
+		if err != nil {
+			return nil, err
+		}
+		if status.Code != 0 {
+			return status, fmt.Errorf("API error: %s", status.Message)
+		}
+
+		switch status.Data.Status {
+		case LeadTaskSucceeded:
+			return status, nil
+		case LeadTaskFailed:
+			return status, fmt.Errorf("lead task %s failed: %s", taskID, status.Data.ErrorMessage)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * poll.Multiplier)
+		if interval > poll.MaxInterval {
+			interval = poll.MaxInterval
+		}
+	}
+}
+
+// Lead is a submitted instant form
+type Lead struct {
+	LeadID     string
+	FormID     string
+	FormName   string
+	AdID       string
+	AdName     string
+	AdGroupID  string
+	CampaignID string
+	CreateTime time.Time
+
+	// Fields holds the answers keyed by column header, such as "email"
+	Fields map[string]string
+}
+
+// leadColumns maps lead file columns to Lead fields
+var leadColumns = map[string]func(*Lead, string){
+	"lead_id":     func(l *Lead, v string) { l.LeadID = v },
+	"form_id":     func(l *Lead, v string) { l.FormID = v },
+	"form_name":   func(l *Lead, v string) { l.FormName = v },
+	"ad_id":       func(l *Lead, v string) { l.AdID = v },
+	"ad_name":     func(l *Lead, v string) { l.AdName = v },
+	"adgroup_id":  func(l *Lead, v string) { l.AdGroupID = v },
+	"campaign_id": func(l *Lead, v string) { l.CampaignID = v },
+	"create_time": func(l *Lead, v string) {
+		if t, err := time.Parse(leadTimeLayout, v); err == nil {
+			l.CreateTime = t
+		}
+	},
+}
+
+// LeadReader decodes leads one at a time from a lead task CSV file
+type LeadReader struct {
+	reader *csv.Reader
+	header []string
+}
+
+// NewLeadReader creates a LeadReader over a lead file
+func NewLeadReader(r io.Reader) *LeadReader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	return &LeadReader{reader: reader}
+}
+
+// Next returns the next lead, or io.EOF after the last one
+func (r *LeadReader) Next() (*Lead, error) {
+	if r.header == nil {
+		header, err := r.reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		r.header = make([]string, len(header))
+		for i, column := range header {
+			column = strings.TrimPrefix(column, "\ufeff")
+			r.header[i] = strings.ToLower(strings.TrimSpace(column))
+		}
+	}
+
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	lead := &Lead{Fields: make(map[string]string)}
+	for i, value := range record {
+		if i >= len(r.header) {
+			break
+		}
+		if set, ok := leadColumns[r.header[i]]; ok {
+			set(lead, value)
+		} else if value != "" {
+			lead.Fields[r.header[i]] = value
+		}
+	}
+	return lead, nil
+}
+
+// LeadExportRequest selects the leads of a form submitted in [Since, Until)
+type LeadExportRequest struct {
+	AdvertiserID string
+	FormID       string
+	Since        time.Time
+	Until        time.Time // defaults to now
+}
+
+// Validate checks the advertiser, form and time window
+func (r *LeadExportRequest) Validate() error {
+	if r.AdvertiserID == "" {
+		return fmt.Errorf("advertiser_id is required")
+	}
+	if r.FormID == "" {
+		return fmt.Errorf("form_id is required")
+	}
+	if !r.Since.IsZero() && !r.Until.IsZero() && !r.Since.Before(r.Until) {
+		return fmt.Errorf("since must be before until")
+	}
+	return nil
+}
+
+// LeadExportResult summarizes an ExportLeads run
+type LeadExportResult struct {
+	TaskID string
+	Leads  int
+
+	// Until is the end of the exported window; pass it as Since on the next
+	// run to fetch only newer leads
+	Until time.Time
+}
+
+// ExportLeads creates a lead task for a time window, waits for it and
+// streams every lead in the window to handle without buffering the file.
+// An error from handle stops the export and is returned.
+func (s *LeadGenService) ExportLeads(ctx context.Context, req *LeadExportRequest, poll AsyncReportPollConfig, handle func(*Lead) error) (*LeadExportResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if handle == nil {
+		return nil, fmt.Errorf("handle cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	until := req.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+	until = until.UTC().Truncate(time.Second)
+	since := req.Since.UTC()
+	if !since.IsZero() && !since.Before(until) {
+		return nil, fmt.Errorf("since must be before until")
+	}
+
+	taskReq := &LeadTaskCreateRequest{
+		AdvertiserID: req.AdvertiserID,
+		FormID:       req.FormID,
+		EndTime:      until.Format(leadTimeLayout),
+	}
+	if !since.IsZero() {
+		taskReq.StartTime = since.Format(leadTimeLayout)
+	}
+
+	task, err := s.CreateLeadTask(ctx, taskReq)
+	if err != nil {
+		return nil, err
+	}
+	if task.Code != 0 {
+		return nil, fmt.Errorf("API error: %s", task.Message)
+	}
+	result := &LeadExportResult{TaskID: task.Data.TaskID, Until: until}
+
+	if _, err := s.WaitForLeadTask(ctx, req.AdvertiserID, result.TaskID, poll); err != nil {
+		return result, err
+	}
+
+	body, err := s.DownloadLeads(ctx, req.AdvertiserID, result.TaskID)
+	if err != nil {
+		return result, err
+	}
+	defer body.Close()
+
+	reader := NewLeadReader(body)
+	for {
+		lead, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to decode leads: %w", err)
+		}
+
+		// The task window is inclusive at both ends; keep it half-open so
+		// consecutive windows do not deliver a lead twice
+		if !lead.CreateTime.IsZero() && (lead.CreateTime.Before(since) || !lead.CreateTime.Before(until)) {
+			continue
+		}
+		if err := handle(lead); err != nil {
+			return result, err
+		}
+		result.Leads++
+	}
+}
+
+// post sends a lead gen request and decodes the response into out
+func (s *LeadGenService) post(ctx context.Context, endpoint string, req interface{}, out interface{}, action string) error {
+	url, err := s.client.BuildURL(endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	return s.client.ParseResponse(resp, out)
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLeadGenService_ExportLeads(t *testing.T) {
+	var checks atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/lead/task/create/":
+			var req LeadTaskCreateRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.StartTime != "2026-01-01 00:00:00" || req.EndTime != "2026-01-02 00:00:00" {
+				t.Errorf("window = %q to %q", req.StartTime, req.EndTime)
+			}
+			writeJSON(w, `{"code":0,"data":{"task_id":"task-1","status":"PROCESSING"}}`)
+		case "/open_api/v1.3/lead/task/check/":
+			status := LeadTaskProcessing
+			if checks.Add(1) > 1 {
+				status = LeadTaskSucceeded
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"code":0,"data":{"task_id":"task-1","status":"%s"}}`, status)
+		case "/open_api/v1.3/lead/task/download/":
+			w.Header().Set("Content-Type", "text/csv")
+			w.Write([]byte("\ufefflead_id,form_id,ad_id,create_time,email,phone_number\n" +
+				"L1,F1,A1,2026-01-01 00:00:00,jane@example.com,+15550100\n" +
+				"L2,F1,A2,2026-01-01 12:30:00,joe@example.com,\n" +
+				"L3,F1,A1,2026-01-02 00:00:00,late@example.com,\n"))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var leads []*Lead
+	result, err := client.LeadGen().ExportLeads(context.Background(), &LeadExportRequest{
+		AdvertiserID: "123",
+		FormID:       "F1",
+		Since:        since,
+		Until:        since.Add(24 * time.Hour),
+	}, AsyncReportPollConfig{InitialInterval: time.Millisecond}, func(lead *Lead) error {
+		leads = append(leads, lead)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ExportLeads() error = %v", err)
+	}
+
+	// L3 falls on the window end and belongs to the next window
+	if result.Leads != 2 || len(leads) != 2 || !result.Until.Equal(since.Add(24*time.Hour)) {
+		t.Fatalf("result = %+v, leads = %d", result, len(leads))
+	}
+	if leads[0].LeadID != "L1" || leads[0].AdID != "A1" || leads[0].Fields["email"] != "jane@example.com" {
+		t.Errorf("lead = %+v", leads[0])
+	}
+	if _, ok := leads[1].Fields["phone_number"]; ok {
+		t.Errorf("empty answers should be omitted: %v", leads[1].Fields)
+	}
+	if !leads[1].CreateTime.Equal(time.Date(2026, 1, 1, 12, 30, 0, 0, time.UTC)) {
+		t.Errorf("create time = %v", leads[1].CreateTime)
+	}
+}
@@ -0,0 +1,207 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Minimum seed sizes for building a lookalike audience
+const (
+	DefaultLookalikeMinSeedSize        = 1000
+	DefaultLookalikeMinCountrySeedSize = 100
+)
+
+// Lookalike estimate issue reasons
+const (
+	LookalikeSourceNotFound         = "SOURCE_NOT_FOUND"
+	LookalikeSourceNotReady         = "SOURCE_NOT_READY"
+	LookalikeSourceIsLookalike      = "SOURCE_IS_LOOKALIKE"
+	LookalikeSeedTooSmall           = "SEED_TOO_SMALL"
+	LookalikeCountrySeedTooSmall    = "COUNTRY_SEED_TOO_SMALL"
+	LookalikeCountryCoverageUnknown = "COUNTRY_COVERAGE_UNKNOWN"
+	LookalikeInvalidSimilarity      = "INVALID_SIMILARITY_LEVEL"
+)
+
+// LookalikeEstimateOptions tunes EstimateLookalike
+type LookalikeEstimateOptions struct {
+	// MinSeedSize is the smallest source audience a lookalike is built from
+	// (defaults to DefaultLookalikeMinSeedSize)
+	MinSeedSize int64
+
+	// MinCountrySeedSize is the smallest number of source members in the
+	// target country (defaults to DefaultLookalikeMinCountrySeedSize)
+	MinCountrySeedSize int64
+
+	// CountrySizes holds the matched source members per country code, e.g.
+	// from the match results of the uploaded file. The API does not report
+	// country coverage, so without it the country check is a warning.
+	CountrySizes map[string]int64
+}
+
+// LookalikeIssue is a reason a lookalike may not be built, with what to do about it
+type LookalikeIssue struct {
+	Reason   string
+	Message  string
+	Guidance string
+
+	// Blocking issues make the create call fail or produce an empty audience
+	Blocking bool
+}
+
+// LookalikeEstimate predicts whether a lookalike audience can be built
+type LookalikeEstimate struct {
+	SourceAudienceID string
+	SourceName       string
+	SourceStatus     string
+	SourceSize       int64
+	CountryCode      string
+
+	// CountrySize is the number of source members in CountryCode, or -1 when
+	// unknown
+	CountrySize int64
+
+	Buildable bool
+	Issues    []LookalikeIssue
+}
+
+// Err returns nil when the lookalike is buildable, or an error listing the
+// blocking issues and their guidance
+func (e *LookalikeEstimate) Err() error {
+	if e.Buildable {
+		return nil
+	}
+	var parts []string
+	for _, issue := range e.Issues {
+		if issue.Blocking {
+			parts = append(parts, fmt.Sprintf("%s: %s", issue.Message, issue.Guidance))
+		}
+	}
+	return fmt.Errorf("lookalike of audience %s cannot be built: %s", e.SourceAudienceID, strings.Join(parts, "; "))
+}
+
+// EstimateLookalike checks the source audience of a lookalike request against
+// the minimum seed thresholds before CreateLookalikeAudience is called, so a
+// seed that is too small or not ready is reported with guidance rather than
+// failing on the server. opts may be nil.
+func (s *DMPService) EstimateLookalike(ctx context.Context, req *LookalikeAudienceCreateRequest, opts *LookalikeEstimateOptions) (*LookalikeEstimate, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.SourceAudienceID == "" {
+		return nil, fmt.Errorf("source_audience_id is required")
+	}
+	if req.CountryCode == "" {
+		return nil, fmt.Errorf("country_code is required")
+	}
+
+	var options LookalikeEstimateOptions
+	if opts != nil {
+		options = *opts
+	}
+	if options.MinSeedSize <= 0 {
+		options.MinSeedSize = DefaultLookalikeMinSeedSize
+	}
+	if options.MinCountrySeedSize <= 0 {
+		options.MinCountrySeedSize = DefaultLookalikeMinCountrySeedSize
+	}
+
+	resp, err := s.GetCustomAudience(ctx, &CustomAudienceGetRequest{
+		AdvertiserID: req.AdvertiserID,
+		AudienceID:   req.SourceAudienceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, fmt.Errorf("API error: %s", resp.Message)
+	}
+
+	estimate := &LookalikeEstimate{
+		SourceAudienceID: req.SourceAudienceID,
+		CountryCode:      req.CountryCode,
+		CountrySize:      -1,
+	}
+	add := func(reason, message, guidance string, blocking bool) {
+		estimate.Issues = append(estimate.Issues, LookalikeIssue{
+			Reason:   reason,
+			Message:  message,
+			Guidance: guidance,
+			Blocking: blocking,
+		})
+	}
+
+	if req.SimilarityLevel != 0 && (req.SimilarityLevel < 1 || req.SimilarityLevel > 10) {
+		add(LookalikeInvalidSimilarity,
+			fmt.Sprintf("similarity level %g is outside 1-10", req.SimilarityLevel),
+			"use a similarity level between 1 (broad) and 10 (narrow)", true)
+	}
+
+	source := findAudience(resp.Data, req.SourceAudienceID)
+	if source == nil {
+		add(LookalikeSourceNotFound,
+			fmt.Sprintf("source audience %s was not found", req.SourceAudienceID),
+			"check that the audience exists and is shared with the advertiser", true)
+		return estimate, nil
+	}
+	estimate.SourceName = source.AudienceName
+	estimate.SourceStatus = source.Status
+	estimate.SourceSize = source.Size
+
+	if strings.EqualFold(source.AudienceType, "LOOKALIKE") {
+		add(LookalikeSourceIsLookalike,
+			"the source audience is itself a lookalike",
+			"seed the lookalike with the custom audience the source was built from", true)
+	}
+
+	switch strings.ToUpper(source.Status) {
+	case "CALCULATING", "PROCESSING", "PENDING":
+		add(LookalikeSourceNotReady,
+			fmt.Sprintf("the source audience is %s", strings.ToLower(source.Status)),
+			"wait until the source audience has finished calculating, then estimate again", true)
+	case "EXPIRED", "INVALID", "DELETED", "FAILED":
+		add(LookalikeSourceNotReady,
+			fmt.Sprintf("the source audience is %s", strings.ToLower(source.Status)),
+			"refresh or recreate the source audience", true)
+	}
+
+	if source.Size < options.MinSeedSize {
+		add(LookalikeSeedTooSmall,
+			fmt.Sprintf("the source audience has %d members, below the minimum of %d", source.Size, options.MinSeedSize),
+			"add members to the source audience or seed from a larger audience", true)
+	}
+
+	if size, ok := options.CountrySizes[req.CountryCode]; ok {
+		estimate.CountrySize = size
+		if size < options.MinCountrySeedSize {
+			add(LookalikeCountrySeedTooSmall,
+				fmt.Sprintf("the source audience has %d members in %s, below the minimum of %d", size, req.CountryCode, options.MinCountrySeedSize),
+				"target a country where the source audience has more members, or grow it in "+req.CountryCode, true)
+		}
+	} else {
+		add(LookalikeCountryCoverageUnknown,
+			fmt.Sprintf("the number of source members in %s is unknown", req.CountryCode),
+			"pass CountrySizes to check country coverage before creating the lookalike", false)
+	}
+
+	estimate.Buildable = true
+	for _, issue := range estimate.Issues {
+		if issue.Blocking {
+			estimate.Buildable = false
+		}
+	}
+	return estimate, nil
+}
+
+// findAudience returns the audience with the given ID
+func findAudience(audiences []CustomAudienceData, audienceID string) *CustomAudienceData {
+	for i := range audiences {
+		if audiences[i].AudienceID == audienceID {
+			return &audiences[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDMPService_EstimateLookalike(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("audience_id") {
+		case "big":
+			w.Write([]byte(`{"code":0,"data":[{"audience_id":"big","audience_type":"CUSTOMER_FILE","size":50000,"status":"READY"}]}`))
+		case "small":
+			w.Write([]byte(`{"code":0,"data":[{"audience_id":"small","audience_type":"CUSTOMER_FILE","size":300,"status":"CALCULATING"}]}`))
+		default:
+			w.Write([]byte(`{"code":0,"data":[]}`))
+		}
+	})
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	reasons := func(estimate *LookalikeEstimate) []string {
+		var got []string
+		for _, issue := range estimate.Issues {
+			got = append(got, issue.Reason)
+		}
+		return got
+	}
+
+	req := &LookalikeAudienceCreateRequest{AdvertiserID: "123", AudienceName: "LAL", SourceAudienceID: "big", CountryCode: "US"}
+	estimate, err := client.DMP().EstimateLookalike(ctx, req, &LookalikeEstimateOptions{CountrySizes: map[string]int64{"US": 40000}})
+	if err != nil {
+		t.Fatalf("EstimateLookalike() error = %v", err)
+	}
+	if !estimate.Buildable || estimate.CountrySize != 40000 || len(estimate.Issues) != 0 || estimate.Err() != nil {
+		t.Errorf("estimate = %+v", estimate)
+	}
+
+	estimate, err = client.DMP().EstimateLookalike(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("EstimateLookalike() error = %v", err)
+	}
+	if !estimate.Buildable || strings.Join(reasons(estimate), ",") != LookalikeCountryCoverageUnknown {
+		t.Errorf("estimate without country sizes = %+v", estimate)
+	}
+
+	req.SourceAudienceID = "small"
+	req.CountryCode = "DE"
+	estimate, err = client.DMP().EstimateLookalike(ctx, req, &LookalikeEstimateOptions{CountrySizes: map[string]int64{"DE": 20}})
+	if err != nil {
+		t.Fatalf("EstimateLookalike() error = %v", err)
+	}
+	want := []string{LookalikeSourceNotReady, LookalikeSeedTooSmall, LookalikeCountrySeedTooSmall}
+	if estimate.Buildable || !reflect.DeepEqual(reasons(estimate), want) {
+		t.Errorf("reasons = %v, want %v", reasons(estimate), want)
+	}
+	if err := estimate.Err(); err == nil || !strings.Contains(err.Error(), "wait until") {
+		t.Errorf("Err() = %v", err)
+	}
+
+	req.SourceAudienceID = "gone"
+	estimate, err = client.DMP().EstimateLookalike(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("EstimateLookalike() error = %v", err)
+	}
+	if estimate.Buildable || reasons(estimate)[0] != LookalikeSourceNotFound {
+		t.Errorf("estimate for missing source = %+v", estimate)
+	}
+}
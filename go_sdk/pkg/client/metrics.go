@@ -0,0 +1,181 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MetricsCollector receives measurements of API calls. Implementations must
+// be safe for concurrent use.
+type MetricsCollector interface {
+	// ObserveRequest records a completed API call, including its retries.
+	// status is the final HTTP status, or zero when no response was received.
+	ObserveRequest(method, endpoint string, status int, latency time.Duration)
+
+	// SetRateLimitTokens records the tokens left in the client rate limiter
+	SetRateLimitTokens(tokens float64)
+}
+
+// DefaultLatencyBuckets are the histogram buckets, in seconds, used by
+// NewPrometheusCollector when none are given
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// requestKey labels a request counter
+type requestKey struct {
+	method, endpoint, status string
+}
+
+// latencyHistogram accumulates request latencies
+type latencyHistogram struct {
+	counts []uint64 // per bucket, not cumulative
+	sum    float64
+	count  uint64
+}
+
+// PrometheusCollector is a MetricsCollector serving its measurements in the
+// Prometheus text exposition format
+type PrometheusCollector struct {
+	buckets []float64
+
+	mu        sync.Mutex
+	requests  map[requestKey]uint64
+	latencies map[string]*latencyHistogram
+	tokens    float64
+	hasTokens bool
+}
+
+// NewPrometheusCollector creates a PrometheusCollector with the given latency
+// buckets in seconds
+func NewPrometheusCollector(buckets ...float64) *PrometheusCollector {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	return &PrometheusCollector{
+		buckets:   buckets,
+		requests:  make(map[requestKey]uint64),
+		latencies: make(map[string]*latencyHistogram),
+	}
+}
+
+// ObserveRequest implements MetricsCollector
+func (p *PrometheusCollector) ObserveRequest(method, endpoint string, status int, latency time.Duration) {
+	statusLabel := "error"
+	if status > 0 {
+		statusLabel = strconv.Itoa(status)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests[requestKey{method, endpoint, statusLabel}]++
+
+	histogram, ok := p.latencies[endpoint]
+	if !ok {
+		histogram = &latencyHistogram{counts: make([]uint64, len(p.buckets))}
+		p.latencies[endpoint] = histogram
+	}
+	seconds := latency.Seconds()
+	for i, bound := range p.buckets {
+		if seconds <= bound {
+			histogram.counts[i]++
+			break
+		}
+	}
+	histogram.sum += seconds
+	histogram.count++
+}
+
+// SetRateLimitTokens implements MetricsCollector
+func (p *PrometheusCollector) SetRateLimitTokens(tokens float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tokens = tokens
+	p.hasTokens = true
+}
+
+// ServeHTTP serves the collected metrics
+func (p *PrometheusCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	_, _ = w.Write([]byte(p.String()))
+}
+
+// Metric names shared by PrometheusCollector and the stats handler
+const (
+	requestsMetric          = "tiktok_sdk_requests_total"
+	requestDurationMetric   = "tiktok_sdk_request_duration_seconds"
+	rateLimiterTokensMetric = "tiktok_sdk_rate_limiter_tokens"
+	rateLimiterTokensHelp   = "Tokens currently available in the rate limiter."
+)
+
+// String renders the collected metrics in the Prometheus text format, using
+// the metric names of the stats handler
+func (p *PrometheusCollector) String() string {
+	var w promWriter
+	p.writeRequests(&w)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.hasTokens {
+		w.metric(rateLimiterTokensMetric, "gauge", rateLimiterTokensHelp, p.tokens)
+	}
+	return w.String()
+}
+
+// writeRequests renders the request counter and latency histogram
+func (p *PrometheusCollector) writeRequests(w *promWriter) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	keys := make([]requestKey, 0, len(p.requests))
+	for key := range p.requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].endpoint != keys[j].endpoint {
+			return keys[i].endpoint < keys[j].endpoint
+		}
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].status < keys[j].status
+	})
+	w.family(requestsMetric, "counter", "API requests issued, by endpoint and final status.")
+	for _, key := range keys {
+		w.sample(requestsMetric, float64(p.requests[key]), "method", key.method, "endpoint", key.endpoint, "status", key.status)
+	}
+
+	endpoints := make([]string, 0, len(p.latencies))
+	for endpoint := range p.latencies {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	w.family(requestDurationMetric, "histogram", "API request latency including retries.")
+	for _, endpoint := range endpoints {
+		histogram := p.latencies[endpoint]
+		var cumulative uint64
+		for i, bound := range p.buckets {
+			cumulative += histogram.counts[i]
+			w.sample(requestDurationMetric+"_bucket", float64(cumulative), "endpoint", endpoint, "le", strconv.FormatFloat(bound, 'g', -1, 64))
+		}
+		w.sample(requestDurationMetric+"_bucket", float64(histogram.count), "endpoint", endpoint, "le", "+Inf")
+		w.sample(requestDurationMetric+"_sum", histogram.sum, "endpoint", endpoint)
+		w.sample(requestDurationMetric+"_count", float64(histogram.count), "endpoint", endpoint)
+	}
+}
+
+// endpointPath strips the query string from an endpoint so metrics keep a
+// bounded label set
+func endpointPath(endpoint string) string {
+	if u, err := url.Parse(endpoint); err == nil {
+		return u.Path
+	}
+	return endpoint
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPrometheusCollector(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	collector := NewPrometheusCollector(0.5, 1)
+	client := newTestClient(t, server, func(c *Config) {
+		c.RateLimit = &RateLimitConfig{RequestsPerSecond: 10, BurstSize: 5}
+		c.Metrics = collector
+	})
+
+	for _, endpoint := range []string{"/open_api/v1.3/campaign/get/?page=1", "/open_api/v1.3/campaign/get/?page=2", "/open_api/v1.3/campaign/get/?fail=1"} {
+		resp, err := client.DoRequest(context.Background(), "GET", endpoint, nil, nil)
+		if err != nil {
+			t.Fatalf("DoRequest failed: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	rec := httptest.NewRecorder()
+	collector.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+	for _, want := range []string{
+		`tiktok_sdk_requests_total{method="GET",endpoint="/open_api/v1.3/campaign/get/",status="200"} 2`,
+		`tiktok_sdk_requests_total{method="GET",endpoint="/open_api/v1.3/campaign/get/",status="400"} 1`,
+		`tiktok_sdk_request_duration_seconds_bucket{endpoint="/open_api/v1.3/campaign/get/",le="+Inf"} 3`,
+		`tiktok_sdk_request_duration_seconds_count{endpoint="/open_api/v1.3/campaign/get/"} 3`,
+		"tiktok_sdk_rate_limiter_tokens ",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected %q in metrics:\n%s", want, body)
+		}
+	}
+
+	// The stats handler serves the collector's series under the same names,
+	// once each
+	rec = httptest.NewRecorder()
+	NewStatsHandler(client).ServeHTTP(rec, httptest.NewRequest("GET", "/stats?format=prometheus", nil))
+	stats := rec.Body.String()
+	for _, want := range []string{
+		`tiktok_sdk_requests_total{method="GET",endpoint="/open_api/v1.3/campaign/get/",status="200"} 2`,
+		`tiktok_sdk_request_duration_seconds_count{endpoint="/open_api/v1.3/campaign/get/"} 3`,
+		"tiktok_sdk_retries_total 0",
+	} {
+		if !strings.Contains(stats, want) {
+			t.Errorf("Expected %q in stats:\n%s", want, stats)
+		}
+	}
+	for _, family := range []string{"tiktok_sdk_requests_total", "tiktok_sdk_rate_limiter_tokens"} {
+		if n := strings.Count(stats, "# TYPE "+family+" "); n != 1 {
+			t.Errorf("%s declared %d times in stats:\n%s", family, n, stats)
+		}
+	}
+}
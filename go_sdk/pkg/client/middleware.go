@@ -0,0 +1,87 @@
+package client
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// Middleware wraps the transport used for every HTTP attempt, including
+// retries, to observe or modify requests and responses
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a function to the http.RoundTripper interface
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req)
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Use appends middleware to the client's transport, inside Config.Middleware
+// and any middleware added by earlier calls: middleware registered first,
+// whether in the config or with Use, sees requests first. The audit
+// middleware of Config.Audit stays innermost. Use must not be called
+// concurrently with requests.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+	c.chainTransport()
+}
+
+// chainTransport rebuilds the HTTP transport from the registered middleware
+func (c *Client) chainTransport() {
+	middleware := append(slices.Clone(c.middleware), c.innermost...)
+	c.httpClient.Transport = chainMiddleware(c.transport, middleware)
+}
+
+// chainMiddleware wraps transport so that middleware[0] is outermost
+func chainMiddleware(transport http.RoundTripper, middleware []Middleware) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	for i := len(middleware) - 1; i >= 0; i-- {
+		transport = middleware[i](transport)
+	}
+	return transport
+}
+
+// LoggingMiddleware logs the method, path, status and duration of every
+// attempt. Headers are not logged, so access tokens never reach the log.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+
+			attrs := []any{"method", req.Method, "path", req.URL.Path, "duration", time.Since(start)}
+			if err != nil {
+				logger.ErrorContext(req.Context(), "tiktok api request failed", append(attrs, "error", err)...)
+				return resp, err
+			}
+			logger.InfoContext(req.Context(), "tiktok api request", append(attrs, "status", resp.StatusCode)...)
+			return resp, nil
+		})
+	}
+}
+
+// LatencyMiddleware calls record with the duration of every attempt. status
+// is zero when the attempt failed without a response.
+func LatencyMiddleware(record func(method, path string, status int, latency time.Duration)) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+			}
+			record(req.Method, req.URL.Path, status, time.Since(start))
+			return resp, err
+		})
+	}
+}
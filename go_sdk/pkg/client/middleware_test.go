@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_Middleware(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Trace-Id") != "trace-1" {
+			t.Errorf("Expected injected trace header, got %q", r.Header.Get("X-Trace-Id"))
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var order []string
+	injectHeader := func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			order = append(order, "header")
+			req.Header.Set("X-Trace-Id", "trace-1")
+			return next.RoundTrip(req)
+		})
+	}
+	var recorded []int
+	latency := LatencyMiddleware(func(method, path string, status int, latency time.Duration) {
+		order = append(order, "latency")
+		recorded = append(recorded, status)
+	})
+
+	var logs strings.Builder
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		AccessToken: "secret_token",
+		Timeout:     5 * time.Second,
+		Middleware:  []Middleware{injectHeader, latency},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.Use(LoggingMiddleware(slog.New(slog.NewTextHandler(&logs, nil))))
+
+	resp, err := client.DoRequest(context.Background(), "GET", "/open_api/v1.3/advertiser/info/", nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if len(order) != 2 || order[0] != "header" || len(recorded) != 1 || recorded[0] != http.StatusOK {
+		t.Errorf("Unexpected middleware calls: order %v, statuses %v", order, recorded)
+	}
+	if !strings.Contains(logs.String(), "path=/open_api/v1.3/advertiser/info/") || strings.Contains(logs.String(), "secret_token") {
+		t.Errorf("Unexpected log output: %s", logs.String())
+	}
+}
+
+func TestClient_UseOrder(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"code":0}`)
+	})
+
+	var order []string
+	named := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+	var audited []string
+	client := newTestClient(t, server, func(c *Config) {
+		c.Middleware = []Middleware{named("config")}
+		c.Audit = &AuditConfig{Sink: AuditSinkFunc(func(ctx context.Context, record AuditRecord) error {
+			audited = append(audited, strings.Join(order, ","))
+			return nil
+		})}
+	})
+	client.Use(named("first"), named("second"))
+	client.Use(named("third"))
+
+	resp, err := client.DoRequest(context.Background(), "POST", "/campaign/update/", strings.NewReader("{}"), nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := strings.Join(order, ","); got != "config,first,second,third" {
+		t.Errorf("middleware order = %s, want config,first,second,third", got)
+	}
+	// The audit middleware stays innermost, after every registered middleware
+	if len(audited) != 1 || audited[0] != "config,first,second,third" {
+		t.Errorf("audited after %v, want after every middleware", audited)
+	}
+}
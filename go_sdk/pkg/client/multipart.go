@@ -0,0 +1,268 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MultipartFile is a file part of a multipart request
+type MultipartFile struct {
+	// FieldName is the form field of the file, e.g. image_file
+	FieldName string
+
+	// FileName is sent as the part's file name
+	FileName string
+
+	// ContentType of the file; detected from its content when empty
+	ContentType string
+
+	// Reader supplies the file content. It is streamed, not buffered. Requests
+	// are retried only when every file Reader is also an io.Seeker. A
+	// cancelled request returns without waiting for a Read in progress.
+	Reader io.Reader
+
+	// SignatureField, if set, names a form field that receives the hex MD5
+	// of the file content, e.g. image_signature
+	SignatureField string
+}
+
+// DoMultipartRequest POSTs form fields and files as multipart/form-data,
+// streaming the files through the client's rate limiting and retry logic
+func (c *Client) DoMultipartRequest(ctx context.Context, endpoint string, fields map[string]string, files []MultipartFile, headers map[string]string) (*http.Response, error) {
+	for _, file := range files {
+		if file.FieldName == "" || file.Reader == nil {
+			return nil, fmt.Errorf("multipart file requires a field name and reader")
+		}
+	}
+
+	body := &multipartBody{ctx: ctx, fields: fields, files: files, boundary: multipart.NewWriter(nil).Boundary()}
+	// The transport waits for a Read of the body to return before giving up
+	// on a cancelled request, so closing the stream is what cancels it
+	stop := context.AfterFunc(ctx, func() { body.Close() })
+	defer func() {
+		stop()
+		body.Close()
+		// Let go of the files before returning, unless the request was
+		// cancelled: a Read blocked on a slow source would hold it up
+		if ctx.Err() == nil {
+			body.wait()
+		}
+	}()
+
+	requestHeaders := map[string]string{"Content-Type": body.contentType()}
+	for key, value := range headers {
+		requestHeaders[key] = value
+	}
+	return c.DoRequest(ctx, "POST", endpoint, body, requestHeaders)
+}
+
+// rewindableBody is a request body that can be produced again for retries
+type rewindableBody interface {
+	rewindable() bool
+	rewind() (io.ReadCloser, error)
+}
+
+// multipartBody encodes a multipart form on the fly through a pipe
+type multipartBody struct {
+	ctx      context.Context
+	fields   map[string]string
+	files    []MultipartFile
+	boundary string
+
+	mu      sync.Mutex
+	current *io.PipeReader
+	done    chan struct{}  // closed when the encoder of current exits
+	last    *multipartBody // latest stream handed out by rewind
+}
+
+// contentType returns the Content-Type header of the form
+func (b *multipartBody) contentType() string {
+	return "multipart/form-data; boundary=" + b.boundary
+}
+
+// Read streams the form, starting the encoder on first use
+func (b *multipartBody) Read(p []byte) (int, error) {
+	b.mu.Lock()
+	if b.current == nil {
+		b.start()
+	}
+	current := b.current
+	b.mu.Unlock()
+
+	n, err := current.Read(p)
+	if err == io.ErrClosedPipe && b.ctx.Err() != nil {
+		// Report why the stream was closed
+		err = context.Cause(b.ctx)
+	}
+	return n, err
+}
+
+// Close stops the encoders of this body and of its latest rewound stream
+// without waiting for them to exit. The transport closes the body when a
+// request is cancelled, so Close must not block on a file Read.
+func (b *multipartBody) Close() error {
+	for _, stream := range b.streams() {
+		stream.halt()
+	}
+	return nil
+}
+
+// wait stops the encoders of this body and of its latest rewound stream
+// and waits for them to let go of the files
+func (b *multipartBody) wait() {
+	for _, stream := range b.streams() {
+		stream.stop()
+	}
+}
+
+// streams returns this body and its latest rewound stream
+func (b *multipartBody) streams() []*multipartBody {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.last != nil {
+		return []*multipartBody{b.last, b}
+	}
+	return []*multipartBody{b}
+}
+
+// halt closes the stream so its encoder exits on its next write
+func (b *multipartBody) halt() {
+	b.mu.Lock()
+	current := b.current
+	b.mu.Unlock()
+	if current != nil {
+		_ = current.Close()
+	}
+}
+
+// stop closes the stream and waits for its encoder to let go of the files
+func (b *multipartBody) stop() {
+	b.halt()
+	b.mu.Lock()
+	done := b.done
+	b.mu.Unlock()
+	if done != nil {
+		<-done
+	}
+}
+
+// rewindable reports whether every file can be read again
+func (b *multipartBody) rewindable() bool {
+	for _, file := range b.files {
+		if _, ok := file.Reader.(io.Seeker); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// rewind seeks the files back to their start and returns a fresh stream,
+// once the previous stream has stopped reading them
+func (b *multipartBody) rewind() (io.ReadCloser, error) {
+	b.mu.Lock()
+	previous := b.last
+	b.mu.Unlock()
+	if previous == nil {
+		previous = b
+	}
+	previous.stop()
+
+	for _, file := range b.files {
+		if _, err := file.Reader.(io.Seeker).Seek(0, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("failed to rewind %s: %w", file.FileName, err)
+		}
+	}
+	next := &multipartBody{ctx: b.ctx, fields: b.fields, files: b.files, boundary: b.boundary}
+	b.mu.Lock()
+	b.last = next
+	b.mu.Unlock()
+	return next, nil
+}
+
+// start encodes the form into a new pipe in the background; the caller holds b.mu
+func (b *multipartBody) start() {
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pw.CloseWithError(b.encode(pw))
+	}()
+	b.current, b.done = pr, done
+}
+
+// encode writes the fields, then each file followed by its signature
+func (b *multipartBody) encode(w io.Writer) error {
+	writer := multipart.NewWriter(w)
+	if err := writer.SetBoundary(b.boundary); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(b.fields))
+	for key := range b.fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := writer.WriteField(key, b.fields[key]); err != nil {
+			return err
+		}
+	}
+
+	for _, file := range b.files {
+		// Sniff the content type from the first 512 bytes, then replay them
+		head := make([]byte, 512)
+		n, err := io.ReadFull(file.Reader, head)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return fmt.Errorf("failed to read %s: %w", file.FileName, err)
+		}
+		head = head[:n]
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = http.DetectContentType(head)
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, file.FieldName, file.FileName))
+		header.Set("Content-Type", contentType)
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return err
+		}
+
+		hash := md5.New()
+		content := io.MultiReader(bytes.NewReader(head), file.Reader)
+		if _, err := io.Copy(io.MultiWriter(part, hash), content); err != nil {
+			return fmt.Errorf("failed to stream %s: %w", file.FileName, err)
+		}
+
+		if file.SignatureField != "" {
+			if err := writer.WriteField(file.SignatureField, hex.EncodeToString(hash.Sum(nil))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return writer.Close()
+}
+
+// uploadFileName names an uploaded file, adding the extension of its type
+func uploadFileName(name, fileType string) string {
+	if name == "" {
+		name = "upload"
+	}
+	ext := "." + strings.ToLower(fileType)
+	if fileType == "" || strings.HasSuffix(strings.ToLower(name), ext) {
+		return name
+	}
+	return name + ext
+}
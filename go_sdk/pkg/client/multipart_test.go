@@ -0,0 +1,117 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_DoMultipartRequest(t *testing.T) {
+	image := []byte("\x89PNG\r\n\x1a\n fake image content")
+	var attempts atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/file/image/ad/upload/" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("ParseMultipartForm() error = %v", err)
+		}
+		file, header, err := r.FormFile("image_file")
+		if err != nil {
+			t.Fatalf("FormFile() error = %v", err)
+		}
+		content, _ := io.ReadAll(file)
+		if !bytes.Equal(content, image) {
+			t.Errorf("file content = %q, want %q", content, image)
+		}
+		if got := header.Header.Get("Content-Type"); got != "image/png" {
+			t.Errorf("file Content-Type = %s, want detected image/png", got)
+		}
+		sum := md5.Sum(image)
+		if got := r.FormValue("image_signature"); got != hex.EncodeToString(sum[:]) {
+			t.Errorf("image_signature = %s", got)
+		}
+		if r.FormValue("advertiser_id") != "123" {
+			t.Errorf("advertiser_id = %q", r.FormValue("advertiser_id"))
+		}
+
+		// Throttle the first attempt so the form has to be streamed again
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writeJSON(w, `{"code":0,"data":{"image_id":"img-1"}}`)
+	})
+
+	client := newTestClient(t, server, func(c *Config) {
+		c.RetryConfig = &RetryConfig{MaxRetries: 2, BackoffStrategy: NewConstantBackoff(time.Millisecond)}
+	})
+
+	resp, err := client.Creative().UploadImage(context.Background(), &ImageUploadRequest{
+		AdvertiserID: "123",
+		ImageData:    image,
+		ImageName:    "banner",
+		ImageType:    "PNG",
+	})
+	if err != nil {
+		t.Fatalf("UploadImage() error = %v", err)
+	}
+	if resp.Data.ImageID != "img-1" || attempts.Load() != 2 {
+		t.Errorf("ImageID = %q after %d attempts, want img-1 after 2", resp.Data.ImageID, attempts.Load())
+	}
+
+	// Unseekable readers are streamed once, without retries
+	attempts.Store(0)
+	_, err = client.DoMultipartRequest(context.Background(), "/file/image/ad/upload/",
+		map[string]string{"advertiser_id": "123"},
+		[]MultipartFile{{FieldName: "image_file", FileName: "banner.png", Reader: io.MultiReader(bytes.NewReader(image)), SignatureField: "image_signature"}},
+		nil)
+	if err != nil {
+		t.Fatalf("DoMultipartRequest() error = %v", err)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("unseekable upload attempted %d times, want 1", got)
+	}
+}
+
+// blockingReader blocks in Read until released
+type blockingReader struct{ release chan struct{} }
+
+func (r blockingReader) Read(p []byte) (int, error) {
+	<-r.release
+	return 0, io.EOF
+}
+
+func TestDoMultipartRequestCancel(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+	})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// A Read stuck on a slow source must not hold up a cancelled upload
+	reader := blockingReader{release: make(chan struct{})}
+	defer close(reader.release)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.DoMultipartRequest(ctx, "/file/video/ad/upload/", map[string]string{"advertiser_id": "123"},
+		[]MultipartFile{{FieldName: "video_file", FileName: "spot.mp4", Reader: reader}}, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("DoMultipartRequest() error = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("DoMultipartRequest() returned after %v", elapsed)
+	}
+}
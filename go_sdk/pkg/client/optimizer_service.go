@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
 )
 
 // optimizerService handles Optimizer related operations
@@ -35,7 +37,10 @@ func (s *optimizerService) CreateRule(ctx context.Context, req *OptimizerRuleCre
 		return nil, fmt.Errorf("actions is required")
 	}
 
-	url := s.client.BuildURL("/optimizer/rule/create/", nil)
+	url, err := s.client.BuildURL("/optimizer/rule/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -66,7 +71,10 @@ func (s *optimizerService) GetRule(ctx context.Context, req *OptimizerRuleGetReq
 		"rule_id":       req.RuleID,
 	}
 
-	url := s.client.BuildURL("/optimizer/rule/get/", params)
+	url, err := s.client.BuildURL("/optimizer/rule/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -101,7 +109,10 @@ func (s *optimizerService) ListRules(ctx context.Context, req *OptimizerRuleList
 		params["status"] = req.Status
 	}
 
-	url := s.client.BuildURL("/optimizer/rule/list/", params)
+	url, err := s.client.BuildURL("/optimizer/rule/list/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -128,7 +139,10 @@ func (s *optimizerService) UpdateRule(ctx context.Context, req *OptimizerRuleUpd
 		return nil, fmt.Errorf("rule_id is required")
 	}
 
-	url := s.client.BuildURL("/optimizer/rule/update/", nil)
+	url, err := s.client.BuildURL("/optimizer/rule/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -163,7 +177,10 @@ func (s *optimizerService) BatchBindRule(ctx context.Context, req *OptimizerRule
 		return nil, fmt.Errorf("object_ids is required")
 	}
 
-	url := s.client.BuildURL("/optimizer/rule/batch/bind/", nil)
+	url, err := s.client.BuildURL("/optimizer/rule/batch/bind/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -201,7 +218,10 @@ func (s *optimizerService) GetRuleResult(ctx context.Context, req *OptimizerRule
 		params["end_date"] = req.EndDate
 	}
 
-	url := s.client.BuildURL("/optimizer/rule/result/get/", params)
+	url, err := s.client.BuildURL("/optimizer/rule/result/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -242,7 +262,10 @@ func (s *optimizerService) ListRuleResults(ctx context.Context, req *OptimizerRu
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/optimizer/rule/result/list/", params)
+	url, err := s.client.BuildURL("/optimizer/rule/result/list/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -296,8 +319,8 @@ type OptimizerRuleData struct {
 	Conditions  []OptimizerRuleCondition `json:"conditions"`
 	Actions     []OptimizerRuleAction    `json:"actions"`
 	ObjectType  string                   `json:"object_type"`
-	CreateTime  string                   `json:"create_time"`
-	UpdateTime  string                   `json:"update_time"`
+	CreateTime  models.Time              `json:"create_time"`
+	UpdateTime  models.Time              `json:"update_time"`
 }
 
 type OptimizerRuleGetRequest struct {
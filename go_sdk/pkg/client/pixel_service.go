@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
 )
 
 // PixelService handles Pixel related operations
@@ -44,16 +46,16 @@ type PixelUpdateRequest struct {
 
 // PixelData represents pixel information
 type PixelData struct {
-	PixelID      string `json:"pixel_id"`
-	PixelName    string `json:"pixel_name"`
-	PixelMode    string `json:"pixel_mode"`
-	PixelCode    string `json:"pixel_code"`
-	Description  string `json:"description"`
-	Status       string `json:"status"`
-	CreateTime   string `json:"create_time"`
-	UpdateTime   string `json:"update_time"`
-	LastFireTime string `json:"last_fire_time"`
-	EventCount   int64  `json:"event_count"`
+	PixelID      string      `json:"pixel_id"`
+	PixelName    string      `json:"pixel_name"`
+	PixelMode    string      `json:"pixel_mode"`
+	PixelCode    string      `json:"pixel_code"`
+	Description  string      `json:"description"`
+	Status       string      `json:"status"`
+	CreateTime   models.Time `json:"create_time"`
+	UpdateTime   models.Time `json:"update_time"`
+	LastFireTime string      `json:"last_fire_time"`
+	EventCount   int64       `json:"event_count"`
 }
 
 // PixelResponse represents the response from pixel operations
@@ -88,7 +90,10 @@ func (s *PixelService) Create(ctx context.Context, req *PixelCreateRequest) (*Pi
 		req.PixelMode = "MANUAL_MODE"
 	}
 
-	url := s.client.BuildURL("/pixel/create/", nil)
+	url, err := s.client.BuildURL("/pixel/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -127,7 +132,10 @@ func (s *PixelService) List(ctx context.Context, req *PixelGetRequest) (*PixelLi
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/pixel/list/", params)
+	url, err := s.client.BuildURL("/pixel/list/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -155,7 +163,10 @@ func (s *PixelService) Update(ctx context.Context, req *PixelUpdateRequest) (*Pi
 		return nil, fmt.Errorf("pixel_id is required")
 	}
 
-	url := s.client.BuildURL("/pixel/update/", nil)
+	url, err := s.client.BuildURL("/pixel/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -216,8 +227,8 @@ type PixelEventData struct {
 	EventType   string                 `json:"event_type"`
 	Description string                 `json:"description"`
 	Status      string                 `json:"status"`
-	CreateTime  string                 `json:"create_time"`
-	UpdateTime  string                 `json:"update_time"`
+	CreateTime  models.Time            `json:"create_time"`
+	UpdateTime  models.Time            `json:"update_time"`
 	Parameters  map[string]interface{} `json:"parameters"`
 	FireCount   int64                  `json:"fire_count"`
 }
@@ -257,7 +268,10 @@ func (s *PixelService) CreateEvent(ctx context.Context, req *PixelEventCreateReq
 		req.EventType = "STANDARD"
 	}
 
-	url := s.client.BuildURL("/pixel/event/create/", nil)
+	url, err := s.client.BuildURL("/pixel/event/create/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -291,7 +305,10 @@ func (s *PixelService) GetEvents(ctx context.Context, req *PixelEventGetRequest)
 		params["event_id"] = req.EventID
 	}
 
-	url := s.client.BuildURL("/pixel/event/stats/", params)
+	url, err := s.client.BuildURL("/pixel/event/stats/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -322,7 +339,10 @@ func (s *PixelService) UpdateEvent(ctx context.Context, req *PixelEventUpdateReq
 		return nil, fmt.Errorf("event_id is required")
 	}
 
-	url := s.client.BuildURL("/pixel/event/update/", nil)
+	url, err := s.client.BuildURL("/pixel/event/update/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -358,7 +378,10 @@ func (s *PixelService) DeleteEvent(ctx context.Context, req *PixelEventDeleteReq
 		return nil, fmt.Errorf("event_id is required")
 	}
 
-	url := s.client.BuildURL("/pixel/event/delete/", nil)
+	url, err := s.client.BuildURL("/pixel/event/delete/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// promWriter renders metrics in the Prometheus text exposition format. The
+// stats handler and PrometheusCollector share it, and with it one set of
+// metric names.
+type promWriter struct {
+	strings.Builder
+}
+
+// family writes the HELP and TYPE lines that precede a metric's samples
+func (w *promWriter) family(name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// sample writes one sample; labels are alternating names and values
+func (w *promWriter) sample(name string, value float64, labels ...string) {
+	w.WriteString(name)
+	if len(labels) > 0 {
+		w.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, "%s=%q", labels[i], labels[i+1])
+		}
+		w.WriteByte('}')
+	}
+	fmt.Fprintf(w, " %g\n", value)
+}
+
+// metric writes a family with a single unlabeled sample
+func (w *promWriter) metric(name, kind, help string, value float64) {
+	w.family(name, kind, help)
+	w.sample(name, value)
+}
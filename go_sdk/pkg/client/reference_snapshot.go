@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+// DownloadReferenceSnapshot fetches regions, languages, interest categories
+// and device models for an advertiser and bundles them into a snapshot that
+// the utils validators can use offline
+func (c *Client) DownloadReferenceSnapshot(ctx context.Context, advertiserID string) (*utils.ReferenceSnapshot, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	snapshot := &utils.ReferenceSnapshot{
+		Version:   utils.ReferenceSnapshotVersion,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	regions, err := c.Tool().GetRegions(ctx, advertiserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, region := range regions.Data {
+		snapshot.Regions = append(snapshot.Regions, utils.ReferenceEntry{ID: region.RegionCode, Name: region.RegionName})
+	}
+
+	languages, err := c.Tool().GetLanguages(ctx, advertiserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, language := range languages.Data {
+		snapshot.Languages = append(snapshot.Languages, utils.ReferenceEntry{ID: language.LanguageCode, Name: language.LanguageName})
+	}
+
+	interests, err := c.Tool().GetInterestCategories(ctx, &InterestCategoriesRequest{AdvertiserID: advertiserID})
+	if err != nil {
+		return nil, err
+	}
+	snapshot.InterestCategories = flattenInterestCategories(interests.Data, nil)
+
+	devices, err := c.Tool().GetDeviceModels(ctx, &DeviceModelsRequest{AdvertiserID: advertiserID})
+	if err != nil {
+		return nil, err
+	}
+	for _, device := range devices.Data {
+		snapshot.DeviceModels = append(snapshot.DeviceModels, utils.ReferenceEntry{ID: device.DeviceModelID, Name: device.DeviceModelName})
+	}
+
+	return snapshot, nil
+}
+
+// flattenInterestCategories walks the interest taxonomy depth-first
+func flattenInterestCategories(categories []InterestCategory, out []utils.ReferenceEntry) []utils.ReferenceEntry {
+	for _, category := range categories {
+		out = append(out, utils.ReferenceEntry{ID: category.InterestCategoryID, Name: category.InterestCategoryName})
+		out = flattenInterestCategories(category.Children, out)
+	}
+	return out
+}
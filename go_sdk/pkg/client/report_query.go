@@ -0,0 +1,378 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// levelRank orders data levels from coarsest to finest
+var levelRank = map[models.DataLevel]int{
+	models.DataLevelAdvertiser: 0,
+	models.DataLevelCampaign:   1,
+	models.DataLevelAdGroup:    2,
+	models.DataLevelAd:         3,
+	models.DataLevelCreative:   3,
+
+	models.DataLevelReservationAdvertiser: 0,
+	models.DataLevelReservationCampaign:   1,
+	models.DataLevelReservationAdGroup:    2,
+	models.DataLevelReservationAd:         3,
+}
+
+// reportMetricLevels is the metric registry: the coarsest data level each
+// metric is reported at
+var reportMetricLevels = map[models.Metric]models.DataLevel{
+	models.MetricImpressions:       models.DataLevelAdvertiser,
+	models.MetricClicks:            models.DataLevelAdvertiser,
+	models.MetricCost:              models.DataLevelAdvertiser,
+	models.MetricCTR:               models.DataLevelAdvertiser,
+	models.MetricCPC:               models.DataLevelAdvertiser,
+	models.MetricCPM:               models.DataLevelAdvertiser,
+	models.MetricConversions:       models.DataLevelAdvertiser,
+	models.MetricCPA:               models.DataLevelAdvertiser,
+	models.MetricROAS:              models.DataLevelAdvertiser,
+	models.MetricSpend:             models.DataLevelAdvertiser,
+	models.MetricReach:             models.DataLevelAdvertiser,
+	models.MetricConversion:        models.DataLevelAdvertiser,
+	models.MetricCostPerConversion: models.DataLevelAdvertiser,
+	models.MetricConversionRate:    models.DataLevelAdvertiser,
+	models.MetricVideoPlayActions:  models.DataLevelAdvertiser,
+	models.MetricFrequency:         models.DataLevelAdvertiser,
+	models.MetricCampaignName:      models.DataLevelCampaign,
+	models.MetricAdGroupName:       models.DataLevelAdGroup,
+	models.MetricAdName:            models.DataLevelAd,
+	models.MetricAdGroupID:         models.DataLevelAd,
+	models.MetricCampaignBudget:    models.DataLevelCampaign,
+	models.MetricAdText:            models.DataLevelAd,
+
+	models.MetricResult:             models.DataLevelAdvertiser,
+	models.MetricCostPerResult:      models.DataLevelAdvertiser,
+	models.MetricRealTimeConversion: models.DataLevelAdvertiser,
+	models.MetricBilledCost:         models.DataLevelAdvertiser,
+	models.MetricCashSpend:          models.DataLevelAdvertiser,
+	models.MetricVoucherSpend:       models.DataLevelAdvertiser,
+
+	models.MetricLikes:            models.DataLevelAdvertiser,
+	models.MetricComments:         models.DataLevelAdvertiser,
+	models.MetricShares:           models.DataLevelAdvertiser,
+	models.MetricFollows:          models.DataLevelAdvertiser,
+	models.MetricProfileVisits:    models.DataLevelAdvertiser,
+	models.MetricVideoWatched2s:   models.DataLevelAdvertiser,
+	models.MetricVideoWatched6s:   models.DataLevelAdvertiser,
+	models.MetricVideoViewsP25:    models.DataLevelAdvertiser,
+	models.MetricVideoViewsP50:    models.DataLevelAdvertiser,
+	models.MetricVideoViewsP75:    models.DataLevelAdvertiser,
+	models.MetricVideoViewsP100:   models.DataLevelAdvertiser,
+	models.MetricAverageVideoPlay: models.DataLevelAdvertiser,
+
+	models.MetricSKANAppInstall:         models.DataLevelAdvertiser,
+	models.MetricSKANCostPerAppInstall:  models.DataLevelAdvertiser,
+	models.MetricSKANConversion:         models.DataLevelAdvertiser,
+	models.MetricSKANCostPerConversion:  models.DataLevelAdvertiser,
+	models.MetricSKANTotalPurchaseValue: models.DataLevelAdvertiser,
+
+	models.MetricCTAConversion: models.DataLevelAdvertiser,
+	models.MetricVTAConversion: models.DataLevelAdvertiser,
+}
+
+// uniqueReachMetrics count unique users, which hourly reports cannot break down
+var uniqueReachMetrics = map[models.Metric]bool{
+	models.MetricReach:     true,
+	models.MetricFrequency: true,
+}
+
+// skanMetrics come from SKAdNetwork postbacks, which identify the campaign
+// and ad group but not the ad, and arrive once a day at most
+var skanMetrics = map[models.Metric]bool{
+	models.MetricSKANAppInstall:         true,
+	models.MetricSKANCostPerAppInstall:  true,
+	models.MetricSKANConversion:         true,
+	models.MetricSKANCostPerConversion:  true,
+	models.MetricSKANTotalPurchaseValue: true,
+}
+
+// idDimensionLevels maps ID dimensions to the data level they group by
+var idDimensionLevels = map[models.Dimension]models.DataLevel{
+	models.DimensionAdvertiserID: models.DataLevelAdvertiser,
+	models.DimensionCampaignID:   models.DataLevelCampaign,
+	models.DimensionAdGroupID:    models.DataLevelAdGroup,
+	models.DimensionAdID:         models.DataLevelAd,
+}
+
+// audienceDimensions are only available in AUDIENCE reports
+var audienceDimensions = map[models.Dimension]bool{
+	models.DimensionAge:              true,
+	models.DimensionGender:           true,
+	models.DimensionCountryCode:      true,
+	models.DimensionPlatform:         true,
+	models.DimensionPlacement:        true,
+	models.DimensionLanguage:         true,
+	models.DimensionProvinceID:       true,
+	models.DimensionInterestCategory: true,
+}
+
+// ReportMetricsAt lists the registered metrics available at a data level
+func ReportMetricsAt(level models.DataLevel) []models.Metric {
+	rank, ok := levelRank[level]
+	if !ok {
+		return nil
+	}
+	var metrics []models.Metric
+	for metric, coarsest := range reportMetricLevels {
+		if rank >= levelRank[coarsest] && !(skanMetrics[metric] && rank > levelRank[models.DataLevelAdGroup]) {
+			metrics = append(metrics, metric)
+		}
+	}
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i] < metrics[j] })
+	return metrics
+}
+
+// ReportDimensionsAt lists the registered dimensions a report of the given
+// type can group by at a data level
+func ReportDimensionsAt(level models.DataLevel, reportType models.ReportType) []models.Dimension {
+	rank, ok := levelRank[level]
+	if !ok {
+		return nil
+	}
+	dimensions := []models.Dimension{models.DimensionStatTimeDay, models.DimensionStatTimeHour}
+	for dimension, idLevel := range idDimensionLevels {
+		if levelRank[idLevel] == rank {
+			dimensions = append(dimensions, dimension)
+		}
+	}
+	if reportType == models.ReportTypeAudience {
+		for dimension := range audienceDimensions {
+			dimensions = append(dimensions, dimension)
+		}
+	}
+	if reportType == models.ReportTypeBasic && level == models.DataLevelAdGroup {
+		dimensions = append(dimensions, models.DimensionSearchTerms)
+	}
+	sort.Slice(dimensions, func(i, j int) bool { return dimensions[i] < dimensions[j] })
+	return dimensions
+}
+
+// ReportQuery builds an integrated report request, checking metrics and
+// dimensions against the data level before anything is sent
+type ReportQuery struct {
+	client *Client
+	req    ReportingRequest
+}
+
+// ReportQuery starts a BASIC auction report query for an advertiser
+func (c *Client) ReportQuery(advertiserID string) *ReportQuery {
+	return &ReportQuery{
+		client: c,
+		req: ReportingRequest{
+			AdvertiserID: advertiserID,
+			ServiceType:  models.ServiceTypeAuction,
+			ReportType:   models.ReportTypeBasic,
+			DataLevel:    models.DataLevelAdvertiser,
+		},
+	}
+}
+
+// Type sets the report type
+func (q *ReportQuery) Type(reportType models.ReportType) *ReportQuery {
+	q.req.ReportType = reportType
+	return q
+}
+
+// Level sets the data level and the service type it belongs to
+func (q *ReportQuery) Level(level models.DataLevel) *ReportQuery {
+	q.req.DataLevel = level
+	q.req.ServiceType = level.ServiceType()
+	return q
+}
+
+// Metrics adds metrics to the report
+func (q *ReportQuery) Metrics(metrics ...models.Metric) *ReportQuery {
+	q.req.Metrics = append(q.req.Metrics, metrics...)
+	return q
+}
+
+// Dimensions adds dimensions to the report
+func (q *ReportQuery) Dimensions(dimensions ...models.Dimension) *ReportQuery {
+	q.req.Dimensions = append(q.req.Dimensions, dimensions...)
+	return q
+}
+
+// DateRange limits the report to the days from start to end inclusive
+func (q *ReportQuery) DateRange(start, end time.Time) *ReportQuery {
+	q.req.StartDate = start.Format("2006-01-02")
+	q.req.EndDate = end.Format("2006-01-02")
+	q.req.QueryLifetime = false
+	return q
+}
+
+// Lifetime reports over the whole lifetime instead of a date range
+func (q *ReportQuery) Lifetime() *ReportQuery {
+	q.req.QueryLifetime = true
+	return q
+}
+
+// Filter adds a filter. IN filters take any number of values; other filter
+// types take one.
+func (q *ReportQuery) Filter(field, filterType string, values ...string) *ReportQuery {
+	filter := ReportingFilter{FieldName: field, FilterType: filterType}
+	if filterType == "IN" {
+		encoded, _ := json.Marshal(values)
+		filter.FilterValue = string(encoded)
+	} else if len(values) > 0 {
+		filter.FilterValue = values[0]
+	}
+	q.req.Filters = append(q.req.Filters, filter)
+	return q
+}
+
+// OrderBy sorts the report by a metric
+func (q *ReportQuery) OrderBy(metric models.Metric, descending bool) *ReportQuery {
+	q.req.OrderField = string(metric)
+	q.req.OrderType = "ASC"
+	if descending {
+		q.req.OrderType = "DESC"
+	}
+	return q
+}
+
+// Page selects a page of results
+func (q *ReportQuery) Page(page, pageSize int) *ReportQuery {
+	q.req.Page = page
+	q.req.PageSize = pageSize
+	return q
+}
+
+// Build checks the query and returns the request. Unlike
+// ReportingRequest.Validate, it also rejects metrics and dimensions missing
+// from the registry or listed twice.
+func (q *ReportQuery) Build() (*ReportingRequest, error) {
+	seenMetrics := make(map[models.Metric]bool, len(q.req.Metrics))
+	for _, metric := range q.req.Metrics {
+		if _, known := reportMetricLevels[metric]; !known {
+			return nil, models.NewValidationError("metrics", fmt.Sprintf("unknown metric %s", metric))
+		}
+		if seenMetrics[metric] {
+			return nil, models.NewValidationError("metrics", fmt.Sprintf("metric %s is listed more than once", metric))
+		}
+		seenMetrics[metric] = true
+	}
+	seenDimensions := make(map[models.Dimension]bool, len(q.req.Dimensions))
+	for _, dimension := range q.req.Dimensions {
+		_, known := idDimensionLevels[dimension]
+		if !known && !audienceDimensions[dimension] && !isTimeDimension(dimension) && dimension != models.DimensionSearchTerms {
+			return nil, models.NewValidationError("dimensions", fmt.Sprintf("unknown dimension %s", dimension))
+		}
+		if seenDimensions[dimension] {
+			return nil, models.NewValidationError("dimensions", fmt.Sprintf("dimension %s is listed more than once", dimension))
+		}
+		seenDimensions[dimension] = true
+	}
+	if q.req.DataLevel == "" {
+		return nil, models.NewValidationError("data_level", "data_level is required")
+	}
+
+	req := q.req
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// Params builds the query and returns the query parameters of the
+// integrated report request
+func (q *ReportQuery) Params() (map[string]interface{}, error) {
+	req, err := q.Build()
+	if err != nil {
+		return nil, err
+	}
+	return req.Params()
+}
+
+// BuildAsync builds the query as an async report task writing a file in
+// outputFormat, CSV or XLSX
+func (q *ReportQuery) BuildAsync(outputFormat, fileName string) (*AsyncReportRequest, error) {
+	req, err := q.Build()
+	if err != nil {
+		return nil, err
+	}
+	return &AsyncReportRequest{ReportingRequest: *req, OutputFormat: outputFormat, FileName: fileName}, nil
+}
+
+// Run builds the query and fetches the report
+func (q *ReportQuery) Run(ctx context.Context) (*ReportingResponse, error) {
+	req, err := q.Build()
+	if err != nil {
+		return nil, err
+	}
+	return q.client.Reporting().GetIntegratedReport(ctx, req)
+}
+
+// validateDataLevel checks that a report's data level is supported, matches
+// its service type, and allows the requested metrics and dimensions. Metrics
+// and dimensions missing from the registry are left to the API.
+func validateDataLevel(r *ReportingRequest) error {
+	level, ok := levelRank[r.DataLevel]
+	if !ok {
+		return models.NewValidationError("data_level", fmt.Sprintf("unsupported data level %s", r.DataLevel))
+	}
+	serviceType := r.ServiceType
+	if serviceType == "" {
+		serviceType = models.ServiceTypeAuction
+	}
+	if r.DataLevel.ServiceType() != serviceType {
+		return models.NewValidationError("data_level", fmt.Sprintf("data level %s cannot be used with service type %s", r.DataLevel, serviceType))
+	}
+
+	for _, metric := range r.Metrics {
+		coarsest, known := reportMetricLevels[metric]
+		if known && level < levelRank[coarsest] {
+			return models.NewValidationError("metrics", fmt.Sprintf("metric %s is not available at %s", metric, r.DataLevel))
+		}
+		if skanMetrics[metric] && level > levelRank[models.DataLevelAdGroup] {
+			return models.NewValidationError("metrics", fmt.Sprintf("metric %s is reported down to the ad group, not at %s", metric, r.DataLevel))
+		}
+	}
+
+	timeDimensions := 0
+	for _, dimension := range r.Dimensions {
+		switch {
+		case isTimeDimension(dimension):
+			timeDimensions++
+			if dimension != models.DimensionStatTimeHour {
+				continue
+			}
+			for _, metric := range r.Metrics {
+				if uniqueReachMetrics[metric] || skanMetrics[metric] {
+					return models.NewValidationError("metrics", fmt.Sprintf("metric %s is not available by hour", metric))
+				}
+			}
+		case dimension == models.DimensionSearchTerms:
+			if r.DataLevel != models.DataLevelAdGroup || r.ReportType != models.ReportTypeBasic {
+				return models.NewValidationError("dimensions", "dimension search_terms requires a BASIC report at AUCTION_ADGROUP")
+			}
+		case audienceDimensions[dimension]:
+			if r.ReportType != models.ReportTypeAudience {
+				return models.NewValidationError("dimensions", fmt.Sprintf("dimension %s requires an AUDIENCE report", dimension))
+			}
+		default:
+			idLevel, known := idDimensionLevels[dimension]
+			if known && levelRank[idLevel] != level {
+				return models.NewValidationError("dimensions", fmt.Sprintf("dimension %s does not match data level %s", dimension, r.DataLevel))
+			}
+		}
+	}
+	if timeDimensions > 1 {
+		return models.NewValidationError("dimensions", "only one of stat_time_day and stat_time_hour can be used")
+	}
+	return nil
+}
+
+// isTimeDimension reports whether a dimension groups rows by time
+func isTimeDimension(dimension models.Dimension) bool {
+	return dimension == models.DimensionStatTimeDay || dimension == models.DimensionStatTimeHour
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestReportQuery_Build(t *testing.T) {
+	client, err := NewClient(&Config{BaseURL: "https://api.example.com", AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	req, err := client.ReportQuery("123").
+		Level(models.DataLevelAdGroup).
+		Metrics(models.MetricSpend, models.MetricImpressions, models.MetricAdGroupName).
+		Dimensions(models.DimensionStatTimeDay, models.DimensionAdGroupID).
+		DateRange(start, end).
+		Filter("campaign_ids", "IN", "1", "2").
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if req.StartDate != "2024-01-01" || req.EndDate != "2024-01-07" || req.Filters[0].FilterValue != `["1","2"]` {
+		t.Errorf("Unexpected request: %+v", req)
+	}
+
+	invalid := map[string]*ReportQuery{
+		"metric below level":  client.ReportQuery("123").Level(models.DataLevelCampaign).Metrics(models.MetricAdName).Dimensions(models.DimensionCampaignID).DateRange(start, end),
+		"dimension level":     client.ReportQuery("123").Level(models.DataLevelCampaign).Metrics(models.MetricSpend).Dimensions(models.DimensionAdGroupID).DateRange(start, end),
+		"audience in basic":   client.ReportQuery("123").Metrics(models.MetricSpend).Dimensions(models.DimensionAdvertiserID, models.DimensionAge).DateRange(start, end),
+		"two time dimensions": client.ReportQuery("123").Metrics(models.MetricSpend).Dimensions(models.DimensionStatTimeDay, models.DimensionStatTimeHour).DateRange(start, end),
+		"missing date range":  client.ReportQuery("123").Metrics(models.MetricSpend).Dimensions(models.DimensionAdvertiserID),
+		"unknown metric":      client.ReportQuery("123").Metrics("spendd").Dimensions(models.DimensionAdvertiserID).DateRange(start, end),
+		"duplicate metric":    client.ReportQuery("123").Metrics(models.MetricSpend, models.MetricSpend).Dimensions(models.DimensionAdvertiserID).DateRange(start, end),
+		"reach by hour":       client.ReportQuery("123").Metrics(models.MetricReach).Dimensions(models.DimensionStatTimeHour).DateRange(start, end),
+		"search terms level":  client.ReportQuery("123").Level(models.DataLevelCampaign).Metrics(models.MetricSpend).Dimensions(models.DimensionCampaignID, models.DimensionSearchTerms).DateRange(start, end),
+	}
+	for name, query := range invalid {
+		if _, err := query.Build(); err == nil {
+			t.Errorf("%s: expected a build error", name)
+		}
+	}
+
+	query := client.ReportQuery("123").
+		Level(models.DataLevelAdGroup).
+		Metrics(models.MetricSpend, models.MetricVideoViewsP100).
+		Dimensions(models.DimensionAdGroupID, models.DimensionSearchTerms).
+		DateRange(start, end)
+	params, err := query.Params()
+	if err != nil {
+		t.Fatalf("Params() error = %v", err)
+	}
+	if params["metrics"] != `["spend","video_views_p100"]` || params["dimensions"] != `["adgroup_id","search_terms"]` || params["data_level"] != "AUCTION_ADGROUP" {
+		t.Errorf("Params() = %v", params)
+	}
+	async, err := query.BuildAsync("CSV", "terms")
+	if err != nil || async.OutputFormat != "CSV" || async.DataLevel != models.DataLevelAdGroup {
+		t.Errorf("BuildAsync() = %+v, %v", async, err)
+	}
+
+	campaignMetrics := ReportMetricsAt(models.DataLevelCampaign)
+	if !slices.Contains(campaignMetrics, models.MetricCampaignName) || slices.Contains(campaignMetrics, models.MetricAdName) {
+		t.Errorf("ReportMetricsAt(campaign) = %v", campaignMetrics)
+	}
+	if dimensions := ReportDimensionsAt(models.DataLevelAd, models.ReportTypeAudience); !slices.Contains(dimensions, models.DimensionGender) || !slices.Contains(dimensions, models.DimensionAdID) || slices.Contains(dimensions, models.DimensionCampaignID) {
+		t.Errorf("ReportDimensionsAt(ad, audience) = %v", dimensions)
+	}
+}
+
+func TestReportingRequest_ValidateDataLevel(t *testing.T) {
+	base := func(level models.DataLevel, service models.ServiceType, dimensions ...models.Dimension) *ReportingRequest {
+		return &ReportingRequest{
+			AdvertiserID:  "123",
+			ServiceType:   service,
+			ReportType:    models.ReportTypeBasic,
+			DataLevel:     level,
+			Dimensions:    dimensions,
+			Metrics:       []models.Metric{models.MetricSpend},
+			QueryLifetime: true,
+		}
+	}
+
+	valid := []*ReportingRequest{
+		base(models.DataLevelAdGroup, "", models.DimensionAdGroupID),
+		base(models.DataLevelCreative, models.ServiceTypeAuction, models.DimensionAdID),
+		base(models.DataLevelReservationCampaign, models.ServiceTypeReservation, models.DimensionCampaignID, models.DimensionStatTimeDay),
+		base(models.DataLevelAd, "", models.DimensionAdID, "custom_dimension"),
+	}
+	for _, req := range valid {
+		if err := req.Validate(); err != nil {
+			t.Errorf("Validate(%s) error = %v", req.DataLevel, err)
+		}
+	}
+
+	invalid := map[string]*ReportingRequest{
+		"unknown level":        base("AUCTION_ACCOUNT", "", models.DimensionAdvertiserID),
+		"reservation, auction": base(models.DataLevelReservationAd, "", models.DimensionAdID),
+		"auction, reservation": base(models.DataLevelAd, models.ServiceTypeReservation, models.DimensionAdID),
+		"dimension level":      base(models.DataLevelReservationAdGroup, models.ServiceTypeReservation, models.DimensionCampaignID),
+	}
+	metricBelowLevel := base(models.DataLevelCampaign, "", models.DimensionCampaignID)
+	metricBelowLevel.Metrics = append(metricBelowLevel.Metrics, models.MetricAdName)
+	invalid["metric below level"] = metricBelowLevel
+	for name, req := range invalid {
+		if err := req.Validate(); err == nil {
+			t.Errorf("%s: expected a validation error", name)
+		}
+	}
+
+	if models.DataLevelReservationAdvertiser.ServiceType() != models.ServiceTypeReservation || models.DataLevelAd.ServiceType() != models.ServiceTypeAuction {
+		t.Error("DataLevel.ServiceType() returned the wrong service type")
+	}
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// gzipMagic starts every gzip file
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// reportColumn locates the ReportingRow field a report column decodes into
+type reportColumn struct {
+	dimension bool
+	index     int
+}
+
+// reportColumns maps report columns to ReportingRow fields by their JSON names
+var reportColumns = func() map[string]reportColumn {
+	columns := make(map[string]reportColumn)
+	for _, part := range []struct {
+		dimension bool
+		typ       reflect.Type
+	}{{true, reflect.TypeFor[ReportingDimensions]()}, {false, reflect.TypeFor[ReportingMetrics]()}} {
+		for i := 0; i < part.typ.NumField(); i++ {
+			name := strings.Split(part.typ.Field(i).Tag.Get("json"), ",")[0]
+			if _, ok := columns[name]; !ok {
+				columns[name] = reportColumn{dimension: part.dimension, index: i}
+			}
+		}
+	}
+	return columns
+}()
+
+// ReportReader decodes the rows of a report CSV file, such as an async
+// report download, one at a time without buffering the file. Gzipped files
+// are detected and decompressed.
+type ReportReader struct {
+	reader *csv.Reader
+	header []string
+}
+
+// NewReportReader creates a ReportReader over a report file
+func NewReportReader(r io.Reader) (*ReportReader, error) {
+	buffered := bufio.NewReader(r)
+	magic, err := buffered.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read report: %w", err)
+	}
+
+	var source io.Reader = buffered
+	if bytes.Equal(magic, gzipMagic) {
+		source, err = gzip.NewReader(buffered)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress report: %w", err)
+		}
+	}
+
+	reader := csv.NewReader(source)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	return &ReportReader{reader: reader}, nil
+}
+
+// Header returns the column names of the report, lowercased
+func (r *ReportReader) Header() ([]string, error) {
+	if r.header == nil {
+		header, err := r.reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		r.header = make([]string, len(header))
+		for i, column := range header {
+			column = strings.TrimPrefix(column, "\ufeff")
+			r.header[i] = strings.ToLower(strings.TrimSpace(column))
+		}
+	}
+	return r.header, nil
+}
+
+// Next returns the next row decoded into a ReportingRow, or io.EOF after the
+// last one. Columns without a ReportingRow field are skipped; use NextMap to
+// keep them.
+func (r *ReportReader) Next() (*ReportingRow, error) {
+	record, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+
+	row := &ReportingRow{}
+	dimensions := reflect.ValueOf(&row.Dimensions).Elem()
+	metrics := reflect.ValueOf(&row.Metrics).Elem()
+	for i, value := range record {
+		if i >= len(r.header) {
+			break
+		}
+		column, ok := reportColumns[r.header[i]]
+		if !ok {
+			continue
+		}
+		field := metrics.Field(column.index)
+		if column.dimension {
+			field = dimensions.Field(column.index)
+		}
+
+		if field.Kind() == reflect.String {
+			field.SetString(value)
+			continue
+		}
+		number, err := r.parseNumber(i, value)
+		if err != nil {
+			return nil, err
+		}
+		field.Set(reflect.ValueOf(models.MetricValue(number)))
+	}
+	return row, nil
+}
+
+// NextMap returns the next row keyed by column, or io.EOF after the last
+// one. Numeric values are decoded as float64 and unavailable values ("-")
+// as nil; ID columns and other text stay strings.
+func (r *ReportReader) NextMap() (map[string]interface{}, error) {
+	record, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+
+	row := make(map[string]interface{}, len(record))
+	for i, value := range record {
+		if i >= len(r.header) {
+			break
+		}
+		column := r.header[i]
+		value = strings.TrimSpace(value)
+		switch {
+		case strings.HasSuffix(column, "_id") || strings.HasSuffix(column, "_ids"):
+			row[column] = value
+		case value == "-":
+			row[column] = nil
+		default:
+			if number, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64); err == nil {
+				row[column] = number
+			} else {
+				row[column] = value
+			}
+		}
+	}
+	return row, nil
+}
+
+// read returns the next record after the header
+func (r *ReportReader) read() ([]string, error) {
+	if _, err := r.Header(); err != nil {
+		return nil, err
+	}
+	return r.reader.Read()
+}
+
+// parseNumber parses a metric of the current record, treating empty and
+// unavailable ("-") values as zero
+func (r *ReportReader) parseNumber(field int, value string) (float64, error) {
+	value = strings.TrimSuffix(strings.TrimSpace(value), "%")
+	if value == "" || value == "-" {
+		return 0, nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		line, _ := r.reader.FieldPos(field)
+		return 0, fmt.Errorf("line %d: invalid %s value %q", line, r.header[field], value)
+	}
+	return number, nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestReportReader(t *testing.T) {
+	const report = "\ufeffCampaign_ID,stat_time_day,spend,impressions,ctr,cost_per_conversion,custom_metric\n" +
+		"1800000000000000001,2026-06-01 00:00:00,12.50,1000,1.20%,-,abc\n" +
+		"1800000000000000002,2026-06-02 00:00:00,8,400,0.5,4.00,7\n"
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	zw.Write([]byte(report))
+	zw.Close()
+
+	for name, data := range map[string][]byte{"plain": []byte(report), "gzip": compressed.Bytes()} {
+		reader, err := NewReportReader(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("%s: NewReportReader() error = %v", name, err)
+		}
+		header, err := reader.Header()
+		if err != nil || header[0] != "campaign_id" {
+			t.Fatalf("%s: Header() = %v, %v", name, header, err)
+		}
+		row, err := reader.Next()
+		if err != nil {
+			t.Fatalf("%s: Next() error = %v", name, err)
+		}
+		if row.Dimensions.CampaignID != "1800000000000000001" || row.Dimensions.StatTimeDay != "2026-06-01 00:00:00" ||
+			row.Metrics.Spend != 12.5 || row.Metrics.Impressions != 1000 || row.Metrics.CTR != 1.2 || row.Metrics.CostPerConversion != 0 {
+			t.Errorf("%s: row = %+v", name, row)
+		}
+		generic, err := reader.NextMap()
+		if err != nil {
+			t.Fatalf("%s: NextMap() error = %v", name, err)
+		}
+		if generic["campaign_id"] != "1800000000000000002" || generic["spend"] != 8.0 || generic["custom_metric"] != 7.0 {
+			t.Errorf("%s: map row = %v", name, generic)
+		}
+		if _, err := reader.Next(); err != io.EOF {
+			t.Errorf("%s: Next() after the last row error = %v, want io.EOF", name, err)
+		}
+	}
+
+	reader, _ := NewReportReader(strings.NewReader("campaign_id,spend,ctr\nc1,-,x\n"))
+	generic, err := reader.NextMap()
+	if err != nil || generic["spend"] != nil || generic["ctr"] != "x" {
+		t.Errorf("NextMap() = %v, %v", generic, err)
+	}
+	reader, _ = NewReportReader(strings.NewReader("campaign_id,spend\nc1,abc\n"))
+	if _, err := reader.Next(); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Next() with an invalid metric error = %v", err)
+	}
+	reader, _ = NewReportReader(strings.NewReader(""))
+	if _, err := reader.Next(); err != io.EOF {
+		t.Errorf("Next() on an empty file error = %v, want io.EOF", err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
 )
 
 // reportService handles Report related operations
@@ -52,7 +54,10 @@ func (s *reportService) GetIntegratedReport(ctx context.Context, req *ReportInte
 		params["size"] = req.Size
 	}
 
-	url := s.client.BuildURL("/report/integrated/get/", params)
+	url, err := s.client.BuildURL("/report/integrated/get/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -82,20 +87,30 @@ func (s *reportService) CreateReportTask(ctx context.Context, req *ReportTaskCre
 		return nil, fmt.Errorf("start_date and end_date are required")
 	}
 
-	url := s.client.BuildURL("/report/task/create/", nil)
-	
-	body, err := json.Marshal(req)
+	return createReportTask(ctx, s.client, req)
+}
+
+// createReportTask posts a report task definition. CreateReportTask and the
+// async reports of the ReportingService describe reports with different
+// request types and share this call.
+func createReportTask(ctx context.Context, client *Client, definition interface{}) (*ReportTaskResponse, error) {
+	url, err := client.BuildURL("/report/task/create/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(definition)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	resp, err := client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create report task: %w", err)
 	}
 
 	var response ReportTaskResponse
-	if err := s.client.ParseResponse(resp, &response); err != nil {
+	if err := client.ParseResponse(resp, &response); err != nil {
 		return nil, err
 	}
 
@@ -113,7 +128,10 @@ func (s *reportService) CheckReportTask(ctx context.Context, req *ReportTaskChec
 		"task_id":       req.TaskID,
 	}
 
-	url := s.client.BuildURL("/report/task/check/", params)
+	url, err := s.client.BuildURL("/report/task/check/", params)
+	if err != nil {
+		return nil, err
+	}
 	
 	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -140,7 +158,10 @@ func (s *reportService) CancelReportTask(ctx context.Context, req *ReportTaskCan
 		return nil, fmt.Errorf("task_id is required")
 	}
 
-	url := s.client.BuildURL("/report/task/cancel/", nil)
+	url, err := s.client.BuildURL("/report/task/cancel/", nil)
+	if err != nil {
+		return nil, err
+	}
 	
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -223,9 +244,9 @@ type ReportTaskResponse struct {
 }
 
 type ReportTaskData struct {
-	TaskID     string `json:"task_id"`
-	Status     string `json:"status"`
-	CreateTime string `json:"create_time"`
+	TaskID     string      `json:"task_id"`
+	Status     string      `json:"status"`
+	CreateTime models.Time `json:"create_time"`
 }
 
 type ReportTaskCheckRequest struct {
@@ -241,16 +262,16 @@ type ReportTaskCheckResponse struct {
 }
 
 type ReportTaskCheckData struct {
-	TaskID       string `json:"task_id"`
-	Status       string `json:"status"` // PENDING, PROCESSING, COMPLETED, FAILED
-	Progress     int    `json:"progress"` // 0-100
-	CreateTime   string `json:"create_time"`
-	UpdateTime   string `json:"update_time"`
-	CompleteTime string `json:"complete_time,omitempty"`
-	DownloadURL  string `json:"download_url,omitempty"`
-	FileSize     int64  `json:"file_size,omitempty"`
-	ErrorCode    string `json:"error_code,omitempty"`
-	ErrorMessage string `json:"error_message,omitempty"`
+	TaskID       string      `json:"task_id"`
+	Status       string      `json:"status"`   // QUEUING, PROCESSING, SUCCESS, FAILED, CANCELED
+	Progress     int         `json:"progress"` // 0-100
+	CreateTime   models.Time `json:"create_time"`
+	UpdateTime   models.Time `json:"update_time"`
+	CompleteTime string      `json:"complete_time,omitempty"`
+	DownloadURL  string      `json:"download_url,omitempty"`
+	FileSize     int64       `json:"file_size,omitempty"`
+	ErrorCode    string      `json:"error_code,omitempty"`
+	ErrorMessage string      `json:"error_message,omitempty"`
 }
 
 type ReportTaskCancelRequest struct {
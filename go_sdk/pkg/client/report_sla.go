@@ -0,0 +1,274 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Report run phases
+const (
+	ReportPhaseCreate   = "CREATE"
+	ReportPhasePoll     = "POLL"
+	ReportPhaseDownload = "DOWNLOAD"
+)
+
+// ReportSLA is the total time budget for an asynchronous report run and how
+// it is split across the create, poll and download phases. Time a phase does
+// not use rolls over to the following phases.
+type ReportSLA struct {
+	Total time.Duration
+
+	// Phase shares of Total; they default to 10% create, 70% poll, 20% download
+	CreateShare   float64
+	PollShare     float64
+	DownloadShare float64
+
+	// MinPollInterval and MaxPollInterval bound the adaptive poll interval
+	// (defaults 2s and 30s)
+	MinPollInterval time.Duration
+	MaxPollInterval time.Duration
+}
+
+// ReportRunResult holds the outcome of RunReportWithSLA, including partial
+// progress when the budget is exceeded
+type ReportRunResult struct {
+	TaskID      string
+	Status      string
+	Progress    int
+	Polls       int
+	DownloadURL string
+	Data        []byte
+	Elapsed     time.Duration
+}
+
+// ReportSLAExceededError is returned when a report run exceeds its budget
+type ReportSLAExceededError struct {
+	Phase    string
+	TaskID   string
+	Progress int
+	Elapsed  time.Duration
+	Budget   time.Duration
+	Err      error
+}
+
+// Error implements the error interface
+func (e ReportSLAExceededError) Error() string {
+	return fmt.Sprintf("report SLA of %s exceeded during %s phase after %s (task %s, %d%% complete)",
+		e.Budget, e.Phase, e.Elapsed.Round(time.Millisecond), e.TaskID, e.Progress)
+}
+
+// Unwrap returns the underlying error
+func (e ReportSLAExceededError) Unwrap() error {
+	return e.Err
+}
+
+// withDefaults fills unset SLA fields
+func (sla ReportSLA) withDefaults() ReportSLA {
+	if sla.CreateShare <= 0 && sla.PollShare <= 0 && sla.DownloadShare <= 0 {
+		sla.CreateShare, sla.PollShare, sla.DownloadShare = 0.1, 0.7, 0.2
+	}
+	if sla.MinPollInterval <= 0 {
+		sla.MinPollInterval = 2 * time.Second
+	}
+	if sla.MaxPollInterval < sla.MinPollInterval {
+		sla.MaxPollInterval = 30 * time.Second
+		if sla.MaxPollInterval < sla.MinPollInterval {
+			sla.MaxPollInterval = sla.MinPollInterval
+		}
+	}
+	return sla
+}
+
+// phaseDeadline returns the deadline for a phase given the cumulative share
+// of the budget that should be spent by its end
+func (sla ReportSLA) phaseDeadline(start time.Time, cumulativeShare float64) time.Time {
+	total := sla.CreateShare + sla.PollShare + sla.DownloadShare
+	return start.Add(time.Duration(float64(sla.Total) * cumulativeShare / total))
+}
+
+// RunReportWithSLA creates a report task, polls it with an adaptive interval
+// and downloads the result, all within sla.Total. When a phase runs over its
+// share of the budget the task is cancelled and a ReportSLAExceededError is
+// returned alongside the partial result.
+func (c *Client) RunReportWithSLA(ctx context.Context, req *ReportTaskCreateRequest, sla ReportSLA) (*ReportRunResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if sla.Total <= 0 {
+		return nil, fmt.Errorf("sla total must be greater than 0")
+	}
+	sla = sla.withDefaults()
+
+	start := time.Now()
+	result := &ReportRunResult{}
+	exceeded := func(phase string, err error) (*ReportRunResult, error) {
+		result.Elapsed = time.Since(start)
+		if result.TaskID != "" {
+			c.cancelReportTask(req.AdvertiserID, result.TaskID)
+		}
+		return result, ReportSLAExceededError{
+			Phase:    phase,
+			TaskID:   result.TaskID,
+			Progress: result.Progress,
+			Elapsed:  result.Elapsed,
+			Budget:   sla.Total,
+			Err:      err,
+		}
+	}
+
+	// Create
+	createCtx, cancel := context.WithDeadline(ctx, sla.phaseDeadline(start, sla.CreateShare))
+	task, err := c.Report().CreateReportTask(createCtx, req)
+	cancel()
+	if err != nil {
+		if isPhaseTimeout(ctx, createCtx) {
+			return exceeded(ReportPhaseCreate, err)
+		}
+		return nil, err
+	}
+	if task.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", task.Code), task.Message, task.RequestID, 0)
+	}
+	result.TaskID = task.Data.TaskID
+	result.Status = task.Data.Status
+
+	// Poll
+	pollDeadline := sla.phaseDeadline(start, sla.CreateShare+sla.PollShare)
+	pollCtx, cancel := context.WithDeadline(ctx, pollDeadline)
+	defer cancel()
+
+	interval := sla.MinPollInterval
+	lastProgress, lastChange := 0, time.Now()
+poll:
+	for {
+		check, err := c.Report().CheckReportTask(pollCtx, &ReportTaskCheckRequest{
+			AdvertiserID: req.AdvertiserID,
+			TaskID:       result.TaskID,
+		})
+		if err != nil {
+			if isPhaseTimeout(ctx, pollCtx) {
+				return exceeded(ReportPhasePoll, err)
+			}
+			return result, err
+		}
+		result.Polls++
+		if check.Code != 0 {
+			result.Elapsed = time.Since(start)
+			return result, models.NewAPIError(fmt.Sprintf("%d", check.Code), check.Message, check.RequestID, 0)
+		}
+		result.Status = check.Data.Status
+		result.Progress = check.Data.Progress
+
+		switch check.Data.Status {
+		case AsyncReportSuccess:
+			result.DownloadURL = check.Data.DownloadURL
+			break poll
+		case AsyncReportFailed, AsyncReportCanceled:
+			result.Elapsed = time.Since(start)
+			return result, fmt.Errorf("report task %s %s: %s", result.TaskID, strings.ToLower(check.Data.Status), check.Data.ErrorMessage)
+		}
+
+		interval = nextPollInterval(interval, lastProgress, result.Progress, time.Since(lastChange), time.Until(pollDeadline), sla)
+		if result.Progress != lastProgress {
+			lastProgress, lastChange = result.Progress, time.Now()
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-pollCtx.Done():
+			timer.Stop()
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+			return exceeded(ReportPhasePoll, pollCtx.Err())
+		case <-timer.C:
+		}
+	}
+
+	// Download
+	if result.DownloadURL != "" {
+		downloadCtx, cancel := context.WithDeadline(ctx, start.Add(sla.Total))
+		defer cancel()
+
+		data, err := c.downloadFile(downloadCtx, result.DownloadURL)
+		if err != nil {
+			if isPhaseTimeout(ctx, downloadCtx) {
+				return exceeded(ReportPhaseDownload, err)
+			}
+			return result, err
+		}
+		result.Data = data
+	}
+
+	result.Elapsed = time.Since(start)
+	return result, nil
+}
+
+// nextPollInterval backs off while progress stalls and, once progress moves,
+// estimates the time to completion so the next poll lands near the finish
+func nextPollInterval(current time.Duration, lastProgress, progress int, sinceChange, remaining time.Duration, sla ReportSLA) time.Duration {
+	next := time.Duration(float64(current) * 1.5)
+
+	if progress > lastProgress && progress < 100 && sinceChange > 0 {
+		perPoint := sinceChange / time.Duration(progress-lastProgress)
+		next = perPoint * time.Duration(100-progress) / 2
+	}
+
+	if next < sla.MinPollInterval {
+		next = sla.MinPollInterval
+	}
+	if next > sla.MaxPollInterval {
+		next = sla.MaxPollInterval
+	}
+	if remaining > 0 && next > remaining {
+		next = remaining
+	}
+	return next
+}
+
+// isPhaseTimeout reports whether a phase context expired while the parent is still live
+func isPhaseTimeout(parent, phase context.Context) bool {
+	return parent.Err() == nil && errors.Is(phase.Err(), context.DeadlineExceeded)
+}
+
+// cancelReportTask cancels an abandoned task on a best-effort basis
+func (c *Client) cancelReportTask(advertiserID, taskID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, _ = c.Report().CancelReportTask(ctx, &ReportTaskCancelRequest{AdvertiserID: advertiserID, TaskID: taskID})
+}
+
+// downloadFile fetches a file from a pre-signed download URL
+func (c *Client) downloadFile(ctx context.Context, downloadURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", downloadURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create download request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("download returned HTTP %d", resp.StatusCode)
+	}
+
+	limit := c.maxResponseBytes()
+	data, fits, err := bufferBody(resp, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read downloaded file: %w", err)
+	}
+	if !fits {
+		return nil, models.NewResponseTooLargeError(resp.StatusCode, limit, resp.ContentLength)
+	}
+	return data, nil
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// slaReportRequest is a report task request that passes validation
+var slaReportRequest = &ReportTaskCreateRequest{AdvertiserID: "123", ReportType: "BASIC", StartDate: "2024-01-01", EndDate: "2024-01-07"}
+
+func TestClient_RunReportWithSLA(t *testing.T) {
+	var checks atomic.Int32
+	var server *httptest.Server
+	server = newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/report/task/create/":
+			writeJSON(w, `{"code":0,"data":{"task_id":"task-1","status":"QUEUING"}}`)
+		case "/open_api/v1.3/report/task/check/":
+			if checks.Add(1) < 2 {
+				writeJSON(w, `{"code":0,"data":{"task_id":"task-1","status":"PROCESSING","progress":50}}`)
+				return
+			}
+			writeJSON(w, `{"code":0,"data":{"task_id":"task-1","status":"SUCCESS","progress":100,"download_url":"`+server.URL+`/files/report.csv"}}`)
+		case "/files/report.csv":
+			w.Header().Set("Content-Type", "text/csv")
+			w.Write([]byte("campaign_id,spend\n1,12.50\n"))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	client := newTestClient(t, server)
+
+	result, err := client.RunReportWithSLA(context.Background(), slaReportRequest, ReportSLA{Total: 5 * time.Second, MinPollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("RunReportWithSLA() error = %v", err)
+	}
+	if result.TaskID != "task-1" || result.Status != AsyncReportSuccess || result.Polls != 2 || result.Progress != 100 {
+		t.Errorf("result = %+v", result)
+	}
+	if string(result.Data) != "campaign_id,spend\n1,12.50\n" {
+		t.Errorf("report data = %q", result.Data)
+	}
+}
+
+func TestClient_RunReportWithSLATimeout(t *testing.T) {
+	var cancels atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/report/task/create/":
+			writeJSON(w, `{"code":0,"data":{"task_id":"task-1"}}`)
+		case "/open_api/v1.3/report/task/check/":
+			writeJSON(w, `{"code":0,"data":{"task_id":"task-1","status":"PROCESSING","progress":10}}`)
+		case "/open_api/v1.3/report/task/cancel/":
+			cancels.Add(1)
+			writeJSON(w, `{"code":0,"data":{"task_id":"task-1","status":"CANCELED"}}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	client := newTestClient(t, server)
+
+	result, err := client.RunReportWithSLA(context.Background(), slaReportRequest, ReportSLA{Total: 100 * time.Millisecond, MinPollInterval: 5 * time.Millisecond})
+	var exceeded ReportSLAExceededError
+	if !errors.As(err, &exceeded) || exceeded.Phase != ReportPhasePoll || exceeded.TaskID != "task-1" || exceeded.Progress != 10 {
+		t.Fatalf("RunReportWithSLA() error = %v, want SLA exceeded while polling", err)
+	}
+	if result == nil || result.Polls == 0 || result.Status != AsyncReportProcessing {
+		t.Errorf("partial result = %+v", result)
+	}
+	if n := cancels.Load(); n != 1 {
+		t.Errorf("task cancelled %d times, want 1", n)
+	}
+}
+
+func TestClient_RunReportWithSLAErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		create string
+		check  string
+		want   string
+	}{
+		{
+			name:   "create error code",
+			create: `{"code":40002,"message":"Invalid report type","request_id":"req1","data":{}}`,
+			want:   "40002",
+		},
+		{
+			name:   "check error code",
+			create: `{"code":0,"data":{"task_id":"task-1"}}`,
+			check:  `{"code":40002,"message":"Task not found","request_id":"req2","data":{}}`,
+			want:   "40002",
+		},
+		{
+			name:   "task failed",
+			create: `{"code":0,"data":{"task_id":"task-1"}}`,
+			check:  `{"code":0,"data":{"task_id":"task-1","status":"FAILED","error_message":"Too many rows"}}`,
+			want:   "report task task-1 failed: Too many rows",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/open_api/v1.3/report/task/create/":
+					writeJSON(w, tt.create)
+				case "/open_api/v1.3/report/task/check/":
+					writeJSON(w, tt.check)
+				default:
+					t.Errorf("Unexpected path %s", r.URL.Path)
+				}
+			})
+			client := newTestClient(t, server)
+
+			_, err := client.RunReportWithSLA(context.Background(), slaReportRequest, ReportSLA{Total: 5 * time.Second, MinPollInterval: time.Millisecond})
+			var apiErr *models.APIError
+			switch {
+			case err == nil:
+				t.Fatal("RunReportWithSLA() error = nil")
+			case errors.As(err, &apiErr):
+				if apiErr.Code != tt.want {
+					t.Errorf("API error code = %s, want %s", apiErr.Code, tt.want)
+				}
+			case err.Error() != tt.want:
+				t.Errorf("RunReportWithSLA() error = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Report task statuses, shared by the async reports of the ReportingService
+// and the report tasks of the ReportService
+const (
+	AsyncReportQueuing    = "QUEUING"
+	AsyncReportProcessing = "PROCESSING"
+	AsyncReportSuccess    = "SUCCESS"
+	AsyncReportFailed     = "FAILED"
+	AsyncReportCanceled   = "CANCELED"
+)
+
+// reportingService implements the ReportingService interface
+type reportingService struct {
+	client *Client
+}
+
+// GetIntegratedReport retrieves a synchronous integrated report
+func (r *reportingService) GetIntegratedReport(ctx context.Context, req *ReportingRequest) (*ReportingResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/report/integrated/get/"
+
+	params, err := req.Params()
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := r.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get integrated report: %w", err)
+	}
+
+	var response ReportingResponse
+	if err := r.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Params returns the query parameters of an integrated report request
+func (r *ReportingRequest) Params() (map[string]interface{}, error) {
+	params := map[string]interface{}{
+		"advertiser_id": r.AdvertiserID,
+		"report_type":   string(r.ReportType),
+	}
+
+	dimensions, err := json.Marshal(r.Dimensions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal dimensions: %w", err)
+	}
+	params["dimensions"] = string(dimensions)
+
+	if len(r.Metrics) > 0 {
+		metrics, err := json.Marshal(r.Metrics)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal metrics: %w", err)
+		}
+		params["metrics"] = string(metrics)
+	}
+
+	if len(r.Filters) > 0 {
+		filtering, err := json.Marshal(r.Filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal filtering: %w", err)
+		}
+		params["filtering"] = string(filtering)
+	}
+
+	if r.ServiceType != "" {
+		params["service_type"] = string(r.ServiceType)
+	}
+	if r.DataLevel != "" {
+		params["data_level"] = string(r.DataLevel)
+	}
+	if r.QueryLifetime {
+		params["query_lifetime"] = true
+	} else {
+		params["start_date"] = r.StartDate
+		params["end_date"] = r.EndDate
+	}
+	if r.OrderField != "" {
+		params["order_field"] = r.OrderField
+	}
+	if r.OrderType != "" {
+		params["order_type"] = r.OrderType
+	}
+	if r.Page > 0 {
+		params["page"] = r.Page
+	}
+	if r.PageSize > 0 {
+		params["page_size"] = r.PageSize
+	}
+	return params, nil
+}
+
+// GetBasicReports retrieves basic performance reports
+func (r *reportingService) GetBasicReports(ctx context.Context, req *ReportingRequest) (*ReportingResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	basic := *req
+	basic.ReportType = models.ReportTypeBasic
+	return r.GetIntegratedReport(ctx, &basic)
+}
+
+// GetAudienceReports retrieves audience reports broken down by age, gender,
+// country or platform
+func (r *reportingService) GetAudienceReports(ctx context.Context, req *AudienceReportingRequest) (*AudienceReportingResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	audience := req.ReportingRequest
+	audience.ReportType = models.ReportTypeAudience
+	resp, err := r.GetIntegratedReport(ctx, &audience)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AudienceReportingResponse{ReportingResponse: *resp}, nil
+}
+
+// CreateAsyncReport creates an asynchronous report task
+func (r *reportingService) CreateAsyncReport(ctx context.Context, req *AsyncReportRequest) (*AsyncReportResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	task, err := createReportTask(ctx, r.client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AsyncReportResponse{BaseResponse: models.BaseResponse{Code: task.Code, Message: task.Message, RequestID: task.RequestID}}
+	response.Data.TaskID = task.Data.TaskID
+	return response, nil
+}
+
+// GetAsyncReportStatus checks the status of an asynchronous report task
+func (r *reportingService) GetAsyncReportStatus(ctx context.Context, req *AsyncReportStatusRequest) (*AsyncReportStatusResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	check, err := r.client.Report().CheckReportTask(ctx, &ReportTaskCheckRequest{AdvertiserID: req.AdvertiserID, TaskID: req.TaskID})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AsyncReportStatusResponse{BaseResponse: models.BaseResponse{Code: check.Code, Message: check.Message, RequestID: check.RequestID}}
+	response.Data.TaskID = check.Data.TaskID
+	response.Data.Status = check.Data.Status
+	response.Data.ErrorMessage = check.Data.ErrorMessage
+	return response, nil
+}
+
+// DownloadAsyncReport streams the file of a completed report task. The
+// caller must close the returned reader.
+func (r *reportingService) DownloadAsyncReport(ctx context.Context, req *AsyncReportStatusRequest) (io.ReadCloser, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	url, err := r.client.BuildURL("/report/task/download/", map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+		"task_id":       req.TaskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := r.client.streamDownload(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download async report %s: %w", req.TaskID, err)
+	}
+	return body, nil
+}
+
+// streamDownload GETs a file endpoint and streams the file. The endpoint may
+// answer with the file itself or with a JSON envelope holding its download
+// URL; any other JSON envelope is returned as an API error. JSON bodies over
+// the stream threshold are the file, and are streamed without buffering.
+func (c *Client) streamDownload(ctx context.Context, url string) (io.ReadCloser, error) {
+	resp, err := c.DoRequest(context.WithValue(ctx, streamResponseKey{}, true), "GET", url, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, c.ParseResponse(resp, nil)
+	}
+	if !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return resp.Body, nil
+	}
+	_, fits, err := bufferBody(resp, c.streamThresholdBytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read download: %w", err)
+	}
+	if !fits {
+		return resp.Body, nil
+	}
+
+	var envelope struct {
+		models.BaseResponse
+		Data struct {
+			DownloadURL string `json:"download_url"`
+		} `json:"data"`
+	}
+	if err := c.ParseResponse(resp, &envelope); err != nil {
+		return nil, err
+	}
+	if envelope.Code != 0 {
+		return nil, models.NewAPIError(strconv.Itoa(envelope.Code), envelope.Message, envelope.RequestID, resp.StatusCode)
+	}
+	if envelope.Data.DownloadURL == "" {
+		return nil, fmt.Errorf("response has no download URL")
+	}
+
+	fileReq, err := http.NewRequestWithContext(ctx, "GET", envelope.Data.DownloadURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create download request: %w", err)
+	}
+	fileResp, err := c.httpClient.Do(fileReq)
+	if err != nil {
+		return nil, err
+	}
+	if fileResp.StatusCode >= 300 {
+		fileResp.Body.Close()
+		return nil, fmt.Errorf("download returned HTTP %d", fileResp.StatusCode)
+	}
+	return fileResp.Body, nil
+}
+
+// AsyncReportPollConfig controls how report tasks are polled
+type AsyncReportPollConfig struct {
+	InitialInterval time.Duration // default 2s
+	MaxInterval     time.Duration // default 30s
+	Multiplier      float64       // default 1.5
+}
+
+// withDefaults fills unset poll settings
+func (p AsyncReportPollConfig) withDefaults() AsyncReportPollConfig {
+	if p.InitialInterval <= 0 {
+		p.InitialInterval = 2 * time.Second
+	}
+	if p.MaxInterval < p.InitialInterval {
+		p.MaxInterval = 30 * time.Second
+		if p.MaxInterval < p.InitialInterval {
+			p.MaxInterval = p.InitialInterval
+		}
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = 1.5
+	}
+	return p
+}
+
+// WaitForAsyncReport polls a report task with exponential backoff until it
+// succeeds, fails or ctx is done. An error code from the API ends polling
+// with an APIError.
+func (c *Client) WaitForAsyncReport(ctx context.Context, req *AsyncReportStatusRequest, poll AsyncReportPollConfig) (*AsyncReportStatusResponse, error) {
+	poll = poll.withDefaults()
+	interval := poll.InitialInterval
+
+	for {
+		status, err := c.Reporting().GetAsyncReportStatus(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if status.Code != 0 {
+			return status, models.NewAPIError(strconv.Itoa(status.Code), status.Message, status.RequestID, 0)
+		}
+
+		switch status.Data.Status {
+		case AsyncReportSuccess:
+			return status, nil
+		case AsyncReportFailed, AsyncReportCanceled:
+			return status, fmt.Errorf("report task %s %s: %s", req.TaskID, strings.ToLower(status.Data.Status), status.Data.ErrorMessage)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * poll.Multiplier)
+		if interval > poll.MaxInterval {
+			interval = poll.MaxInterval
+		}
+	}
+}
+
+// RunAsyncReport creates a report task, waits for it to complete and streams
+// the file to w without buffering it in memory. It returns the task ID and
+// the number of bytes written.
+func (c *Client) RunAsyncReport(ctx context.Context, req *AsyncReportRequest, w io.Writer, poll AsyncReportPollConfig) (string, int64, error) {
+	task, err := c.Reporting().CreateAsyncReport(ctx, req)
+	if err != nil {
+		return "", 0, err
+	}
+	if task.Code != 0 {
+		return "", 0, models.NewAPIError(strconv.Itoa(task.Code), task.Message, task.RequestID, 0)
+	}
+
+	statusReq := &AsyncReportStatusRequest{AdvertiserID: req.AdvertiserID, TaskID: task.Data.TaskID}
+	if _, err := c.WaitForAsyncReport(ctx, statusReq, poll); err != nil {
+		return statusReq.TaskID, 0, err
+	}
+
+	body, err := c.Reporting().DownloadAsyncReport(ctx, statusReq)
+	if err != nil {
+		return statusReq.TaskID, 0, err
+	}
+	defer body.Close()
+
+	n, err := io.Copy(w, body)
+	if err != nil {
+		return statusReq.TaskID, n, fmt.Errorf("failed to write async report: %w", err)
+	}
+	return statusReq.TaskID, n, nil
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestReportingService_GetBasicReports(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("report_type"); got != "BASIC" {
+			t.Errorf("Expected report_type BASIC, got %q", got)
+		}
+		writeJSON(w, `{"code":0,"message":"OK","data":{"list":[{"dimensions":{"campaign_id":"1","stat_time_day":"2024-01-01 00:00:00"},"metrics":{"campaign_name":"Spring","spend":"12.50","impressions":"1000","ctr":"-"}}],"page_info":{"page":1,"page_size":10,"total_number":1}}}`)
+	})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	resp, err := client.Reporting().GetBasicReports(context.Background(), &ReportingRequest{
+		AdvertiserID: "123",
+		DataLevel:    models.DataLevelCampaign,
+		Dimensions:   []models.Dimension{models.DimensionCampaignID, models.DimensionStatTimeDay},
+		Metrics:      []models.Metric{models.MetricSpend, models.MetricImpressions, models.MetricCTR},
+		StartDate:    "2024-01-01",
+		EndDate:      "2024-01-07",
+	})
+	if err != nil {
+		t.Fatalf("GetBasicReports failed: %v", err)
+	}
+	if len(resp.Data.List) != 1 {
+		t.Fatalf("Expected 1 row, got %d", len(resp.Data.List))
+	}
+	row := resp.Data.List[0]
+	if row.Dimensions.CampaignID != "1" || row.Metrics.CampaignName != "Spring" {
+		t.Errorf("Unexpected row: %+v", row)
+	}
+	if row.Metrics.Spend != 12.5 || row.Metrics.Impressions != 1000 || row.Metrics.CTR != 0 {
+		t.Errorf("Unexpected metrics: %+v", row.Metrics)
+	}
+}
+
+func TestClient_RunAsyncReport(t *testing.T) {
+	var checks atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/report/task/create/":
+			writeJSON(w, `{"code":0,"message":"OK","data":{"task_id":"task-1"}}`)
+		case "/open_api/v1.3/report/task/check/":
+			status := AsyncReportProcessing
+			if checks.Add(1) >= 2 {
+				status = AsyncReportSuccess
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"code":0,"message":"OK","data":{"task_id":"task-1","status":"` + status + `"}}`))
+		case "/open_api/v1.3/report/task/download/":
+			if r.URL.Query().Get("task_id") != "task-1" {
+				t.Errorf("Unexpected task_id %q", r.URL.Query().Get("task_id"))
+			}
+			w.Header().Set("Content-Type", "text/csv")
+			_, _ = w.Write([]byte("campaign_id,spend\n1,12.50\n"))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	var out strings.Builder
+	taskID, n, err := client.RunAsyncReport(context.Background(), &AsyncReportRequest{
+		ReportingRequest: ReportingRequest{
+			AdvertiserID:  "123",
+			ReportType:    models.ReportTypeBasic,
+			Dimensions:    []models.Dimension{models.DimensionCampaignID},
+			QueryLifetime: true,
+		},
+	}, &out, AsyncReportPollConfig{InitialInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("RunAsyncReport failed: %v", err)
+	}
+	if taskID != "task-1" || checks.Load() != 2 {
+		t.Errorf("Unexpected task %q after %d checks", taskID, checks.Load())
+	}
+	if out.String() != "campaign_id,spend\n1,12.50\n" || n != int64(out.Len()) {
+		t.Errorf("Unexpected report body %q (%d bytes)", out.String(), n)
+	}
+}
+
+func TestClient_WaitForAsyncReportAPIError(t *testing.T) {
+	var checks atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		checks.Add(1)
+		writeJSON(w, `{"code":40002,"message":"Task not found","request_id":"req1","data":{}}`)
+	})
+	client := newTestClient(t, server)
+
+	_, err := client.WaitForAsyncReport(context.Background(), &AsyncReportStatusRequest{AdvertiserID: "123", TaskID: "task-1"}, AsyncReportPollConfig{InitialInterval: time.Millisecond})
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "40002" || apiErr.RequestID != "req1" {
+		t.Errorf("WaitForAsyncReport() error = %v, want API error 40002", err)
+	}
+	if n := checks.Load(); n != 1 {
+		t.Errorf("task checked %d times after an API error, want 1", n)
+	}
+}
+
+func TestClient_RunAsyncReportCreateError(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/report/task/create/" {
+			t.Errorf("Unexpected path %s after a failed create", r.URL.Path)
+		}
+		writeJSON(w, `{"code":40002,"message":"Invalid dimensions","request_id":"req2","data":{}}`)
+	})
+	client := newTestClient(t, server)
+
+	var out strings.Builder
+	taskID, _, err := client.RunAsyncReport(context.Background(), &AsyncReportRequest{
+		ReportingRequest: ReportingRequest{
+			AdvertiserID:  "123",
+			ReportType:    models.ReportTypeBasic,
+			Dimensions:    []models.Dimension{models.DimensionCampaignID},
+			QueryLifetime: true,
+		},
+	}, &out, AsyncReportPollConfig{InitialInterval: time.Millisecond})
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "40002" {
+		t.Errorf("RunAsyncReport() error = %v, want API error 40002", err)
+	}
+	if taskID != "" || out.Len() != 0 {
+		t.Errorf("RunAsyncReport() = task %q, body %q after a failed create", taskID, out.String())
+	}
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/clienttest"
+)
+
+func TestResponseFixtures(t *testing.T) {
+	clienttest.RunGoldenTests(t, "testdata/responses", ResponseTypes())
+}
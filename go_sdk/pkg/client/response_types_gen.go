@@ -0,0 +1,184 @@
+// Code generated by validategen. DO NOT EDIT.
+
+package client
+
+import "reflect"
+
+// ResponseTypes returns the type of every response struct by name, for
+// decoding recorded API responses in golden tests
+func ResponseTypes() map[string]reflect.Type {
+	return map[string]reflect.Type{
+		"ActionCategoryResponse":               reflect.TypeFor[ActionCategoryResponse](),
+		"AdCreateResponse":                     reflect.TypeFor[AdCreateResponse](),
+		"AdDeleteResponse":                     reflect.TypeFor[AdDeleteResponse](),
+		"AdGetResponse":                        reflect.TypeFor[AdGetResponse](),
+		"AdGroupCopyResponse":                  reflect.TypeFor[AdGroupCopyResponse](),
+		"AdGroupCreateResponse":                reflect.TypeFor[AdGroupCreateResponse](),
+		"AdGroupDeleteResponse":                reflect.TypeFor[AdGroupDeleteResponse](),
+		"AdGroupGetResponse":                   reflect.TypeFor[AdGroupGetResponse](),
+		"AdGroupStatusUpdateResponse":          reflect.TypeFor[AdGroupStatusUpdateResponse](),
+		"AdGroupUpdateResponse":                reflect.TypeFor[AdGroupUpdateResponse](),
+		"AdStatusUpdateResponse":               reflect.TypeFor[AdStatusUpdateResponse](),
+		"AdUpdateResponse":                     reflect.TypeFor[AdUpdateResponse](),
+		"AdvertiserFundTransactionGetResponse": reflect.TypeFor[AdvertiserFundTransactionGetResponse](),
+		"AdvertiserUserGetResponse":            reflect.TypeFor[AdvertiserUserGetResponse](),
+		"AdvertiserUserResponse":               reflect.TypeFor[AdvertiserUserResponse](),
+		"AppListResponse":                      reflect.TypeFor[AppListResponse](),
+		"AppResponse":                          reflect.TypeFor[AppResponse](),
+		"AsyncReportResponse":                  reflect.TypeFor[AsyncReportResponse](),
+		"AsyncReportStatusResponse":            reflect.TypeFor[AsyncReportStatusResponse](),
+		"AudienceOverlapResponse":              reflect.TypeFor[AudienceOverlapResponse](),
+		"AudienceReportingResponse":            reflect.TypeFor[AudienceReportingResponse](),
+		"AuthorizedPostListResponse":           reflect.TypeFor[AuthorizedPostListResponse](),
+		"BCAccountTransactionResponse":         reflect.TypeFor[BCAccountTransactionResponse](),
+		"BCAdvertiserCreateResponse":           reflect.TypeFor[BCAdvertiserCreateResponse](),
+		"BCAssetAdminDeleteResponse":           reflect.TypeFor[BCAssetAdminDeleteResponse](),
+		"BCAssetAdminResponse":                 reflect.TypeFor[BCAssetAdminResponse](),
+		"BCAssetAssignResponse":                reflect.TypeFor[BCAssetAssignResponse](),
+		"BCAssetGroupListResponse":             reflect.TypeFor[BCAssetGroupListResponse](),
+		"BCAssetGroupResponse":                 reflect.TypeFor[BCAssetGroupResponse](),
+		"BCAssetMemberResponse":                reflect.TypeFor[BCAssetMemberResponse](),
+		"BCAssetPartnerResponse":               reflect.TypeFor[BCAssetPartnerResponse](),
+		"BCAssetResponse":                      reflect.TypeFor[BCAssetResponse](),
+		"BCAssetUnassignResponse":              reflect.TypeFor[BCAssetUnassignResponse](),
+		"BCBalanceResponse":                    reflect.TypeFor[BCBalanceResponse](),
+		"BCBillingGroupResponse":               reflect.TypeFor[BCBillingGroupResponse](),
+		"BCImageUploadResponse":                reflect.TypeFor[BCImageUploadResponse](),
+		"BCInvoiceResponse":                    reflect.TypeFor[BCInvoiceResponse](),
+		"BCInvoiceUnpaidResponse":              reflect.TypeFor[BCInvoiceUnpaidResponse](),
+		"BCListResponse":                       reflect.TypeFor[BCListResponse](),
+		"BCMemberAssignResponse":               reflect.TypeFor[BCMemberAssignResponse](),
+		"BCMemberDeleteResponse":               reflect.TypeFor[BCMemberDeleteResponse](),
+		"BCMemberInviteResponse":               reflect.TypeFor[BCMemberInviteResponse](),
+		"BCMemberResponse":                     reflect.TypeFor[BCMemberResponse](),
+		"BCMemberUpdateResponse":               reflect.TypeFor[BCMemberUpdateResponse](),
+		"BCPartnerAddResponse":                 reflect.TypeFor[BCPartnerAddResponse](),
+		"BCPartnerAssetDeleteResponse":         reflect.TypeFor[BCPartnerAssetDeleteResponse](),
+		"BCPartnerAssetResponse":               reflect.TypeFor[BCPartnerAssetResponse](),
+		"BCPartnerDeleteResponse":              reflect.TypeFor[BCPartnerDeleteResponse](),
+		"BCPartnerResponse":                    reflect.TypeFor[BCPartnerResponse](),
+		"BCPixelLinkResponse":                  reflect.TypeFor[BCPixelLinkResponse](),
+		"BCPixelLinkUpdateResponse":            reflect.TypeFor[BCPixelLinkUpdateResponse](),
+		"BCPixelTransferResponse":              reflect.TypeFor[BCPixelTransferResponse](),
+		"BCResponse":                           reflect.TypeFor[BCResponse](),
+		"BCTransactionResponse":                reflect.TypeFor[BCTransactionResponse](),
+		"BCTransferResponse":                   reflect.TypeFor[BCTransferResponse](),
+		"BidRecommendResponse":                 reflect.TypeFor[BidRecommendResponse](),
+		"BlockedWordCheckResponse":             reflect.TypeFor[BlockedWordCheckResponse](),
+		"BlockedWordCreateResponse":            reflect.TypeFor[BlockedWordCreateResponse](),
+		"BlockedWordDeleteResponse":            reflect.TypeFor[BlockedWordDeleteResponse](),
+		"BlockedWordListResponse":              reflect.TypeFor[BlockedWordListResponse](),
+		"BlockedWordTaskCheckResponse":         reflect.TypeFor[BlockedWordTaskCheckResponse](),
+		"BlockedWordTaskCreateResponse":        reflect.TypeFor[BlockedWordTaskCreateResponse](),
+		"BlockedWordUpdateResponse":            reflect.TypeFor[BlockedWordUpdateResponse](),
+		"CampaignCopyResponse":                 reflect.TypeFor[CampaignCopyResponse](),
+		"CampaignCreateResponse":               reflect.TypeFor[CampaignCreateResponse](),
+		"CampaignDeleteResponse":               reflect.TypeFor[CampaignDeleteResponse](),
+		"CampaignGetResponse":                  reflect.TypeFor[CampaignGetResponse](),
+		"CampaignStatusUpdateResponse":         reflect.TypeFor[CampaignStatusUpdateResponse](),
+		"CampaignUpdateResponse":               reflect.TypeFor[CampaignUpdateResponse](),
+		"CarriersResponse":                     reflect.TypeFor[CarriersResponse](),
+		"CatalogFeedListResponse":              reflect.TypeFor[CatalogFeedListResponse](),
+		"CatalogFeedLogResponse":               reflect.TypeFor[CatalogFeedLogResponse](),
+		"CatalogFeedResponse":                  reflect.TypeFor[CatalogFeedResponse](),
+		"CatalogListResponse":                  reflect.TypeFor[CatalogListResponse](),
+		"CatalogOverviewResponse":              reflect.TypeFor[CatalogOverviewResponse](),
+		"CatalogProductDeleteResponse":         reflect.TypeFor[CatalogProductDeleteResponse](),
+		"CatalogProductFileResponse":           reflect.TypeFor[CatalogProductFileResponse](),
+		"CatalogProductGetResponse":            reflect.TypeFor[CatalogProductGetResponse](),
+		"CatalogProductLogResponse":            reflect.TypeFor[CatalogProductLogResponse](),
+		"CatalogProductUploadResponse":         reflect.TypeFor[CatalogProductUploadResponse](),
+		"CatalogResponse":                      reflect.TypeFor[CatalogResponse](),
+		"CommentDeleteResponse":                reflect.TypeFor[CommentDeleteResponse](),
+		"CommentListResponse":                  reflect.TypeFor[CommentListResponse](),
+		"CommentPostResponse":                  reflect.TypeFor[CommentPostResponse](),
+		"CommentReferenceResponse":             reflect.TypeFor[CommentReferenceResponse](),
+		"CommentStatusUpdateResponse":          reflect.TypeFor[CommentStatusUpdateResponse](),
+		"CommentTaskCheckResponse":             reflect.TypeFor[CommentTaskCheckResponse](),
+		"CommentTaskResponse":                  reflect.TypeFor[CommentTaskResponse](),
+		"ContextualTagResponse":                reflect.TypeFor[ContextualTagResponse](),
+		"CreateAdvertiserResponse":             reflect.TypeFor[CreateAdvertiserResponse](),
+		"CreativeAssetDeleteResponse":          reflect.TypeFor[CreativeAssetDeleteResponse](),
+		"CreativeAssetShareResponse":           reflect.TypeFor[CreativeAssetShareResponse](),
+		"CreativeGetResponse":                  reflect.TypeFor[CreativeGetResponse](),
+		"CreativeImageEditResponse":            reflect.TypeFor[CreativeImageEditResponse](),
+		"CreativePortfolioListResponse":        reflect.TypeFor[CreativePortfolioListResponse](),
+		"CreativePortfolioResponse":            reflect.TypeFor[CreativePortfolioResponse](),
+		"CreativeShareableLinkCreateResponse":  reflect.TypeFor[CreativeShareableLinkCreateResponse](),
+		"CreativeSmartTextGenerateResponse":    reflect.TypeFor[CreativeSmartTextGenerateResponse](),
+		"CreativeUpdateResponse":               reflect.TypeFor[CreativeUpdateResponse](),
+		"CurrenciesResponse":                   reflect.TypeFor[CurrenciesResponse](),
+		"CustomAudienceApplyLogResponse":       reflect.TypeFor[CustomAudienceApplyLogResponse](),
+		"CustomAudienceApplyResponse":          reflect.TypeFor[CustomAudienceApplyResponse](),
+		"CustomAudienceCreateResponse":         reflect.TypeFor[CustomAudienceCreateResponse](),
+		"CustomAudienceDeleteResponse":         reflect.TypeFor[CustomAudienceDeleteResponse](),
+		"CustomAudienceFileUploadResponse":     reflect.TypeFor[CustomAudienceFileUploadResponse](),
+		"CustomAudienceGetResponse":            reflect.TypeFor[CustomAudienceGetResponse](),
+		"CustomAudienceListResponse":           reflect.TypeFor[CustomAudienceListResponse](),
+		"CustomAudienceResponse":               reflect.TypeFor[CustomAudienceResponse](),
+		"CustomAudienceShareCancelResponse":    reflect.TypeFor[CustomAudienceShareCancelResponse](),
+		"CustomAudienceShareLogResponse":       reflect.TypeFor[CustomAudienceShareLogResponse](),
+		"CustomAudienceShareResponse":          reflect.TypeFor[CustomAudienceShareResponse](),
+		"CustomAudienceUpdateResponse":         reflect.TypeFor[CustomAudienceUpdateResponse](),
+		"DeviceModelsResponse":                 reflect.TypeFor[DeviceModelsResponse](),
+		"GetAdvertiserBalanceResponse":         reflect.TypeFor[GetAdvertiserBalanceResponse](),
+		"GetAdvertiserFundResponse":            reflect.TypeFor[GetAdvertiserFundResponse](),
+		"GetAdvertisersResponse":               reflect.TypeFor[GetAdvertisersResponse](),
+		"HashtagRecommendResponse":             reflect.TypeFor[HashtagRecommendResponse](),
+		"IdentityListResponse":                 reflect.TypeFor[IdentityListResponse](),
+		"IdentityResponse":                     reflect.TypeFor[IdentityResponse](),
+		"IdentityVideoInfoResponse":            reflect.TypeFor[IdentityVideoInfoResponse](),
+		"ImageUploadResponse":                  reflect.TypeFor[ImageUploadResponse](),
+		"InstantPageListResponse":              reflect.TypeFor[InstantPageListResponse](),
+		"InstantPagePreviewResponse":           reflect.TypeFor[InstantPagePreviewResponse](),
+		"InterestCategoriesResponse":           reflect.TypeFor[InterestCategoriesResponse](),
+		"InterestKeywordResponse":              reflect.TypeFor[InterestKeywordResponse](),
+		"LanguagesResponse":                    reflect.TypeFor[LanguagesResponse](),
+		"LeadFormListResponse":                 reflect.TypeFor[LeadFormListResponse](),
+		"LeadFormResponse":                     reflect.TypeFor[LeadFormResponse](),
+		"LeadTaskResponse":                     reflect.TypeFor[LeadTaskResponse](),
+		"LookalikeAudienceCreateResponse":      reflect.TypeFor[LookalikeAudienceCreateResponse](),
+		"OSVersionResponse":                    reflect.TypeFor[OSVersionResponse](),
+		"OptimizerRuleBatchBindResponse":       reflect.TypeFor[OptimizerRuleBatchBindResponse](),
+		"OptimizerRuleListResponse":            reflect.TypeFor[OptimizerRuleListResponse](),
+		"OptimizerRuleResponse":                reflect.TypeFor[OptimizerRuleResponse](),
+		"OptimizerRuleResultListResponse":      reflect.TypeFor[OptimizerRuleResultListResponse](),
+		"OptimizerRuleResultResponse":          reflect.TypeFor[OptimizerRuleResultResponse](),
+		"PhoneRegionCodeResponse":              reflect.TypeFor[PhoneRegionCodeResponse](),
+		"PixelEventListResponse":               reflect.TypeFor[PixelEventListResponse](),
+		"PixelEventResponse":                   reflect.TypeFor[PixelEventResponse](),
+		"PixelListResponse":                    reflect.TypeFor[PixelListResponse](),
+		"PixelResponse":                        reflect.TypeFor[PixelResponse](),
+		"PostAuthorizationResponse":            reflect.TypeFor[PostAuthorizationResponse](),
+		"RegionsResponse":                      reflect.TypeFor[RegionsResponse](),
+		"ReportIntegratedResponse":             reflect.TypeFor[ReportIntegratedResponse](),
+		"ReportTaskCancelResponse":             reflect.TypeFor[ReportTaskCancelResponse](),
+		"ReportTaskCheckResponse":              reflect.TypeFor[ReportTaskCheckResponse](),
+		"ReportTaskResponse":                   reflect.TypeFor[ReportTaskResponse](),
+		"ReportingResponse":                    reflect.TypeFor[ReportingResponse](),
+		"SavedAudienceListResponse":            reflect.TypeFor[SavedAudienceListResponse](),
+		"SavedAudienceResponse":                reflect.TypeFor[SavedAudienceResponse](),
+		"SmartPlusCampaignListResponse":        reflect.TypeFor[SmartPlusCampaignListResponse](),
+		"SmartPlusCampaignResponse":            reflect.TypeFor[SmartPlusCampaignResponse](),
+		"SmartPlusStatusUpdateResponse":        reflect.TypeFor[SmartPlusStatusUpdateResponse](),
+		"SplitTestCreateResponse":              reflect.TypeFor[SplitTestCreateResponse](),
+		"SplitTestEndResponse":                 reflect.TypeFor[SplitTestEndResponse](),
+		"SplitTestResponse":                    reflect.TypeFor[SplitTestResponse](),
+		"SplitTestResultResponse":              reflect.TypeFor[SplitTestResultResponse](),
+		"TargetingInfoResponse":                reflect.TypeFor[TargetingInfoResponse](),
+		"TargetingListResponse":                reflect.TypeFor[TargetingListResponse](),
+		"TargetingSearchResponse":              reflect.TypeFor[TargetingSearchResponse](),
+		"TimezoneResponse":                     reflect.TypeFor[TimezoneResponse](),
+		"TokenResponse":                        reflect.TypeFor[TokenResponse](),
+		"TokenValidationResponse":              reflect.TypeFor[TokenValidationResponse](),
+		"TrackEventsResponse":                  reflect.TypeFor[TrackEventsResponse](),
+		"TransferAdvertiserResponse":           reflect.TypeFor[TransferAdvertiserResponse](),
+		"TravelIntentSubmitResponse":           reflect.TypeFor[TravelIntentSubmitResponse](),
+		"URLValidateResponse":                  reflect.TypeFor[URLValidateResponse](),
+		"UpdateAdvertiserResponse":             reflect.TypeFor[UpdateAdvertiserResponse](),
+		"UploadSessionResponse":                reflect.TypeFor[UploadSessionResponse](),
+		"VideoCaptionGenerateResponse":         reflect.TypeFor[VideoCaptionGenerateResponse](),
+		"VideoCaptionGetResponse":              reflect.TypeFor[VideoCaptionGetResponse](),
+		"VideoUploadResponse":                  reflect.TypeFor[VideoUploadResponse](),
+	}
+}
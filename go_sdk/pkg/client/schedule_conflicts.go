@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Schedule conflict reasons
+const (
+	ConflictInvalidSchedule   = "INVALID_SCHEDULE"
+	ConflictAlreadyEnded      = "ALREADY_ENDED"
+	ConflictOutsideFlight     = "OUTSIDE_CAMPAIGN_FLIGHT"
+	ConflictNoDaypartingSlots = "NO_DAYPARTING_SLOTS"
+)
+
+// daypartingSlots is the length of a dayparting string: 48 half hours for
+// each day from Monday to Sunday
+const daypartingSlots = 7 * 48
+
+// CampaignFlight is the period a campaign is planned to run. A zero Start or
+// End leaves that side unbounded.
+type CampaignFlight struct {
+	CampaignID string
+	Start      time.Time
+	End        time.Time
+}
+
+// ScheduleConflict flags an ad group whose schedule prevents it from delivering
+type ScheduleConflict struct {
+	AdGroupID   string
+	AdGroupName string
+	Reason      string
+	Message     string
+}
+
+// CheckScheduleConflicts loads the advertiser timezone and the campaign's ad
+// groups and reports the ad groups that will never deliver within the flight
+func (c *Client) CheckScheduleConflicts(ctx context.Context, advertiserID string, flight CampaignFlight) ([]ScheduleConflict, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if flight.CampaignID == "" {
+		return nil, fmt.Errorf("campaign_id is required")
+	}
+
+	advertiser, err := c.Account().GetAdvertiserInfo(ctx, advertiserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load advertiser timezone: %w", err)
+	}
+
+	var adGroups []AdGroupInfo
+	for page := 1; ; page++ {
+		resp, err := c.AdGroup().Get(ctx, &AdGroupGetRequest{
+			AdvertiserID: advertiserID,
+			Filtering:    &AdGroupFiltering{CampaignIDs: []string{flight.CampaignID}},
+			Page:         page,
+			PageSize:     100,
+		})
+		if err != nil {
+			return nil, err
+		}
+		adGroups = append(adGroups, resp.Data...)
+		if page >= resp.PageInfo.TotalPage {
+			break
+		}
+	}
+
+	return DetectScheduleConflicts(flight, adGroups, advertiser.Timezone, time.Now())
+}
+
+// DetectScheduleConflicts checks ad group schedules and dayparting against a
+// campaign flight. Schedule times are interpreted in the advertiser timezone.
+func DetectScheduleConflicts(flight CampaignFlight, adGroups []AdGroupInfo, timezone string, now time.Time) ([]ScheduleConflict, error) {
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, fmt.Errorf("invalid advertiser timezone %q: %w", timezone, err)
+		}
+	}
+
+	var conflicts []ScheduleConflict
+	for _, adGroup := range adGroups {
+		reason, message := scheduleConflict(flight, adGroup, loc, now)
+		if reason != "" {
+			conflicts = append(conflicts, ScheduleConflict{
+				AdGroupID:   adGroup.AdGroupID,
+				AdGroupName: adGroup.AdGroupName,
+				Reason:      reason,
+				Message:     message,
+			})
+		}
+	}
+	return conflicts, nil
+}
+
+// scheduleConflict returns the reason an ad group cannot deliver, if any
+func scheduleConflict(flight CampaignFlight, adGroup AdGroupInfo, loc *time.Location, now time.Time) (string, string) {
+	start, err := parseScheduleTime(adGroup.ScheduleStartTime, loc)
+	if err != nil {
+		return ConflictInvalidSchedule, err.Error()
+	}
+	end, err := parseScheduleTime(adGroup.ScheduleEndTime, loc)
+	if err != nil {
+		return ConflictInvalidSchedule, err.Error()
+	}
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		return ConflictInvalidSchedule, "schedule end time is not after its start time"
+	}
+	if !end.IsZero() && !end.After(now) {
+		return ConflictAlreadyEnded, fmt.Sprintf("schedule ended at %s", end.Format("2006-01-02 15:04"))
+	}
+
+	// Effective delivery window: ad group schedule within the flight, from now on
+	windowStart, windowEnd := latestTime(start, flight.Start, now), earliestTime(end, flight.End)
+	if !windowEnd.IsZero() && !windowEnd.After(windowStart) {
+		return ConflictOutsideFlight, "schedule does not overlap the campaign flight"
+	}
+
+	if adGroup.Dayparting != "" && !daypartingDelivers(adGroup.Dayparting, windowStart.In(loc), windowEnd) {
+		return ConflictNoDaypartingSlots, "no dayparting slot falls within the delivery window"
+	}
+	return "", ""
+}
+
+// daypartingDelivers reports whether any active dayparting slot falls in the window
+func daypartingDelivers(dayparting string, start, end time.Time) bool {
+	if !strings.Contains(dayparting, "1") {
+		return false
+	}
+	// Strings of an unexpected length cannot be mapped to slots
+	if len(dayparting) != daypartingSlots {
+		return true
+	}
+
+	// A window of a week or more covers every slot
+	if end.IsZero() || end.Sub(start) >= 7*24*time.Hour {
+		return true
+	}
+
+	slot := start.Truncate(30 * time.Minute)
+	for ; slot.Before(end); slot = slot.Add(30 * time.Minute) {
+		day := (int(slot.Weekday()) + 6) % 7 // Monday first
+		index := day*48 + slot.Hour()*2 + slot.Minute()/30
+		if dayparting[index] == '1' {
+			return true
+		}
+	}
+	return false
+}
+
+// parseScheduleTime parses a schedule time in the advertiser timezone
+func parseScheduleTime(value string, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid schedule time %q", value)
+	}
+	return t, nil
+}
+
+// latestTime returns the latest non-zero time
+func latestTime(times ...time.Time) time.Time {
+	var result time.Time
+	for _, t := range times {
+		if t.After(result) {
+			result = t
+		}
+	}
+	return result
+}
+
+// earliestTime returns the earliest non-zero time, or zero when all are zero
+func earliestTime(times ...time.Time) time.Time {
+	var result time.Time
+	for _, t := range times {
+		if !t.IsZero() && (result.IsZero() || t.Before(result)) {
+			result = t
+		}
+	}
+	return result
+}
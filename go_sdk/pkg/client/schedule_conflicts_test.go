@@ -0,0 +1,47 @@
+package client
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDetectScheduleConflicts(t *testing.T) {
+	now := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC) // Monday
+	flight := CampaignFlight{
+		CampaignID: "1",
+		Start:      time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		End:        time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+	weekendOnly := strings.Repeat("0", 5*48) + strings.Repeat("1", 2*48)
+
+	adGroups := []AdGroupInfo{
+		{AdGroupID: "ok", AdGroupSchedule: AdGroupSchedule{ScheduleStartTime: "2024-03-01 00:00:00", ScheduleEndTime: "2024-03-20 00:00:00"}},
+		{AdGroupID: "ended", AdGroupSchedule: AdGroupSchedule{ScheduleStartTime: "2024-02-01 00:00:00", ScheduleEndTime: "2024-03-02 00:00:00"}},
+		{AdGroupID: "after", AdGroupSchedule: AdGroupSchedule{ScheduleStartTime: "2024-04-05 00:00:00"}},
+		{AdGroupID: "weekdays", AdGroupSchedule: AdGroupSchedule{ScheduleStartTime: "2024-03-04 00:00:00", ScheduleEndTime: "2024-03-08 00:00:00", Dayparting: weekendOnly}},
+	}
+
+	conflicts, err := DetectScheduleConflicts(flight, adGroups, "UTC", now)
+	if err != nil {
+		t.Fatalf("DetectScheduleConflicts() error = %v", err)
+	}
+
+	got := make(map[string]string)
+	for _, conflict := range conflicts {
+		got[conflict.AdGroupID] = conflict.Reason
+	}
+	want := map[string]string{
+		"ended":    ConflictAlreadyEnded,
+		"after":    ConflictOutsideFlight,
+		"weekdays": ConflictNoDaypartingSlots,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d conflicts, got %+v", len(want), conflicts)
+	}
+	for id, reason := range want {
+		if got[id] != reason {
+			t.Errorf("Ad group %s: expected %s, got %q", id, reason, got[id])
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Negative keyword suggestion reasons
+const (
+	NegativeKeywordNoConversions = "NO_CONVERSIONS"
+	NegativeKeywordHighCost      = "HIGH_COST_PER_CONVERSION"
+	NegativeKeywordLowCTR        = "LOW_CTR"
+)
+
+// Defaults for NegativeKeywordRules
+const (
+	DefaultNegativeKeywordMinClicks      = 20
+	DefaultNegativeKeywordMinImpressions = 1000
+)
+
+// SearchTermReportRequest represents the request for a search term report
+type SearchTermReportRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+
+	// CampaignIDs and AdGroupIDs limit the report; all ad groups with search
+	// placement are reported when both are empty
+	CampaignIDs []string `json:"campaign_ids,omitempty"`
+	AdGroupIDs  []string `json:"adgroup_ids,omitempty"`
+
+	StartDate string `json:"start_date"` // YYYY-MM-DD
+	EndDate   string `json:"end_date"`
+}
+
+// SearchTermStats is the performance of one search query in an ad group
+type SearchTermStats struct {
+	SearchTerm  string  `json:"search_term"`
+	AdGroupID   string  `json:"adgroup_id"`
+	Impressions float64 `json:"impressions"`
+	Clicks      float64 `json:"clicks"`
+	Conversions float64 `json:"conversions"`
+	Spend       float64 `json:"spend"`
+}
+
+// CTR returns clicks per impression
+func (s SearchTermStats) CTR() float64 {
+	if s.Impressions == 0 {
+		return 0
+	}
+	return s.Clicks / s.Impressions
+}
+
+// CostPerConversion returns spend per conversion, or zero without conversions
+func (s SearchTermStats) CostPerConversion() float64 {
+	if s.Conversions == 0 {
+		return 0
+	}
+	return s.Spend / s.Conversions
+}
+
+// SearchTermReport holds the search queries that triggered an advertiser's
+// ads over a date range
+type SearchTermReport struct {
+	AdvertiserID string            `json:"advertiser_id"`
+	StartDate    string            `json:"start_date"`
+	EndDate      string            `json:"end_date"`
+	Terms        []SearchTermStats `json:"terms"`
+}
+
+// GetSearchTermReport retrieves query level performance of ad groups with
+// search placement, reading every page of the report
+func (r *reportingService) GetSearchTermReport(ctx context.Context, req *SearchTermReportRequest) (*SearchTermReport, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var filters []ReportingFilter
+	for _, filter := range []struct {
+		field string
+		ids   []string
+	}{{"campaign_ids", req.CampaignIDs}, {"adgroup_ids", req.AdGroupIDs}} {
+		if len(filter.ids) == 0 {
+			continue
+		}
+		ids, err := json.Marshal(filter.ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %w", filter.field, err)
+		}
+		filters = append(filters, ReportingFilter{FieldName: filter.field, FilterType: "IN", FilterValue: string(ids)})
+	}
+
+	report := &SearchTermReport{AdvertiserID: req.AdvertiserID, StartDate: req.StartDate, EndDate: req.EndDate}
+	for page := 1; ; page++ {
+		resp, err := r.GetIntegratedReport(ctx, &ReportingRequest{
+			AdvertiserID: req.AdvertiserID,
+			ReportType:   models.ReportTypeBasic,
+			DataLevel:    models.DataLevelAdGroup,
+			Dimensions:   []models.Dimension{models.DimensionAdGroupID, models.DimensionSearchTerms},
+			Metrics:      []models.Metric{models.MetricSpend, models.MetricImpressions, models.MetricClicks, models.MetricConversion},
+			Filters:      filters,
+			StartDate:    req.StartDate,
+			EndDate:      req.EndDate,
+			Page:         page,
+			PageSize:     1000,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get search terms: %w", err)
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+		for _, row := range resp.Data.List {
+			report.Terms = append(report.Terms, SearchTermStats{
+				SearchTerm:  row.Dimensions.SearchTerms,
+				AdGroupID:   row.Dimensions.AdGroupID,
+				Impressions: float64(row.Metrics.Impressions),
+				Clicks:      float64(row.Metrics.Clicks),
+				Conversions: float64(row.Metrics.Conversion),
+				Spend:       float64(row.Metrics.Spend),
+			})
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			return report, nil
+		}
+	}
+}
+
+// NegativeKeywordRules decide which search terms SuggestNegativeKeywords
+// flags. A term is judged on its totals across ad groups.
+type NegativeKeywordRules struct {
+	// MinClicks is the clicks a term needs before its conversions are judged
+	// (defaults to DefaultNegativeKeywordMinClicks)
+	MinClicks float64
+
+	// MaxSpendWithoutConversion flags terms that spent at least this much
+	// without a conversion; zero flags any term over MinClicks without one
+	MaxSpendWithoutConversion float64
+
+	// MaxCostPerConversion flags converting terms that cost more than this
+	// per conversion; zero disables the rule
+	MaxCostPerConversion float64
+
+	// MinCTR flags terms with at least MinImpressions whose click-through
+	// rate is below it, e.g. 0.005; zero disables the rule
+	MinCTR float64
+
+	// MinImpressions is the impressions a term needs before its CTR is
+	// judged (defaults to DefaultNegativeKeywordMinImpressions)
+	MinImpressions float64
+}
+
+// NegativeKeywordSuggestion is a search term worth excluding from the ad
+// groups it triggered
+type NegativeKeywordSuggestion struct {
+	Keyword     string   `json:"keyword"`
+	AdGroupIDs  []string `json:"adgroup_ids"`
+	Reason      string   `json:"reason"`
+	Message     string   `json:"message"`
+	Impressions float64  `json:"impressions"`
+	Clicks      float64  `json:"clicks"`
+	Conversions float64  `json:"conversions"`
+	Spend       float64  `json:"spend"`
+}
+
+// SuggestNegativeKeywords returns the poorly performing search terms of the
+// report, most spend first. Terms are compared case-insensitively.
+func (r *SearchTermReport) SuggestNegativeKeywords(rules NegativeKeywordRules) []NegativeKeywordSuggestion {
+	if rules.MinClicks <= 0 {
+		rules.MinClicks = DefaultNegativeKeywordMinClicks
+	}
+	if rules.MinImpressions <= 0 {
+		rules.MinImpressions = DefaultNegativeKeywordMinImpressions
+	}
+
+	terms := make(map[string]*NegativeKeywordSuggestion)
+	var keywords []string
+	for _, stats := range r.Terms {
+		keyword := strings.Join(strings.Fields(strings.ToLower(stats.SearchTerm)), " ")
+		if keyword == "" {
+			continue
+		}
+		term, ok := terms[keyword]
+		if !ok {
+			term = &NegativeKeywordSuggestion{Keyword: keyword}
+			terms[keyword] = term
+			keywords = append(keywords, keyword)
+		}
+		if stats.AdGroupID != "" && !containsString(term.AdGroupIDs, stats.AdGroupID) {
+			term.AdGroupIDs = append(term.AdGroupIDs, stats.AdGroupID)
+		}
+		term.Impressions += stats.Impressions
+		term.Clicks += stats.Clicks
+		term.Conversions += stats.Conversions
+		term.Spend += stats.Spend
+	}
+
+	var suggestions []NegativeKeywordSuggestion
+	for _, keyword := range keywords {
+		term := terms[keyword]
+		stats := SearchTermStats{Impressions: term.Impressions, Clicks: term.Clicks, Conversions: term.Conversions, Spend: term.Spend}
+		switch {
+		case term.Conversions == 0 && term.Clicks >= rules.MinClicks && term.Spend >= rules.MaxSpendWithoutConversion:
+			term.Reason = NegativeKeywordNoConversions
+			term.Message = fmt.Sprintf("%.0f clicks and %.2f spent without a conversion", term.Clicks, term.Spend)
+		case rules.MaxCostPerConversion > 0 && term.Conversions > 0 && stats.CostPerConversion() > rules.MaxCostPerConversion:
+			term.Reason = NegativeKeywordHighCost
+			term.Message = fmt.Sprintf("%.2f per conversion, above %.2f", stats.CostPerConversion(), rules.MaxCostPerConversion)
+		case rules.MinCTR > 0 && term.Impressions >= rules.MinImpressions && stats.CTR() < rules.MinCTR:
+			term.Reason = NegativeKeywordLowCTR
+			term.Message = fmt.Sprintf("click-through rate %.2f%% over %.0f impressions, below %.2f%%", stats.CTR()*100, term.Impressions, rules.MinCTR*100)
+		default:
+			continue
+		}
+		suggestions = append(suggestions, *term)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Spend > suggestions[j].Spend
+	})
+	return suggestions
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSearchTermReport(t *testing.T) {
+	var dimensions, filtering string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		dimensions = r.URL.Query().Get("dimensions")
+		filtering = r.URL.Query().Get("filtering")
+		if r.URL.Query().Get("page") == "1" {
+			w.Write([]byte(`{"code":0,"data":{"page_info":{"page":1,"total_page":2},"list":[
+				{"dimensions":{"adgroup_id":"g1","search_terms":"running shoes"},"metrics":{"spend":"120.00","impressions":"4000","clicks":"80","conversion":"6"}},
+				{"dimensions":{"adgroup_id":"g1","search_terms":"Free  Shoes"},"metrics":{"spend":"30.00","impressions":"1500","clicks":"15","conversion":"0"}}]}}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"data":{"page_info":{"page":2,"total_page":2},"list":[
+			{"dimensions":{"adgroup_id":"g2","search_terms":"free shoes"},"metrics":{"spend":"25.00","impressions":"900","clicks":"10","conversion":"0"}},
+			{"dimensions":{"adgroup_id":"g2","search_terms":"shoe repair"},"metrics":{"spend":"90.00","impressions":"3000","clicks":"40","conversion":"1"}},
+			{"dimensions":{"adgroup_id":"g2","search_terms":"shoes"},"metrics":{"spend":"5.00","impressions":"20000","clicks":"20","conversion":"1"}}]}}`))
+	})
+
+	client := newTestClient(t, server)
+
+	report, err := client.Reporting().GetSearchTermReport(context.Background(), &SearchTermReportRequest{
+		AdvertiserID: "123",
+		CampaignIDs:  []string{"c1"},
+		StartDate:    "2026-06-01",
+		EndDate:      "2026-06-30",
+	})
+	if err != nil {
+		t.Fatalf("GetSearchTermReport() error = %v", err)
+	}
+	if !strings.Contains(dimensions, "search_terms") || !strings.Contains(filtering, "campaign_ids") {
+		t.Errorf("dimensions = %s, filtering = %s", dimensions, filtering)
+	}
+	if len(report.Terms) != 5 || report.Terms[0].SearchTerm != "running shoes" || report.Terms[0].CTR() != 0.02 || report.Terms[0].CostPerConversion() != 20 {
+		t.Fatalf("terms = %+v", report.Terms)
+	}
+
+	suggestions := report.SuggestNegativeKeywords(NegativeKeywordRules{MaxCostPerConversion: 50, MinCTR: 0.005})
+	if len(suggestions) != 3 {
+		t.Fatalf("suggestions = %+v", suggestions)
+	}
+	if s := suggestions[0]; s.Keyword != "shoe repair" || s.Reason != NegativeKeywordHighCost {
+		t.Errorf("suggestions[0] = %+v", s)
+	}
+	if s := suggestions[1]; s.Keyword != "free shoes" || s.Reason != NegativeKeywordNoConversions || s.Clicks != 25 || !reflect.DeepEqual(s.AdGroupIDs, []string{"g1", "g2"}) {
+		t.Errorf("suggestions[1] = %+v", s)
+	}
+	if s := suggestions[2]; s.Keyword != "shoes" || s.Reason != NegativeKeywordLowCTR {
+		t.Errorf("suggestions[2] = %+v", s)
+	}
+
+	if len(report.SuggestNegativeKeywords(NegativeKeywordRules{MaxSpendWithoutConversion: 100})) != 0 {
+		t.Error("no term spent 100 without converting")
+	}
+	if _, err := client.Reporting().GetSearchTermReport(context.Background(), &SearchTermReportRequest{AdvertiserID: "123"}); err == nil {
+		t.Error("GetSearchTermReport() without dates should fail")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
 )
 
 // accountService implements the AccountService interface
@@ -14,7 +16,7 @@ type accountService struct {
 
 // GetAdvertisers retrieves a list of advertisers
 func (a *accountService) GetAdvertisers(ctx context.Context, req *GetAdvertisersRequest) (*GetAdvertisersResponse, error) {
-	endpoint := "/open_api/v1.3/advertiser/info/"
+	endpoint := "/advertiser/info/"
 
 	params := map[string]interface{}{
 		"advertiser_ids": fmt.Sprintf("[%s]", strings.Join(req.AdvertiserIDs, ",")),
@@ -32,7 +34,10 @@ func (a *accountService) GetAdvertisers(ctx context.Context, req *GetAdvertisers
 		params["page_size"] = req.PageSize
 	}
 
-	url := a.client.BuildURL(endpoint, params)
+	url, err := a.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := a.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -68,7 +73,7 @@ func (a *accountService) GetAdvertiserInfo(ctx context.Context, advertiserID str
 
 // UpdateAdvertiser updates advertiser information
 func (a *accountService) UpdateAdvertiser(ctx context.Context, req *UpdateAdvertiserRequest) (*UpdateAdvertiserResponse, error) {
-	endpoint := "/open_api/v1.3/advertiser/update/"
+	endpoint := "/advertiser/update/"
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -90,7 +95,7 @@ func (a *accountService) UpdateAdvertiser(ctx context.Context, req *UpdateAdvert
 
 // CreateAdvertiser creates a new advertiser account
 func (a *accountService) CreateAdvertiser(ctx context.Context, req *CreateAdvertiserRequest) (*CreateAdvertiserResponse, error) {
-	endpoint := "/open_api/v1.3/advertiser/create/"
+	endpoint := "/advertiser/create/"
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -112,13 +117,16 @@ func (a *accountService) CreateAdvertiser(ctx context.Context, req *CreateAdvert
 
 // GetAdvertiserBalance retrieves advertiser account balance
 func (a *accountService) GetAdvertiserBalance(ctx context.Context, req *GetAdvertiserBalanceRequest) (*GetAdvertiserBalanceResponse, error) {
-	endpoint := "/open_api/v1.3/advertiser/balance/get/"
+	endpoint := "/advertiser/balance/get/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
 	}
 
-	url := a.client.BuildURL(endpoint, params)
+	url, err := a.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := a.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -135,7 +143,7 @@ func (a *accountService) GetAdvertiserBalance(ctx context.Context, req *GetAdver
 
 // GetAdvertiserFund retrieves advertiser fund information
 func (a *accountService) GetAdvertiserFund(ctx context.Context, req *GetAdvertiserFundRequest) (*GetAdvertiserFundResponse, error) {
-	endpoint := "/open_api/v1.3/advertiser/fund/get/"
+	endpoint := "/advertiser/fund/get/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
@@ -145,7 +153,18 @@ func (a *accountService) GetAdvertiserFund(ctx context.Context, req *GetAdvertis
 		params["fund_types"] = fmt.Sprintf("[%s]", strings.Join(req.FundTypes, ","))
 	}
 
-	url := a.client.BuildURL(endpoint, params)
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+
+	if req.PageSize > 0 {
+		params["page_size"] = req.PageSize
+	}
+
+	url, err := a.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := a.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -160,6 +179,182 @@ func (a *accountService) GetAdvertiserFund(ctx context.Context, req *GetAdvertis
 	return &response, nil
 }
 
+// GetAdvertiserFundTransactions retrieves a page of fund grants, consumptions,
+// expirations and adjustments
+func (a *accountService) GetAdvertiserFundTransactions(ctx context.Context, req *AdvertiserFundTransactionGetRequest) (*AdvertiserFundTransactionGetResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/advertiser/fund/transaction/get/"
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+	}
+
+	if len(req.FundTypes) > 0 {
+		params["fund_types"] = fmt.Sprintf("[%s]", strings.Join(req.FundTypes, ","))
+	}
+
+	if len(req.TransactionTypes) > 0 {
+		params["transaction_types"] = fmt.Sprintf("[%s]", strings.Join(req.TransactionTypes, ","))
+	}
+
+	if req.StartDate != "" {
+		params["start_date"] = req.StartDate
+		params["end_date"] = req.EndDate
+	}
+
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+
+	if req.PageSize > 0 {
+		params["page_size"] = req.PageSize
+	}
+
+	url, err := a.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get advertiser fund transactions: %w", err)
+	}
+
+	var response AdvertiserFundTransactionGetResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetAllAdvertiserFundTransactions pages through every fund transaction
+// matching the request, ignoring its Page
+func (a *accountService) GetAllAdvertiserFundTransactions(ctx context.Context, req *AdvertiserFundTransactionGetRequest) ([]AdvertiserFundTransaction, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	pageReq := *req
+	if pageReq.PageSize <= 0 {
+		pageReq.PageSize = 100
+	}
+
+	var transactions []AdvertiserFundTransaction
+	for page := 1; ; page++ {
+		pageReq.Page = page
+		resp, err := a.GetAdvertiserFundTransactions(ctx, &pageReq)
+		if err != nil {
+			return transactions, err
+		}
+		if resp.Code != 0 {
+			return transactions, fmt.Errorf("API error: %s", resp.Message)
+		}
+
+		transactions = append(transactions, resp.Data...)
+		if len(resp.Data) < pageReq.PageSize || (resp.PageInfo.TotalPage > 0 && page >= resp.PageInfo.TotalPage) {
+			return transactions, nil
+		}
+	}
+}
+
+// GetAdvertiserUsers lists the users with access to an advertiser and their roles
+func (a *accountService) GetAdvertiserUsers(ctx context.Context, req *AdvertiserUserGetRequest) (*AdvertiserUserGetResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	endpoint := "/advertiser/user/get/"
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+	}
+
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+
+	if req.PageSize > 0 {
+		params["page_size"] = req.PageSize
+	}
+
+	url, err := a.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get advertiser users: %w", err)
+	}
+
+	var response AdvertiserUserGetResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// AddAdvertiserUser invites a user to an advertiser with a role
+func (a *accountService) AddAdvertiserUser(ctx context.Context, req *AdvertiserUserAddRequest) (*AdvertiserUserResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return a.postUser(ctx, "/advertiser/user/add/", req, "add advertiser user")
+}
+
+// UpdateAdvertiserUserRole changes the role of an advertiser user
+func (a *accountService) UpdateAdvertiserUserRole(ctx context.Context, req *AdvertiserUserRoleUpdateRequest) (*AdvertiserUserResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return a.postUser(ctx, "/advertiser/user/role/update/", req, "update advertiser user role")
+}
+
+// RemoveAdvertiserUser revokes a user's access to an advertiser
+func (a *accountService) RemoveAdvertiserUser(ctx context.Context, req *AdvertiserUserRemoveRequest) (*AdvertiserUserResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return a.postUser(ctx, "/advertiser/user/remove/", req, "remove advertiser user")
+}
+
+// postUser sends an advertiser user management request
+func (a *accountService) postUser(ctx context.Context, endpoint string, req interface{}, action string) (*AdvertiserUserResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := a.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	var response AdvertiserUserResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // campaignService implements the CampaignService interface
 type campaignService struct {
 	client *Client
@@ -167,7 +362,7 @@ type campaignService struct {
 
 // Create creates a new campaign
 func (c *campaignService) Create(ctx context.Context, req *CampaignCreateRequest) (*CampaignCreateResponse, error) {
-	endpoint := "/open_api/v1.3/campaign/create/"
+	endpoint := "/campaign/create/"
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -189,7 +384,7 @@ func (c *campaignService) Create(ctx context.Context, req *CampaignCreateRequest
 
 // Get retrieves campaign information
 func (c *campaignService) Get(ctx context.Context, req *CampaignGetRequest) (*CampaignGetResponse, error) {
-	endpoint := "/open_api/v1.3/campaign/get/"
+	endpoint := "/campaign/get/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
@@ -203,6 +398,14 @@ func (c *campaignService) Get(ctx context.Context, req *CampaignGetRequest) (*Ca
 		params["fields"] = strings.Join(req.Fields, ",")
 	}
 
+	if req.Filtering != nil {
+		filtering, err := json.Marshal(req.Filtering)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal filtering: %w", err)
+		}
+		params["filtering"] = string(filtering)
+	}
+
 	if req.Page > 0 {
 		params["page"] = req.Page
 	}
@@ -211,7 +414,10 @@ func (c *campaignService) Get(ctx context.Context, req *CampaignGetRequest) (*Ca
 		params["page_size"] = req.PageSize
 	}
 
-	url := c.client.BuildURL(endpoint, params)
+	url, err := c.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := c.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -228,7 +434,7 @@ func (c *campaignService) Get(ctx context.Context, req *CampaignGetRequest) (*Ca
 
 // Update updates a campaign
 func (c *campaignService) Update(ctx context.Context, req *CampaignUpdateRequest) (*CampaignUpdateResponse, error) {
-	endpoint := "/open_api/v1.3/campaign/update/"
+	endpoint := "/campaign/update/"
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -250,7 +456,7 @@ func (c *campaignService) Update(ctx context.Context, req *CampaignUpdateRequest
 
 // Delete deletes campaigns
 func (c *campaignService) Delete(ctx context.Context, req *CampaignDeleteRequest) (*CampaignDeleteResponse, error) {
-	endpoint := "/open_api/v1.3/campaign/delete/"
+	endpoint := "/campaign/delete/"
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -272,7 +478,20 @@ func (c *campaignService) Delete(ctx context.Context, req *CampaignDeleteRequest
 
 // UpdateStatus updates campaign status
 func (c *campaignService) UpdateStatus(ctx context.Context, req *CampaignStatusUpdateRequest) (*CampaignStatusUpdateResponse, error) {
-	endpoint := "/open_api/v1.3/campaign/status/update/"
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	err := c.client.checkStatusTransitions(ctx, "campaign", req.CampaignIDs, req.Operation, func(ctx context.Context, ids []string) ([]EntityStatus, error) {
+		return c.campaignStatuses(ctx, req.AdvertiserID, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := "/campaign/status/update/"
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -292,6 +511,602 @@ func (c *campaignService) UpdateStatus(ctx context.Context, req *CampaignStatusU
 	return &response, nil
 }
 
+// ListDeleted retrieves campaigns that are in the deleted state
+func (c *campaignService) ListDeleted(ctx context.Context, req *CampaignListDeletedRequest) (*CampaignGetResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	return c.Get(ctx, &CampaignGetRequest{
+		AdvertiserID: req.AdvertiserID,
+		Fields:       req.Fields,
+		Filtering: &CampaignFiltering{
+			SecondaryStatus: string(models.CampaignStatusDelete),
+		},
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+}
+
+// Restore restores deleted campaigns to the disabled state so they do not
+// resume spending until explicitly enabled. The API has no recycle bin
+// endpoint, so this is a status update to DISABLE; campaigns the API no
+// longer allows to be updated are rejected with an *models.APIError.
+func (c *campaignService) Restore(ctx context.Context, req *CampaignRestoreRequest) (*CampaignStatusUpdateResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.CampaignIDs) == 0 {
+		return nil, fmt.Errorf("campaign_ids are required")
+	}
+
+	resp, err := c.UpdateStatus(ctx, &CampaignStatusUpdateRequest{
+		AdvertiserID: req.AdvertiserID,
+		CampaignIDs:  req.CampaignIDs,
+		Operation:    "DISABLE",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+	}
+	return resp, nil
+}
+
+// Copy duplicates campaigns within the advertiser or into another one. The
+// response maps each source ID to the ID of its copy.
+func (c *campaignService) Copy(ctx context.Context, req *CampaignCopyRequest) (*CampaignCopyResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := validateCopyStatus(req.OperationStatus); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/campaign/copy/"
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy campaigns: %w", err)
+	}
+
+	var response CampaignCopyResponse
+	if err := c.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// adGroupService implements the AdGroupService interface
+type adGroupService struct {
+	client *Client
+}
+
+// Create creates a new ad group
+func (a *adGroupService) Create(ctx context.Context, req *AdGroupCreateRequest) (*AdGroupCreateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/adgroup/create/"
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := a.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ad group: %w", err)
+	}
+
+	var response AdGroupCreateResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Get retrieves ad group information
+func (a *adGroupService) Get(ctx context.Context, req *AdGroupGetRequest) (*AdGroupGetResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	endpoint := "/adgroup/get/"
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+	}
+
+	if len(req.Fields) > 0 {
+		fields, err := json.Marshal(req.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal fields: %w", err)
+		}
+		params["fields"] = string(fields)
+	}
+
+	if req.Filtering != nil {
+		filtering, err := json.Marshal(req.Filtering)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal filtering: %w", err)
+		}
+		params["filtering"] = string(filtering)
+	}
+
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+
+	if req.PageSize > 0 {
+		params["page_size"] = req.PageSize
+	}
+
+	url, err := a.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ad groups: %w", err)
+	}
+
+	var response AdGroupGetResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Update updates an ad group
+func (a *adGroupService) Update(ctx context.Context, req *AdGroupUpdateRequest) (*AdGroupUpdateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/adgroup/update/"
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := a.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ad group: %w", err)
+	}
+
+	var response AdGroupUpdateResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Delete deletes ad groups
+func (a *adGroupService) Delete(ctx context.Context, req *AdGroupDeleteRequest) (*AdGroupDeleteResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.AdGroupIDs) == 0 {
+		return nil, fmt.Errorf("adgroup_ids are required")
+	}
+
+	resp, err := a.UpdateStatus(ctx, &AdGroupStatusUpdateRequest{
+		AdvertiserID: req.AdvertiserID,
+		AdGroupIDs:   req.AdGroupIDs,
+		Operation:    "DELETE",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdGroupDeleteResponse{BaseResponse: resp.BaseResponse}
+	response.Data.AdGroupIDs = resp.Data.AdGroupIDs
+	return response, nil
+}
+
+// UpdateStatus enables, disables or deletes ad groups
+func (a *adGroupService) UpdateStatus(ctx context.Context, req *AdGroupStatusUpdateRequest) (*AdGroupStatusUpdateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	err := a.client.checkStatusTransitions(ctx, "ad group", req.AdGroupIDs, req.Operation, func(ctx context.Context, ids []string) ([]EntityStatus, error) {
+		return a.adGroupStatuses(ctx, req.AdvertiserID, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := "/adgroup/status/update/"
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := a.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ad group status: %w", err)
+	}
+
+	var response AdGroupStatusUpdateResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// ListDeleted retrieves ad groups that are in the deleted state
+func (a *adGroupService) ListDeleted(ctx context.Context, req *AdGroupListDeletedRequest) (*AdGroupGetResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	return a.Get(ctx, &AdGroupGetRequest{
+		AdvertiserID: req.AdvertiserID,
+		Filtering: &AdGroupFiltering{
+			SecondaryStatus: string(models.AdGroupStatusDelete),
+		},
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+}
+
+// Restore restores deleted ad groups to the disabled state with a status update,
+// as Campaign Restore does; ad groups that can no longer be updated are
+// rejected with an *models.APIError
+func (a *adGroupService) Restore(ctx context.Context, req *AdGroupRestoreRequest) (*AdGroupStatusUpdateResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.AdGroupIDs) == 0 {
+		return nil, fmt.Errorf("adgroup_ids are required")
+	}
+
+	resp, err := a.UpdateStatus(ctx, &AdGroupStatusUpdateRequest{
+		AdvertiserID: req.AdvertiserID,
+		AdGroupIDs:   req.AdGroupIDs,
+		Operation:    "DISABLE",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+	}
+	return resp, nil
+}
+
+// Copy duplicates ad groups into their own campaigns or into a target
+// campaign. The response maps each source ID to the ID of its copy.
+func (a *adGroupService) Copy(ctx context.Context, req *AdGroupCopyRequest) (*AdGroupCopyResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.TargetAdvertiserID != "" && req.TargetAdvertiserID != req.AdvertiserID && req.TargetCampaignID == "" {
+		return nil, models.NewValidationError("target_campaign_id", "target_campaign_id is required when copying into another advertiser")
+	}
+	if err := validateCopyStatus(req.OperationStatus); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/adgroup/copy/"
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := a.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy ad groups: %w", err)
+	}
+
+	var response AdGroupCopyResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// validateCopyStatus checks the status requested for copied entities
+func validateCopyStatus(status string) error {
+	if status != "" && status != "ENABLE" && status != "DISABLE" {
+		return models.NewValidationError("operation_status", "operation_status must be ENABLE or DISABLE")
+	}
+	return nil
+}
+
+// adService implements the AdService interface
+type adService struct {
+	client *Client
+}
+
+// Create creates ads under an ad group
+func (a *adService) Create(ctx context.Context, req *AdCreateRequest) (*AdCreateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := a.checkPlacementCoverage(ctx, req.AdvertiserID, req.AdGroupID, req.Creatives); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/ad/create/"
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := a.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ads: %w", err)
+	}
+
+	var response AdCreateResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// checkPlacementCoverage verifies placement-customized creatives against the
+// placements selected on their ad group
+func (a *adService) checkPlacementCoverage(ctx context.Context, advertiserID, adGroupID string, creatives []AdCreative) error {
+	customized := false
+	for i := range creatives {
+		if len(creatives[i].PlacementMaterials) > 0 {
+			customized = true
+			break
+		}
+	}
+	if !customized {
+		return nil
+	}
+
+	resp, err := a.client.AdGroup().Get(ctx, &AdGroupGetRequest{
+		AdvertiserID: advertiserID,
+		Fields:       []string{"adgroup_id", "placement_type", "placements"},
+		Filtering:    &AdGroupFiltering{AdGroupIDs: []string{adGroupID}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load ad group placements: %w", err)
+	}
+	if len(resp.Data) == 0 {
+		return fmt.Errorf("ad group %s not found", adGroupID)
+	}
+
+	adGroup := resp.Data[0]
+	if adGroup.PlacementType != models.PlacementTypeNormal {
+		return models.NewValidationError("placement_materials", "placement customization requires an ad group with PLACEMENT_TYPE_NORMAL")
+	}
+	for i := range creatives {
+		if len(creatives[i].PlacementMaterials) == 0 {
+			continue
+		}
+		if err := ValidatePlacementCoverage(adGroup.Placements, &creatives[i]); err != nil {
+			return fmt.Errorf("creative %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Get retrieves ad information
+func (a *adService) Get(ctx context.Context, req *AdGetRequest) (*AdGetResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	endpoint := "/ad/get/"
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+	}
+
+	if len(req.Fields) > 0 {
+		fields, err := json.Marshal(req.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal fields: %w", err)
+		}
+		params["fields"] = string(fields)
+	}
+
+	if req.Filtering != nil {
+		filtering, err := json.Marshal(req.Filtering)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal filtering: %w", err)
+		}
+		params["filtering"] = string(filtering)
+	}
+
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+
+	if req.PageSize > 0 {
+		params["page_size"] = req.PageSize
+	}
+
+	url, err := a.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ads: %w", err)
+	}
+
+	var response AdGetResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Update updates ads within an ad group
+func (a *adService) Update(ctx context.Context, req *AdUpdateRequest) (*AdUpdateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/ad/update/"
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := a.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ads: %w", err)
+	}
+
+	var response AdUpdateResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Delete deletes ads
+func (a *adService) Delete(ctx context.Context, req *AdDeleteRequest) (*AdDeleteResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.AdIDs) == 0 {
+		return nil, fmt.Errorf("ad_ids are required")
+	}
+
+	resp, err := a.UpdateStatus(ctx, &AdStatusUpdateRequest{
+		AdvertiserID: req.AdvertiserID,
+		AdIDs:        req.AdIDs,
+		Operation:    "DELETE",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdDeleteResponse{BaseResponse: resp.BaseResponse}
+	response.Data.AdIDs = resp.Data.AdIDs
+	return response, nil
+}
+
+// UpdateStatus enables, disables or deletes ads
+func (a *adService) UpdateStatus(ctx context.Context, req *AdStatusUpdateRequest) (*AdStatusUpdateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	err := a.client.checkStatusTransitions(ctx, "ad", req.AdIDs, req.Operation, func(ctx context.Context, ids []string) ([]EntityStatus, error) {
+		return a.adStatuses(ctx, req.AdvertiserID, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := "/ad/status/update/"
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := a.client.DoRequest(ctx, "POST", endpoint, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ad status: %w", err)
+	}
+
+	var response AdStatusUpdateResponse
+	if err := a.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// ListDeleted retrieves ads that are in the deleted state
+func (a *adService) ListDeleted(ctx context.Context, req *AdListDeletedRequest) (*AdGetResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	return a.Get(ctx, &AdGetRequest{
+		AdvertiserID: req.AdvertiserID,
+		Filtering: &AdFiltering{
+			SecondaryStatus: string(models.AdStatusDelete),
+		},
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+}
+
+// Restore restores deleted ads to the disabled state with a status update,
+// as Campaign Restore does; ads that can no longer be updated are
+// rejected with an *models.APIError
+func (a *adService) Restore(ctx context.Context, req *AdRestoreRequest) (*AdStatusUpdateResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.AdIDs) == 0 {
+		return nil, fmt.Errorf("ad_ids are required")
+	}
+
+	resp, err := a.UpdateStatus(ctx, &AdStatusUpdateRequest{
+		AdvertiserID: req.AdvertiserID,
+		AdIDs:        req.AdIDs,
+		Operation:    "DISABLE",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+	}
+	return resp, nil
+}
+
 // toolService implements the ToolService interface
 type toolService struct {
 	client *Client
@@ -299,13 +1114,16 @@ type toolService struct {
 
 // GetLanguages retrieves supported languages
 func (t *toolService) GetLanguages(ctx context.Context, advertiserID string) (*LanguagesResponse, error) {
-	endpoint := "/open_api/v1.3/tool/language/"
+	endpoint := "/tool/language/"
 
 	params := map[string]interface{}{
 		"advertiser_id": advertiserID,
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -322,13 +1140,16 @@ func (t *toolService) GetLanguages(ctx context.Context, advertiserID string) (*L
 
 // GetCurrencies retrieves supported currencies
 func (t *toolService) GetCurrencies(ctx context.Context, advertiserID string) (*CurrenciesResponse, error) {
-	endpoint := "/open_api/v1.3/tool/currency/"
+	endpoint := "/tool/currency/"
 
 	params := map[string]interface{}{
 		"advertiser_id": advertiserID,
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -345,13 +1166,16 @@ func (t *toolService) GetCurrencies(ctx context.Context, advertiserID string) (*
 
 // GetRegions retrieves supported regions
 func (t *toolService) GetRegions(ctx context.Context, advertiserID string) (*RegionsResponse, error) {
-	endpoint := "/open_api/v1.3/tool/region/"
+	endpoint := "/tool/region/"
 
 	params := map[string]interface{}{
 		"advertiser_id": advertiserID,
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -368,7 +1192,7 @@ func (t *toolService) GetRegions(ctx context.Context, advertiserID string) (*Reg
 
 // GetInterestCategories retrieves interest categories for targeting
 func (t *toolService) GetInterestCategories(ctx context.Context, req *InterestCategoriesRequest) (*InterestCategoriesResponse, error) {
-	endpoint := "/open_api/v1.3/tool/interest_category/"
+	endpoint := "/tool/interest_category/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
@@ -386,7 +1210,10 @@ func (t *toolService) GetInterestCategories(ctx context.Context, req *InterestCa
 		params["special_industries"] = fmt.Sprintf("[%s]", strings.Join(req.SpecialIndustries, ","))
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -403,7 +1230,7 @@ func (t *toolService) GetInterestCategories(ctx context.Context, req *InterestCa
 
 // GetCarriers retrieves mobile carriers for targeting
 func (t *toolService) GetCarriers(ctx context.Context, req *CarriersRequest) (*CarriersResponse, error) {
-	endpoint := "/open_api/v1.3/tool/carrier/"
+	endpoint := "/tool/carrier/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
@@ -413,7 +1240,10 @@ func (t *toolService) GetCarriers(ctx context.Context, req *CarriersRequest) (*C
 		params["location_ids"] = fmt.Sprintf("[%s]", strings.Join(req.LocationIDs, ","))
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -430,7 +1260,7 @@ func (t *toolService) GetCarriers(ctx context.Context, req *CarriersRequest) (*C
 
 // GetDeviceModels retrieves device models for targeting
 func (t *toolService) GetDeviceModels(ctx context.Context, req *DeviceModelsRequest) (*DeviceModelsResponse, error) {
-	endpoint := "/open_api/v1.3/tool/device_model/"
+	endpoint := "/tool/device_model/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
@@ -440,7 +1270,10 @@ func (t *toolService) GetDeviceModels(ctx context.Context, req *DeviceModelsRequ
 		params["os_type"] = req.OSType
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -457,7 +1290,7 @@ func (t *toolService) GetDeviceModels(ctx context.Context, req *DeviceModelsRequ
 
 // GetTargetingInfo retrieves targeting information by ID
 func (t *toolService) GetTargetingInfo(ctx context.Context, req *TargetingInfoRequest) (*TargetingInfoResponse, error) {
-	endpoint := "/open_api/v1.3/tool/targeting/info/"
+	endpoint := "/tool/targeting/info/"
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -479,7 +1312,7 @@ func (t *toolService) GetTargetingInfo(ctx context.Context, req *TargetingInfoRe
 
 // GetBidRecommendation retrieves bid recommendations
 func (t *toolService) GetBidRecommendation(ctx context.Context, req *BidRecommendRequest) (*BidRecommendResponse, error) {
-	endpoint := "/open_api/v1.3/tool/bid/recommend/"
+	endpoint := "/tool/bid/recommend/"
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -501,7 +1334,7 @@ func (t *toolService) GetBidRecommendation(ctx context.Context, req *BidRecommen
 
 // GetTargetingList retrieves targeting list
 func (t *toolService) GetTargetingList(ctx context.Context, req *TargetingListRequest) (*TargetingListResponse, error) {
-	endpoint := "/open_api/v1.3/tool/targeting/list/"
+	endpoint := "/tool/targeting/list/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
@@ -521,7 +1354,10 @@ func (t *toolService) GetTargetingList(ctx context.Context, req *TargetingListRe
 		params["size"] = req.Size
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -538,7 +1374,7 @@ func (t *toolService) GetTargetingList(ctx context.Context, req *TargetingListRe
 
 // SearchTargeting searches targeting options
 func (t *toolService) SearchTargeting(ctx context.Context, req *TargetingSearchRequest) (*TargetingSearchResponse, error) {
-	endpoint := "/open_api/v1.3/tool/targeting/search/"
+	endpoint := "/tool/targeting/search/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
@@ -553,7 +1389,10 @@ func (t *toolService) SearchTargeting(ctx context.Context, req *TargetingSearchR
 		params["country_code"] = req.CountryCode
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -570,14 +1409,17 @@ func (t *toolService) SearchTargeting(ctx context.Context, req *TargetingSearchR
 
 // GetOSVersions retrieves OS versions for targeting
 func (t *toolService) GetOSVersions(ctx context.Context, req *OSVersionRequest) (*OSVersionResponse, error) {
-	endpoint := "/open_api/v1.3/tool/os_version/"
+	endpoint := "/tool/os_version/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
 		"os_type":       req.OSType,
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -594,13 +1436,16 @@ func (t *toolService) GetOSVersions(ctx context.Context, req *OSVersionRequest)
 
 // GetTimezones retrieves supported timezones
 func (t *toolService) GetTimezones(ctx context.Context, advertiserID string) (*TimezoneResponse, error) {
-	endpoint := "/open_api/v1.3/tool/timezone/"
+	endpoint := "/tool/timezone/"
 
 	params := map[string]interface{}{
 		"advertiser_id": advertiserID,
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -617,7 +1462,7 @@ func (t *toolService) GetTimezones(ctx context.Context, advertiserID string) (*T
 
 // ValidateURL validates a URL
 func (t *toolService) ValidateURL(ctx context.Context, req *URLValidateRequest) (*URLValidateResponse, error) {
-	endpoint := "/open_api/v1.3/tool/url/validate/"
+	endpoint := "/tool/url/validate/"
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -639,7 +1484,7 @@ func (t *toolService) ValidateURL(ctx context.Context, req *URLValidateRequest)
 
 // GetHashtagRecommendations retrieves hashtag recommendations
 func (t *toolService) GetHashtagRecommendations(ctx context.Context, req *HashtagRecommendRequest) (*HashtagRecommendResponse, error) {
-	endpoint := "/open_api/v1.3/tool/hashtag/recommend/"
+	endpoint := "/tool/hashtag/recommend/"
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -661,7 +1506,7 @@ func (t *toolService) GetHashtagRecommendations(ctx context.Context, req *Hashta
 
 // GetInterestKeywords retrieves interest keywords
 func (t *toolService) GetInterestKeywords(ctx context.Context, req *InterestKeywordRequest) (*InterestKeywordResponse, error) {
-	endpoint := "/open_api/v1.3/tool/interest_keyword/get/"
+	endpoint := "/tool/interest_keyword/get/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
@@ -675,7 +1520,10 @@ func (t *toolService) GetInterestKeywords(ctx context.Context, req *InterestKeyw
 		params["country_code"] = req.CountryCode
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -692,7 +1540,7 @@ func (t *toolService) GetInterestKeywords(ctx context.Context, req *InterestKeyw
 
 // GetActionCategories retrieves action categories
 func (t *toolService) GetActionCategories(ctx context.Context, req *ActionCategoryRequest) (*ActionCategoryResponse, error) {
-	endpoint := "/open_api/v1.3/tool/action_category/"
+	endpoint := "/tool/action_category/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
@@ -702,7 +1550,10 @@ func (t *toolService) GetActionCategories(ctx context.Context, req *ActionCatego
 		params["special_industries"] = req.SpecialIndustries
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -719,7 +1570,7 @@ func (t *toolService) GetActionCategories(ctx context.Context, req *ActionCatego
 
 // GetContextualTags retrieves contextual tags
 func (t *toolService) GetContextualTags(ctx context.Context, req *ContextualTagRequest) (*ContextualTagResponse, error) {
-	endpoint := "/open_api/v1.3/tool/contextual_tag/get/"
+	endpoint := "/tool/contextual_tag/get/"
 
 	params := map[string]interface{}{
 		"advertiser_id": req.AdvertiserID,
@@ -732,7 +1583,10 @@ func (t *toolService) GetContextualTags(ctx context.Context, req *ContextualTagR
 		params["country_code"] = req.CountryCode
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
@@ -749,13 +1603,16 @@ func (t *toolService) GetContextualTags(ctx context.Context, req *ContextualTagR
 
 // GetPhoneRegionCodes retrieves phone region codes
 func (t *toolService) GetPhoneRegionCodes(ctx context.Context, advertiserID string) (*PhoneRegionCodeResponse, error) {
-	endpoint := "/open_api/v1.3/tool/phone_region_code/"
+	endpoint := "/tool/phone_region_code/"
 
 	params := map[string]interface{}{
 		"advertiser_id": advertiserID,
 	}
 
-	url := t.client.BuildURL(endpoint, params)
+	url, err := t.client.BuildURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := t.client.DoRequest(ctx, "GET", url, nil, nil)
 	if err != nil {
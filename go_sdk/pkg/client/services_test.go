@@ -0,0 +1,463 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestAccount_AdvertiserUsers(t *testing.T) {
+	var updated AdvertiserUserRoleUpdateRequest
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/advertiser/user/get/":
+			w.Write([]byte(`{"code":0,"data":[{"user_id":"u1","email":"ops@example.com","role":"OPERATOR","status":"ACTIVE"}],"page_info":{"page":1,"total_page":1}}`))
+		case "/open_api/v1.3/advertiser/user/role/update/":
+			json.NewDecoder(r.Body).Decode(&updated)
+			w.Write([]byte(`{"code":0,"data":{"user_id":"u1","role":"ADMIN"}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	users, err := client.Account().GetAdvertiserUsers(context.Background(), &AdvertiserUserGetRequest{AdvertiserID: "123"})
+	if err != nil {
+		t.Fatalf("GetAdvertiserUsers() error = %v", err)
+	}
+	if len(users.Data) != 1 || users.Data[0].Role != models.AdvertiserRoleOperator {
+		t.Errorf("users = %+v", users.Data)
+	}
+
+	resp, err := client.Account().UpdateAdvertiserUserRole(context.Background(), &AdvertiserUserRoleUpdateRequest{
+		AdvertiserID: "123",
+		UserID:       "u1",
+		Role:         models.AdvertiserRoleAdmin,
+	})
+	if err != nil {
+		t.Fatalf("UpdateAdvertiserUserRole() error = %v", err)
+	}
+	if updated.UserID != "u1" || updated.Role != models.AdvertiserRoleAdmin || resp.Data.Role != models.AdvertiserRoleAdmin {
+		t.Errorf("sent %+v, got %+v", updated, resp.Data)
+	}
+
+	if _, err := client.Account().AddAdvertiserUser(context.Background(), &AdvertiserUserAddRequest{
+		AdvertiserID: "123",
+		Email:        "new@example.com",
+		Role:         "OWNER",
+	}); err == nil {
+		t.Error("AddAdvertiserUser() accepted an unknown role")
+	}
+}
+
+func TestAccount_FundTransactions(t *testing.T) {
+	var queries []url.Values
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/advertiser/fund/transaction/get/" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		queries = append(queries, query)
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("page") == "1" {
+			w.Write([]byte(`{"code":0,"data":[
+				{"transaction_id":"t1","fund_type":"COUPON","transaction_type":"GRANT","amount":100,"grant_id":"g1","create_time":"2024-01-01 00:00:00","expire_time":"2024-02-01 00:00:00"},
+				{"transaction_id":"t2","fund_type":"COUPON","transaction_type":"CONSUME","amount":-30,"grant_id":"g1","create_time":"2024-01-05 00:00:00"}
+			],"page_info":{"page":1,"page_size":2,"total_page":2}}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"data":[
+			{"transaction_id":"t3","fund_type":"COUPON","transaction_type":"EXPIRE","amount":-70,"grant_id":"g1","create_time":"2024-02-01 00:00:00"}
+		],"page_info":{"page":2,"page_size":2,"total_page":2}}`))
+	})
+
+	client := newTestClient(t, server)
+
+	transactions, err := client.Account().GetAllAdvertiserFundTransactions(context.Background(), &AdvertiserFundTransactionGetRequest{
+		AdvertiserID:     "123",
+		TransactionTypes: []string{FundTransactionGrant, FundTransactionConsume, FundTransactionExpire},
+		StartDate:        "2024-01-01",
+		EndDate:          "2024-02-29",
+		PageSize:         2,
+	})
+	if err != nil {
+		t.Fatalf("GetAllAdvertiserFundTransactions() error = %v", err)
+	}
+	if len(transactions) != 3 || len(queries) != 2 {
+		t.Fatalf("got %d transactions in %d requests", len(transactions), len(queries))
+	}
+	if queries[0].Get("transaction_types") != "[GRANT,CONSUME,EXPIRE]" || queries[0].Get("start_date") != "2024-01-01" || queries[0].Get("end_date") != "2024-02-29" {
+		t.Errorf("query = %v", queries[0])
+	}
+
+	summary := SummarizeFundGrants(transactions)
+	if len(summary) != 1 {
+		t.Fatalf("summary = %+v", summary)
+	}
+	if g := summary[0]; g.Granted != 100 || g.Consumed != 30 || g.Expired != 70 || g.Remaining != 0 || g.ExpireTime.String() != "2024-02-01 00:00:00" {
+		t.Errorf("usage = %+v", g)
+	}
+
+	if _, err := client.Account().GetAdvertiserFundTransactions(context.Background(), &AdvertiserFundTransactionGetRequest{
+		AdvertiserID: "123",
+		StartDate:    "2024-01-01",
+	}); err == nil {
+		t.Error("GetAdvertiserFundTransactions() accepted a start date without an end date")
+	}
+	if len(queries) != 2 {
+		t.Errorf("invalid request reached the server")
+	}
+}
+
+func TestCampaignAndAdGroupCopy(t *testing.T) {
+	var campaignCopy CampaignCopyRequest
+	var adGroupCopy AdGroupCopyRequest
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/campaign/copy/":
+			json.NewDecoder(r.Body).Decode(&campaignCopy)
+			w.Write([]byte(`{"code":0,"data":{"campaigns":[{"source_id":"c1","id":"c9"}],"adgroups":[{"source_id":"g1","id":"g9"}]}}`))
+		case "/open_api/v1.3/adgroup/copy/":
+			json.NewDecoder(r.Body).Decode(&adGroupCopy)
+			w.Write([]byte(`{"code":0,"data":{"adgroups":[{"source_id":"g1","id":"g8"}]}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	campaigns, err := client.Campaign().Copy(context.Background(), &CampaignCopyRequest{
+		AdvertiserID:       "123",
+		CampaignIDs:        []string{"c1"},
+		TargetAdvertiserID: "456",
+		NameSuffix:         " (Q3)",
+		CopyChildren:       true,
+	})
+	if err != nil {
+		t.Fatalf("Campaign().Copy() error = %v", err)
+	}
+	if campaignCopy.TargetAdvertiserID != "456" || !campaignCopy.CopyChildren || campaignCopy.NameSuffix != " (Q3)" {
+		t.Errorf("sent %+v", campaignCopy)
+	}
+	if len(campaigns.Data.Campaigns) != 1 || campaigns.Data.Campaigns[0].ID != "c9" || len(campaigns.Data.AdGroups) != 1 {
+		t.Errorf("campaigns = %+v", campaigns.Data)
+	}
+
+	adGroups, err := client.AdGroup().Copy(context.Background(), &AdGroupCopyRequest{
+		AdvertiserID:     "123",
+		AdGroupIDs:       []string{"g1"},
+		TargetCampaignID: "c2",
+	})
+	if err != nil {
+		t.Fatalf("AdGroup().Copy() error = %v", err)
+	}
+	if adGroupCopy.TargetCampaignID != "c2" || adGroupCopy.CopyChildren {
+		t.Errorf("sent %+v", adGroupCopy)
+	}
+	if len(adGroups.Data.AdGroups) != 1 || adGroups.Data.AdGroups[0].SourceID != "g1" {
+		t.Errorf("adgroups = %+v", adGroups.Data)
+	}
+
+	invalid := []*AdGroupCopyRequest{
+		{AdvertiserID: "123"},
+		{AdvertiserID: "123", AdGroupIDs: []string{"g1"}, TargetAdvertiserID: "456"},
+		{AdvertiserID: "123", AdGroupIDs: []string{"g1"}, OperationStatus: "DELETE"},
+	}
+	for _, req := range invalid {
+		if _, err := client.AdGroup().Copy(context.Background(), req); err == nil {
+			t.Errorf("AdGroup().Copy(%+v) accepted an invalid request", req)
+		}
+	}
+}
+
+func TestRecycleBin(t *testing.T) {
+	var filters []string
+	updates := map[string]map[string]interface{}{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/campaign/get/", "/open_api/v1.3/adgroup/get/", "/open_api/v1.3/ad/get/":
+			filters = append(filters, r.URL.Query().Get("filtering"))
+			writeJSON(w, `{"code":0,"data":[],"page_info":{"page":1,"total_page":1}}`)
+		case "/open_api/v1.3/campaign/status/update/", "/open_api/v1.3/adgroup/status/update/", "/open_api/v1.3/ad/status/update/":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			updates[r.URL.Path] = body
+			if ids, _ := body["ad_ids"].([]interface{}); len(ids) > 0 && ids[0] == "purged" {
+				writeJSON(w, `{"code":40002,"message":"Ad does not exist","request_id":"req-1"}`)
+				return
+			}
+			writeJSON(w, `{"code":0,"message":"OK","data":{}}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	if _, err := client.Campaign().ListDeleted(ctx, &CampaignListDeletedRequest{AdvertiserID: "123"}); err != nil {
+		t.Fatalf("Campaign ListDeleted() error = %v", err)
+	}
+	if _, err := client.AdGroup().ListDeleted(ctx, &AdGroupListDeletedRequest{AdvertiserID: "123"}); err != nil {
+		t.Fatalf("AdGroup ListDeleted() error = %v", err)
+	}
+	if _, err := client.Ad().ListDeleted(ctx, &AdListDeletedRequest{AdvertiserID: "123"}); err != nil {
+		t.Fatalf("Ad ListDeleted() error = %v", err)
+	}
+	want := []string{
+		`{"secondary_status":"CAMPAIGN_STATUS_DELETE"}`,
+		`{"secondary_status":"ADGROUP_STATUS_DELETE"}`,
+		`{"secondary_status":"AD_STATUS_DELETE"}`,
+	}
+	for i, filter := range filters {
+		if i >= len(want) || filter != want[i] {
+			t.Errorf("filters = %v, want %v", filters, want)
+			break
+		}
+	}
+
+	if _, err := client.Campaign().Restore(ctx, &CampaignRestoreRequest{AdvertiserID: "123", CampaignIDs: []string{"c1"}}); err != nil {
+		t.Fatalf("Campaign Restore() error = %v", err)
+	}
+	if _, err := client.AdGroup().Restore(ctx, &AdGroupRestoreRequest{AdvertiserID: "123", AdGroupIDs: []string{"g1"}}); err != nil {
+		t.Fatalf("AdGroup Restore() error = %v", err)
+	}
+	if _, err := client.Ad().Restore(ctx, &AdRestoreRequest{AdvertiserID: "123", AdIDs: []string{"a1"}}); err != nil {
+		t.Fatalf("Ad Restore() error = %v", err)
+	}
+	if op := updates["/open_api/v1.3/campaign/status/update/"]["operation"]; op != "DISABLE" {
+		t.Errorf("campaign restore operation = %v, want DISABLE", op)
+	}
+	for _, path := range []string{"/open_api/v1.3/adgroup/status/update/", "/open_api/v1.3/ad/status/update/"} {
+		if op := updates[path]["operation_status"]; op != "DISABLE" {
+			t.Errorf("%s operation_status = %v, want DISABLE", path, op)
+		}
+	}
+
+	// Entities the API no longer restores are reported as API errors
+	_, err := client.Ad().Restore(ctx, &AdRestoreRequest{AdvertiserID: "123", AdIDs: []string{"purged"}})
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "40002" || apiErr.RequestID != "req-1" {
+		t.Errorf("Restore() of a purged ad error = %v", err)
+	}
+	if _, err := client.Campaign().Restore(ctx, &CampaignRestoreRequest{AdvertiserID: "123"}); err == nil {
+		t.Error("Restore() without campaign IDs should fail")
+	}
+}
+
+func TestAdService(t *testing.T) {
+	bodies := map[string]map[string]interface{}{}
+	var query url.Values
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/ad/create/", "/open_api/v1.3/ad/update/", "/open_api/v1.3/ad/status/update/":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			bodies[r.URL.Path] = body
+			writeJSON(w, `{"code":0,"message":"OK","data":{"ad_ids":["a1"]}}`)
+		case "/open_api/v1.3/ad/get/":
+			query = r.URL.Query()
+			writeJSON(w, `{"code":0,"data":[{"ad_id":"a1","adgroup_id":"g1","ad_format":"SINGLE_VIDEO","ad_text":"Shop now",
+				"identity_id":"id1","video_id":"v1","call_to_action":"SHOP_NOW","landing_page_url":"https://example.com",
+				"operation_status":"ENABLE","create_time":"2024-03-01 10:00:00"}],"page_info":{"page":1,"total_page":1}}`)
+		case "/open_api/v1.3/adgroup/get/":
+			writeJSON(w, `{"code":0,"data":[{"adgroup_id":"g1","placement_type":"PLACEMENT_TYPE_NORMAL","placements":["PLACEMENT_TIKTOK"]}]}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	creative := AdCreative{
+		AdName:         "Spring video",
+		AdFormat:       "SINGLE_VIDEO",
+		AdText:         "Shop now",
+		IdentityID:     "id1",
+		IdentityType:   "CUSTOMIZED_USER",
+		VideoID:        "v1",
+		CallToAction:   "SHOP_NOW",
+		LandingPageURL: "https://example.com",
+	}
+	created, err := client.Ad().Create(ctx, &AdCreateRequest{AdvertiserID: "123", AdGroupID: "g1", Creatives: []AdCreative{creative}})
+	if err != nil || len(created.Data.AdIDs) != 1 {
+		t.Fatalf("Create() = %+v, %v", created, err)
+	}
+	sent := bodies["/open_api/v1.3/ad/create/"]["creatives"].([]interface{})[0].(map[string]interface{})
+	if sent["identity_type"] != "CUSTOMIZED_USER" || sent["call_to_action"] != "SHOP_NOW" || sent["landing_page_url"] != "https://example.com" || sent["video_id"] != "v1" {
+		t.Errorf("create creative = %v", sent)
+	}
+
+	ads, err := client.Ad().Get(ctx, &AdGetRequest{AdvertiserID: "123", Fields: []string{"ad_id", "ad_text"}, Filtering: &AdFiltering{AdGroupIDs: []string{"g1"}}, PageSize: 10})
+	if err != nil || len(ads.Data) != 1 {
+		t.Fatalf("Get() = %+v, %v", ads, err)
+	}
+	if ad := ads.Data[0]; ad.AdID != "a1" || ad.CallToAction != "SHOP_NOW" || ad.CreateTime.IsZero() {
+		t.Errorf("ad = %+v", ad)
+	}
+	if query.Get("fields") != `["ad_id","ad_text"]` || query.Get("filtering") != `{"adgroup_ids":["g1"]}` || query.Get("page_size") != "10" {
+		t.Errorf("get query = %v", query)
+	}
+
+	creative.AdText = "Shop the sale"
+	if _, err := client.Ad().Update(ctx, &AdUpdateRequest{AdvertiserID: "123", AdGroupID: "g1", Creatives: []AdUpdateCreative{{AdID: "a1", AdCreative: creative}}}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	updated := bodies["/open_api/v1.3/ad/update/"]["creatives"].([]interface{})[0].(map[string]interface{})
+	if updated["ad_id"] != "a1" || updated["ad_text"] != "Shop the sale" {
+		t.Errorf("update creative = %v", updated)
+	}
+
+	deleted, err := client.Ad().Delete(ctx, &AdDeleteRequest{AdvertiserID: "123", AdIDs: []string{"a1"}})
+	if err != nil || len(deleted.Data.AdIDs) != 1 {
+		t.Fatalf("Delete() = %+v, %v", deleted, err)
+	}
+	if op := bodies["/open_api/v1.3/ad/status/update/"]["operation_status"]; op != "DELETE" {
+		t.Errorf("delete operation_status = %v", op)
+	}
+
+	// Placement-customized creatives are checked against the ad group
+	creative.PlacementMaterials = []PlacementMaterial{{Placement: models.PlacementTikTok, VideoID: "v2"}, {Placement: models.PlacementPangle, VideoID: "v3"}}
+	if _, err := client.Ad().Create(ctx, &AdCreateRequest{AdvertiserID: "123", AdGroupID: "g1", Creatives: []AdCreative{creative}}); err == nil {
+		t.Error("Create() accepted media for a placement the ad group does not use")
+	}
+
+	invalid := []struct {
+		name string
+		call func() error
+	}{
+		{"create without creatives", func() error {
+			_, err := client.Ad().Create(ctx, &AdCreateRequest{AdvertiserID: "123", AdGroupID: "g1"})
+			return err
+		}},
+		{"update without ad ID", func() error {
+			_, err := client.Ad().Update(ctx, &AdUpdateRequest{AdvertiserID: "123", AdGroupID: "g1", Creatives: []AdUpdateCreative{{AdCreative: creative}}})
+			return err
+		}},
+		{"unknown status operation", func() error {
+			_, err := client.Ad().UpdateStatus(ctx, &AdStatusUpdateRequest{AdvertiserID: "123", AdIDs: []string{"a1"}, Operation: "PAUSE"})
+			return err
+		}},
+		{"delete without ads", func() error {
+			_, err := client.Ad().Delete(ctx, &AdDeleteRequest{AdvertiserID: "123"})
+			return err
+		}},
+	}
+	for _, tt := range invalid {
+		if err := tt.call(); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestAdGroupService(t *testing.T) {
+	bodies := map[string]map[string]interface{}{}
+	var query url.Values
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/adgroup/create/", "/open_api/v1.3/adgroup/update/":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			bodies[r.URL.Path] = body
+			writeJSON(w, `{"code":0,"message":"OK","data":{"adgroup_id":"g1"}}`)
+		case "/open_api/v1.3/adgroup/status/update/":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			bodies[r.URL.Path] = body
+			writeJSON(w, `{"code":0,"message":"OK","data":{"adgroup_ids":["g1"]}}`)
+		case "/open_api/v1.3/adgroup/get/":
+			query = r.URL.Query()
+			writeJSON(w, `{"code":0,"data":[{"adgroup_id":"g1","campaign_id":"c1","adgroup_name":"Spring",
+				"placement_type":"PLACEMENT_TYPE_NORMAL","placements":["PLACEMENT_TIKTOK"],"operation_status":"ENABLE",
+				"age_groups":["AGE_25_34"],"budget_mode":"BUDGET_MODE_DAY","budget":50,"bid_type":"BID_TYPE_CUSTOM","bid_price":1.5,
+				"schedule_type":"SCHEDULE_FROM_NOW","create_time":"2024-03-01 10:00:00"}],"page_info":{"page":1,"total_page":1}}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	create := &AdGroupCreateRequest{
+		AdvertiserID:     "123",
+		CampaignID:       "c1",
+		AdGroupName:      "Spring",
+		PlacementType:    models.PlacementTypeNormal,
+		Placements:       []models.Placement{models.PlacementTikTok},
+		AdGroupTargeting: AdGroupTargeting{LocationIDs: []string{"6252001"}, AgeGroups: []models.AgeGroup{models.Age25To34}},
+		AdGroupBidding:   AdGroupBidding{BudgetMode: models.BudgetModeDaily, Budget: 50, BidType: models.BidTypeMaxBid, BidPrice: 1.5},
+		AdGroupSchedule:  AdGroupSchedule{ScheduleType: "SCHEDULE_START_END", ScheduleStartTime: "2024-03-01 00:00:00", ScheduleEndTime: "2024-03-31 00:00:00"},
+	}
+	created, err := client.AdGroup().Create(ctx, create)
+	if err != nil || created.Data.AdGroupID != "g1" {
+		t.Fatalf("Create() = %+v, %v", created, err)
+	}
+	sent := bodies["/open_api/v1.3/adgroup/create/"]
+	if sent["campaign_id"] != "c1" || sent["placement_type"] != "PLACEMENT_TYPE_NORMAL" || sent["budget"] != 50.0 ||
+		sent["bid_price"] != 1.5 || sent["schedule_end_time"] != "2024-03-31 00:00:00" || sent["location_ids"] == nil {
+		t.Errorf("create body = %v", sent)
+	}
+
+	groups, err := client.AdGroup().Get(ctx, &AdGroupGetRequest{AdvertiserID: "123", Filtering: &AdGroupFiltering{CampaignIDs: []string{"c1"}}, Page: 2})
+	if err != nil || len(groups.Data) != 1 {
+		t.Fatalf("Get() = %+v, %v", groups, err)
+	}
+	if g := groups.Data[0]; g.AdGroupID != "g1" || g.BidType != models.BidTypeMaxBid || len(g.AgeGroups) != 1 || g.CreateTime.IsZero() {
+		t.Errorf("ad group = %+v", g)
+	}
+	if query.Get("filtering") != `{"campaign_ids":["c1"]}` || query.Get("page") != "2" {
+		t.Errorf("get query = %v", query)
+	}
+
+	if _, err := client.AdGroup().Update(ctx, &AdGroupUpdateRequest{AdvertiserID: "123", AdGroupID: "g1", AdGroupBidding: AdGroupBidding{BidPrice: 2}}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if updated := bodies["/open_api/v1.3/adgroup/update/"]; updated["adgroup_id"] != "g1" || updated["bid_price"] != 2.0 || updated["budget"] != nil {
+		t.Errorf("update body = %v", updated)
+	}
+
+	deleted, err := client.AdGroup().Delete(ctx, &AdGroupDeleteRequest{AdvertiserID: "123", AdGroupIDs: []string{"g1"}})
+	if err != nil || len(deleted.Data.AdGroupIDs) != 1 {
+		t.Fatalf("Delete() = %+v, %v", deleted, err)
+	}
+	if op := bodies["/open_api/v1.3/adgroup/status/update/"]["operation_status"]; op != "DELETE" {
+		t.Errorf("delete operation_status = %v", op)
+	}
+
+	invalid := []struct {
+		name string
+		call func() error
+	}{
+		{"create without schedule", func() error {
+			req := *create
+			req.AdGroupSchedule = AdGroupSchedule{}
+			_, err := client.AdGroup().Create(ctx, &req)
+			return err
+		}},
+		{"update without ad group ID", func() error {
+			_, err := client.AdGroup().Update(ctx, &AdGroupUpdateRequest{AdvertiserID: "123"})
+			return err
+		}},
+		{"delete without ad groups", func() error {
+			_, err := client.AdGroup().Delete(ctx, &AdGroupDeleteRequest{AdvertiserID: "123"})
+			return err
+		}},
+		{"get without advertiser", func() error {
+			_, err := client.AdGroup().Get(ctx, &AdGroupGetRequest{})
+			return err
+		}},
+	}
+	for _, tt := range invalid {
+		if err := tt.call(); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
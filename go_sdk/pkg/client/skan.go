@@ -0,0 +1,256 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// CampaignTypeIOS14 is the campaign type of iOS 14 dedicated campaigns,
+// which attribute installs through SKAdNetwork
+const CampaignTypeIOS14 = "IOS14_CAMPAIGN"
+
+// iOS 14 dedicated campaign quota types of an ad group
+const (
+	IOS14QuotaOccupied   = "OCCUPIED"
+	IOS14QuotaUnoccupied = "UNOCCUPIED"
+)
+
+// SKANMaxConversionValue is the largest SKAdNetwork fine conversion value
+const SKANMaxConversionValue = 63
+
+// SKAN 4 coarse conversion values
+const (
+	SKANCoarseLow    = "low"
+	SKANCoarseMedium = "medium"
+	SKANCoarseHigh   = "high"
+)
+
+// SKANConversionValue maps a fine conversion value to the app event that
+// sets it
+type SKANConversionValue struct {
+	Value     int    `json:"conversion_value"` // 0-63
+	EventName string `json:"event_name"`
+
+	// Revenue is the lowest purchase revenue the value stands for, if any
+	Revenue float64 `json:"revenue,omitempty"`
+}
+
+// SKANConversionSchema is the conversion value schema an iOS 14 ad group
+// optimizes against
+type SKANConversionSchema struct {
+	Values []SKANConversionValue `json:"conversion_values"`
+
+	// CoarseValues maps the SKAN 4 coarse values low, medium and high to
+	// app events
+	CoarseValues map[string]string `json:"coarse_values,omitempty"`
+}
+
+// Validate checks that conversion values are in range, unique and named
+func (s *SKANConversionSchema) Validate() error {
+	if len(s.Values) == 0 {
+		return models.NewValidationError("skan_conversion_schema", "conversion_values is required")
+	}
+	seen := make(map[int]bool, len(s.Values))
+	for _, value := range s.Values {
+		if value.Value < 0 || value.Value > SKANMaxConversionValue {
+			return models.NewValidationError("skan_conversion_schema", fmt.Sprintf("conversion value %d must be between 0 and %d", value.Value, SKANMaxConversionValue))
+		}
+		if seen[value.Value] {
+			return models.NewValidationError("skan_conversion_schema", fmt.Sprintf("conversion value %d is mapped more than once", value.Value))
+		}
+		seen[value.Value] = true
+		if value.EventName == "" {
+			return models.NewValidationError("skan_conversion_schema", fmt.Sprintf("conversion value %d needs an event_name", value.Value))
+		}
+	}
+	for coarse := range s.CoarseValues {
+		if coarse != SKANCoarseLow && coarse != SKANCoarseMedium && coarse != SKANCoarseHigh {
+			return models.NewValidationError("skan_conversion_schema", fmt.Sprintf("unknown coarse value %s; use low, medium or high", coarse))
+		}
+	}
+	return nil
+}
+
+// validateSKANCampaign checks the SKAdNetwork settings of a campaign
+func validateSKANCampaign(r *CampaignCreateRequest) error {
+	if r.CampaignType != CampaignTypeIOS14 {
+		if r.DisableSKANCampaign {
+			return models.NewValidationError("disable_skan_campaign", "disable_skan_campaign only applies to IOS14_CAMPAIGN campaigns")
+		}
+		return nil
+	}
+	if r.ObjectiveType != models.ObjectiveAppPromotion {
+		return models.NewValidationError("campaign_type", "IOS14_CAMPAIGN campaigns must use the APP_PROMOTION objective")
+	}
+	return nil
+}
+
+// validateSKANAdGroup checks the SKAdNetwork settings of an ad group
+func validateSKANAdGroup(r *AdGroupCreateRequest) error {
+	if r.SKANConversionSchema == nil && r.IOS14QuotaType == "" {
+		return nil
+	}
+	if r.PromotionType != "APP_IOS" {
+		return models.NewValidationError("promotion_type", "SKAdNetwork settings require promotion_type APP_IOS")
+	}
+	if r.AppID == "" {
+		return models.NewValidationError("app_id", "app_id is required for SKAdNetwork ad groups")
+	}
+	if r.IOS14QuotaType != "" && r.IOS14QuotaType != IOS14QuotaOccupied && r.IOS14QuotaType != IOS14QuotaUnoccupied {
+		return models.NewValidationError("ios14_quota_type", "ios14_quota_type must be OCCUPIED or UNOCCUPIED")
+	}
+	if r.SKANConversionSchema != nil {
+		return r.SKANConversionSchema.Validate()
+	}
+	return nil
+}
+
+// SKANReportRequest represents the request for a SKAdNetwork report
+type SKANReportRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+
+	// CampaignIDs limits the report; every campaign is reported when empty
+	CampaignIDs []string `json:"campaign_ids,omitempty"`
+
+	// DataLevel is AUCTION_CAMPAIGN (the default) or AUCTION_ADGROUP;
+	// postbacks do not identify ads
+	DataLevel models.DataLevel `json:"data_level,omitempty"`
+
+	StartDate string `json:"start_date"` // YYYY-MM-DD
+	EndDate   string `json:"end_date"`
+}
+
+// SKANStats is the SKAdNetwork attributed performance of a campaign or ad
+// group on one day
+type SKANStats struct {
+	CampaignID    string  `json:"campaign_id"`
+	AdGroupID     string  `json:"adgroup_id,omitempty"`
+	Date          string  `json:"date"`
+	Spend         float64 `json:"spend"`
+	Installs      float64 `json:"installs"`
+	Conversions   float64 `json:"conversions"`
+	PurchaseValue float64 `json:"purchase_value"`
+}
+
+// CostPerInstall returns spend per SKAN attributed install
+func (s SKANStats) CostPerInstall() float64 {
+	if s.Installs == 0 {
+		return 0
+	}
+	return s.Spend / s.Installs
+}
+
+// ROAS returns SKAN attributed purchase value per unit of spend
+func (s SKANStats) ROAS() float64 {
+	if s.Spend == 0 {
+		return 0
+	}
+	return s.PurchaseValue / s.Spend
+}
+
+// SKANReport holds the daily SKAdNetwork postback metrics of an
+// advertiser's campaigns. Recent days fill in as delayed postbacks arrive.
+type SKANReport struct {
+	AdvertiserID string      `json:"advertiser_id"`
+	StartDate    string      `json:"start_date"`
+	EndDate      string      `json:"end_date"`
+	Rows         []SKANStats `json:"rows"`
+}
+
+// Totals sums the rows of the report
+func (r *SKANReport) Totals() SKANStats {
+	var totals SKANStats
+	for _, row := range r.Rows {
+		totals.Spend += row.Spend
+		totals.Installs += row.Installs
+		totals.Conversions += row.Conversions
+		totals.PurchaseValue += row.PurchaseValue
+	}
+	return totals
+}
+
+// GetSKANReport retrieves daily SKAdNetwork installs, conversions and
+// purchase value, reading every page of the report
+func (r *reportingService) GetSKANReport(ctx context.Context, req *SKANReportRequest) (*SKANReport, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	level := req.DataLevel
+	if level == "" {
+		level = models.DataLevelCampaign
+	}
+	dimensions := []models.Dimension{models.DimensionCampaignID, models.DimensionStatTimeDay}
+	if level == models.DataLevelAdGroup {
+		dimensions[0] = models.DimensionAdGroupID
+	}
+
+	var filters []ReportingFilter
+	if len(req.CampaignIDs) > 0 {
+		ids, err := json.Marshal(req.CampaignIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal campaign_ids: %w", err)
+		}
+		filters = append(filters, ReportingFilter{FieldName: "campaign_ids", FilterType: "IN", FilterValue: string(ids)})
+	}
+
+	report := &SKANReport{AdvertiserID: req.AdvertiserID, StartDate: req.StartDate, EndDate: req.EndDate}
+	for page := 1; ; page++ {
+		resp, err := r.GetIntegratedReport(ctx, &ReportingRequest{
+			AdvertiserID: req.AdvertiserID,
+			ReportType:   models.ReportTypeBasic,
+			DataLevel:    level,
+			Dimensions:   dimensions,
+			Metrics: []models.Metric{
+				models.MetricSpend,
+				models.MetricSKANAppInstall,
+				models.MetricSKANConversion,
+				models.MetricSKANTotalPurchaseValue,
+			},
+			Filters:   filters,
+			StartDate: req.StartDate,
+			EndDate:   req.EndDate,
+			Page:      page,
+			PageSize:  1000,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get SKAN report: %w", err)
+		}
+		if resp.Code != 0 {
+			return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+		}
+		for _, row := range resp.Data.List {
+			report.Rows = append(report.Rows, SKANStats{
+				CampaignID:    row.Dimensions.CampaignID,
+				AdGroupID:     row.Dimensions.AdGroupID,
+				Date:          row.Dimensions.StatTimeDay,
+				Spend:         float64(row.Metrics.Spend),
+				Installs:      float64(row.Metrics.SKANAppInstall),
+				Conversions:   float64(row.Metrics.SKANConversion),
+				PurchaseValue: float64(row.Metrics.SKANTotalPurchaseValue),
+			})
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			return report, nil
+		}
+	}
+}
+
+// Validate checks the advertiser, data level and date range
+func (r *SKANReportRequest) Validate() error {
+	if r.AdvertiserID == "" {
+		return models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+	if r.DataLevel != "" && r.DataLevel != models.DataLevelCampaign && r.DataLevel != models.DataLevelAdGroup {
+		return models.NewValidationError("data_level", "data_level must be AUCTION_CAMPAIGN or AUCTION_ADGROUP")
+	}
+	if r.StartDate == "" || r.EndDate == "" {
+		return models.NewValidationError("start_date", "start_date and end_date are required")
+	}
+	return nil
+}
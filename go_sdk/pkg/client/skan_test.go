@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestSKAN(t *testing.T) {
+	campaign := NewIOS14AppInstallCampaign(CampaignBasics{AdvertiserID: "123", CampaignName: "iOS installs", Budget: 100})
+	if err := campaign.Validate(); err != nil || campaign.CampaignType != CampaignTypeIOS14 {
+		t.Fatalf("NewIOS14AppInstallCampaign() = %+v, Validate() = %v", campaign, err)
+	}
+	traffic := NewTrafficCampaign(CampaignBasics{AdvertiserID: "123", CampaignName: "Visits", Budget: 100})
+	traffic.DisableSKANCampaign = true
+	if err := traffic.Validate(); err == nil {
+		t.Error("disable_skan_campaign on a regular campaign should fail")
+	}
+	traffic.DisableSKANCampaign, traffic.CampaignType = false, CampaignTypeIOS14
+	if err := traffic.Validate(); err == nil {
+		t.Error("an IOS14_CAMPAIGN traffic campaign should fail")
+	}
+
+	schema := &SKANConversionSchema{
+		Values:       []SKANConversionValue{{Value: 0, EventName: "install"}, {Value: 12, EventName: "purchase", Revenue: 9.99}},
+		CoarseValues: map[string]string{SKANCoarseHigh: "purchase"},
+	}
+	adGroup := &AdGroupCreateRequest{
+		AdvertiserID:         "123",
+		CampaignID:           "c1",
+		AdGroupName:          "iOS",
+		PromotionType:        "APP_IOS",
+		PlacementType:        models.PlacementTypeAutomatic,
+		AdGroupSchedule:      AdGroupSchedule{ScheduleType: "SCHEDULE_FROM_NOW"},
+		AppID:                "app1",
+		IOS14QuotaType:       IOS14QuotaOccupied,
+		SKANConversionSchema: schema,
+	}
+	if err := adGroup.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	for name, mutate := range map[string]func(*AdGroupCreateRequest){
+		"android app":     func(r *AdGroupCreateRequest) { r.PromotionType = "APP_ANDROID" },
+		"missing app":     func(r *AdGroupCreateRequest) { r.AppID = "" },
+		"bad quota":       func(r *AdGroupCreateRequest) { r.IOS14QuotaType = "FULL" },
+		"value over 63":   func(r *AdGroupCreateRequest) { r.SKANConversionSchema.Values[1].Value = 64 },
+		"duplicate value": func(r *AdGroupCreateRequest) { r.SKANConversionSchema.Values[1].Value = 0 },
+		"bad coarse value": func(r *AdGroupCreateRequest) {
+			r.SKANConversionSchema.CoarseValues = map[string]string{"top": "purchase"}
+		},
+	} {
+		req := *adGroup
+		copied := *schema
+		copied.Values = slices.Clone(schema.Values)
+		req.SKANConversionSchema = &copied
+		mutate(&req)
+		if err := req.Validate(); err == nil {
+			t.Errorf("%s: Validate() should fail", name)
+		}
+	}
+
+	var query url.Values
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		writeJSON(w, `{"code":0,"data":{"page_info":{"page":1,"total_page":1},"list":[
+			{"dimensions":{"campaign_id":"c1","stat_time_day":"2026-06-01 00:00:00"},"metrics":{"spend":"100.00","skan_app_install":"40","skan_conversion":"8","skan_total_purchase_value":"150.00"}},
+			{"dimensions":{"campaign_id":"c1","stat_time_day":"2026-06-02 00:00:00"},"metrics":{"spend":"60.00","skan_app_install":"-","skan_conversion":"-","skan_total_purchase_value":"-"}}]}}`)
+	})
+
+	client := newTestClient(t, server)
+	report, err := client.Reporting().GetSKANReport(context.Background(), &SKANReportRequest{
+		AdvertiserID: "123",
+		CampaignIDs:  []string{"c1"},
+		StartDate:    "2026-06-01",
+		EndDate:      "2026-06-02",
+	})
+	if err != nil {
+		t.Fatalf("GetSKANReport() error = %v", err)
+	}
+	if !strings.Contains(query.Get("metrics"), "skan_app_install") || query.Get("data_level") != "AUCTION_CAMPAIGN" {
+		t.Errorf("query = %v", query)
+	}
+	if len(report.Rows) != 2 || report.Rows[0].CostPerInstall() != 2.5 || report.Rows[0].ROAS() != 1.5 || report.Rows[1].Installs != 0 {
+		t.Fatalf("rows = %+v", report.Rows)
+	}
+	if totals := report.Totals(); totals.Spend != 160 || totals.Installs != 40 {
+		t.Errorf("totals = %+v", totals)
+	}
+
+	if _, err := client.Reporting().GetSKANReport(context.Background(), &SKANReportRequest{AdvertiserID: "123", DataLevel: models.DataLevelAd, StartDate: "2026-06-01", EndDate: "2026-06-02"}); err == nil {
+		t.Error("a SKAN report at ad level should fail")
+	}
+	_, err = client.ReportQuery("123").Level(models.DataLevelAd).Metrics(models.MetricSKANAppInstall).DateRange(time.Now().AddDate(0, 0, -7), time.Now()).Build()
+	if err == nil {
+		t.Error("ReportQuery with a SKAN metric at ad level should fail")
+	}
+}
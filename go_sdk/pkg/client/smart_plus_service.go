@@ -0,0 +1,513 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Smart+ asset group limits
+const (
+	SmartPlusMaxMedia           = 50
+	SmartPlusMaxTitles          = 5
+	SmartPlusMaxCallToActions   = 3
+	SmartPlusMinDailyBudget     = 50.0
+	SmartPlusMaxCampaignNameLen = 512
+)
+
+// smartPlusObjectives are the objectives available to Smart+ campaigns
+var smartPlusObjectives = map[models.ObjectiveType]bool{
+	models.ObjectiveConversions:    true,
+	models.ObjectiveAppPromotion:   true,
+	models.ObjectiveLeadGeneration: true,
+	models.ObjectiveProductSales:   true,
+}
+
+// smartPlusManualOnlyFields are manual campaign fields that Smart+ manages automatically
+var smartPlusManualOnlyFields = map[string]bool{
+	"adgroup_id":            true,
+	"audience_ids":          true,
+	"excluded_audience_ids": true,
+	"interest_category_ids": true,
+	"action_category_ids":   true,
+	"age_groups":            true,
+	"gender":                true,
+	"languages":             true,
+	"placement_type":        true,
+	"placements":            true,
+	"bid_type":              true,
+	"bid_price":             true,
+	"conversion_bid_price":  true,
+	"pacing":                true,
+	"dayparting":            true,
+	"frequency":             true,
+	"frequency_schedule":    true,
+}
+
+// SmartPlusReportDimensions are the dimensions supported by Smart+ reports
+var SmartPlusReportDimensions = []string{
+	"campaign_id",
+	"stat_time_day",
+	"stat_time_hour",
+	"country_code",
+	"material_id",
+	"main_material_id",
+}
+
+// SmartPlusService handles Smart+ (automated) campaign operations
+type SmartPlusService struct {
+	client *Client
+}
+
+// NewSmartPlusService creates a new SmartPlusService
+func NewSmartPlusService(client *Client) *SmartPlusService {
+	return &SmartPlusService{client: client}
+}
+
+// SmartPlusMedia represents a video or image in a Smart+ asset group
+type SmartPlusMedia struct {
+	VideoID string `json:"video_id,omitempty"`
+	ImageID string `json:"image_id,omitempty"`
+}
+
+// SmartPlusAssetGroup represents the creative inputs Smart+ combines automatically
+type SmartPlusAssetGroup struct {
+	MediaList      []SmartPlusMedia `json:"media_info_list"`
+	Titles         []string         `json:"title_list"`
+	CallToActions  []string         `json:"call_to_action_list,omitempty"`
+	IdentityID     string           `json:"identity_id,omitempty"`
+	IdentityType   string           `json:"identity_type,omitempty"`
+	LandingPageURL string           `json:"landing_page_url,omitempty"`
+}
+
+// SmartPlusCampaignCreateRequest represents the request for creating a Smart+ campaign
+type SmartPlusCampaignCreateRequest struct {
+	AdvertiserID      string               `json:"advertiser_id"`
+	CampaignName      string               `json:"campaign_name"`
+	ObjectiveType     models.ObjectiveType `json:"objective_type"`
+	BudgetMode        models.BudgetMode    `json:"budget_mode"`
+	Budget            float64              `json:"budget"`
+	ScheduleType      string               `json:"schedule_type,omitempty"` // SCHEDULE_FROM_NOW, SCHEDULE_START_END
+	ScheduleStart     string               `json:"schedule_start_time,omitempty"`
+	ScheduleEnd       string               `json:"schedule_end_time,omitempty"`
+	LocationIDs       []string             `json:"location_ids"`
+	PixelID           string               `json:"pixel_id,omitempty"`
+	OptimizationEvent string               `json:"optimization_event,omitempty"`
+	AppID             string               `json:"app_id,omitempty"`
+	AssetGroup        SmartPlusAssetGroup  `json:"creative_list"`
+
+	// AdditionalFields carries API fields not modelled above; manual-only
+	// targeting and bidding fields are rejected
+	AdditionalFields map[string]interface{} `json:"-"`
+}
+
+// SmartPlusCampaignUpdateRequest represents the request for updating a Smart+ campaign
+type SmartPlusCampaignUpdateRequest struct {
+	AdvertiserID     string                 `json:"advertiser_id"`
+	CampaignID       string                 `json:"campaign_id"`
+	CampaignName     string                 `json:"campaign_name,omitempty"`
+	Budget           float64                `json:"budget,omitempty"`
+	ScheduleEnd      string                 `json:"schedule_end_time,omitempty"`
+	AssetGroup       *SmartPlusAssetGroup   `json:"creative_list,omitempty"`
+	AdditionalFields map[string]interface{} `json:"-"`
+}
+
+// SmartPlusCampaignGetRequest represents the request for retrieving Smart+ campaigns
+type SmartPlusCampaignGetRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	CampaignIDs  []string `json:"campaign_ids"`
+}
+
+// SmartPlusStatusUpdateRequest represents the request for updating Smart+ campaign status
+type SmartPlusStatusUpdateRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	CampaignIDs  []string `json:"campaign_ids"`
+	Operation    string   `json:"operation_status"` // ENABLE, DISABLE, DELETE
+}
+
+// SmartPlusReportRequest represents the request for a Smart+ campaign report
+type SmartPlusReportRequest struct {
+	AdvertiserID string
+	CampaignIDs  []string
+	Dimensions   []string
+	Metrics      []string
+	StartDate    string
+	EndDate      string
+	Page         int
+	PageSize     int
+}
+
+// SmartPlusCampaign represents Smart+ campaign information
+type SmartPlusCampaign struct {
+	CampaignID        string              `json:"campaign_id"`
+	CampaignName      string              `json:"campaign_name"`
+	AdvertiserID      string              `json:"advertiser_id"`
+	ObjectiveType     string              `json:"objective_type"`
+	BudgetMode        string              `json:"budget_mode"`
+	Budget            float64             `json:"budget"`
+	OperationStatus   string              `json:"operation_status"`
+	ScheduleStart     string              `json:"schedule_start_time,omitempty"`
+	ScheduleEnd       string              `json:"schedule_end_time,omitempty"`
+	LocationIDs       []string            `json:"location_ids,omitempty"`
+	PixelID           string              `json:"pixel_id,omitempty"`
+	OptimizationEvent string              `json:"optimization_event,omitempty"`
+	AssetGroup        SmartPlusAssetGroup `json:"creative_list"`
+	CreateTime        models.Time         `json:"create_time,omitempty"`
+	ModifyTime        models.Time         `json:"modify_time,omitempty"`
+}
+
+// SmartPlusCampaignResponse represents the response from Smart+ create and update operations
+type SmartPlusCampaignResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		CampaignID string `json:"campaign_id"`
+	} `json:"data"`
+}
+
+// SmartPlusCampaignListResponse represents the response for retrieving Smart+ campaigns
+type SmartPlusCampaignListResponse struct {
+	Code      int                 `json:"code"`
+	Message   string              `json:"message"`
+	RequestID string              `json:"request_id"`
+	Data      []SmartPlusCampaign `json:"data"`
+}
+
+// SmartPlusStatusUpdateResponse represents the response for Smart+ status updates
+type SmartPlusStatusUpdateResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		CampaignIDs []string `json:"campaign_ids"`
+	} `json:"data"`
+}
+
+// CreateCampaign creates a Smart+ campaign with its asset group
+func (s *SmartPlusService) CreateCampaign(ctx context.Context, req *SmartPlusCampaignCreateRequest) (*SmartPlusCampaignResponse, error) {
+	if err := ValidateSmartPlusCampaign(req); err != nil {
+		return nil, err
+	}
+
+	body, err := mergeSmartPlusFields(req, req.AdditionalFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, "/campaign/spc/create/", body, "create smart+ campaign")
+}
+
+// UpdateCampaign updates the budget, schedule, name or assets of a Smart+ campaign
+func (s *SmartPlusService) UpdateCampaign(ctx context.Context, req *SmartPlusCampaignUpdateRequest) (*SmartPlusCampaignResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.CampaignID == "" {
+		return nil, fmt.Errorf("campaign_id is required")
+	}
+	if req.AssetGroup != nil {
+		if err := ValidateSmartPlusAssetGroup(req.AssetGroup); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkSmartPlusFields(req.AdditionalFields); err != nil {
+		return nil, err
+	}
+
+	body, err := mergeSmartPlusFields(req, req.AdditionalFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, "/campaign/spc/update/", body, "update smart+ campaign")
+}
+
+// GetCampaigns retrieves Smart+ campaigns with their asset groups
+func (s *SmartPlusService) GetCampaigns(ctx context.Context, req *SmartPlusCampaignGetRequest) (*SmartPlusCampaignListResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.CampaignIDs) == 0 {
+		return nil, fmt.Errorf("campaign_ids is required")
+	}
+
+	campaignIDs, err := json.Marshal(req.CampaignIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal campaign_ids: %w", err)
+	}
+
+	url, err := s.client.BuildURL("/campaign/spc/get/", map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+		"campaign_ids":  string(campaignIDs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get smart+ campaigns: %w", err)
+	}
+
+	var response SmartPlusCampaignListResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UpdateStatus enables, disables or deletes Smart+ campaigns
+func (s *SmartPlusService) UpdateStatus(ctx context.Context, req *SmartPlusStatusUpdateRequest) (*SmartPlusStatusUpdateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.CampaignIDs) == 0 {
+		return nil, fmt.Errorf("campaign_ids is required")
+	}
+	switch req.Operation {
+	case "ENABLE", "DISABLE", "DELETE":
+	default:
+		return nil, fmt.Errorf("operation must be ENABLE, DISABLE or DELETE")
+	}
+
+	url, err := s.client.BuildURL("/campaign/spc/status/update/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update smart+ campaign status: %w", err)
+	}
+
+	var response SmartPlusStatusUpdateResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetReport retrieves a report for Smart+ campaigns using only the
+// dimensions Smart+ supports
+func (s *SmartPlusService) GetReport(ctx context.Context, req *SmartPlusReportRequest) (*ReportingResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if len(req.Metrics) == 0 {
+		return nil, fmt.Errorf("metrics is required")
+	}
+
+	dimensions := req.Dimensions
+	if len(dimensions) == 0 {
+		dimensions = []string{"campaign_id"}
+	}
+	if err := ValidateSmartPlusDimensions(dimensions); err != nil {
+		return nil, err
+	}
+
+	reportReq := &ReportingRequest{
+		AdvertiserID: req.AdvertiserID,
+		ReportType:   models.ReportTypeBasic,
+		DataLevel:    models.DataLevelCampaign,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		Page:         req.Page,
+		PageSize:     req.PageSize,
+	}
+	for _, dimension := range dimensions {
+		reportReq.Dimensions = append(reportReq.Dimensions, models.Dimension(dimension))
+	}
+	for _, metric := range req.Metrics {
+		reportReq.Metrics = append(reportReq.Metrics, models.Metric(metric))
+	}
+	if len(req.CampaignIDs) > 0 {
+		ids, err := json.Marshal(req.CampaignIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal campaign_ids: %w", err)
+		}
+		reportReq.Filters = []ReportingFilter{{FieldName: "campaign_ids", FilterType: "IN", FilterValue: string(ids)}}
+	}
+
+	return s.client.Reporting().GetIntegratedReport(ctx, reportReq)
+}
+
+// post sends a prepared body to a Smart+ endpoint
+func (s *SmartPlusService) post(ctx context.Context, endpoint string, body []byte, action string) (*SmartPlusCampaignResponse, error) {
+	url, err := s.client.BuildURL(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	var response SmartPlusCampaignResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Validate checks the request against the constrained Smart+ field set
+func (r *SmartPlusCampaignCreateRequest) Validate() error {
+	return ValidateSmartPlusCampaign(r)
+}
+
+// ValidateSmartPlusCampaign validates a Smart+ create request against the
+// constrained Smart+ field set
+func ValidateSmartPlusCampaign(req *SmartPlusCampaignCreateRequest) error {
+	if req == nil {
+		return fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return fmt.Errorf("advertiser_id is required")
+	}
+	if req.CampaignName == "" {
+		return fmt.Errorf("campaign_name is required")
+	}
+	if len(req.CampaignName) > SmartPlusMaxCampaignNameLen {
+		return fmt.Errorf("campaign_name cannot exceed %d characters", SmartPlusMaxCampaignNameLen)
+	}
+	if !smartPlusObjectives[req.ObjectiveType] {
+		return fmt.Errorf("objective_type %q is not supported for smart+ campaigns", req.ObjectiveType)
+	}
+	switch req.BudgetMode {
+	case models.BudgetModeDaily:
+		if req.Budget < SmartPlusMinDailyBudget {
+			return fmt.Errorf("daily budget must be at least %.0f", SmartPlusMinDailyBudget)
+		}
+	case models.BudgetModeTotal:
+		if req.Budget <= 0 {
+			return fmt.Errorf("budget must be greater than 0")
+		}
+		if req.ScheduleEnd == "" {
+			return fmt.Errorf("schedule_end_time is required with a total budget")
+		}
+	default:
+		return fmt.Errorf("budget_mode must be BUDGET_MODE_DAY or BUDGET_MODE_TOTAL")
+	}
+	if len(req.LocationIDs) == 0 {
+		return fmt.Errorf("location_ids is required")
+	}
+	switch req.ObjectiveType {
+	case models.ObjectiveAppPromotion:
+		if req.AppID == "" {
+			return fmt.Errorf("app_id is required for app promotion")
+		}
+	case models.ObjectiveConversions, models.ObjectiveProductSales:
+		if req.PixelID == "" {
+			return fmt.Errorf("pixel_id is required for %s", req.ObjectiveType)
+		}
+	}
+
+	if err := ValidateSmartPlusAssetGroup(&req.AssetGroup); err != nil {
+		return err
+	}
+	return checkSmartPlusFields(req.AdditionalFields)
+}
+
+// ValidateSmartPlusAssetGroup validates the creative inputs of a Smart+ campaign
+func ValidateSmartPlusAssetGroup(group *SmartPlusAssetGroup) error {
+	if group == nil {
+		return fmt.Errorf("asset group is required")
+	}
+	if len(group.MediaList) == 0 {
+		return fmt.Errorf("asset group requires at least one video or image")
+	}
+	if len(group.MediaList) > SmartPlusMaxMedia {
+		return fmt.Errorf("asset group cannot exceed %d media items", SmartPlusMaxMedia)
+	}
+	for i, media := range group.MediaList {
+		if (media.VideoID == "") == (media.ImageID == "") {
+			return fmt.Errorf("media item %d must set exactly one of video_id or image_id", i)
+		}
+	}
+	if len(group.Titles) == 0 {
+		return fmt.Errorf("asset group requires at least one title")
+	}
+	if len(group.Titles) > SmartPlusMaxTitles {
+		return fmt.Errorf("asset group cannot exceed %d titles", SmartPlusMaxTitles)
+	}
+	if len(group.CallToActions) > SmartPlusMaxCallToActions {
+		return fmt.Errorf("asset group cannot exceed %d call to actions", SmartPlusMaxCallToActions)
+	}
+	return nil
+}
+
+// ValidateSmartPlusDimensions rejects report dimensions Smart+ does not support
+func ValidateSmartPlusDimensions(dimensions []string) error {
+	allowed := make(map[string]bool, len(SmartPlusReportDimensions))
+	for _, d := range SmartPlusReportDimensions {
+		allowed[d] = true
+	}
+	for _, d := range dimensions {
+		if !allowed[d] {
+			return fmt.Errorf("dimension %q is not supported for smart+ reports", d)
+		}
+	}
+	return nil
+}
+
+// checkSmartPlusFields rejects manual-only fields in additional request fields
+func checkSmartPlusFields(fields map[string]interface{}) error {
+	var rejected []string
+	for key := range fields {
+		if smartPlusManualOnlyFields[key] {
+			rejected = append(rejected, key)
+		}
+	}
+	if len(rejected) > 0 {
+		sort.Strings(rejected)
+		return fmt.Errorf("fields not allowed on smart+ campaigns: %s", strings.Join(rejected, ", "))
+	}
+	return nil
+}
+
+// mergeSmartPlusFields marshals a typed request and overlays additional fields
+// without overriding typed values
+func mergeSmartPlusFields(req interface{}, additional map[string]interface{}) ([]byte, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	if len(additional) == 0 {
+		return body, nil
+	}
+
+	var merged map[string]interface{}
+	if err := json.Unmarshal(body, &merged); err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	for key, value := range additional {
+		if _, ok := merged[key]; !ok {
+			merged[key] = value
+		}
+	}
+
+	body, err = json.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	return body, nil
+}
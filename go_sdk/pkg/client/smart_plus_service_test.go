@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// validSmartPlusCampaign returns a Smart+ create request that passes validation
+func validSmartPlusCampaign() *SmartPlusCampaignCreateRequest {
+	return &SmartPlusCampaignCreateRequest{
+		AdvertiserID:  "123",
+		CampaignName:  "Spring Smart+",
+		ObjectiveType: models.ObjectiveConversions,
+		BudgetMode:    models.BudgetModeDaily,
+		Budget:        100,
+		LocationIDs:   []string{"6252001"},
+		PixelID:       "px1",
+		AssetGroup: SmartPlusAssetGroup{
+			MediaList: []SmartPlusMedia{{VideoID: "v1"}, {ImageID: "i1"}},
+			Titles:    []string{"Shop spring"},
+		},
+	}
+}
+
+func TestSmartPlusService(t *testing.T) {
+	bodies := map[string]map[string]interface{}{}
+	var query map[string]string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/campaign/spc/create/", "/open_api/v1.3/campaign/spc/update/", "/open_api/v1.3/campaign/spc/status/update/":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			bodies[r.URL.Path] = body
+			writeJSON(w, `{"code":0,"data":{"campaign_id":"spc1","campaign_ids":["spc1"]}}`)
+		case "/open_api/v1.3/campaign/spc/get/", "/open_api/v1.3/report/integrated/get/":
+			query = map[string]string{}
+			for key := range r.URL.Query() {
+				query[key] = r.URL.Query().Get(key)
+			}
+			if strings.HasSuffix(r.URL.Path, "/spc/get/") {
+				writeJSON(w, `{"code":0,"data":[{"campaign_id":"spc1","creative_list":{"media_info_list":[{"video_id":"v1"}],"title_list":["Shop spring"]}}]}`)
+				return
+			}
+			writeJSON(w, `{"code":0,"data":{"list":[{"dimensions":{"campaign_id":"spc1"},"metrics":{"spend":"12.5"}}],"page_info":{"page":1,"total_page":1}}}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	req := validSmartPlusCampaign()
+	req.AdditionalFields = map[string]interface{}{"deep_bid_type": "DEFAULT", "campaign_name": "ignored"}
+	created, err := client.SmartPlus().CreateCampaign(ctx, req)
+	if err != nil || created.Data.CampaignID != "spc1" {
+		t.Fatalf("CreateCampaign() = %+v, %v", created, err)
+	}
+	create := bodies["/open_api/v1.3/campaign/spc/create/"]
+	if create["deep_bid_type"] != "DEFAULT" || create["campaign_name"] != "Spring Smart+" || create["creative_list"] == nil {
+		t.Errorf("create body = %v", create)
+	}
+
+	if _, err := client.SmartPlus().UpdateCampaign(ctx, &SmartPlusCampaignUpdateRequest{AdvertiserID: "123", CampaignID: "spc1", Budget: 200}); err != nil {
+		t.Fatalf("UpdateCampaign() error = %v", err)
+	}
+	if update := bodies["/open_api/v1.3/campaign/spc/update/"]; update["budget"] != 200.0 || update["creative_list"] != nil {
+		t.Errorf("update body = %v", update)
+	}
+
+	campaigns, err := client.SmartPlus().GetCampaigns(ctx, &SmartPlusCampaignGetRequest{AdvertiserID: "123", CampaignIDs: []string{"spc1"}})
+	if err != nil || len(campaigns.Data) != 1 || campaigns.Data[0].AssetGroup.MediaList[0].VideoID != "v1" {
+		t.Fatalf("GetCampaigns() = %+v, %v", campaigns, err)
+	}
+	if query["campaign_ids"] != `["spc1"]` {
+		t.Errorf("get query = %v", query)
+	}
+
+	if _, err := client.SmartPlus().UpdateStatus(ctx, &SmartPlusStatusUpdateRequest{AdvertiserID: "123", CampaignIDs: []string{"spc1"}, Operation: "DISABLE"}); err != nil {
+		t.Fatalf("UpdateStatus() error = %v", err)
+	}
+	if status := bodies["/open_api/v1.3/campaign/spc/status/update/"]; status["operation_status"] != "DISABLE" {
+		t.Errorf("status body = %v", status)
+	}
+
+	report, err := client.SmartPlus().GetReport(ctx, &SmartPlusReportRequest{
+		AdvertiserID: "123",
+		CampaignIDs:  []string{"spc1"},
+		Metrics:      []string{"spend"},
+		StartDate:    "2024-03-01",
+		EndDate:      "2024-03-07",
+	})
+	if err != nil || len(report.Data.List) != 1 {
+		t.Fatalf("GetReport() = %+v, %v", report, err)
+	}
+	if query["data_level"] != "AUCTION_CAMPAIGN" || query["dimensions"] != `["campaign_id"]` ||
+		query["filtering"] != `[{"field_name":"campaign_ids","filter_type":"IN","filter_value":"[\"spc1\"]"}]` {
+		t.Errorf("report query = %v", query)
+	}
+	if _, err := client.SmartPlus().GetReport(ctx, &SmartPlusReportRequest{AdvertiserID: "123", Metrics: []string{"spend"}, Dimensions: []string{"adgroup_id"}}); err == nil {
+		t.Error("GetReport() accepted a dimension Smart+ does not support")
+	}
+	if _, err := client.SmartPlus().UpdateStatus(ctx, &SmartPlusStatusUpdateRequest{AdvertiserID: "123", CampaignIDs: []string{"spc1"}, Operation: "PAUSE"}); err == nil {
+		t.Error("UpdateStatus() accepted an unknown operation")
+	}
+	if _, err := client.SmartPlus().UpdateCampaign(ctx, &SmartPlusCampaignUpdateRequest{AdvertiserID: "123", CampaignID: "spc1", AdditionalFields: map[string]interface{}{"bid_price": 1}}); err == nil {
+		t.Error("UpdateCampaign() accepted a manual-only field")
+	}
+}
+
+func TestValidateSmartPlusCampaign(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*SmartPlusCampaignCreateRequest)
+		wantErr string
+	}{
+		{name: "valid", modify: func(r *SmartPlusCampaignCreateRequest) {}},
+		{name: "unsupported objective", modify: func(r *SmartPlusCampaignCreateRequest) { r.ObjectiveType = models.ObjectiveReach }, wantErr: "not supported"},
+		{name: "daily budget too low", modify: func(r *SmartPlusCampaignCreateRequest) { r.Budget = 10 }, wantErr: "at least 50"},
+		{name: "total budget without end", modify: func(r *SmartPlusCampaignCreateRequest) { r.BudgetMode = models.BudgetModeTotal }, wantErr: "schedule_end_time"},
+		{name: "missing pixel", modify: func(r *SmartPlusCampaignCreateRequest) { r.PixelID = "" }, wantErr: "pixel_id"},
+		{name: "app promotion without app", modify: func(r *SmartPlusCampaignCreateRequest) { r.ObjectiveType = models.ObjectiveAppPromotion }, wantErr: "app_id"},
+		{name: "no locations", modify: func(r *SmartPlusCampaignCreateRequest) { r.LocationIDs = nil }, wantErr: "location_ids"},
+		{name: "media with both IDs", modify: func(r *SmartPlusCampaignCreateRequest) {
+			r.AssetGroup.MediaList = []SmartPlusMedia{{VideoID: "v1", ImageID: "i1"}}
+		}, wantErr: "exactly one"},
+		{name: "too many titles", modify: func(r *SmartPlusCampaignCreateRequest) {
+			r.AssetGroup.Titles = make([]string, SmartPlusMaxTitles+1)
+		}, wantErr: "titles"},
+		{name: "manual-only fields", modify: func(r *SmartPlusCampaignCreateRequest) {
+			r.AdditionalFields = map[string]interface{}{"pacing": "PACING_MODE_SMOOTH", "age_groups": []string{"AGE_18_24"}}
+		}, wantErr: "age_groups, pacing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validSmartPlusCampaign()
+			tt.modify(req)
+			err := req.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Spark Ads post authorization statuses
+const (
+	PostAuthStatusAuthorized   = "AUTHORIZED"
+	PostAuthStatusUnauthorized = "UNAUTHORIZED"
+)
+
+// PostAuthorizeRequest represents the request for applying a TikTok post
+// authorization code to an advertiser
+type PostAuthorizeRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	AuthCode     string `json:"auth_code"`
+}
+
+// PostAuthorizationRequest represents the request for looking up the post
+// behind an authorization code
+type PostAuthorizationRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	AuthCode     string `json:"auth_code"`
+}
+
+// AuthorizedPostListRequest represents the request for listing the posts
+// authorized to an advertiser
+type AuthorizedPostListRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	Page         int    `json:"page,omitempty"`
+	PageSize     int    `json:"page_size,omitempty"`
+}
+
+// AuthorizedPost represents a TikTok post authorized for Spark Ads, together
+// with the creator it was published by
+type AuthorizedPost struct {
+	ItemInfo struct {
+		ItemID   string `json:"item_id"`
+		Text     string `json:"text,omitempty"`
+		AuthCode string `json:"auth_code,omitempty"`
+		Status   string `json:"status,omitempty"`
+	} `json:"item_info"`
+	UserInfo struct {
+		IdentityID   string `json:"identity_id"`
+		DisplayName  string `json:"display_name,omitempty"`
+		ProfileImage string `json:"profile_image,omitempty"`
+	} `json:"user_info"`
+	VideoInfo struct {
+		VideoID   string  `json:"id,omitempty"`
+		PosterURL string  `json:"poster_url,omitempty"`
+		Duration  float64 `json:"duration,omitempty"`
+		Width     int     `json:"width,omitempty"`
+		Height    int     `json:"height,omitempty"`
+	} `json:"video_info"`
+	AuthInfo IdentityVideoAuthInfo `json:"auth_info"`
+}
+
+// Authorized reports whether the post can currently be promoted
+func (p *AuthorizedPost) Authorized() bool {
+	return p.AuthInfo.AdAuthStatus == PostAuthStatusAuthorized
+}
+
+// PostAuthorizationResponse represents the response for an authorization
+// code lookup
+type PostAuthorizationResponse struct {
+	Code      int            `json:"code"`
+	Message   string         `json:"message"`
+	RequestID string         `json:"request_id"`
+	Data      AuthorizedPost `json:"data"`
+}
+
+// AuthorizedPostListResponse represents the response for listing authorized posts
+type AuthorizedPostListResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		List     []AuthorizedPost `json:"list"`
+		PageInfo struct {
+			Page        int `json:"page"`
+			PageSize    int `json:"page_size"`
+			TotalNumber int `json:"total_number"`
+			TotalPage   int `json:"total_page"`
+		} `json:"page_info"`
+	} `json:"data"`
+}
+
+// AuthorizePost applies a post authorization code generated by a creator in
+// the TikTok app, binding the post to the advertiser
+func (s *IdentityService) AuthorizePost(ctx context.Context, req *PostAuthorizeRequest) (*models.BaseResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.AuthCode == "" {
+		return nil, fmt.Errorf("auth_code is required")
+	}
+
+	url, err := s.client.BuildURL("/tt_video/authorize/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authorize post: %w", err)
+	}
+
+	var response models.BaseResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetPostAuthorization retrieves the post, creator and authorization status
+// behind an authorization code
+func (s *IdentityService) GetPostAuthorization(ctx context.Context, req *PostAuthorizationRequest) (*PostAuthorizationResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if req.AuthCode == "" {
+		return nil, fmt.Errorf("auth_code is required")
+	}
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+		"auth_code":     req.AuthCode,
+	}
+
+	url, err := s.client.BuildURL("/tt_video/info/", params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get post authorization: %w", err)
+	}
+
+	var response PostAuthorizationResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// ListAuthorizedPosts lists the posts authorized to an advertiser
+func (s *IdentityService) ListAuthorizedPosts(ctx context.Context, req *AuthorizedPostListRequest) (*AuthorizedPostListResponse, error) {
+	if req == nil || req.AdvertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+
+	params := map[string]interface{}{
+		"advertiser_id": req.AdvertiserID,
+	}
+	if req.Page > 0 {
+		params["page"] = req.Page
+	}
+	if req.PageSize > 0 {
+		params["page_size"] = req.PageSize
+	}
+
+	url, err := s.client.BuildURL("/tt_video/list/", params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authorized posts: %w", err)
+	}
+
+	var response AuthorizedPostListResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// SparkAdCreativeFromAuthCode applies an authorization code and returns the
+// creative fields of a Spark Ad promoting the authorized post under the
+// creator's AUTH_CODE identity
+func (s *IdentityService) SparkAdCreativeFromAuthCode(ctx context.Context, advertiserID, authCode string) (*AdCreative, error) {
+	applied, err := s.AuthorizePost(ctx, &PostAuthorizeRequest{AdvertiserID: advertiserID, AuthCode: authCode})
+	if err != nil {
+		return nil, err
+	}
+	if applied.Code != 0 {
+		return nil, fmt.Errorf("API error: %s", applied.Message)
+	}
+
+	resp, err := s.GetPostAuthorization(ctx, &PostAuthorizationRequest{AdvertiserID: advertiserID, AuthCode: authCode})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, fmt.Errorf("API error: %s", resp.Message)
+	}
+	post := resp.Data
+	if !post.Authorized() {
+		return nil, fmt.Errorf("post %s is not authorized for promotion (ad_auth_status %s)", post.ItemInfo.ItemID, post.AuthInfo.AdAuthStatus)
+	}
+
+	return &AdCreative{
+		AdFormat:     "SINGLE_VIDEO",
+		IdentityID:   post.UserInfo.IdentityID,
+		IdentityType: string(models.IdentityTypeAuthCode),
+		TikTokItemID: post.ItemInfo.ItemID,
+	}, nil
+}
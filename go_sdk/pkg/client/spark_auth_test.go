@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestIdentityService_SparkAdCreativeFromAuthCode(t *testing.T) {
+	var authorized bool
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/tt_video/authorize/":
+			var body PostAuthorizeRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.AuthCode != "#abc123" {
+				t.Errorf("auth_code = %q", body.AuthCode)
+			}
+			authorized = true
+			w.Write([]byte(`{"code":0,"message":"OK"}`))
+		case "/open_api/v1.3/tt_video/info/":
+			if !authorized {
+				t.Error("info requested before the code was applied")
+			}
+			w.Write([]byte(`{"code":0,"data":{"item_info":{"item_id":"7234567890123456789"},"user_info":{"identity_id":"creator-1"},"auth_info":{"ad_auth_status":"AUTHORIZED"}}}`))
+		case "/open_api/v1.3/tt_video/list/":
+			if got := r.URL.Query().Get("page_size"); got != "50" {
+				t.Errorf("page_size = %q", got)
+			}
+			w.Write([]byte(`{"code":0,"data":{"list":[{"item_info":{"item_id":"1"},"auth_info":{"ad_auth_status":"UNAUTHORIZED"}}],"page_info":{"total_page":1}}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	creative, err := client.Identity().SparkAdCreativeFromAuthCode(context.Background(), "123", "#abc123")
+	if err != nil {
+		t.Fatalf("SparkAdCreativeFromAuthCode() error = %v", err)
+	}
+	if creative.IdentityID != "creator-1" || creative.IdentityType != "AUTH_CODE" || creative.TikTokItemID != "7234567890123456789" {
+		t.Errorf("creative = %+v", creative)
+	}
+
+	posts, err := client.Identity().ListAuthorizedPosts(context.Background(), &AuthorizedPostListRequest{AdvertiserID: "123", PageSize: 50})
+	if err != nil {
+		t.Fatalf("ListAuthorizedPosts() error = %v", err)
+	}
+	if len(posts.Data.List) != 1 || posts.Data.List[0].Authorized() {
+		t.Errorf("posts = %+v", posts.Data.List)
+	}
+
+	if _, err := client.Identity().AuthorizePost(context.Background(), &PostAuthorizeRequest{AdvertiserID: "123"}); err == nil {
+		t.Error("AuthorizePost() accepted a request without auth_code")
+	}
+}
@@ -0,0 +1,329 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// SplitTestVariable is the setting a split test compares across its ad groups
+type SplitTestVariable string
+
+const (
+	SplitTestVariableTargeting SplitTestVariable = "TARGETING"
+	SplitTestVariableBidding   SplitTestVariable = "BIDDING_OPTIMIZATION"
+	SplitTestVariableCreative  SplitTestVariable = "CREATIVE"
+	SplitTestVariableCustom    SplitTestVariable = "CUSTOM"
+)
+
+// SplitTestStatus is the lifecycle status of a split test
+type SplitTestStatus string
+
+const (
+	SplitTestStatusScheduled SplitTestStatus = "NOT_STARTED"
+	SplitTestStatusRunning   SplitTestStatus = "IN_PROGRESS"
+	SplitTestStatusFinished  SplitTestStatus = "FINISHED"
+	SplitTestStatusEnded     SplitTestStatus = "ENDED"
+)
+
+// Split test limits enforced by the API
+const (
+	MinSplitTestAdGroups = 2
+	MaxSplitTestAdGroups = 5
+	MinSplitTestDuration = 7 * 24 * time.Hour
+	MaxSplitTestDuration = 30 * 24 * time.Hour
+)
+
+// SplitTestService handles split tests, which divide an audience between ad
+// groups that differ in one variable and report which performed better
+type SplitTestService struct {
+	client *Client
+}
+
+// NewSplitTestService creates a new SplitTestService
+func NewSplitTestService(client *Client) *SplitTestService {
+	return &SplitTestService{client: client}
+}
+
+// SplitTestCreateRequest represents the request for creating a split test
+type SplitTestCreateRequest struct {
+	AdvertiserID string            `json:"advertiser_id"`
+	TestName     string            `json:"split_test_name"`
+	TestVariable SplitTestVariable `json:"test_variable"`
+	AdGroupIDs   []string          `json:"adgroup_ids"`
+
+	// BudgetSplit is each ad group's percentage of the test budget, in the
+	// order of AdGroupIDs; the budget is split evenly when empty
+	BudgetSplit []int `json:"budget_split,omitempty"`
+
+	// StartTime and EndTime use the "2006-01-02 15:04:05" layout in UTC
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+
+	// KeyMetric is the metric the winner is decided on, e.g. "cpa"
+	KeyMetric string `json:"key_metric,omitempty"`
+}
+
+// SplitTest represents a split test
+type SplitTest struct {
+	SplitTestID  string            `json:"split_test_id"`
+	TestName     string            `json:"split_test_name"`
+	TestVariable SplitTestVariable `json:"test_variable"`
+	Status       SplitTestStatus   `json:"status"`
+	AdGroupIDs   []string          `json:"adgroup_ids"`
+	BudgetSplit  []int             `json:"budget_split,omitempty"`
+	KeyMetric    string            `json:"key_metric,omitempty"`
+	StartTime    models.Time       `json:"start_time"`
+	EndTime      models.Time       `json:"end_time"`
+}
+
+// SplitTestGroupResult is the performance of one ad group in a split test
+type SplitTestGroupResult struct {
+	AdGroupID         string             `json:"adgroup_id"`
+	Spend             models.MetricValue `json:"spend"`
+	Conversions       models.MetricValue `json:"conversion"`
+	CostPerConversion models.MetricValue `json:"cost_per_conversion"`
+	KeyMetricValue    models.MetricValue `json:"key_metric_value"`
+}
+
+// SplitTestResult is the outcome of a split test
+type SplitTestResult struct {
+	SplitTestID string          `json:"split_test_id"`
+	Status      SplitTestStatus `json:"status"`
+	KeyMetric   string          `json:"key_metric"`
+
+	// WinnerAdGroupID is empty until the test has a statistically
+	// significant winner
+	WinnerAdGroupID string `json:"winner_adgroup_id"`
+
+	// Confidence is the percentage confidence that the winner would win again
+	Confidence models.MetricValue     `json:"confidence"`
+	Groups     []SplitTestGroupResult `json:"groups"`
+}
+
+// SplitTestCreateResponse represents the response for creating a split test
+type SplitTestCreateResponse struct {
+	models.BaseResponse
+	Data struct {
+		SplitTestID string `json:"split_test_id"`
+	} `json:"data"`
+}
+
+// SplitTestResponse represents the response for getting a split test
+type SplitTestResponse struct {
+	models.BaseResponse
+	Data SplitTest `json:"data"`
+}
+
+// SplitTestResultResponse represents the response for split test results
+type SplitTestResultResponse struct {
+	models.BaseResponse
+	Data SplitTestResult `json:"data"`
+}
+
+// SplitTestEndResponse represents the response for ending a split test
+type SplitTestEndResponse struct {
+	models.BaseResponse
+	Data struct {
+		SplitTestID string `json:"split_test_id"`
+	} `json:"data"`
+}
+
+// Create starts a split test over existing ad groups
+func (s *SplitTestService) Create(ctx context.Context, req *SplitTestCreateRequest) (*SplitTestCreateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	url, err := s.client.BuildURL("/split_test/create/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create split test: %w", err)
+	}
+
+	var response SplitTestCreateResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Get retrieves a split test and its status
+func (s *SplitTestService) Get(ctx context.Context, advertiserID, splitTestID string) (*SplitTestResponse, error) {
+	url, err := s.testURL("/split_test/get/", advertiserID, splitTestID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get split test: %w", err)
+	}
+
+	var response SplitTestResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetResult retrieves the per ad group performance of a split test and its
+// winner, if one has been decided
+func (s *SplitTestService) GetResult(ctx context.Context, advertiserID, splitTestID string) (*SplitTestResultResponse, error) {
+	url, err := s.testURL("/split_test/result/get/", advertiserID, splitTestID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoRequest(ctx, "GET", url, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get split test result: %w", err)
+	}
+
+	var response SplitTestResultResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// End stops a running split test before its end time. The ad groups keep
+// delivering as ordinary ad groups.
+func (s *SplitTestService) End(ctx context.Context, advertiserID, splitTestID string) (*SplitTestEndResponse, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if splitTestID == "" {
+		return nil, fmt.Errorf("split_test_id is required")
+	}
+
+	url, err := s.client.BuildURL("/split_test/end/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"advertiser_id": advertiserID,
+		"split_test_id": splitTestID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(body)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to end split test: %w", err)
+	}
+
+	var response SplitTestEndResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// testURL builds the URL of a GET endpoint addressing one split test
+func (s *SplitTestService) testURL(endpoint, advertiserID, splitTestID string) (string, error) {
+	if advertiserID == "" {
+		return "", fmt.Errorf("advertiser_id is required")
+	}
+	if splitTestID == "" {
+		return "", fmt.Errorf("split_test_id is required")
+	}
+	return s.client.BuildURL(endpoint, map[string]interface{}{
+		"advertiser_id": advertiserID,
+		"split_test_id": splitTestID,
+	})
+}
+
+// Winner returns the results of the winning ad group, or false while the
+// test has no winner
+func (r *SplitTestResult) Winner() (*SplitTestGroupResult, bool) {
+	if r.WinnerAdGroupID == "" {
+		return nil, false
+	}
+	for i := range r.Groups {
+		if r.Groups[i].AdGroupID == r.WinnerAdGroupID {
+			return &r.Groups[i], true
+		}
+	}
+	return nil, false
+}
+
+// Validate checks the ad groups, budget split and duration of the test
+func (r *SplitTestCreateRequest) Validate() error {
+	if r.AdvertiserID == "" {
+		return models.NewValidationError("advertiser_id", "advertiser_id is required")
+	}
+	if r.TestName == "" {
+		return models.NewValidationError("split_test_name", "split_test_name is required")
+	}
+	switch r.TestVariable {
+	case SplitTestVariableTargeting, SplitTestVariableBidding, SplitTestVariableCreative, SplitTestVariableCustom:
+	default:
+		return models.NewValidationError("test_variable", "test_variable must be TARGETING, BIDDING_OPTIMIZATION, CREATIVE or CUSTOM")
+	}
+
+	if len(r.AdGroupIDs) < MinSplitTestAdGroups || len(r.AdGroupIDs) > MaxSplitTestAdGroups {
+		return models.NewValidationError("adgroup_ids", fmt.Sprintf("a split test compares %d to %d ad groups", MinSplitTestAdGroups, MaxSplitTestAdGroups))
+	}
+	seen := make(map[string]bool, len(r.AdGroupIDs))
+	for _, id := range r.AdGroupIDs {
+		if id == "" {
+			return models.NewValidationError("adgroup_ids", "ad group ID cannot be empty")
+		}
+		if seen[id] {
+			return models.NewValidationError("adgroup_ids", fmt.Sprintf("ad group %s is listed more than once", id))
+		}
+		seen[id] = true
+	}
+
+	if len(r.BudgetSplit) > 0 {
+		if len(r.BudgetSplit) != len(r.AdGroupIDs) {
+			return models.NewValidationError("budget_split", "budget_split needs one percentage per ad group")
+		}
+		total := 0
+		for _, share := range r.BudgetSplit {
+			if share <= 0 {
+				return models.NewValidationError("budget_split", "every ad group needs a positive share of the budget")
+			}
+			total += share
+		}
+		if total != 100 {
+			return models.NewValidationError("budget_split", fmt.Sprintf("budget_split adds up to %d%%, not 100%%", total))
+		}
+	}
+
+	start, err := time.Parse(models.DateTimeLayout, r.StartTime)
+	if err != nil {
+		return models.NewValidationError("start_time", "start_time must be in YYYY-MM-DD HH:MM:SS format")
+	}
+	end, err := time.Parse(models.DateTimeLayout, r.EndTime)
+	if err != nil {
+		return models.NewValidationError("end_time", "end_time must be in YYYY-MM-DD HH:MM:SS format")
+	}
+	if duration := end.Sub(start); duration < MinSplitTestDuration || duration > MaxSplitTestDuration {
+		return models.NewValidationError("end_time", fmt.Sprintf("a split test runs for %d to %d days", MinSplitTestDuration/(24*time.Hour), MaxSplitTestDuration/(24*time.Hour)))
+	}
+	return nil
+}
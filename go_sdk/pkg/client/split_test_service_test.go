@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestSplitTestService(t *testing.T) {
+	var created SplitTestCreateRequest
+	var ended map[string]string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open_api/v1.3/split_test/create/":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.Write([]byte(`{"code":0,"data":{"split_test_id":"st1"}}`))
+		case "/open_api/v1.3/split_test/get/":
+			if r.URL.Query().Get("split_test_id") != "st1" {
+				t.Errorf("split_test_id = %s", r.URL.Query().Get("split_test_id"))
+			}
+			w.Write([]byte(`{"code":0,"data":{"split_test_id":"st1","status":"IN_PROGRESS","adgroup_ids":["g1","g2"],"start_time":"2026-06-01 00:00:00"}}`))
+		case "/open_api/v1.3/split_test/result/get/":
+			w.Write([]byte(`{"code":0,"data":{"split_test_id":"st1","status":"FINISHED","key_metric":"cpa","winner_adgroup_id":"g2","confidence":"92.5",
+				"groups":[{"adgroup_id":"g1","spend":"500.00","cost_per_conversion":"12.50"},{"adgroup_id":"g2","spend":"500.00","cost_per_conversion":"8.20"}]}}`))
+		case "/open_api/v1.3/split_test/end/":
+			json.NewDecoder(r.Body).Decode(&ended)
+			w.Write([]byte(`{"code":0,"data":{"split_test_id":"st1"}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	req := &SplitTestCreateRequest{
+		AdvertiserID: "123",
+		TestName:     "Broad vs interest",
+		TestVariable: SplitTestVariableTargeting,
+		AdGroupIDs:   []string{"g1", "g2"},
+		BudgetSplit:  []int{50, 50},
+		StartTime:    "2026-06-01 00:00:00",
+		EndTime:      "2026-06-15 00:00:00",
+		KeyMetric:    "cpa",
+	}
+	createResp, err := client.SplitTest().Create(ctx, req)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if createResp.Data.SplitTestID != "st1" || created.TestVariable != SplitTestVariableTargeting || len(created.BudgetSplit) != 2 {
+		t.Errorf("created %+v, sent %+v", createResp.Data, created)
+	}
+
+	test, err := client.SplitTest().Get(ctx, "123", "st1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if test.Data.Status != SplitTestStatusRunning || test.Data.StartTime.IsZero() {
+		t.Errorf("split test = %+v", test.Data)
+	}
+
+	result, err := client.SplitTest().GetResult(ctx, "123", "st1")
+	if err != nil {
+		t.Fatalf("GetResult() error = %v", err)
+	}
+	winner, ok := result.Data.Winner()
+	if !ok || winner.AdGroupID != "g2" || winner.CostPerConversion != 8.2 || result.Data.Confidence != 92.5 {
+		t.Errorf("winner = %+v, %v", winner, ok)
+	}
+	if _, ok := (&SplitTestResult{Groups: result.Data.Groups}).Winner(); ok {
+		t.Error("Winner() without a winner_adgroup_id should report none")
+	}
+
+	if _, err := client.SplitTest().End(ctx, "123", "st1"); err != nil {
+		t.Fatalf("End() error = %v", err)
+	}
+	if ended["split_test_id"] != "st1" {
+		t.Errorf("ended %v", ended)
+	}
+
+	invalid := []func(r *SplitTestCreateRequest){
+		func(r *SplitTestCreateRequest) { r.TestVariable = "PLACEMENT" },
+		func(r *SplitTestCreateRequest) { r.AdGroupIDs = []string{"g1"} },
+		func(r *SplitTestCreateRequest) { r.AdGroupIDs = []string{"g1", "g1"} },
+		func(r *SplitTestCreateRequest) { r.BudgetSplit = []int{60, 30} },
+		func(r *SplitTestCreateRequest) { r.BudgetSplit = []int{100} },
+		func(r *SplitTestCreateRequest) { r.EndTime = "2026-06-03 00:00:00" },
+		func(r *SplitTestCreateRequest) { r.EndTime = "2026-08-01 00:00:00" },
+		func(r *SplitTestCreateRequest) { r.StartTime = "2026-06-01" },
+	}
+	for i, mutate := range invalid {
+		r := *req
+		mutate(&r)
+		if err := r.Validate(); err == nil {
+			t.Errorf("case %d: Validate() should fail for %+v", i, r)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CacheStats reports the effectiveness of an SDK cache
+type CacheStats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+	Size   int   `json:"size"`
+}
+
+// HitRate returns the fraction of lookups served from the cache
+func (s CacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// RateLimiterStats reports the state of the client rate limiter
+type RateLimiterStats struct {
+	Enabled     bool    `json:"enabled"`
+	Limit       float64 `json:"limit"`
+	Burst       int     `json:"burst"`
+	Tokens      float64 `json:"tokens"`
+	Waits       int64   `json:"waits"`
+	WaitSeconds float64 `json:"wait_seconds"`
+}
+
+// ClientStats is a point-in-time snapshot of SDK health
+type ClientStats struct {
+	Requests           int64                 `json:"requests"`
+	Attempts           int64                 `json:"attempts"`
+	Retries            int64                 `json:"retries"`
+	Failures           int64                 `json:"failures"`
+	RateLimiter        RateLimiterStats      `json:"rate_limiter"`
+	Caches             map[string]CacheStats `json:"caches,omitempty"`
+	TokenExpiresAt     *time.Time            `json:"token_expires_at,omitempty"`
+	TokenExpirySeconds float64               `json:"token_expiry_seconds,omitempty"`
+
+	// CircuitBreakers reports the circuit of every endpoint group seen so
+	// far when Config.CircuitBreaker is set
+	CircuitBreakers map[string]CircuitBreakerStats `json:"circuit_breakers,omitempty"`
+}
+
+// expiringTokenSource is a TokenSource that knows when its token expires
+type expiringTokenSource interface {
+	expiry() time.Time
+}
+
+// clientStats holds the counters behind Client.Stats
+type clientStats struct {
+	requests  atomic.Int64
+	attempts  atomic.Int64
+	retries   atomic.Int64
+	failures  atomic.Int64
+	waits     atomic.Int64
+	waitNanos atomic.Int64
+
+	mu          sync.RWMutex
+	caches      map[string]func() CacheStats
+	tokenExpiry time.Time
+}
+
+// RegisterCacheStats exposes a cache's counters under name in Stats and the stats handler
+func (c *Client) RegisterCacheStats(name string, fn func() CacheStats) {
+	c.stats.mu.Lock()
+	defer c.stats.mu.Unlock()
+
+	if c.stats.caches == nil {
+		c.stats.caches = make(map[string]func() CacheStats)
+	}
+	c.stats.caches[name] = fn
+}
+
+// SetAccessTokenExpiry records when Config.AccessToken expires so the stats
+// report a countdown. A RefreshingTokenSource in Config.TokenSource reports
+// its own expiry instead. Tokens in Config.TokenStore belong to many
+// advertisers and are not reported.
+func (c *Client) SetAccessTokenExpiry(expiresAt time.Time) {
+	c.stats.mu.Lock()
+	defer c.stats.mu.Unlock()
+
+	c.stats.tokenExpiry = expiresAt
+}
+
+// Stats returns a snapshot of request, retry, rate limiter, circuit breaker,
+// cache and token statistics
+func (c *Client) Stats() ClientStats {
+	stats := ClientStats{
+		Requests: c.stats.requests.Load(),
+		Attempts: c.stats.attempts.Load(),
+		Retries:  c.stats.retries.Load(),
+		Failures: c.stats.failures.Load(),
+		RateLimiter: RateLimiterStats{
+			Waits:       c.stats.waits.Load(),
+			WaitSeconds: time.Duration(c.stats.waitNanos.Load()).Seconds(),
+		},
+	}
+
+	if c.rateLimiter != nil {
+		stats.RateLimiter.Enabled = true
+		stats.RateLimiter.Limit = float64(c.rateLimiter.Limit())
+		stats.RateLimiter.Burst = c.rateLimiter.Burst()
+		stats.RateLimiter.Tokens = c.rateLimiter.Tokens()
+	}
+	if c.breakers != nil {
+		stats.CircuitBreakers = c.breakers.stats()
+	}
+
+	c.stats.mu.RLock()
+	defer c.stats.mu.RUnlock()
+
+	if len(c.stats.caches) > 0 {
+		stats.Caches = make(map[string]CacheStats, len(c.stats.caches))
+		for name, fn := range c.stats.caches {
+			stats.Caches[name] = fn()
+		}
+	}
+	expiry := c.stats.tokenExpiry
+	if source, ok := c.config.TokenSource.(expiringTokenSource); ok {
+		if sourceExpiry := source.expiry(); !sourceExpiry.IsZero() {
+			expiry = sourceExpiry
+		}
+	}
+	if !expiry.IsZero() {
+		stats.TokenExpiresAt = &expiry
+		stats.TokenExpirySeconds = time.Until(expiry).Seconds()
+	}
+
+	return stats
+}
+
+// NewStatsHandler returns an HTTP handler serving the client's stats as JSON,
+// or in the Prometheus text format when requested with ?format=prometheus or
+// an Accept header of text/plain. When Config.Metrics is a
+// PrometheusCollector, its per-endpoint request counts and latencies are
+// served too, so one scrape covers both.
+func NewStatsHandler(c *Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stats := c.Stats()
+
+		if r.URL.Query().Get("format") == "prometheus" || strings.HasPrefix(r.Header.Get("Accept"), "text/plain") {
+			w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+			collector, _ := c.config.Metrics.(*PrometheusCollector)
+			_, _ = w.Write([]byte(stats.prometheus(collector)))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(stats)
+	})
+}
+
+// prometheus renders the stats in the Prometheus text exposition format.
+// With a collector, the request counter is broken down by endpoint and
+// status and the latency histogram is included.
+func (s ClientStats) prometheus(collector *PrometheusCollector) string {
+	var w promWriter
+
+	if collector != nil {
+		collector.writeRequests(&w)
+	} else {
+		w.metric("tiktok_sdk_requests_total", "counter", "API requests issued.", float64(s.Requests))
+	}
+	w.metric("tiktok_sdk_attempts_total", "counter", "HTTP attempts including retries.", float64(s.Attempts))
+	w.metric("tiktok_sdk_retries_total", "counter", "HTTP attempts that were retries.", float64(s.Retries))
+	w.metric("tiktok_sdk_failures_total", "counter", "Requests that failed after all attempts.", float64(s.Failures))
+	w.metric("tiktok_sdk_rate_limiter_waits_total", "counter", "Requests that waited on the rate limiter.", float64(s.RateLimiter.Waits))
+	w.metric("tiktok_sdk_rate_limiter_wait_seconds_total", "counter", "Time spent waiting on the rate limiter.", s.RateLimiter.WaitSeconds)
+	if s.RateLimiter.Enabled {
+		w.metric(rateLimiterTokensMetric, "gauge", rateLimiterTokensHelp, s.RateLimiter.Tokens)
+	}
+
+	names := make([]string, 0, len(s.Caches))
+	for name := range s.Caches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) > 0 {
+		w.family("tiktok_sdk_cache_hit_ratio", "gauge", "Fraction of cache lookups served from the cache.")
+		for _, name := range names {
+			w.sample("tiktok_sdk_cache_hit_ratio", s.Caches[name].HitRate(), "cache", name)
+		}
+	}
+
+	groups := make([]string, 0, len(s.CircuitBreakers))
+	for group := range s.CircuitBreakers {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	if len(groups) > 0 {
+		w.family("tiktok_sdk_circuit_breaker_open", "gauge", "Whether the endpoint group's circuit breaker rejects requests (1 open, 0.5 half-open).")
+		for _, group := range groups {
+			value := 0.0
+			switch s.CircuitBreakers[group].State {
+			case CircuitOpen:
+				value = 1
+			case CircuitHalfOpen:
+				value = 0.5
+			}
+			w.sample("tiktok_sdk_circuit_breaker_open", value, "group", group)
+		}
+		w.family("tiktok_sdk_circuit_breaker_rejected_total", "counter", "Requests rejected by an open circuit breaker.")
+		for _, group := range groups {
+			w.sample("tiktok_sdk_circuit_breaker_rejected_total", float64(s.CircuitBreakers[group].Rejected), "group", group)
+		}
+	}
+
+	if s.TokenExpiresAt != nil {
+		w.metric("tiktok_sdk_token_expiry_seconds", "gauge", "Seconds until the access token expires.", s.TokenExpirySeconds)
+	}
+
+	return w.String()
+}
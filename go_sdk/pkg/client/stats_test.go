@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStatsHandler(t *testing.T) {
+	attempts := 0
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writeJSON(w, `{"code": 0, "message": "success"}`)
+	})
+
+	client := newTestClient(t, server, func(c *Config) { c.UserAgent = "tiktok-business-api-go-sdk/1.0.0" })
+	client.RegisterCacheStats("targeting", func() CacheStats { return CacheStats{Hits: 3, Misses: 1} })
+
+	resp, err := client.DoRequest(context.Background(), "GET", "/test", nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+	resp.Body.Close()
+
+	stats := client.Stats()
+	if stats.Requests != 1 || stats.Attempts != 2 || stats.Retries != 1 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+
+	rec := httptest.NewRecorder()
+	NewStatsHandler(client).ServeHTTP(rec, httptest.NewRequest("GET", "/stats?format=prometheus", nil))
+	body := rec.Body.String()
+	if !strings.Contains(body, "tiktok_sdk_retries_total 1") {
+		t.Errorf("Expected retries metric, got:\n%s", body)
+	}
+	if !strings.Contains(body, `tiktok_sdk_cache_hit_ratio{cache="targeting"} 0.75`) {
+		t.Errorf("Expected cache hit ratio metric, got:\n%s", body)
+	}
+}
+
+func TestStats_TokenExpiry(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"code": 0, "message": "success"}`)
+	})
+
+	client := newTestClient(t, server)
+	if stats := client.Stats(); stats.TokenExpiresAt != nil {
+		t.Errorf("TokenExpiresAt = %v for a token of unknown expiry", stats.TokenExpiresAt)
+	}
+
+	// A refreshing source reports the expiry of its current token
+	source := NewRefreshingTokenSource(client.Auth(), &TokenResponse{AccessToken: "live", RefreshToken: "refresh", ExpiresIn: 3600})
+	client.config.TokenSource = source
+	if stats := client.Stats(); stats.TokenExpiresAt == nil || stats.TokenExpirySeconds < 3590 || stats.TokenExpirySeconds > 3600 {
+		t.Errorf("token source expiry = %v, %gs, want about an hour", stats.TokenExpiresAt, stats.TokenExpirySeconds)
+	}
+	client.config.TokenSource = nil
+
+	// Tokens of the token store belong to other advertisers and are left out
+	ctx := context.Background()
+	client.SetAccessTokenExpiry(time.Now().Add(2 * time.Hour))
+	store := NewMemoryTokenStore()
+	store.Set(ctx, "adv", &StoredToken{AccessToken: "stored", ExpiresAt: time.Now().Add(30 * time.Minute)})
+	client.config.TokenStore = store
+	resp, err := client.DoRequest(WithAdvertiserToken(ctx, "adv"), "GET", "/test", nil, nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+	resp.Body.Close()
+	if stats := client.Stats(); stats.TokenExpiresAt == nil || stats.TokenExpirySeconds < 7190 || stats.TokenExpirySeconds > 7200 {
+		t.Errorf("access token expiry = %v, %gs, want about two hours", stats.TokenExpiresAt, stats.TokenExpirySeconds)
+	}
+}
+
+func TestStats_Caches(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, fmt.Sprintf(`{"code":0,"data":{"expires_at":%d}}`, time.Now().Add(time.Hour).Unix()))
+	})
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if _, err := client.Auth().ValidateToken(ctx, "live"); err != nil {
+			t.Fatalf("ValidateToken() error = %v", err)
+		}
+	}
+
+	cache, err := NewEntityCache(client, EntityCacheConfig{AdvertiserID: "123"})
+	if err != nil {
+		t.Fatalf("NewEntityCache() error = %v", err)
+	}
+	cache.Campaign("c1")
+	cache.FindAdByName("", "Spring")
+
+	caches := client.Stats().Caches
+	if got := caches["token_validation"]; got.Hits != 2 || got.Misses != 1 || got.Size != 1 {
+		t.Errorf("token_validation stats = %+v", got)
+	}
+	if got := caches["entities_123"]; got.Hits != 0 || got.Misses != 2 || got.Size != 0 {
+		t.Errorf("entities_123 stats = %+v", got)
+	}
+}
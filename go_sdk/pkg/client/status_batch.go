@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+// Maximum IDs accepted by one status update call
+const (
+	MaxCampaignStatusIDs = 20
+	MaxAdGroupStatusIDs  = 100
+	MaxAdStatusIDs       = 100
+)
+
+// BatchStatusOptions tunes the batch status helpers
+type BatchStatusOptions struct {
+	// BatchSize is the number of IDs per call, capped at and defaulting to
+	// the API limit of the entity
+	BatchSize int
+
+	// Concurrency caps parallel calls (defaults to 4)
+	Concurrency int
+}
+
+// BatchStatusResult merges the outcome of a batched status update
+type BatchStatusResult struct {
+	Operation string `json:"operation"`
+
+	// Succeeded lists the updated IDs in request order
+	Succeeded []string `json:"succeeded"`
+
+	// Failed maps each ID that was not updated to the reason
+	Failed map[string]error `json:"-"`
+
+	// Batches is the number of calls made
+	Batches int `json:"batches"`
+}
+
+// Err returns nil when every ID was updated, or an error counting the failures
+func (r *BatchStatusResult) Err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(r.Failed))
+	for id := range r.Failed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return fmt.Errorf("%s failed for %d of %d IDs, first %s: %w", r.Operation, len(ids), len(ids)+len(r.Succeeded), ids[0], r.Failed[ids[0]])
+}
+
+// errNotUpdated is recorded for IDs missing from a successful batch response
+var errNotUpdated = errors.New("not updated: missing from the API response")
+
+// UpdateCampaignStatusBatch enables, disables or deletes any number of
+// campaigns, splitting the IDs into calls of at most MaxCampaignStatusIDs
+// made concurrently. A failed call fails only its own IDs. opts may be nil.
+func (c *Client) UpdateCampaignStatusBatch(ctx context.Context, advertiserID string, campaignIDs []string, operation string, opts *BatchStatusOptions) (*BatchStatusResult, error) {
+	return c.updateStatusBatch(ctx, advertiserID, campaignIDs, operation, MaxCampaignStatusIDs, opts, func(ctx context.Context, ids []string) ([]string, error) {
+		resp, err := c.Campaign().UpdateStatus(ctx, &CampaignStatusUpdateRequest{AdvertiserID: advertiserID, CampaignIDs: ids, Operation: operation})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, fmt.Errorf("API error: %s", resp.Message)
+		}
+		return resp.Data.CampaignIDs, nil
+	})
+}
+
+// UpdateAdGroupStatusBatch enables, disables or deletes any number of ad
+// groups in concurrent calls of at most MaxAdGroupStatusIDs. opts may be nil.
+func (c *Client) UpdateAdGroupStatusBatch(ctx context.Context, advertiserID string, adGroupIDs []string, operation string, opts *BatchStatusOptions) (*BatchStatusResult, error) {
+	return c.updateStatusBatch(ctx, advertiserID, adGroupIDs, operation, MaxAdGroupStatusIDs, opts, func(ctx context.Context, ids []string) ([]string, error) {
+		resp, err := c.AdGroup().UpdateStatus(ctx, &AdGroupStatusUpdateRequest{AdvertiserID: advertiserID, AdGroupIDs: ids, Operation: operation})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, fmt.Errorf("API error: %s", resp.Message)
+		}
+		return resp.Data.AdGroupIDs, nil
+	})
+}
+
+// UpdateAdStatusBatch enables, disables or deletes any number of ads in
+// concurrent calls of at most MaxAdStatusIDs. opts may be nil.
+func (c *Client) UpdateAdStatusBatch(ctx context.Context, advertiserID string, adIDs []string, operation string, opts *BatchStatusOptions) (*BatchStatusResult, error) {
+	return c.updateStatusBatch(ctx, advertiserID, adIDs, operation, MaxAdStatusIDs, opts, func(ctx context.Context, ids []string) ([]string, error) {
+		resp, err := c.Ad().UpdateStatus(ctx, &AdStatusUpdateRequest{AdvertiserID: advertiserID, AdIDs: ids, Operation: operation})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, fmt.Errorf("API error: %s", resp.Message)
+		}
+		return resp.Data.AdIDs, nil
+	})
+}
+
+// updateStatusBatch splits ids into batches and merges the per-ID outcomes.
+// update returns the IDs the API reports as updated; when it reports none,
+// the whole batch is taken as updated.
+func (c *Client) updateStatusBatch(ctx context.Context, advertiserID string, ids []string, operation string, limit int, opts *BatchStatusOptions, update func(ctx context.Context, ids []string) ([]string, error)) (*BatchStatusResult, error) {
+	if err := utils.ValidateRequiredString(advertiserID, "advertiser_id"); err != nil {
+		return nil, err
+	}
+	if err := validateOperationStatus(operation); err != nil {
+		return nil, err
+	}
+
+	batchSize, concurrency := limit, 4
+	if opts != nil {
+		if opts.BatchSize > 0 && opts.BatchSize < limit {
+			batchSize = opts.BatchSize
+		}
+		if opts.Concurrency > 0 {
+			concurrency = opts.Concurrency
+		}
+	}
+
+	// Drop duplicates and blanks so each ID is updated and reported once
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	result := &BatchStatusResult{Operation: operation, Failed: make(map[string]error)}
+	updated := make(map[string]bool, len(unique))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for start := 0; start < len(unique); start += batchSize {
+		end := start + batchSize
+		if end > len(unique) {
+			end = len(unique)
+		}
+		batch := unique[start:end]
+		result.Batches++
+
+		wg.Add(1)
+		go func(batch []string) {
+			defer wg.Done()
+			var confirmed []string
+			var err error
+			select {
+			case sem <- struct{}{}:
+				confirmed, err = update(ctx, batch)
+				<-sem
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				for _, id := range batch {
+					result.Failed[id] = err
+				}
+				return
+			}
+			if len(confirmed) == 0 {
+				confirmed = batch
+			}
+			for _, id := range confirmed {
+				updated[id] = true
+			}
+			for _, id := range batch {
+				if !updated[id] {
+					result.Failed[id] = errNotUpdated
+				}
+			}
+		}(batch)
+	}
+	wg.Wait()
+
+	for _, id := range unique {
+		if updated[id] {
+			result.Succeeded = append(result.Succeeded, id)
+		}
+	}
+	return result, nil
+}
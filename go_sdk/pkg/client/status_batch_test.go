@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestClient_UpdateStatusBatch(t *testing.T) {
+	var mu sync.Mutex
+	batchSizes := make(map[string][]int)
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			CampaignIDs []string `json:"campaign_ids"`
+			AdIDs       []string `json:"ad_ids"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/campaign/status/update/"):
+			batchSizes["campaign"] = append(batchSizes["campaign"], len(req.CampaignIDs))
+			if containsString(req.CampaignIDs, "c-40") {
+				_, _ = w.Write([]byte(`{"code": 40002, "message": "campaign not found"}`))
+				return
+			}
+			var updated []string
+			for _, id := range req.CampaignIDs {
+				if id != "c-5" {
+					updated = append(updated, id)
+				}
+			}
+			ids, _ := json.Marshal(updated)
+			fmt.Fprintf(w, `{"code": 0, "data": {"campaign_ids": %s}}`, ids)
+		case strings.HasSuffix(r.URL.Path, "/ad/status/update/"):
+			batchSizes["ad"] = append(batchSizes["ad"], len(req.AdIDs))
+			_, _ = w.Write([]byte(`{"code": 0, "data": {}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, server)
+
+	var campaignIDs []string
+	for i := 0; i < 45; i++ {
+		campaignIDs = append(campaignIDs, fmt.Sprintf("c-%d", i))
+	}
+	campaignIDs = append(campaignIDs, "c-0")
+
+	result, err := client.UpdateCampaignStatusBatch(context.Background(), "123", campaignIDs, "DISABLE", nil)
+	if err != nil {
+		t.Fatalf("UpdateCampaignStatusBatch() error = %v", err)
+	}
+	sort.Ints(batchSizes["campaign"])
+	if result.Batches != 3 || !reflect.DeepEqual(batchSizes["campaign"], []int{5, 20, 20}) {
+		t.Errorf("batches = %d, sizes = %v", result.Batches, batchSizes["campaign"])
+	}
+	if len(result.Succeeded) != 39 || result.Succeeded[0] != "c-0" {
+		t.Errorf("succeeded = %v", result.Succeeded)
+	}
+	if len(result.Failed) != 6 || !errors.Is(result.Failed["c-5"], errNotUpdated) || result.Failed["c-41"] == nil {
+		t.Errorf("failed = %v", result.Failed)
+	}
+	if result.Err() == nil {
+		t.Error("Expected Err() to report the failed IDs")
+	}
+
+	var adIDs []string
+	for i := 0; i < 150; i++ {
+		adIDs = append(adIDs, fmt.Sprintf("a-%d", i))
+	}
+	result, err = client.UpdateAdStatusBatch(context.Background(), "123", adIDs, "ENABLE", &BatchStatusOptions{Concurrency: 1})
+	if err != nil || result.Err() != nil || len(result.Succeeded) != 150 {
+		t.Fatalf("UpdateAdStatusBatch() = %+v, %v", result, err)
+	}
+	sort.Ints(batchSizes["ad"])
+	if !reflect.DeepEqual(batchSizes["ad"], []int{50, 100}) {
+		t.Errorf("ad batch sizes = %v", batchSizes["ad"])
+	}
+
+	if _, err := client.UpdateAdGroupStatusBatch(context.Background(), "123", []string{"g"}, "PAUSE", nil); err == nil {
+		t.Error("Expected an invalid operation to be rejected")
+	}
+	if _, err := client.Campaign().UpdateStatus(context.Background(), &CampaignStatusUpdateRequest{AdvertiserID: "123", CampaignIDs: campaignIDs, Operation: "DISABLE"}); err == nil {
+		t.Error("Expected UpdateStatus to reject more than MaxCampaignStatusIDs IDs")
+	}
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// EntityStatus is the current status of a campaign, ad group or ad
+type EntityStatus struct {
+	Entity          string // campaign, ad group or ad
+	ID              string
+	OperationStatus models.OperationStatus
+	SecondaryStatus models.SecondaryStatus
+}
+
+// Deleted reports whether the entity, or a campaign or ad group above it,
+// has been deleted
+func (s EntityStatus) Deleted() bool {
+	return s.OperationStatus == models.OperationStatusDelete || s.SecondaryStatus.Deleted() || s.SecondaryStatus.ParentDeleted()
+}
+
+// ValidateTransition checks that operation can be applied to the entity. A
+// deleted entity can only be restored, by disabling it; nothing can be done
+// to one deleted along with its parent.
+func (s EntityStatus) ValidateTransition(operation models.OperationStatus) error {
+	if err := validateOperationStatus(string(operation)); err != nil {
+		return err
+	}
+	switch {
+	case s.SecondaryStatus.ParentDeleted():
+		return models.NewValidationError("operation_status", fmt.Sprintf("cannot %s %s %s: its parent was deleted (%s)", operation, s.Entity, s.ID, s.SecondaryStatus))
+	case !s.Deleted():
+		return nil
+	case operation == models.OperationStatusEnable:
+		return models.NewValidationError("operation_status", fmt.Sprintf("cannot ENABLE %s %s: it is deleted; restore it first, which leaves it disabled", s.Entity, s.ID))
+	case operation == models.OperationStatusDelete:
+		return models.NewValidationError("operation_status", fmt.Sprintf("cannot DELETE %s %s: it is already deleted", s.Entity, s.ID))
+	}
+	return nil
+}
+
+// statusLookupPageSize is the most IDs looked up per get call, the largest
+// page the get endpoints return
+const statusLookupPageSize = 1000
+
+// statusFilterAll is the primary_status filter of the get endpoints that
+// includes deleted entities, which are left out by default
+const statusFilterAll = "STATUS_ALL"
+
+// checkStatusTransitions looks up the entities about to be updated when
+// Config.CheckStatusTransitions is set and rejects the update if the
+// operation cannot apply to any of them, or if any of them is not found.
+func (c *Client) checkStatusTransitions(ctx context.Context, entity string, ids []string, operation string, lookup func(ctx context.Context, ids []string) ([]EntityStatus, error)) error {
+	if !c.config.CheckStatusTransitions {
+		return nil
+	}
+	found := make(map[string]bool, len(ids))
+	var errs []error
+	for chunk := range slices.Chunk(ids, statusLookupPageSize) {
+		statuses, err := lookup(ctx, chunk)
+		if err != nil {
+			return fmt.Errorf("failed to check current status: %w", err)
+		}
+		for _, status := range statuses {
+			found[status.ID] = true
+			if err := status.ValidateTransition(models.OperationStatus(operation)); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	for _, id := range ids {
+		if !found[id] {
+			errs = append(errs, models.NewValidationError("operation_status", fmt.Sprintf("cannot %s %s %s: it was not found", operation, entity, id)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// campaignStatuses returns the current status of campaigns
+func (c *campaignService) campaignStatuses(ctx context.Context, advertiserID string, ids []string) ([]EntityStatus, error) {
+	resp, err := c.Get(ctx, &CampaignGetRequest{
+		AdvertiserID: advertiserID,
+		Fields:       []string{"campaign_id", "operation_status", "secondary_status"},
+		Filtering:    &CampaignFiltering{CampaignIDs: ids, PrimaryStatus: statusFilterAll},
+		PageSize:     len(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+	}
+	statuses := make([]EntityStatus, 0, len(resp.Data))
+	for _, campaign := range resp.Data {
+		statuses = append(statuses, EntityStatus{
+			Entity:          "campaign",
+			ID:              campaign.CampaignID,
+			OperationStatus: models.OperationStatus(campaign.OperationStatus),
+			SecondaryStatus: models.SecondaryStatus(campaign.SecondaryStatus),
+		})
+	}
+	return statuses, nil
+}
+
+// adGroupStatuses returns the current status of ad groups
+func (a *adGroupService) adGroupStatuses(ctx context.Context, advertiserID string, ids []string) ([]EntityStatus, error) {
+	resp, err := a.Get(ctx, &AdGroupGetRequest{
+		AdvertiserID: advertiserID,
+		Fields:       []string{"adgroup_id", "operation_status", "secondary_status"},
+		Filtering:    &AdGroupFiltering{AdGroupIDs: ids, PrimaryStatus: statusFilterAll},
+		PageSize:     len(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+	}
+	statuses := make([]EntityStatus, 0, len(resp.Data))
+	for _, adGroup := range resp.Data {
+		statuses = append(statuses, EntityStatus{
+			Entity:          "ad group",
+			ID:              adGroup.AdGroupID,
+			OperationStatus: models.OperationStatus(adGroup.OperationStatus),
+			SecondaryStatus: models.SecondaryStatus(adGroup.SecondaryStatus),
+		})
+	}
+	return statuses, nil
+}
+
+// adStatuses returns the current status of ads
+func (a *adService) adStatuses(ctx context.Context, advertiserID string, ids []string) ([]EntityStatus, error) {
+	resp, err := a.Get(ctx, &AdGetRequest{
+		AdvertiserID: advertiserID,
+		Fields:       []string{"ad_id", "operation_status", "secondary_status"},
+		Filtering:    &AdFiltering{AdIDs: ids, PrimaryStatus: statusFilterAll},
+		PageSize:     len(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, models.NewAPIError(fmt.Sprintf("%d", resp.Code), resp.Message, resp.RequestID, 0)
+	}
+	statuses := make([]EntityStatus, 0, len(resp.Data))
+	for _, ad := range resp.Data {
+		statuses = append(statuses, EntityStatus{
+			Entity:          "ad",
+			ID:              ad.AdID,
+			OperationStatus: models.OperationStatus(ad.OperationStatus),
+			SecondaryStatus: models.SecondaryStatus(ad.SecondaryStatus),
+		})
+	}
+	return statuses, nil
+}
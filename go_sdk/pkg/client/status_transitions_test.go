@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestStatusTransitions(t *testing.T) {
+	var updates []string
+	var lookups [][]string
+	campaigns := map[string]string{
+		"c1": `{"campaign_id":"c1","operation_status":"ENABLE","secondary_status":"CAMPAIGN_STATUS_ENABLE"}`,
+		"c2": `{"campaign_id":"c2","operation_status":"DELETE","secondary_status":"CAMPAIGN_STATUS_DELETE"}`,
+	}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/campaign/get/"):
+			var filtering CampaignFiltering
+			json.Unmarshal([]byte(r.URL.Query().Get("filtering")), &filtering)
+			if filtering.PrimaryStatus != "STATUS_ALL" {
+				t.Errorf("lookup primary_status = %q, want deleted campaigns included", filtering.PrimaryStatus)
+			}
+			lookups = append(lookups, filtering.CampaignIDs)
+			var data []string
+			for _, id := range filtering.CampaignIDs {
+				if campaign, ok := campaigns[id]; ok {
+					data = append(data, campaign)
+				}
+			}
+			w.Write([]byte(`{"code":0,"data":[` + strings.Join(data, ",") + `]}`))
+		case strings.HasSuffix(r.URL.Path, "/ad/get/"):
+			w.Write([]byte(`{"code":0,"data":[{"ad_id":"a1","operation_status":"DISABLE","secondary_status":"AD_STATUS_CAMPAIGN_DELETE"}]}`))
+		default:
+			updates = append(updates, r.URL.Path)
+			w.Write([]byte(`{"code":0,"data":{}}`))
+		}
+	})
+
+	client := newTestClient(t, server, func(c *Config) { c.CheckStatusTransitions = true })
+	ctx := context.Background()
+
+	_, err := client.Campaign().UpdateStatus(ctx, &CampaignStatusUpdateRequest{AdvertiserID: "123", CampaignIDs: []string{"c1", "c2"}, Operation: "ENABLE"})
+	var validation models.ValidationError
+	if !errors.As(err, &validation) || !strings.Contains(err.Error(), "cannot ENABLE campaign c2: it is deleted") {
+		t.Errorf("enabling a deleted campaign error = %v", err)
+	}
+	if _, err := client.Campaign().UpdateStatus(ctx, &CampaignStatusUpdateRequest{AdvertiserID: "123", CampaignIDs: []string{"c2"}, Operation: "DELETE"}); err == nil || !strings.Contains(err.Error(), "already deleted") {
+		t.Errorf("deleting a deleted campaign error = %v", err)
+	}
+	if _, err := client.Campaign().Restore(ctx, &CampaignRestoreRequest{AdvertiserID: "123", CampaignIDs: []string{"c2"}}); err != nil {
+		t.Errorf("Restore() error = %v", err)
+	}
+	if _, err := client.Ad().UpdateStatus(ctx, &AdStatusUpdateRequest{AdvertiserID: "123", AdIDs: []string{"a1"}, Operation: "DISABLE"}); err == nil || !strings.Contains(err.Error(), "its parent was deleted") {
+		t.Errorf("disabling an ad of a deleted campaign error = %v", err)
+	}
+	if len(updates) != 1 || !strings.HasSuffix(updates[0], "/campaign/status/update/") {
+		t.Errorf("updates = %v", updates)
+	}
+
+	// IDs not found fail the update
+	_, err = client.Campaign().UpdateStatus(ctx, &CampaignStatusUpdateRequest{AdvertiserID: "123", CampaignIDs: []string{"c1", "c3"}, Operation: "DISABLE"})
+	if !errors.As(err, &validation) || !strings.Contains(err.Error(), "cannot DISABLE campaign c3: it was not found") {
+		t.Errorf("updating a missing campaign error = %v", err)
+	}
+	if len(updates) != 1 {
+		t.Errorf("updates = %v", updates)
+	}
+
+	// Lookups are made a page at a time
+	ids := []string{"c1"}
+	for i := range 1500 {
+		ids = append(ids, fmt.Sprintf("x%d", i))
+	}
+	lookups = nil
+	campaignService := client.Campaign().(*campaignService)
+	err = client.checkStatusTransitions(ctx, "campaign", ids, "DISABLE", func(ctx context.Context, ids []string) ([]EntityStatus, error) {
+		return campaignService.campaignStatuses(ctx, "123", ids)
+	})
+	if err == nil || !strings.Contains(err.Error(), "cannot DISABLE campaign x1499: it was not found") || strings.Contains(err.Error(), "campaign c1:") {
+		t.Errorf("checking 1501 campaigns error = %v", err)
+	}
+	if len(lookups) != 2 || len(lookups[0]) != 1000 || len(lookups[1]) != 501 {
+		t.Errorf("looked up %d pages, want 1000 and 501 IDs", len(lookups))
+	}
+
+	status := EntityStatus{Entity: "ad group", ID: "g1", OperationStatus: models.OperationStatusDisable, SecondaryStatus: models.AdGroupStatusDisable}
+	for _, operation := range []models.OperationStatus{models.OperationStatusEnable, models.OperationStatusDisable, models.OperationStatusDelete} {
+		if err := status.ValidateTransition(operation); err != nil {
+			t.Errorf("ValidateTransition(%s) error = %v", operation, err)
+		}
+	}
+	if err := status.ValidateTransition("PAUSE"); err == nil {
+		t.Error("ValidateTransition(PAUSE) should fail")
+	}
+}
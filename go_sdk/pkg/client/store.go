@@ -0,0 +1,205 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+// Store is a key/value store, partitioned into namespaces, that stateful SDK
+// features can share for persistence: tokens, event batches, labels,
+// audience members and checkpoints each keep to their own namespace. Values
+// are opaque bytes. Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the value stored under key, or nil when there is none
+	Get(ctx context.Context, namespace, key string) ([]byte, error)
+
+	// Put stores a value under key, replacing any previous one
+	Put(ctx context.Context, namespace, key string, value []byte) error
+
+	// List returns the keys of a namespace in sorted order
+	List(ctx context.Context, namespace string) ([]string, error)
+
+	// Delete removes the value stored under key
+	Delete(ctx context.Context, namespace, key string) error
+}
+
+// memoryStore keeps values in process memory
+type memoryStore struct {
+	mu         sync.RWMutex
+	namespaces map[string]map[string][]byte
+}
+
+// NewMemoryStore creates a Store that keeps values in memory. Values are
+// lost when the process exits.
+func NewMemoryStore() Store {
+	return &memoryStore{namespaces: make(map[string]map[string][]byte)}
+}
+
+// Get returns the value stored under key
+func (m *memoryStore) Get(ctx context.Context, namespace, key string) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	value, ok := m.namespaces[namespace][key]
+	if !ok {
+		return nil, nil
+	}
+	return bytes.Clone(value), nil
+}
+
+// Put stores a value under key
+func (m *memoryStore) Put(ctx context.Context, namespace, key string, value []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	putStoreValue(m.namespaces, namespace, key, value)
+	return nil
+}
+
+// List returns the keys of a namespace
+func (m *memoryStore) List(ctx context.Context, namespace string) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return sortedStoreKeys(m.namespaces[namespace]), nil
+}
+
+// Delete removes the value stored under key
+func (m *memoryStore) Delete(ctx context.Context, namespace, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deleteStoreValue(m.namespaces, namespace, key)
+	return nil
+}
+
+// fileStore keeps values in a JSON file readable only by its owner
+type fileStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileStore creates a Store backed by a JSON file. Every write replaces
+// the file atomically, so it suits the modest state of a single process. The
+// file may hold credentials and is written with owner-only permissions.
+func NewFileStore(path string) Store {
+	return &fileStore{path: path}
+}
+
+// Get returns the value stored under key
+func (f *fileStore) Get(ctx context.Context, namespace, key string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	all, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	return all[namespace][key], nil
+}
+
+// Put stores a value under key
+func (f *fileStore) Put(ctx context.Context, namespace, key string, value []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	all, err := f.read()
+	if err != nil {
+		return err
+	}
+	putStoreValue(all, namespace, key, value)
+	return f.write(all)
+}
+
+// List returns the keys of a namespace
+func (f *fileStore) List(ctx context.Context, namespace string) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	all, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	return sortedStoreKeys(all[namespace]), nil
+}
+
+// Delete removes the value stored under key
+func (f *fileStore) Delete(ctx context.Context, namespace, key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	all, err := f.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := all[namespace][key]; !ok {
+		return nil
+	}
+	deleteStoreValue(all, namespace, key)
+	return f.write(all)
+}
+
+// read loads every namespace; a missing file holds none
+func (f *fileStore) read() (map[string]map[string][]byte, error) {
+	all := make(map[string]map[string][]byte)
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return all, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read store: %w", err)
+	}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("failed to parse store: %w", err)
+	}
+	return all, nil
+}
+
+// write replaces the file atomically
+func (f *fileStore) write(all map[string]map[string][]byte) error {
+	data, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal store: %w", err)
+	}
+
+	if err := utils.WriteFileAtomic(f.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write store: %w", err)
+	}
+	return nil
+}
+
+// putStoreValue stores a copy of value, never nil so it reads back as present
+func putStoreValue(namespaces map[string]map[string][]byte, namespace, key string, value []byte) {
+	values, ok := namespaces[namespace]
+	if !ok {
+		values = make(map[string][]byte)
+		namespaces[namespace] = values
+	}
+	values[key] = append([]byte{}, value...)
+}
+
+// deleteStoreValue removes a value, dropping its namespace once empty
+func deleteStoreValue(namespaces map[string]map[string][]byte, namespace, key string) {
+	delete(namespaces[namespace], key)
+	if len(namespaces[namespace]) == 0 {
+		delete(namespaces, namespace)
+	}
+}
+
+// sortedStoreKeys returns the keys of a namespace in sorted order
+func sortedStoreKeys(values map[string][]byte) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
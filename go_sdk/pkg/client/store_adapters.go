@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Store namespaces of the feature stores backed by a Store
+const (
+	StoreNamespaceTokens             = "tokens"
+	StoreNamespaceEventBatches       = "event_batches"
+	StoreNamespaceLabels             = "labels"
+	StoreNamespaceAudienceMembers    = "audience_members"
+	StoreNamespaceCommentCheckpoints = "comment_checkpoints"
+)
+
+// storeNamespace keeps JSON encoded values of one type in a Store namespace
+type storeNamespace[T any] struct {
+	store Store
+	name  string
+}
+
+// get returns the value stored under key, or nil when there is none
+func (n storeNamespace[T]) get(ctx context.Context, key string) (*T, error) {
+	data, err := n.store.Get(ctx, n.name, key)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	value := new(T)
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, fmt.Errorf("failed to parse %s %s: %w", n.name, key, err)
+	}
+	return value, nil
+}
+
+// put stores a value under key
+func (n storeNamespace[T]) put(ctx context.Context, key string, value *T) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s %s: %w", n.name, key, err)
+	}
+	return n.store.Put(ctx, n.name, key, data)
+}
+
+// list returns every stored value in key order
+func (n storeNamespace[T]) list(ctx context.Context) ([]*T, error) {
+	keys, err := n.store.List(ctx, n.name)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]*T, 0, len(keys))
+	for _, key := range keys {
+		value, err := n.get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		// Skip keys deleted since they were listed
+		if value != nil {
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
+// delete removes the value stored under key
+func (n storeNamespace[T]) delete(ctx context.Context, key string) error {
+	return n.store.Delete(ctx, n.name, key)
+}
+
+// storeTokenStore keeps tokens in a Store
+type storeTokenStore struct {
+	tokens storeNamespace[StoredToken]
+}
+
+// NewTokenStore creates a TokenStore that keeps tokens in the tokens
+// namespace of a Store
+func NewTokenStore(store Store) TokenStore {
+	return &storeTokenStore{tokens: storeNamespace[StoredToken]{store: store, name: StoreNamespaceTokens}}
+}
+
+// Get returns the token stored under key
+func (s *storeTokenStore) Get(ctx context.Context, key string) (*StoredToken, error) {
+	return s.tokens.get(ctx, key)
+}
+
+// Set stores a token under key
+func (s *storeTokenStore) Set(ctx context.Context, key string, token *StoredToken) error {
+	return s.tokens.put(ctx, key, token)
+}
+
+// Delete removes the token stored under key
+func (s *storeTokenStore) Delete(ctx context.Context, key string) error {
+	return s.tokens.delete(ctx, key)
+}
+
+// storeEventBatchStore keeps event batches in a Store
+type storeEventBatchStore struct {
+	batches storeNamespace[EventBatch]
+}
+
+// NewEventBatchStore creates an EventBatchStore that keeps batches in the
+// event_batches namespace of a Store
+func NewEventBatchStore(store Store) EventBatchStore {
+	return &storeEventBatchStore{batches: storeNamespace[EventBatch]{store: store, name: StoreNamespaceEventBatches}}
+}
+
+// Put saves a new batch or replaces a batch with the same ID
+func (s *storeEventBatchStore) Put(ctx context.Context, batch *EventBatch) error {
+	return s.batches.put(ctx, batch.ID, batch)
+}
+
+// List returns the stored batches, oldest first
+func (s *storeEventBatchStore) List(ctx context.Context) ([]*EventBatch, error) {
+	batches, err := s.batches.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(batches, func(i, j int) bool { return batches[i].CreatedAt.Before(batches[j].CreatedAt) })
+	return batches, nil
+}
+
+// Delete removes a batch
+func (s *storeEventBatchStore) Delete(ctx context.Context, id string) error {
+	return s.batches.delete(ctx, id)
+}
+
+// storeLabelStore keeps labels in a Store
+type storeLabelStore struct {
+	entities storeNamespace[LabeledEntity]
+}
+
+// NewLabelStore creates a LabelStore that keeps labels in the labels
+// namespace of a Store
+func NewLabelStore(store Store) LabelStore {
+	return &storeLabelStore{entities: storeNamespace[LabeledEntity]{store: store, name: StoreNamespaceLabels}}
+}
+
+// Get returns the labels of an entity
+func (s *storeLabelStore) Get(ctx context.Context, entity LabeledEntity) (map[string]string, error) {
+	stored, err := s.entities.get(ctx, entity.key())
+	if err != nil || stored == nil {
+		return nil, err
+	}
+	return stored.Labels, nil
+}
+
+// Put replaces the labels of an entity
+func (s *storeLabelStore) Put(ctx context.Context, entity LabeledEntity) error {
+	if len(entity.Labels) == 0 {
+		return s.entities.delete(ctx, entity.key())
+	}
+	return s.entities.put(ctx, entity.key(), &entity)
+}
+
+// List returns every labeled entity
+func (s *storeLabelStore) List(ctx context.Context) ([]LabeledEntity, error) {
+	stored, err := s.entities.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entities := make([]LabeledEntity, 0, len(stored))
+	for _, entity := range stored {
+		entities = append(entities, *entity)
+	}
+	return entities, nil
+}
+
+// storeAudienceMemberStore keeps audience members in a Store
+type storeAudienceMemberStore struct {
+	members storeNamespace[[]string]
+}
+
+// NewAudienceMemberStore creates an AudienceMemberStore that keeps members
+// in the audience_members namespace of a Store
+func NewAudienceMemberStore(store Store) AudienceMemberStore {
+	return &storeAudienceMemberStore{members: storeNamespace[[]string]{store: store, name: StoreNamespaceAudienceMembers}}
+}
+
+// Load returns the previously uploaded members for an audience
+func (s *storeAudienceMemberStore) Load(ctx context.Context, audienceID string) ([]string, error) {
+	members, err := s.members.get(ctx, audienceID)
+	if err != nil || members == nil {
+		return nil, err
+	}
+	return *members, nil
+}
+
+// Save records the members currently uploaded to an audience
+func (s *storeAudienceMemberStore) Save(ctx context.Context, audienceID string, members []string) error {
+	return s.members.put(ctx, audienceID, &members)
+}
+
+// storeCommentCheckpointStore keeps comment export checkpoints in a Store
+type storeCommentCheckpointStore struct {
+	checkpoints storeNamespace[CommentCheckpoint]
+}
+
+// NewCommentCheckpointStore creates a CommentCheckpointStore that keeps
+// checkpoints in the comment_checkpoints namespace of a Store
+func NewCommentCheckpointStore(store Store) CommentCheckpointStore {
+	return &storeCommentCheckpointStore{checkpoints: storeNamespace[CommentCheckpoint]{store: store, name: StoreNamespaceCommentCheckpoints}}
+}
+
+// Load returns the checkpoint for an advertiser, or an empty checkpoint
+func (s *storeCommentCheckpointStore) Load(ctx context.Context, advertiserID string) (*CommentCheckpoint, error) {
+	checkpoint, err := s.checkpoints.get(ctx, advertiserID)
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint == nil {
+		return &CommentCheckpoint{}, nil
+	}
+	return checkpoint, nil
+}
+
+// Save records the checkpoint for an advertiser
+func (s *storeCommentCheckpointStore) Save(ctx context.Context, advertiserID string, checkpoint *CommentCheckpoint) error {
+	return s.checkpoints.put(ctx, advertiserID, checkpoint)
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	for name, store := range map[string]Store{
+		"memory": NewMemoryStore(),
+		"file":   NewFileStore(filepath.Join(t.TempDir(), "state.json")),
+	} {
+		if value, err := store.Get(ctx, "cursors", "a"); value != nil || err != nil {
+			t.Errorf("%s: Get(missing) = %q, %v", name, value, err)
+		}
+		for _, key := range []string{"b", "a"} {
+			if err := store.Put(ctx, "cursors", key, []byte("cursor-"+key)); err != nil {
+				t.Fatalf("%s: Put() error = %v", name, err)
+			}
+		}
+		store.Put(ctx, "other", "a", []byte{})
+		if value, _ := store.Get(ctx, "cursors", "a"); string(value) != "cursor-a" {
+			t.Errorf("%s: Get() = %q", name, value)
+		}
+		if value, _ := store.Get(ctx, "other", "a"); value == nil || len(value) != 0 {
+			t.Errorf("%s: Get(empty value) = %#v", name, value)
+		}
+		if keys, _ := store.List(ctx, "cursors"); !reflect.DeepEqual(keys, []string{"a", "b"}) {
+			t.Errorf("%s: List() = %v", name, keys)
+		}
+		store.Delete(ctx, "cursors", "a")
+		if keys, _ := store.List(ctx, "cursors"); !reflect.DeepEqual(keys, []string{"b"}) {
+			t.Errorf("%s: List() after Delete = %v", name, keys)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "state.json")
+	store := NewFileStore(path)
+	tokens := NewTokenStore(store)
+	tokens.Set(ctx, "adv1", &StoredToken{AccessToken: "at1"})
+
+	batches := NewEventBatchStore(store)
+	now := time.Now()
+	batches.Put(ctx, &EventBatch{ID: "z", CreatedAt: now})
+	batches.Put(ctx, &EventBatch{ID: "y", CreatedAt: now.Add(time.Second)})
+
+	labels := NewLabelStore(store)
+	entity := LabeledEntity{EntityType: "campaign", AdvertiserID: "adv1", EntityID: "c1", Labels: map[string]string{"team": "growth"}}
+	labels.Put(ctx, entity)
+
+	NewAudienceMemberStore(store).Save(ctx, "aud1", []string{"h1", "h2"})
+	NewCommentCheckpointStore(store).Save(ctx, "adv1", &CommentCheckpoint{DeliveredIDs: []string{"cm1"}})
+
+	// A fresh store over the same file sees everything written above
+	reopened := NewFileStore(path)
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("store file = %v, %v", info, err)
+	}
+	if token, err := NewTokenStore(reopened).Get(ctx, "adv1"); err != nil || token.AccessToken != "at1" {
+		t.Errorf("token = %+v, %v", token, err)
+	}
+	if list, _ := NewEventBatchStore(reopened).List(ctx); len(list) != 2 || list[0].ID != "z" {
+		t.Errorf("batches = %+v", list)
+	}
+	if got, _ := NewLabelStore(reopened).Get(ctx, entity); got["team"] != "growth" {
+		t.Errorf("labels = %v", got)
+	}
+	if members, _ := NewAudienceMemberStore(reopened).Load(ctx, "aud1"); !reflect.DeepEqual(members, []string{"h1", "h2"}) {
+		t.Errorf("members = %v", members)
+	}
+	if checkpoint, _ := NewCommentCheckpointStore(reopened).Load(ctx, "adv2"); checkpoint == nil || len(checkpoint.DeliveredIDs) != 0 {
+		t.Errorf("missing checkpoint = %+v", checkpoint)
+	}
+	if namespaces, _ := reopened.List(ctx, StoreNamespaceCommentCheckpoints); !reflect.DeepEqual(namespaces, []string{"adv1"}) {
+		t.Errorf("checkpoint keys = %v", namespaces)
+	}
+
+	entity.Labels = nil
+	labels.Put(ctx, entity)
+	if list, _ := labels.List(ctx); len(list) != 0 {
+		t.Errorf("labels after clearing = %+v", list)
+	}
+}
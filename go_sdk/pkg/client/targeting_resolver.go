@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// TargetingResolverConfig configures a TargetingResolver
+type TargetingResolverConfig struct {
+	// ChunkSize is the number of IDs sent per GetTargetingInfo call (defaults to 50)
+	ChunkSize int
+
+	// Concurrency caps parallel GetTargetingInfo calls (defaults to 4)
+	Concurrency int
+
+	// TTL is how long resolved entries stay cached (defaults to 24 hours)
+	TTL time.Duration
+
+	// MaxRateLimitRetries is how often a chunk is retried after a rate limit error (defaults to 3)
+	MaxRateLimitRetries int
+}
+
+// targetingCacheEntry is a cached TargetingInfo with its expiry
+type targetingCacheEntry struct {
+	info      TargetingInfo
+	expiresAt time.Time
+}
+
+// TargetingResolver resolves large sets of targeting IDs by chunking,
+// caching and issuing GetTargetingInfo calls concurrently
+type TargetingResolver struct {
+	client *Client
+	config TargetingResolverConfig
+
+	mu     sync.RWMutex
+	cache  map[string]targetingCacheEntry
+	hits   int64
+	misses int64
+}
+
+// NewTargetingResolver creates a new TargetingResolver and registers its
+// cache under "targeting_info" in the client stats
+func NewTargetingResolver(client *Client, config TargetingResolverConfig) *TargetingResolver {
+	if config.ChunkSize <= 0 {
+		config.ChunkSize = 50
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = 4
+	}
+	if config.TTL <= 0 {
+		config.TTL = 24 * time.Hour
+	}
+	if config.MaxRateLimitRetries <= 0 {
+		config.MaxRateLimitRetries = 3
+	}
+
+	r := &TargetingResolver{
+		client: client,
+		config: config,
+		cache:  make(map[string]targetingCacheEntry),
+	}
+	client.RegisterCacheStats("targeting_info", r.CacheStats)
+	return r
+}
+
+// Resolve returns TargetingInfo for each ID that the API recognizes, keyed by ID
+func (r *TargetingResolver) Resolve(ctx context.Context, advertiserID, targetingType, countryCode string, ids []string) (map[string]TargetingInfo, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	if targetingType == "" {
+		return nil, fmt.Errorf("type is required")
+	}
+
+	result := make(map[string]TargetingInfo, len(ids))
+	var missing []string
+	seen := make(map[string]bool, len(ids))
+
+	now := time.Now()
+	r.mu.Lock()
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if entry, ok := r.cache[targetingCacheKey(targetingType, countryCode, id)]; ok && now.Before(entry.expiresAt) {
+			result[id] = entry.info
+			r.hits++
+			continue
+		}
+		r.misses++
+		missing = append(missing, id)
+	}
+	r.mu.Unlock()
+
+	var (
+		wg       sync.WaitGroup
+		resultMu sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, r.config.Concurrency)
+
+	for start := 0; start < len(missing); start += r.config.ChunkSize {
+		end := start + r.config.ChunkSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+		chunk := missing[start:end]
+
+		wg.Add(1)
+		go func(chunk []string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			infos, err := r.fetchChunk(ctx, &TargetingInfoRequest{
+				AdvertiserID: advertiserID,
+				Type:         targetingType,
+				IDs:          chunk,
+				CountryCode:  countryCode,
+			})
+
+			resultMu.Lock()
+			defer resultMu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			for _, info := range infos {
+				result[info.ID] = info
+			}
+		}(chunk)
+	}
+	wg.Wait()
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	if firstErr != nil {
+		return result, fmt.Errorf("failed to resolve targeting info: %w", firstErr)
+	}
+	return result, nil
+}
+
+// CacheStats returns the resolver's cache counters
+func (r *TargetingResolver) CacheStats() CacheStats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return CacheStats{Hits: r.hits, Misses: r.misses, Size: len(r.cache)}
+}
+
+// fetchChunk resolves one chunk, backing off when the API reports rate limiting
+func (r *TargetingResolver) fetchChunk(ctx context.Context, req *TargetingInfoRequest) ([]TargetingInfo, error) {
+	delay := time.Second
+	for attempt := 0; ; attempt++ {
+		resp, err := r.client.Tool().GetTargetingInfo(ctx, req)
+		if err == nil {
+			r.store(req.Type, req.CountryCode, resp.Data)
+			return resp.Data, nil
+		}
+
+		var apiErr *models.APIError
+		if !errors.As(err, &apiErr) || apiErr.SuggestedAction != models.ActionReduceRate || attempt >= r.config.MaxRateLimitRetries {
+			return nil, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+// store caches resolved entries
+func (r *TargetingResolver) store(targetingType, countryCode string, infos []TargetingInfo) {
+	expiresAt := time.Now().Add(r.config.TTL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, info := range infos {
+		r.cache[targetingCacheKey(targetingType, countryCode, info.ID)] = targetingCacheEntry{info: info, expiresAt: expiresAt}
+	}
+}
+
+// targetingCacheKey builds the cache key for a targeting ID
+func targetingCacheKey(targetingType, countryCode, id string) string {
+	return targetingType + "|" + countryCode + "|" + id
+}
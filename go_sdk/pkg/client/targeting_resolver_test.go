@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTargetingResolver_ChunksAndCaches(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		var req TargetingInfoRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if len(req.IDs) > 2 {
+			t.Errorf("Expected chunks of at most 2 IDs, got %d", len(req.IDs))
+		}
+
+		resp := TargetingInfoResponse{}
+		for _, id := range req.IDs {
+			resp.Data = append(resp.Data, TargetingInfo{ID: id, Name: "loc-" + id, Type: req.Type})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+
+	client := newTestClient(t, server)
+
+	resolver := NewTargetingResolver(client, TargetingResolverConfig{ChunkSize: 2, Concurrency: 2})
+	ids := []string{"1", "2", "3", "4", "5", "1"}
+
+	result, err := resolver.Resolve(context.Background(), "123", "LOCATION", "", ids)
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if len(result) != 5 || result["3"].Name != "loc-3" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 chunked calls, got %d", calls.Load())
+	}
+
+	if _, err := resolver.Resolve(context.Background(), "123", "LOCATION", "", ids); err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected cached lookup to skip the API, got %d calls", calls.Load())
+	}
+	if stats := resolver.CacheStats(); stats.Hits != 5 || stats.Misses != 5 {
+		t.Errorf("Unexpected cache stats: %+v", stats)
+	}
+}
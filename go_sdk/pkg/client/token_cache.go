@@ -0,0 +1,147 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// DefaultValidationCacheTTL is how long ValidateToken results are reused
+const DefaultValidationCacheTTL = time.Minute
+
+// maxTokenCacheEntries bounds the cache; expired entries are pruned beyond it
+const maxTokenCacheEntries = 1024
+
+// tokenCacheEntry is a cached validation result
+type tokenCacheEntry struct {
+	result   TokenValidationResponse
+	cachedAt time.Time
+}
+
+// tokenCache remembers validation results and known expiry times of tokens.
+// Tokens are keyed by hash so they are not kept in memory.
+type tokenCache struct {
+	ttl time.Duration
+
+	mu       sync.Mutex
+	results  map[string]tokenCacheEntry
+	expiries map[string]time.Time
+	hits     int64
+	misses   int64
+}
+
+// newTokenCache creates a cache keeping results for ttl. A negative ttl
+// disables result caching; expiry checks still apply.
+func newTokenCache(ttl time.Duration) *tokenCache {
+	if ttl == 0 {
+		ttl = DefaultValidationCacheTTL
+	}
+	return &tokenCache{
+		ttl:      ttl,
+		results:  make(map[string]tokenCacheEntry),
+		expiries: make(map[string]time.Time),
+	}
+}
+
+// tokenKey hashes a token for use as a cache key
+func tokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// lookup returns the validation result for token if it is cached or the
+// token is known to have expired
+func (tc *tokenCache) lookup(token string, now time.Time) (*TokenValidationResponse, bool) {
+	key := tokenKey(token)
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if expiresAt, ok := tc.expiries[key]; ok && !now.Before(expiresAt) {
+		delete(tc.results, key)
+		tc.hits++
+		return &TokenValidationResponse{Valid: false, ExpiresAt: expiresAt.Unix()}, true
+	}
+
+	entry, ok := tc.results[key]
+	if !ok {
+		tc.misses++
+		return nil, false
+	}
+	if now.Sub(entry.cachedAt) >= tc.ttl {
+		delete(tc.results, key)
+		tc.misses++
+		return nil, false
+	}
+	tc.hits++
+	result := entry.result
+	return &result, true
+}
+
+// stats returns the cache counters; Size counts cached validation results
+func (tc *tokenCache) stats() CacheStats {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	return CacheStats{Hits: tc.hits, Misses: tc.misses, Size: len(tc.results)}
+}
+
+// store caches a validation result and the expiry it reports
+func (tc *tokenCache) store(token string, result *TokenValidationResponse, now time.Time) {
+	key := tokenKey(token)
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if result.ExpiresAt > 0 {
+		tc.expiries[key] = time.Unix(result.ExpiresAt, 0)
+	}
+	if tc.ttl > 0 {
+		tc.results[key] = tokenCacheEntry{result: *result, cachedAt: now}
+	}
+	tc.prune(now)
+}
+
+// storeExpiry records when a newly issued token expires
+func (tc *tokenCache) storeExpiry(token string, expiresIn int, now time.Time) {
+	if token == "" || expiresIn <= 0 {
+		return
+	}
+	key := tokenKey(token)
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.expiries[key] = now.Add(time.Duration(expiresIn) * time.Second)
+	tc.prune(now)
+}
+
+// invalidate forgets everything cached about token
+func (tc *tokenCache) invalidate(token string) {
+	key := tokenKey(token)
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	delete(tc.results, key)
+	delete(tc.expiries, key)
+}
+
+// prune drops stale entries once the cache grows past its bound; the caller
+// holds tc.mu
+func (tc *tokenCache) prune(now time.Time) {
+	if len(tc.results)+len(tc.expiries) <= maxTokenCacheEntries {
+		return
+	}
+	for key, entry := range tc.results {
+		if now.Sub(entry.cachedAt) >= tc.ttl {
+			delete(tc.results, key)
+		}
+	}
+	for key, expiresAt := range tc.expiries {
+		if !now.Before(expiresAt) {
+			delete(tc.expiries, key)
+		}
+	}
+}
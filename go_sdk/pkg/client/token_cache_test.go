@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAuth_ValidateTokenCache(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		expiresAt := time.Now().Add(time.Hour).Unix()
+		if r.Header.Get("Access-Token") == "expired" {
+			expiresAt = time.Now().Add(-time.Hour).Unix()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"code":0,"data":{"expires_at":%d,"scope":"ads"}}`, expiresAt)
+	})
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	auth := client.Auth()
+
+	for i := 0; i < 3; i++ {
+		result, err := auth.ValidateToken(ctx, "live")
+		if err != nil || !result.Valid {
+			t.Fatalf("ValidateToken() = %+v, %v", result, err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("API called %d times for cached token, want 1", got)
+	}
+
+	auth.InvalidateToken("live")
+	if _, err := auth.ValidateToken(ctx, "live"); err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("API called %d times after invalidation, want 2", got)
+	}
+
+	// The reported expiry is checked locally from then on
+	if _, err := auth.ValidateToken(ctx, "expired"); err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	result, err := auth.ValidateToken(ctx, "expired")
+	if err != nil || result.Valid {
+		t.Errorf("ValidateToken(expired) = %+v, %v, want invalid", result, err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("API called %d times, want 3", got)
+	}
+}
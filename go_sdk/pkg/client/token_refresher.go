@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Token refresher defaults
+const (
+	DefaultRefreshLead          = 10 * time.Minute
+	DefaultRefreshRetryInterval = 30 * time.Second
+
+	// refresherCheckInterval bounds how long the refresher sleeps, so tokens
+	// replaced by a request-driven refresh are picked up
+	refresherCheckInterval = time.Minute
+
+	// refresherEventBuffer is the number of events kept for a slow reader
+	refresherEventBuffer = 16
+)
+
+// TokenRefreshEvent reports one background refresh attempt
+type TokenRefreshEvent struct {
+	Time      time.Time
+	ExpiresAt time.Time // expiry of the new token, zero if unknown or on failure
+	Err       error
+}
+
+// TokenRefresher renews the token of a RefreshingTokenSource in a background
+// goroutine, ahead of its expiry, instead of on the request that finds it
+// expiring. It refreshes through the source, so it is serialized with
+// request-driven refreshes: requests issued during a refresh wait for the new
+// token.
+type TokenRefresher struct {
+	source        *RefreshingTokenSource
+	lead          time.Duration
+	retryInterval time.Duration
+	events        chan TokenRefreshEvent
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewTokenRefresher creates a TokenRefresher renewing tokens lead before they
+// expire, or DefaultRefreshLead when lead is zero
+func NewTokenRefresher(source *RefreshingTokenSource, lead time.Duration) *TokenRefresher {
+	if lead <= 0 {
+		lead = DefaultRefreshLead
+	}
+	return &TokenRefresher{
+		source:        source,
+		lead:          lead,
+		retryInterval: DefaultRefreshRetryInterval,
+		events:        make(chan TokenRefreshEvent, refresherEventBuffer),
+	}
+}
+
+// SetRetryInterval sets how long to wait after a failed refresh before trying
+// again. It takes effect on the next Start.
+func (r *TokenRefresher) SetRetryInterval(interval time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.retryInterval = interval
+}
+
+// Events returns the channel refresh attempts are published on. Events are
+// dropped when the channel is full.
+func (r *TokenRefresher) Events() <-chan TokenRefreshEvent {
+	return r.events
+}
+
+// Start launches the background goroutine. It runs until Stop is called or
+// ctx is canceled.
+func (r *TokenRefresher) Start(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cancel != nil {
+		return fmt.Errorf("token refresher already started")
+	}
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+	go r.run(ctx, r.retryInterval, r.done)
+	return nil
+}
+
+// Stop stops the background goroutine, canceling any refresh in flight, and
+// waits for it to exit. The refresher can be started again afterwards.
+func (r *TokenRefresher) Stop() {
+	r.mu.Lock()
+	cancel, done := r.cancel, r.done
+	r.cancel, r.done = nil, nil
+	r.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// run refreshes the token whenever it comes within the lead of expiring
+func (r *TokenRefresher) run(ctx context.Context, retryInterval time.Duration, done chan struct{}) {
+	defer close(done)
+
+	for {
+		wait := refresherCheckInterval
+		if expiresAt := r.source.expiry(); !expiresAt.IsZero() {
+			if until := time.Until(expiresAt) - r.lead; until < wait {
+				wait = until
+			}
+		}
+		if sleepContext(ctx, wait) != nil || ctx.Err() != nil {
+			return
+		}
+
+		refreshed, err := r.source.refreshWithin(ctx, r.lead)
+		if ctx.Err() != nil {
+			return
+		}
+		if !refreshed {
+			continue
+		}
+
+		event := TokenRefreshEvent{Time: time.Now(), Err: err}
+		if err == nil {
+			event.ExpiresAt = r.source.expiry()
+		}
+		select {
+		case r.events <- event:
+		default:
+		}
+
+		if err != nil && sleepContext(ctx, retryInterval) != nil {
+			return
+		}
+	}
+}
+
+// expiry returns when the current token expires, zero if unknown
+func (s *RefreshingTokenSource) expiry() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.expiresAt
+}
+
+// refreshWithin refreshes the token if it expires within lead, reporting
+// whether a refresh was attempted
+func (s *RefreshingTokenSource) refreshWithin(ctx context.Context, lead time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.expiresAt.IsZero() || time.Until(s.expiresAt) > lead {
+		return false, nil
+	}
+	return true, s.refresh(ctx)
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenRefresher(t *testing.T) {
+	var refreshes atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if refreshes.Add(1) == 1 {
+			w.Write([]byte(`{"code":40001,"message":"temporarily unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"message":"OK","data":{"access_token":"new_token","refresh_token":"refresh_2","expires_in":86400}}`))
+	})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, ClientID: "app", ClientSecret: "secret", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	source := NewRefreshingTokenSource(client.Auth(), &TokenResponse{AccessToken: "old_token", RefreshToken: "refresh_1", ExpiresIn: 60})
+	refresher := NewTokenRefresher(source, 5*time.Minute)
+	refresher.SetRetryInterval(10 * time.Millisecond)
+
+	if err := refresher.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := refresher.Start(context.Background()); err == nil {
+		t.Error("Start() succeeded on a running refresher")
+	}
+
+	failed := <-refresher.Events()
+	if failed.Err == nil {
+		t.Errorf("Expected the first refresh to fail, got %+v", failed)
+	}
+	select {
+	case event := <-refresher.Events():
+		if event.Err != nil || time.Until(event.ExpiresAt) < 23*time.Hour {
+			t.Errorf("event = %+v", event)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("No refresh after the retry interval")
+	}
+	refresher.Stop()
+	refresher.Stop()
+
+	token, err := source.Token(context.Background())
+	if err != nil || token != "new_token" {
+		t.Errorf("Token() = %q, %v", token, err)
+	}
+	if n := refreshes.Load(); n != 2 {
+		t.Errorf("Expected 2 refresh attempts, got %d", n)
+	}
+}
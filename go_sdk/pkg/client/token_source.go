@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// codeAccessTokenInvalid is the API response code for an invalid or expired access token
+const codeAccessTokenInvalid = 40105
+
+// tokenRefreshSkew is how long before expiry a token is refreshed proactively
+const tokenRefreshSkew = time.Minute
+
+// TokenSource supplies the access tokens sent with API requests
+type TokenSource interface {
+	// Token returns the access token for a request
+	Token(ctx context.Context) (string, error)
+
+	// Refresh replaces an access token rejected by the API and returns the new one
+	Refresh(ctx context.Context, rejected string) (string, error)
+}
+
+// staticTokenSource always returns the same token
+type staticTokenSource string
+
+// StaticTokenSource returns a TokenSource for a fixed access token
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+func (s staticTokenSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	return "", fmt.Errorf("static access token cannot be refreshed")
+}
+
+// RefreshingTokenSource keeps an access token fresh using the OAuth refresh
+// token flow. Refreshes are serialized, so concurrent requests rejected with
+// the same token trigger a single refresh.
+type RefreshingTokenSource struct {
+	auth AuthService
+
+	// OnRefresh, if set, is called with every newly issued token, e.g. to persist it
+	OnRefresh func(*TokenResponse)
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expiresAt    time.Time
+}
+
+// NewRefreshingTokenSource creates a RefreshingTokenSource starting from the
+// given token and refreshing it through auth
+func NewRefreshingTokenSource(auth AuthService, token *TokenResponse) *RefreshingTokenSource {
+	s := &RefreshingTokenSource{auth: auth}
+	s.set(token)
+	return s
+}
+
+// Token returns the current access token, refreshing it first when it is about to expire
+func (s *RefreshingTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken == "" || (!s.expiresAt.IsZero() && time.Until(s.expiresAt) < tokenRefreshSkew) {
+		if err := s.refresh(ctx); err != nil {
+			return "", err
+		}
+	}
+	return s.accessToken, nil
+}
+
+// Refresh refreshes the access token unless another caller already replaced
+// the rejected token
+func (s *RefreshingTokenSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != rejected && s.accessToken != "" {
+		return s.accessToken, nil
+	}
+	if err := s.refresh(ctx); err != nil {
+		return "", err
+	}
+	return s.accessToken, nil
+}
+
+// refresh exchanges the refresh token for a new token; s.mu must be held
+func (s *RefreshingTokenSource) refresh(ctx context.Context) error {
+	if s.refreshToken == "" {
+		return fmt.Errorf("refresh token is required")
+	}
+
+	token, err := s.auth.RefreshToken(withoutTokenSource(ctx), s.refreshToken)
+	if err != nil {
+		return fmt.Errorf("failed to refresh access token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return fmt.Errorf("refresh returned no access token")
+	}
+
+	s.set(token)
+	if s.OnRefresh != nil {
+		s.OnRefresh(token)
+	}
+	return nil
+}
+
+// set stores a token, keeping the previous refresh token when none is returned
+func (s *RefreshingTokenSource) set(token *TokenResponse) {
+	if token == nil {
+		return
+	}
+	s.accessToken = token.AccessToken
+	if token.RefreshToken != "" {
+		s.refreshToken = token.RefreshToken
+	}
+	s.expiresAt = time.Time{}
+	if token.ExpiresIn > 0 {
+		s.expiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+}
+
+// noTokenSourceKey marks requests that must not consult the token source,
+// such as the refresh request itself
+type noTokenSourceKey struct{}
+
+// withoutTokenSource returns a context whose requests bypass the token source
+func withoutTokenSource(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noTokenSourceKey{}, true)
+}
+
+// tokenSource returns the source of a request's token: the TokenStore entry
+// selected with WithAdvertiserToken, else Config.TokenSource. It returns nil
+// when the request carries its own token or uses Config.AccessToken.
+func (c *Client) tokenSource(ctx context.Context, headers map[string]string) TokenSource {
+	if ctx.Value(noTokenSourceKey{}) != nil {
+		return nil
+	}
+	if _, explicit := headers["Access-Token"]; explicit {
+		return nil
+	}
+	if key, ok := ctx.Value(tokenStoreKey{}).(string); ok {
+		return &storedTokenSource{client: c, key: key}
+	}
+	if c.config.TokenSource == nil {
+		return nil
+	}
+	return c.config.TokenSource
+}
+
+// tokenRejected reports whether the API rejected the request's access token,
+// either with a 401 status or a 40105 response code. Rejected responses are closed.
+func tokenRejected(resp *http.Response, bodyLimit int64) bool {
+	if resp.StatusCode == http.StatusUnauthorized {
+		_ = resp.Body.Close()
+		return true
+	}
+	if status, ok := peekResponseStatus(resp, bodyLimit); ok && status.Code == codeAccessTokenInvalid {
+		_ = resp.Body.Close()
+		return true
+	}
+	return false
+}
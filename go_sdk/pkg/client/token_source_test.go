@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestClient_TokenSourceRefresh(t *testing.T) {
+	var refreshes atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/open_api/v1.3/oauth2/refresh_token/" {
+			refreshes.Add(1)
+			time.Sleep(20 * time.Millisecond)
+			w.Write([]byte(`{"code":0,"message":"OK","data":{"access_token":"new_token","refresh_token":"refresh_2","expires_in":86400}}`))
+			return
+		}
+		if r.Header.Get("Access-Token") != "new_token" {
+			w.Write([]byte(`{"code":40105,"message":"Access token is invalid"}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"message":"OK","data":{}}`))
+	})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, ClientID: "app", ClientSecret: "secret", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	source := NewRefreshingTokenSource(client.Auth(), &TokenResponse{AccessToken: "old_token", RefreshToken: "refresh_1"})
+	client.config.TokenSource = source
+
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		go func() {
+			resp, err := client.DoRequest(context.Background(), "GET", "/open_api/v1.3/advertiser/info/", nil, nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			var result models.BaseResponse
+			if err := client.ParseResponse(resp, &result); err != nil {
+				errs <- err
+				return
+			}
+			if result.Code != 0 {
+				err = errors.New(result.Message)
+			}
+			errs <- err
+		}()
+	}
+	for i := 0; i < 5; i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("Request failed: %v", err)
+		}
+	}
+
+	if n := refreshes.Load(); n != 1 {
+		t.Errorf("Expected a single refresh, got %d", n)
+	}
+	if token, _ := source.Token(context.Background()); token != "new_token" {
+		t.Errorf("Expected new_token, got %q", token)
+	}
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// StoredToken is an access token kept in a TokenStore
+type StoredToken struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+
+	// ExpiresAt is when the access token expires, zero when unknown
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
+
+// NewStoredToken converts a token issued by the auth service, computing its expiry
+func NewStoredToken(token *TokenResponse) *StoredToken {
+	stored := &StoredToken{AccessToken: token.AccessToken, RefreshToken: token.RefreshToken}
+	if token.ExpiresIn > 0 {
+		stored.ExpiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return stored
+}
+
+// TokenStore keeps the access tokens of many advertisers or apps, keyed by
+// advertiser or app ID
+type TokenStore interface {
+	// Get returns the token stored under key, or nil when there is none
+	Get(ctx context.Context, key string) (*StoredToken, error)
+
+	// Set stores a token under key, replacing any previous one
+	Set(ctx context.Context, key string, token *StoredToken) error
+
+	// Delete removes the token stored under key
+	Delete(ctx context.Context, key string) error
+}
+
+// memoryTokenStore keeps tokens in memory
+type memoryTokenStore struct {
+	mu     sync.RWMutex
+	tokens map[string]StoredToken
+}
+
+// NewMemoryTokenStore creates a TokenStore that keeps tokens in memory
+func NewMemoryTokenStore() TokenStore {
+	return &memoryTokenStore{tokens: make(map[string]StoredToken)}
+}
+
+// Get returns the token stored under key
+func (m *memoryTokenStore) Get(ctx context.Context, key string) (*StoredToken, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	token, ok := m.tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+// Set stores a token under key
+func (m *memoryTokenStore) Set(ctx context.Context, key string, token *StoredToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tokens[key] = *token
+	return nil
+}
+
+// Delete removes the token stored under key
+func (m *memoryTokenStore) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.tokens, key)
+	return nil
+}
+
+// NewFileTokenStore creates a TokenStore backed by a JSON file. It is a
+// TokenStore over NewFileStore, which writes the file with owner-only
+// permissions since it holds credentials.
+func NewFileTokenStore(path string) TokenStore {
+	return NewTokenStore(NewFileStore(path))
+}
+
+// tokenStoreKey selects the TokenStore entry used by a request
+type tokenStoreKey struct{}
+
+// WithAdvertiserToken returns a context whose requests are authenticated with
+// the token stored under id in Config.TokenStore. The id is the advertiser or
+// app ID the token was stored under.
+func WithAdvertiserToken(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tokenStoreKey{}, id)
+}
+
+// storedTokenSource serves the token of one TokenStore entry, refreshing it
+// through the client's auth service when it expires or is rejected
+type storedTokenSource struct {
+	client *Client
+	key    string
+}
+
+// Token returns the stored token, refreshing it first when it is about to expire
+func (s *storedTokenSource) Token(ctx context.Context) (string, error) {
+	token, err := s.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if token.ExpiresAt.IsZero() || time.Until(token.ExpiresAt) >= tokenRefreshSkew || token.RefreshToken == "" {
+		return token.AccessToken, nil
+	}
+	return s.Refresh(ctx, token.AccessToken)
+}
+
+// Refresh refreshes the stored token unless another request already replaced
+// the rejected one
+func (s *storedTokenSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	lock := s.client.tokenStoreLock(s.key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	token, err := s.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if token.AccessToken != rejected {
+		return token.AccessToken, nil
+	}
+	if token.RefreshToken == "" {
+		return "", fmt.Errorf("access token for %s cannot be refreshed without a refresh token", s.key)
+	}
+
+	refreshed, err := s.client.Auth().RefreshToken(withoutTokenSource(ctx), token.RefreshToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh access token for %s: %w", s.key, err)
+	}
+	if refreshed.AccessToken == "" {
+		return "", fmt.Errorf("refresh returned no access token for %s", s.key)
+	}
+
+	stored := NewStoredToken(refreshed)
+	if stored.RefreshToken == "" {
+		stored.RefreshToken = token.RefreshToken
+	}
+	if err := s.client.config.TokenStore.Set(ctx, s.key, stored); err != nil {
+		return "", fmt.Errorf("failed to store access token for %s: %w", s.key, err)
+	}
+	return stored.AccessToken, nil
+}
+
+// get loads the entry, failing when it is missing
+func (s *storedTokenSource) get(ctx context.Context) (*StoredToken, error) {
+	if s.client.config.TokenStore == nil {
+		return nil, fmt.Errorf("no TokenStore configured for the token of %s", s.key)
+	}
+	token, err := s.client.config.TokenStore.Get(ctx, s.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load access token for %s: %w", s.key, err)
+	}
+	if token == nil || token.AccessToken == "" {
+		return nil, fmt.Errorf("no access token stored for %s", s.key)
+	}
+	return token, nil
+}
+
+// tokenStoreLock returns the mutex serializing refreshes of a TokenStore entry
+func (c *Client) tokenStoreLock(key string) *sync.Mutex {
+	lock, _ := c.tokenStoreLocks.LoadOrStore(key, &sync.Mutex{})
+	return lock.(*sync.Mutex)
+}
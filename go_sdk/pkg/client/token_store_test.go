@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_TokenStore(t *testing.T) {
+	var refreshes atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/open_api/v1.3/oauth2/refresh_token/" {
+			refreshes.Add(1)
+			w.Write([]byte(`{"code":0,"data":{"access_token":"token_b2","expires_in":86400}}`))
+			return
+		}
+		switch r.Header.Get("Access-Token") {
+		case "token_a", "token_b2":
+			fmt.Fprintf(w, `{"code":0,"data":{"token":%q}}`, r.Header.Get("Access-Token"))
+		default:
+			w.Write([]byte(`{"code":40105,"message":"Access token is invalid"}`))
+		}
+	})
+
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	store := NewFileTokenStore(path)
+	ctx := context.Background()
+	store.Set(ctx, "adv_a", &StoredToken{AccessToken: "token_a"})
+	store.Set(ctx, "adv_b", &StoredToken{AccessToken: "token_b1", RefreshToken: "refresh_b"})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, ClientID: "app", ClientSecret: "secret", TokenStore: store, Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	get := func(ctx context.Context) (string, error) {
+		resp, err := client.DoRequest(ctx, "GET", "/advertiser/info/", nil, nil)
+		if err != nil {
+			return "", err
+		}
+		var body struct {
+			Code int `json:"code"`
+			Data struct {
+				Token string `json:"token"`
+			} `json:"data"`
+		}
+		if err := client.ParseResponse(resp, &body); err != nil {
+			return "", err
+		}
+		return body.Data.Token, nil
+	}
+
+	if token, err := get(WithAdvertiserToken(ctx, "adv_a")); err != nil || token != "token_a" {
+		t.Errorf("adv_a token = %q, %v", token, err)
+	}
+	if token, err := get(WithAdvertiserToken(ctx, "adv_b")); err != nil || token != "token_b2" {
+		t.Errorf("adv_b token = %q, %v", token, err)
+	}
+	if got := refreshes.Load(); got != 1 {
+		t.Errorf("refreshed %d times, want 1", got)
+	}
+
+	stored, err := NewFileTokenStore(path).Get(ctx, "adv_b")
+	if err != nil || stored == nil || stored.AccessToken != "token_b2" || stored.RefreshToken != "refresh_b" || stored.ExpiresAt.IsZero() {
+		t.Errorf("persisted token = %+v, %v", stored, err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("token file mode = %v, %v", info, err)
+	}
+
+	if _, err := get(WithAdvertiserToken(ctx, "adv_unknown")); err == nil || !strings.Contains(err.Error(), "no access token stored") {
+		t.Errorf("unknown advertiser error = %v", err)
+	}
+	if err := store.Delete(ctx, "adv_a"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if stored, _ := store.Get(ctx, "adv_a"); stored != nil {
+		t.Errorf("deleted token = %+v", stored)
+	}
+
+	memory := NewMemoryTokenStore()
+	memory.Set(ctx, "app_1", &StoredToken{AccessToken: "token_a"})
+	if stored, _ := memory.Get(ctx, "app_1"); stored == nil || stored.AccessToken != "token_a" {
+		t.Errorf("memory token = %+v", stored)
+	}
+}
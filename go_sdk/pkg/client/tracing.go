@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans created by this SDK
+const tracerName = "github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+
+// Span attributes recorded for API calls
+const (
+	attrEndpoint     = attribute.Key("tiktok.endpoint")
+	attrAdvertiserID = attribute.Key("tiktok.advertiser_id")
+	attrRequestID    = attribute.Key("tiktok.request_id")
+	attrResponseCode = attribute.Key("tiktok.response_code")
+	attrMethod       = attribute.Key("http.request.method")
+	attrStatusCode   = attribute.Key("http.response.status_code")
+)
+
+// startSpan starts a client span for an API call made with DoRequest
+func (c *Client) startSpan(ctx context.Context, method, endpoint string, body io.Reader) (context.Context, trace.Span) {
+	path := endpoint
+	var query url.Values
+	if u, err := url.Parse(endpoint); err == nil {
+		path, query = u.Path, u.Query()
+	}
+
+	attrs := []attribute.KeyValue{attrMethod.String(method), attrEndpoint.String(path)}
+	if advertiserID := requestAdvertiserID(query, body); advertiserID != "" {
+		attrs = append(attrs, attrAdvertiserID.String(advertiserID))
+	}
+
+	tracer := c.config.TracerProvider.Tracer(tracerName)
+	return tracer.Start(ctx, method+" "+path, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// endSpan records the outcome of an API call and ends its span
+func endSpan(span trace.Span, resp *http.Response, err error, bodyLimit int64) {
+	defer span.End()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	span.SetAttributes(attrStatusCode.Int(resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, resp.Status)
+		return
+	}
+	if status, ok := peekResponseStatus(resp, bodyLimit); ok {
+		span.SetAttributes(attrResponseCode.Int(status.Code), attrRequestID.String(status.RequestID))
+		if status.Code != 0 {
+			span.SetStatus(codes.Error, strconv.Itoa(status.Code)+": "+status.Message)
+		}
+	}
+}
+
+// requestAdvertiserID finds the advertiser of a call in its query string or,
+// without consuming it, its JSON body
+func requestAdvertiserID(query url.Values, body io.Reader) string {
+	if id := query.Get("advertiser_id"); id != "" {
+		return id
+	}
+
+	sized, ok := body.(interface {
+		io.ReaderAt
+		Size() int64
+	})
+	if !ok {
+		return ""
+	}
+	var payload struct {
+		AdvertiserID string `json:"advertiser_id"`
+	}
+	_ = json.NewDecoder(io.NewSectionReader(sized, 0, sized.Size())).Decode(&payload)
+	return payload.AdvertiserID
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestClient_Tracing(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"code":40002,"message":"invalid param","request_id":"req-1"}`)
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	client := newTestClient(t, server, func(c *Config) { c.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)) })
+
+	resp, err := client.DoRequest(context.Background(), "POST", "/open_api/v1.3/campaign/create/", strings.NewReader(`{"advertiser_id":"123"}`), nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+	var result models.BaseResponse
+	if err := client.ParseResponse(resp, &result); err != nil || result.Code != 40002 {
+		t.Fatalf("Expected the response body to stay readable, got %+v (err %v)", result, err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "POST /open_api/v1.3/campaign/create/" || span.Status().Code != codes.Error {
+		t.Errorf("Unexpected span %q with status %v", span.Name(), span.Status())
+	}
+	attrs := make(map[string]string)
+	for _, kv := range span.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.Emit()
+	}
+	if attrs["tiktok.advertiser_id"] != "123" || attrs["tiktok.request_id"] != "req-1" || attrs["tiktok.response_code"] != "40002" {
+		t.Errorf("Unexpected span attributes: %v", attrs)
+	}
+}
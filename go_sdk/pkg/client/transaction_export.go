@@ -0,0 +1,271 @@
+package client
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// LedgerEntry is a business center transaction normalized for accounting
+// export. Amount is positive for money into the account and negative for
+// money out.
+type LedgerEntry struct {
+	TransactionID string
+	AccountID     string
+	Time          time.Time
+	RawTime       string
+	Type          string
+	Description   string
+	Amount        float64
+	Currency      string
+	Status        string
+}
+
+// DefaultCreditTransactionTypes are the transaction types that add funds.
+// Other types are treated as debits unless their amount is already negative.
+var DefaultCreditTransactionTypes = []string{"RECHARGE", "REFUND", "TRANSFER_IN", "COUPON", "CREDIT", "RETURN"}
+
+// LedgerEntriesFromTransactions converts GetTransactions results. creditTypes
+// defaults to DefaultCreditTransactionTypes.
+func LedgerEntriesFromTransactions(transactions []TransactionInfo, creditTypes ...string) []LedgerEntry {
+	entries := make([]LedgerEntry, 0, len(transactions))
+	for _, t := range transactions {
+		entries = append(entries, newLedgerEntry(t.TransactionID, "", t.CreateTime.String(), t.Type, t.Description, t.Amount, t.Currency, t.Status, creditTypes))
+	}
+	return entries
+}
+
+// LedgerEntriesFromAccountTransactions converts GetAccountTransactions
+// results. creditTypes defaults to DefaultCreditTransactionTypes.
+func LedgerEntriesFromAccountTransactions(transactions []BCAccountTransaction, creditTypes ...string) []LedgerEntry {
+	entries := make([]LedgerEntry, 0, len(transactions))
+	for _, t := range transactions {
+		entries = append(entries, newLedgerEntry(t.TransactionID, t.AccountID, t.CreateTime.String(), t.TransactionType, t.Description, t.Amount, t.Currency, t.Status, creditTypes))
+	}
+	return entries
+}
+
+// LedgerEntriesFromInvoiceLineItems converts invoice line items to ledger
+// entries for reconciliation against the transaction history. Each line item
+// is a debit of its total amount, identified by invoice ID and advertiser.
+func LedgerEntriesFromInvoiceLineItems(invoices []BCInvoice) []LedgerEntry {
+	var entries []LedgerEntry
+	for _, invoice := range invoices {
+		for _, item := range invoice.LineItems {
+			entries = append(entries, newLedgerEntry(
+				invoice.InvoiceID+"/"+item.AdvertiserID, item.AdvertiserID, invoice.IssueDate,
+				invoice.InvoiceType, item.Description, -math.Abs(item.TotalAmount), item.Currency, invoice.Status, nil,
+			))
+		}
+	}
+	return entries
+}
+
+// newLedgerEntry signs the amount by transaction type and parses the time
+func newLedgerEntry(id, accountID, createTime, txType, description string, amount float64, currency, status string, creditTypes []string) LedgerEntry {
+	if len(creditTypes) == 0 {
+		creditTypes = DefaultCreditTransactionTypes
+	}
+	credit := false
+	for _, creditType := range creditTypes {
+		if strings.EqualFold(txType, creditType) {
+			credit = true
+			break
+		}
+	}
+	if amount > 0 && !credit {
+		amount = -amount
+	}
+
+	entry := LedgerEntry{
+		TransactionID: id,
+		AccountID:     accountID,
+		RawTime:       createTime,
+		Type:          txType,
+		Description:   description,
+		Amount:        amount,
+		Currency:      currency,
+		Status:        status,
+	}
+	if parsed, err := models.ParseTime(createTime, nil); err == nil {
+		entry.Time = parsed.Time
+	}
+	return entry
+}
+
+// LedgerField is a value that can be mapped to an export column
+type LedgerField string
+
+const (
+	LedgerFieldTransactionID LedgerField = "transaction_id"
+	LedgerFieldAccountID     LedgerField = "account_id"
+	LedgerFieldDate          LedgerField = "date"
+	LedgerFieldType          LedgerField = "type"
+	LedgerFieldDescription   LedgerField = "description"
+	LedgerFieldAmount        LedgerField = "amount" // signed per AmountSign
+	LedgerFieldDebit         LedgerField = "debit"  // money out, as a positive number
+	LedgerFieldCredit        LedgerField = "credit" // money in, as a positive number
+	LedgerFieldCurrency      LedgerField = "currency"
+	LedgerFieldStatus        LedgerField = "status"
+)
+
+// AmountSign selects the sign convention of LedgerFieldAmount
+type AmountSign int
+
+const (
+	// CreditPositive writes money in as positive and money out as negative,
+	// as bank statement imports expect
+	CreditPositive AmountSign = iota
+
+	// DebitPositive writes money out as positive, as expense ledgers expect
+	DebitPositive
+)
+
+// ExportColumn maps a ledger field to a CSV column
+type ExportColumn struct {
+	Header string
+	Field  LedgerField
+}
+
+// TransactionExportFormat describes an accounting CSV layout
+type TransactionExportFormat struct {
+	Columns    []ExportColumn
+	AmountSign AmountSign
+
+	// DateLayout formats dates (defaults to 2006-01-02)
+	DateLayout string
+
+	// Delimiter separates fields (defaults to a comma)
+	Delimiter rune
+
+	// Decimals is the number of decimal places written for amounts
+	Decimals int
+}
+
+// Accounting CSV layouts for common import tools
+var (
+	// QuickBooksFormat is the three-column bank transaction import
+	QuickBooksFormat = TransactionExportFormat{
+		Columns: []ExportColumn{
+			{Header: "Date", Field: LedgerFieldDate},
+			{Header: "Description", Field: LedgerFieldDescription},
+			{Header: "Amount", Field: LedgerFieldAmount},
+		},
+		DateLayout: "01/02/2006",
+		Decimals:   2,
+	}
+
+	// XeroFormat is the precoded bank statement import
+	XeroFormat = TransactionExportFormat{
+		Columns: []ExportColumn{
+			{Header: "*Date", Field: LedgerFieldDate},
+			{Header: "*Amount", Field: LedgerFieldAmount},
+			{Header: "Payee", Field: LedgerFieldType},
+			{Header: "Description", Field: LedgerFieldDescription},
+			{Header: "Reference", Field: LedgerFieldTransactionID},
+			{Header: "Currency Code", Field: LedgerFieldCurrency},
+		},
+		DateLayout: "02/01/2006",
+		Decimals:   2,
+	}
+
+	// DebitCreditFormat is a general ledger journal with separate debit and
+	// credit columns, accepted by most ERP systems
+	DebitCreditFormat = TransactionExportFormat{
+		Columns: []ExportColumn{
+			{Header: "Date", Field: LedgerFieldDate},
+			{Header: "Reference", Field: LedgerFieldTransactionID},
+			{Header: "Account", Field: LedgerFieldAccountID},
+			{Header: "Type", Field: LedgerFieldType},
+			{Header: "Description", Field: LedgerFieldDescription},
+			{Header: "Debit", Field: LedgerFieldDebit},
+			{Header: "Credit", Field: LedgerFieldCredit},
+			{Header: "Currency", Field: LedgerFieldCurrency},
+			{Header: "Status", Field: LedgerFieldStatus},
+		},
+		Decimals: 2,
+	}
+)
+
+// WriteTransactionsCSV writes ledger entries as CSV in the given format
+func WriteTransactionsCSV(w io.Writer, entries []LedgerEntry, format TransactionExportFormat) error {
+	if len(format.Columns) == 0 {
+		return fmt.Errorf("export format has no columns")
+	}
+	dateLayout := format.DateLayout
+	if dateLayout == "" {
+		dateLayout = "2006-01-02"
+	}
+
+	writer := csv.NewWriter(w)
+	if format.Delimiter != 0 {
+		writer.Comma = format.Delimiter
+	}
+
+	header := make([]string, len(format.Columns))
+	for i, column := range format.Columns {
+		header[i] = column.Header
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	formatAmount := func(amount float64) string {
+		return strconv.FormatFloat(amount, 'f', format.Decimals, 64)
+	}
+
+	record := make([]string, len(format.Columns))
+	for _, entry := range entries {
+		for i, column := range format.Columns {
+			var value string
+			switch column.Field {
+			case LedgerFieldTransactionID:
+				value = entry.TransactionID
+			case LedgerFieldAccountID:
+				value = entry.AccountID
+			case LedgerFieldDate:
+				value = entry.RawTime
+				if !entry.Time.IsZero() {
+					value = entry.Time.Format(dateLayout)
+				}
+			case LedgerFieldType:
+				value = entry.Type
+			case LedgerFieldDescription:
+				value = entry.Description
+			case LedgerFieldAmount:
+				amount := entry.Amount
+				if format.AmountSign == DebitPositive && amount != 0 {
+					amount = -amount
+				}
+				value = formatAmount(amount)
+			case LedgerFieldDebit:
+				if entry.Amount < 0 {
+					value = formatAmount(math.Abs(entry.Amount))
+				}
+			case LedgerFieldCredit:
+				if entry.Amount > 0 {
+					value = formatAmount(entry.Amount)
+				}
+			case LedgerFieldCurrency:
+				value = entry.Currency
+			case LedgerFieldStatus:
+				value = entry.Status
+			default:
+				return fmt.Errorf("unknown ledger field %q", column.Field)
+			}
+			record[i] = value
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestWriteTransactionsCSV(t *testing.T) {
+	entries := LedgerEntriesFromTransactions([]TransactionInfo{
+		{TransactionID: "t1", Type: "RECHARGE", Amount: 500, Currency: "USD", Description: "Top up", CreateTime: models.NewTime(time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC))},
+		{TransactionID: "t2", Type: "CONSUME", Amount: 120.5, Currency: "USD", Description: "Ad spend, March", CreateTime: models.NewTime(time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC))},
+	})
+
+	var quickBooks bytes.Buffer
+	if err := WriteTransactionsCSV(&quickBooks, entries, QuickBooksFormat); err != nil {
+		t.Fatalf("WriteTransactionsCSV() error = %v", err)
+	}
+	want := "Date,Description,Amount\n03/05/2024,Top up,500.00\n03/06/2024,\"Ad spend, March\",-120.50\n"
+	if quickBooks.String() != want {
+		t.Errorf("QuickBooks CSV =\n%s\nwant\n%s", quickBooks.String(), want)
+	}
+
+	var journal bytes.Buffer
+	format := DebitCreditFormat
+	format.Delimiter = ';'
+	if err := WriteTransactionsCSV(&journal, entries, format); err != nil {
+		t.Fatalf("WriteTransactionsCSV() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(journal.String()), "\n")
+	if lines[1] != "2024-03-05;t1;;RECHARGE;Top up;;500.00;USD;" || lines[2] != "2024-03-06;t2;;CONSUME;Ad spend, March;120.50;;USD;" {
+		t.Errorf("journal CSV = %q", lines)
+	}
+
+	expenses := QuickBooksFormat
+	expenses.AmountSign = DebitPositive
+	var expenseCSV bytes.Buffer
+	_ = WriteTransactionsCSV(&expenseCSV, entries[1:], expenses)
+	if !strings.HasSuffix(expenseCSV.String(), ",120.50\n") {
+		t.Errorf("debit-positive CSV = %q", expenseCSV.String())
+	}
+}
@@ -0,0 +1,306 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+// Travel intent signal types
+const (
+	TravelIntentSearch   = "SEARCH"
+	TravelIntentView     = "VIEW"
+	TravelIntentPurchase = "PURCHASE"
+)
+
+// Travel verticals
+const (
+	TravelVerticalHotel  = "HOTEL"
+	TravelVerticalFlight = "FLIGHT"
+)
+
+// MaxTravelEventsPerRequest is the largest batch accepted per submission
+const MaxTravelEventsPerRequest = 1000
+
+// travelIntentEvents maps intent types to the standard event names
+var travelIntentEvents = map[string]string{
+	TravelIntentSearch:   "Search",
+	TravelIntentView:     "ViewContent",
+	TravelIntentPurchase: "CompletePayment",
+}
+
+// TravelService handles travel intent signals for travel dynamic ads
+type TravelService struct {
+	client *Client
+}
+
+// NewTravelService creates a new TravelService
+func NewTravelService(client *Client) *TravelService {
+	return &TravelService{client: client}
+}
+
+// TravelerIdentity holds traveler identifiers; plain values are normalized
+// and SHA-256 hashed before submission
+type TravelerIdentity struct {
+	Email      string
+	Phone      string
+	ExternalID string
+	IP         string
+	UserAgent  string
+}
+
+// TravelDestination describes where the traveler intends to go
+type TravelDestination struct {
+	City               string `json:"city,omitempty"`
+	Region             string `json:"region,omitempty"`
+	CountryCode        string `json:"country,omitempty"`
+	DestinationAirport string `json:"destination_airport,omitempty"`
+	OriginAirport      string `json:"origin_airport,omitempty"`
+	HotelID            string `json:"hotel_id,omitempty"`
+	DestinationID      string `json:"destination_id,omitempty"`
+}
+
+// TravelIntentEvent represents a single search, view or purchase signal
+type TravelIntentEvent struct {
+	Intent    string
+	Vertical  string
+	EventID   string
+	EventTime time.Time
+	PageURL   string
+
+	Traveler    TravelerIdentity
+	Destination TravelDestination
+
+	// Hotel stays use check-in/out dates, flights use departing/returning (YYYY-MM-DD)
+	CheckInDate   string
+	CheckOutDate  string
+	DepartingDate string
+	ReturningDate string
+
+	NumAdults   int
+	NumChildren int
+	NumInfants  int
+
+	ContentIDs []string
+	Value      float64
+	Currency   string
+}
+
+// TravelIntentSubmitRequest represents the request for submitting travel intent signals
+type TravelIntentSubmitRequest struct {
+	PixelCode     string
+	Events        []TravelIntentEvent
+	TestEventCode string
+}
+
+// TravelIntentSubmitResponse represents the response from submitting travel intent signals
+type TravelIntentSubmitResponse struct {
+	Code      int             `json:"code"`
+	Message   string          `json:"message"`
+	RequestID string          `json:"request_id"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// travelEventPayload is the wire format of a travel intent event
+type travelEventPayload struct {
+	Event      string                 `json:"event"`
+	EventTime  int64                  `json:"event_time"`
+	EventID    string                 `json:"event_id,omitempty"`
+	User       map[string]string      `json:"user"`
+	Page       map[string]string      `json:"page,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// SubmitIntentSignals validates, hashes and submits travel intent events
+func (s *TravelService) SubmitIntentSignals(ctx context.Context, req *TravelIntentSubmitRequest) (*TravelIntentSubmitResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	payloads := make([]travelEventPayload, 0, len(req.Events))
+	for i := range req.Events {
+		payloads = append(payloads, buildTravelEventPayload(&req.Events[i]))
+	}
+
+	body := map[string]interface{}{
+		"event_source":    "web",
+		"event_source_id": req.PixelCode,
+		"data":            payloads,
+	}
+	if req.TestEventCode != "" {
+		body["test_event_code"] = req.TestEventCode
+	}
+
+	url, err := s.client.BuildURL("/event/track/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := s.client.DoRequest(ctx, "POST", url, strings.NewReader(string(data)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit travel intent signals: %w", err)
+	}
+
+	var response TravelIntentSubmitResponse
+	if err := s.client.ParseResponse(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Validate checks the pixel code, batch size and every event
+func (r *TravelIntentSubmitRequest) Validate() error {
+	if r.PixelCode == "" {
+		return fmt.Errorf("pixel_code is required")
+	}
+	if len(r.Events) == 0 {
+		return fmt.Errorf("events is required")
+	}
+	if len(r.Events) > MaxTravelEventsPerRequest {
+		return fmt.Errorf("events cannot exceed %d per request", MaxTravelEventsPerRequest)
+	}
+	for i := range r.Events {
+		if err := ValidateTravelIntentEvent(&r.Events[i]); err != nil {
+			return fmt.Errorf("event %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateTravelIntentEvent checks a travel intent event for required fields
+// and consistent dates
+func ValidateTravelIntentEvent(event *TravelIntentEvent) error {
+	if _, ok := travelIntentEvents[event.Intent]; !ok {
+		return fmt.Errorf("intent must be SEARCH, VIEW or PURCHASE")
+	}
+	if event.Traveler.Email == "" && event.Traveler.Phone == "" && event.Traveler.ExternalID == "" {
+		return fmt.Errorf("at least one traveler identifier is required")
+	}
+	if event.Destination == (TravelDestination{}) {
+		return fmt.Errorf("destination is required")
+	}
+
+	switch event.Vertical {
+	case TravelVerticalHotel:
+		if err := validateTravelDates("check_in_date", event.CheckInDate, "check_out_date", event.CheckOutDate); err != nil {
+			return err
+		}
+	case TravelVerticalFlight:
+		if err := validateTravelDates("departing_date", event.DepartingDate, "returning_date", event.ReturningDate); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("vertical must be HOTEL or FLIGHT")
+	}
+
+	if event.Intent == TravelIntentPurchase && (event.Value <= 0 || event.Currency == "") {
+		return fmt.Errorf("value and currency are required for purchase events")
+	}
+	return nil
+}
+
+// validateTravelDates checks a start date is present and not after the end date
+func validateTravelDates(startField, start, endField, end string) error {
+	if start == "" {
+		return fmt.Errorf("%s is required", startField)
+	}
+	startDate, err := time.Parse("2006-01-02", start)
+	if err != nil {
+		return fmt.Errorf("%s must be in YYYY-MM-DD format", startField)
+	}
+	if end == "" {
+		return nil
+	}
+	endDate, err := time.Parse("2006-01-02", end)
+	if err != nil {
+		return fmt.Errorf("%s must be in YYYY-MM-DD format", endField)
+	}
+	if endDate.Before(startDate) {
+		return fmt.Errorf("%s cannot be before %s", endField, startField)
+	}
+	return nil
+}
+
+// buildTravelEventPayload converts an event to its wire format, hashing traveler identifiers
+func buildTravelEventPayload(event *TravelIntentEvent) travelEventPayload {
+	eventTime := event.EventTime
+	if eventTime.IsZero() {
+		eventTime = time.Now()
+	}
+
+	user := make(map[string]string)
+	if event.Traveler.Email != "" {
+		user["email"] = utils.HashEmail(event.Traveler.Email)
+	}
+	if event.Traveler.Phone != "" {
+		user["phone"] = utils.HashPhone(event.Traveler.Phone)
+	}
+	if event.Traveler.ExternalID != "" {
+		user["external_id"] = utils.HashExternalID(event.Traveler.ExternalID)
+	}
+	if event.Traveler.IP != "" {
+		user["ip"] = event.Traveler.IP
+	}
+	if event.Traveler.UserAgent != "" {
+		user["user_agent"] = event.Traveler.UserAgent
+	}
+
+	properties := map[string]interface{}{
+		"content_type": strings.ToLower(event.Vertical),
+	}
+	destination, _ := json.Marshal(event.Destination)
+	_ = json.Unmarshal(destination, &properties)
+
+	optional := map[string]string{
+		"checkin_date":   event.CheckInDate,
+		"checkout_date":  event.CheckOutDate,
+		"departing_date": event.DepartingDate,
+		"returning_date": event.ReturningDate,
+		"currency":       event.Currency,
+	}
+	for k, v := range optional {
+		if v != "" {
+			properties[k] = v
+		}
+	}
+	if event.NumAdults > 0 {
+		properties["num_adults"] = event.NumAdults
+	}
+	if event.NumChildren > 0 {
+		properties["num_children"] = event.NumChildren
+	}
+	if event.NumInfants > 0 {
+		properties["num_infants"] = event.NumInfants
+	}
+	if len(event.ContentIDs) > 0 {
+		properties["content_ids"] = event.ContentIDs
+	}
+	if event.Value > 0 {
+		properties["value"] = event.Value
+	}
+
+	payload := travelEventPayload{
+		Event:      travelIntentEvents[event.Intent],
+		EventTime:  eventTime.Unix(),
+		EventID:    event.EventID,
+		User:       user,
+		Properties: properties,
+	}
+	if event.PageURL != "" {
+		payload.Page = map[string]string{"url": event.PageURL}
+	}
+	return payload
+}
@@ -18,25 +18,25 @@ type GetAdvertisersResponse struct {
 }
 
 type AdvertiserInfo struct {
-	AdvertiserID            string  `json:"advertiser_id"`
-	AdvertiserName          string  `json:"advertiser_name"`
-	Status                  string  `json:"status"`
-	Currency                string  `json:"currency"`
-	Timezone                string  `json:"timezone"`
-	CompanyName             string  `json:"company_name,omitempty"`
-	Industry                string  `json:"industry,omitempty"`
-	Language                string  `json:"language,omitempty"`
-	ContactName             string  `json:"contact_name,omitempty"`
-	ContactEmail            string  `json:"contact_email,omitempty"`
-	ContactPhone            string  `json:"contact_phone,omitempty"`
-	Address                 string  `json:"address,omitempty"`
-	LicenseNo               string  `json:"license_no,omitempty"`
-	LicenseURL              string  `json:"license_url,omitempty"`
-	PromotionCenterCity     string  `json:"promotion_center_city,omitempty"`
-	PromotionCenterProvince string  `json:"promotion_center_province,omitempty"`
-	Balance                 float64 `json:"balance,omitempty"`
-	CreateTime              string  `json:"create_time,omitempty"`
-	Role                    string  `json:"role,omitempty"`
+	AdvertiserID            string      `json:"advertiser_id"`
+	AdvertiserName          string      `json:"advertiser_name"`
+	Status                  string      `json:"status"`
+	Currency                string      `json:"currency"`
+	Timezone                string      `json:"timezone"`
+	CompanyName             string      `json:"company_name,omitempty"`
+	Industry                string      `json:"industry,omitempty"`
+	Language                string      `json:"language,omitempty"`
+	ContactName             string      `json:"contact_name,omitempty"`
+	ContactEmail            string      `json:"contact_email,omitempty"`
+	ContactPhone            string      `json:"contact_phone,omitempty"`
+	Address                 string      `json:"address,omitempty"`
+	LicenseNo               string      `json:"license_no,omitempty"`
+	LicenseURL              string      `json:"license_url,omitempty"`
+	PromotionCenterCity     string      `json:"promotion_center_city,omitempty"`
+	PromotionCenterProvince string      `json:"promotion_center_province,omitempty"`
+	Balance                 float64     `json:"balance,omitempty"`
+	CreateTime              models.Time `json:"create_time,omitempty"`
+	Role                    string      `json:"role,omitempty"`
 }
 
 type UpdateAdvertiserRequest struct {
@@ -72,10 +72,12 @@ type GetAdvertiserBalanceResponse struct {
 type GetAdvertiserFundRequest struct {
 	AdvertiserID string   `json:"advertiser_id"`
 	FundTypes    []string `json:"fund_types,omitempty"`
+	Page         int      `json:"page,omitempty"`
+	PageSize     int      `json:"page_size,omitempty"`
 }
 
 type GetAdvertiserFundResponse struct {
-	models.BaseResponse
+	models.ListResponse
 	Data []FundInfo `json:"data"`
 }
 
@@ -87,6 +89,105 @@ type FundInfo struct {
 	ValidEnd   string  `json:"valid_end,omitempty"`
 }
 
+// Fund transaction types
+const (
+	FundTransactionGrant      = "GRANT"
+	FundTransactionConsume    = "CONSUME"
+	FundTransactionExpire     = "EXPIRE"
+	FundTransactionAdjustment = "ADJUSTMENT"
+	FundTransactionRefund     = "REFUND"
+)
+
+// AdvertiserFundTransactionGetRequest lists the fund movements of an advertiser
+type AdvertiserFundTransactionGetRequest struct {
+	AdvertiserID     string   `json:"advertiser_id"`
+	FundTypes        []string `json:"fund_types,omitempty"`
+	TransactionTypes []string `json:"transaction_types,omitempty"`
+
+	// StartDate and EndDate bound the transaction date (YYYY-MM-DD) and
+	// must be set together
+	StartDate string `json:"start_date,omitempty"`
+	EndDate   string `json:"end_date,omitempty"`
+
+	Page     int `json:"page,omitempty"`
+	PageSize int `json:"page_size,omitempty"`
+}
+
+// AdvertiserFundTransaction is a grant, consumption, expiration or adjustment
+// of an advertiser fund. Amount is positive for money added to the fund and
+// negative for money taken from it.
+type AdvertiserFundTransaction struct {
+	TransactionID   string  `json:"transaction_id"`
+	FundType        string  `json:"fund_type"`
+	TransactionType string  `json:"transaction_type"`
+	Amount          float64 `json:"amount"`
+	BalanceAfter    float64 `json:"balance_after"`
+	Currency        string  `json:"currency"`
+
+	// GrantID identifies the coupon or grant the transaction draws on
+	GrantID     string      `json:"grant_id,omitempty"`
+	CreateTime  models.Time `json:"create_time"`
+	ExpireTime  models.Time `json:"expire_time,omitempty"`
+	Description string      `json:"description,omitempty"`
+}
+
+type AdvertiserFundTransactionGetResponse struct {
+	models.ListResponse
+	Data []AdvertiserFundTransaction `json:"data"`
+}
+
+// AdvertiserUserGetRequest lists the users with access to an advertiser
+type AdvertiserUserGetRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	Page         int    `json:"page,omitempty"`
+	PageSize     int    `json:"page_size,omitempty"`
+}
+
+// AdvertiserUser is a user with access to an advertiser account
+type AdvertiserUser struct {
+	UserID      string                `json:"user_id"`
+	DisplayName string                `json:"display_name,omitempty"`
+	Email       string                `json:"email,omitempty"`
+	Role        models.AdvertiserRole `json:"role"`
+	Status      string                `json:"status,omitempty"` // ACTIVE, PENDING
+	SourceBCID  string                `json:"source_bc_id,omitempty"`
+	CreateTime  models.Time           `json:"create_time,omitempty"`
+}
+
+type AdvertiserUserGetResponse struct {
+	models.ListResponse
+	Data []AdvertiserUser `json:"data"`
+}
+
+// AdvertiserUserAddRequest invites a user to an advertiser by email
+type AdvertiserUserAddRequest struct {
+	AdvertiserID string                `json:"advertiser_id"`
+	Email        string                `json:"email"`
+	Role         models.AdvertiserRole `json:"role"`
+}
+
+// AdvertiserUserRoleUpdateRequest changes the role of a user
+type AdvertiserUserRoleUpdateRequest struct {
+	AdvertiserID string                `json:"advertiser_id"`
+	UserID       string                `json:"user_id"`
+	Role         models.AdvertiserRole `json:"role"`
+}
+
+// AdvertiserUserRemoveRequest revokes a user's access to an advertiser
+type AdvertiserUserRemoveRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	UserID       string `json:"user_id"`
+}
+
+type AdvertiserUserResponse struct {
+	models.BaseResponse
+	Data struct {
+		UserID string                `json:"user_id,omitempty"`
+		Role   models.AdvertiserRole `json:"role,omitempty"`
+		Status string                `json:"status,omitempty"`
+	} `json:"data"`
+}
+
 type CreateAdvertiserRequest struct {
 	AdvertiserName string `json:"advertiser_name"`
 	CompanyName    string `json:"company_name"`
@@ -119,6 +220,10 @@ type CampaignCreateRequest struct {
 	DeepBidType       string               `json:"deep_bid_type,omitempty"`
 	CampaignType      string               `json:"campaign_type,omitempty"`
 	SpecialIndustries []string             `json:"special_industries,omitempty"`
+
+	// DisableSKANCampaign turns off SKAdNetwork attribution of an
+	// IOS14_CAMPAIGN campaign
+	DisableSKANCampaign bool `json:"disable_skan_campaign,omitempty"`
 }
 
 type CampaignCreateResponse struct {
@@ -129,11 +234,21 @@ type CampaignCreateResponse struct {
 }
 
 type CampaignGetRequest struct {
-	AdvertiserID string   `json:"advertiser_id"`
-	CampaignIDs  []string `json:"campaign_ids,omitempty"`
-	Fields       []string `json:"fields,omitempty"`
-	Page         int      `json:"page,omitempty"`
-	PageSize     int      `json:"page_size,omitempty"`
+	AdvertiserID string             `json:"advertiser_id"`
+	CampaignIDs  []string           `json:"campaign_ids,omitempty"`
+	Fields       []string           `json:"fields,omitempty"`
+	Filtering    *CampaignFiltering `json:"filtering,omitempty"`
+	Page         int                `json:"page,omitempty"`
+	PageSize     int                `json:"page_size,omitempty"`
+}
+
+// CampaignFiltering narrows the campaigns returned by campaign/get
+type CampaignFiltering struct {
+	CampaignIDs     []string `json:"campaign_ids,omitempty"`
+	CampaignName    string   `json:"campaign_name,omitempty"`
+	ObjectiveType   string   `json:"objective_type,omitempty"`
+	PrimaryStatus   string   `json:"primary_status,omitempty"`
+	SecondaryStatus string   `json:"secondary_status,omitempty"`
 }
 
 type CampaignGetResponse struct {
@@ -142,19 +257,21 @@ type CampaignGetResponse struct {
 }
 
 type CampaignInfo struct {
-	CampaignID        string   `json:"campaign_id"`
-	CampaignName      string   `json:"campaign_name"`
-	AdvertiserID      string   `json:"advertiser_id"`
-	Status            string   `json:"status"`
-	ObjectiveType     string   `json:"objective_type"`
-	Budget            float64  `json:"budget"`
-	BudgetMode        string   `json:"budget_mode"`
-	AppPromotionType  string   `json:"app_promotion_type,omitempty"`
-	DeepBidType       string   `json:"deep_bid_type,omitempty"`
-	CampaignType      string   `json:"campaign_type,omitempty"`
-	SpecialIndustries []string `json:"special_industries,omitempty"`
-	CreateTime        string   `json:"create_time,omitempty"`
-	ModifyTime        string   `json:"modify_time,omitempty"`
+	CampaignID        string      `json:"campaign_id"`
+	CampaignName      string      `json:"campaign_name"`
+	AdvertiserID      string      `json:"advertiser_id"`
+	Status            string      `json:"status"`
+	OperationStatus   string      `json:"operation_status,omitempty"`
+	SecondaryStatus   string      `json:"secondary_status,omitempty"`
+	ObjectiveType     string      `json:"objective_type"`
+	Budget            float64     `json:"budget"`
+	BudgetMode        string      `json:"budget_mode"`
+	AppPromotionType  string      `json:"app_promotion_type,omitempty"`
+	DeepBidType       string      `json:"deep_bid_type,omitempty"`
+	CampaignType      string      `json:"campaign_type,omitempty"`
+	SpecialIndustries []string    `json:"special_industries,omitempty"`
+	CreateTime        models.Time `json:"create_time,omitempty"`
+	ModifyTime        models.Time `json:"modify_time,omitempty"`
 }
 
 type CampaignUpdateRequest struct {
@@ -197,13 +314,68 @@ type CampaignStatusUpdateResponse struct {
 	} `json:"data"`
 }
 
+// CampaignListDeletedRequest lists campaigns in the deleted (recycle) state
+type CampaignListDeletedRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	Fields       []string `json:"fields,omitempty"`
+	Page         int      `json:"page,omitempty"`
+	PageSize     int      `json:"page_size,omitempty"`
+}
+
+// CampaignRestoreRequest restores deleted campaigns
+type CampaignRestoreRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	CampaignIDs  []string `json:"campaign_ids"`
+}
+
+// CampaignCopyRequest represents the request for duplicating campaigns
+type CampaignCopyRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	CampaignIDs  []string `json:"campaign_ids"`
+
+	// TargetAdvertiserID copies the campaigns into another advertiser under
+	// the same Business Center; they are copied within AdvertiserID when empty
+	TargetAdvertiserID string `json:"target_advertiser_id,omitempty"`
+
+	// NameSuffix is appended to the copied names; the API's default suffix
+	// is used when empty
+	NameSuffix string `json:"name_suffix,omitempty"`
+
+	// CopyChildren also copies each campaign's ad groups and ads
+	CopyChildren bool `json:"copy_child_objects,omitempty"`
+
+	// OperationStatus is the status of the copies, ENABLE or DISABLE
+	// (defaults to DISABLE)
+	OperationStatus string `json:"operation_status,omitempty"`
+}
+
+// CopiedEntity maps a copied campaign, ad group or ad to its copy
+type CopiedEntity struct {
+	SourceID string `json:"source_id"`
+	ID       string `json:"id"`
+}
+
+// CampaignCopyResponse represents the response from duplicating campaigns
+type CampaignCopyResponse struct {
+	models.BaseResponse
+	Data struct {
+		Campaigns []CopiedEntity `json:"campaigns"`
+		AdGroups  []CopiedEntity `json:"adgroups,omitempty"`
+		Ads       []CopiedEntity `json:"ads,omitempty"`
+	} `json:"data"`
+}
+
 // Authentication types
 type TokenResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	ExpiresIn    int    `json:"expires_in"`
-	TokenType    string `json:"token_type"`
-	Scope        string `json:"scope"`
+	AccessToken           string      `json:"access_token"`
+	RefreshToken          string      `json:"refresh_token"`
+	ExpiresIn             int         `json:"expires_in"`
+	RefreshTokenExpiresIn int         `json:"refresh_token_expires_in,omitempty"`
+	TokenType             string      `json:"token_type"`
+	Scope                 OAuthScopes `json:"scope"`
+
+	// AdvertiserIDs are the ad accounts the user authorized
+	AdvertiserIDs []string `json:"advertiser_ids,omitempty"`
 }
 
 type TokenValidationResponse struct {
@@ -212,39 +384,500 @@ type TokenValidationResponse struct {
 	Scope     string `json:"scope"`
 }
 
-// Type definitions for services not yet fully implemented
-
-type AdCreateRequest struct{}
-type AdCreateResponse struct{}
-type AdGetRequest struct{}
-type AdGetResponse struct{}
-type AdUpdateRequest struct{}
-type AdUpdateResponse struct{}
-type AdDeleteRequest struct{}
-type AdDeleteResponse struct{}
-type AdStatusUpdateRequest struct{}
-type AdStatusUpdateResponse struct{}
-
-type AdGroupCreateRequest struct{}
-type AdGroupCreateResponse struct{}
-type AdGroupGetRequest struct{}
-type AdGroupGetResponse struct{}
-type AdGroupUpdateRequest struct{}
-type AdGroupUpdateResponse struct{}
-type AdGroupDeleteRequest struct{}
-type AdGroupDeleteResponse struct{}
-type AdGroupStatusUpdateRequest struct{}
-type AdGroupStatusUpdateResponse struct{}
+// Ad-related types
+type AdCreateRequest struct {
+	AdvertiserID string       `json:"advertiser_id"`
+	AdGroupID    string       `json:"adgroup_id"`
+	Creatives    []AdCreative `json:"creatives"`
+}
+
+// AdCreative holds the creative, identity and destination settings of an ad
+type AdCreative struct {
+	AdName                 string   `json:"ad_name,omitempty"`
+	AdFormat               string   `json:"ad_format,omitempty"` // SINGLE_VIDEO, SINGLE_IMAGE, CAROUSEL_ADS
+	AdText                 string   `json:"ad_text,omitempty"`
+	IdentityID             string   `json:"identity_id,omitempty"`
+	IdentityType           string   `json:"identity_type,omitempty"` // CUSTOMIZED_USER, AUTH_CODE, TT_USER, BC_AUTH_TT
+	IdentityAuthorizedBCID string   `json:"identity_authorized_bc_id,omitempty"`
+	VideoID                string   `json:"video_id,omitempty"`
+	ImageIDs               []string `json:"image_ids,omitempty"`
+	TikTokItemID           string   `json:"tiktok_item_id,omitempty"`
+	CallToAction           string   `json:"call_to_action,omitempty"`
+	CallToActionID         string   `json:"call_to_action_id,omitempty"`
+	LandingPageURL         string   `json:"landing_page_url,omitempty"`
+	PageID                 string   `json:"page_id,omitempty"` // Instant page opened instead of LandingPageURL
+	DisplayName            string   `json:"display_name,omitempty"`
+	AppName                string   `json:"app_name,omitempty"`
+	DeeplinkType           string   `json:"deeplink_type,omitempty"`
+	Deeplink               string   `json:"deeplink,omitempty"`
+	TrackingPixelID        string   `json:"tracking_pixel_id,omitempty"`
+	ImpressionTrackingURL  string   `json:"impression_tracking_url,omitempty"`
+	ClickTrackingURL       string   `json:"click_tracking_url,omitempty"`
+
+	// PlacementMaterials overrides the media per placement; placements
+	// without an entry fall back to VideoID/ImageIDs
+	PlacementMaterials []PlacementMaterial `json:"placement_materials,omitempty"`
+}
+
+// PlacementMaterial holds the media an ad uses on one placement
+type PlacementMaterial struct {
+	Placement models.Placement `json:"placement"`
+	VideoID   string           `json:"video_id,omitempty"`
+	ImageIDs  []string         `json:"image_ids,omitempty"`
+	AdText    string           `json:"ad_text,omitempty"`
+}
+
+type AdCreateResponse struct {
+	models.BaseResponse
+	Data struct {
+		AdIDs []string `json:"ad_ids"`
+	} `json:"data"`
+}
+
+type AdGetRequest struct {
+	AdvertiserID string       `json:"advertiser_id"`
+	Fields       []string     `json:"fields,omitempty"`
+	Filtering    *AdFiltering `json:"filtering,omitempty"`
+	Page         int          `json:"page,omitempty"`
+	PageSize     int          `json:"page_size,omitempty"`
+}
+
+// AdFiltering narrows the ads returned by ad/get
+type AdFiltering struct {
+	CampaignIDs     []string `json:"campaign_ids,omitempty"`
+	AdGroupIDs      []string `json:"adgroup_ids,omitempty"`
+	AdIDs           []string `json:"ad_ids,omitempty"`
+	PrimaryStatus   string   `json:"primary_status,omitempty"`
+	SecondaryStatus string   `json:"secondary_status,omitempty"`
+}
+
+type AdGetResponse struct {
+	models.ListResponse
+	Data []AdInfo `json:"data"`
+}
+
+type AdInfo struct {
+	AdCreative
+	AdID            string      `json:"ad_id"`
+	AdvertiserID    string      `json:"advertiser_id"`
+	CampaignID      string      `json:"campaign_id"`
+	AdGroupID       string      `json:"adgroup_id"`
+	OperationStatus string      `json:"operation_status"`
+	SecondaryStatus string      `json:"secondary_status,omitempty"`
+	CreateTime      models.Time `json:"create_time,omitempty"`
+	ModifyTime      models.Time `json:"modify_time,omitempty"`
+}
+
+type AdUpdateRequest struct {
+	AdvertiserID string             `json:"advertiser_id"`
+	AdGroupID    string             `json:"adgroup_id"`
+	Creatives    []AdUpdateCreative `json:"creatives"`
+}
+
+// AdUpdateCreative identifies the ad to update and its new creative settings
+type AdUpdateCreative struct {
+	AdID string `json:"ad_id"`
+	AdCreative
+}
+
+type AdUpdateResponse struct {
+	models.BaseResponse
+	Data struct {
+		AdIDs []string `json:"ad_ids"`
+	} `json:"data"`
+}
+
+type AdDeleteRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	AdIDs        []string `json:"ad_ids"`
+}
+
+type AdDeleteResponse struct {
+	models.BaseResponse
+	Data struct {
+		AdIDs []string `json:"ad_ids"`
+	} `json:"data"`
+}
+
+type AdStatusUpdateRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	AdIDs        []string `json:"ad_ids"`
+	Operation    string   `json:"operation_status"` // ENABLE, DISABLE, DELETE
+}
+
+type AdStatusUpdateResponse struct {
+	models.BaseResponse
+	Data struct {
+		AdIDs  []string `json:"ad_ids"`
+		Status string   `json:"status,omitempty"`
+	} `json:"data"`
+}
+
+// AdListDeletedRequest lists ads in the deleted (recycle) state
+type AdListDeletedRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	Page         int    `json:"page,omitempty"`
+	PageSize     int    `json:"page_size,omitempty"`
+}
+
+// AdRestoreRequest restores deleted ads
+type AdRestoreRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	AdIDs        []string `json:"ad_ids"`
+}
+
+// Ad group-related types
+type AdGroupCreateRequest struct {
+	AdvertiserID  string `json:"advertiser_id"`
+	CampaignID    string `json:"campaign_id"`
+	AdGroupName   string `json:"adgroup_name"`
+	PromotionType string `json:"promotion_type,omitempty"` // WEBSITE, APP_ANDROID, APP_IOS, LEAD_GENERATION
+
+	// Placement
+	PlacementType models.PlacementType `json:"placement_type"`
+	Placements    []models.Placement   `json:"placements,omitempty"`
+
+	AdGroupTargeting
+	AdGroupBidding
+	AdGroupSchedule
+	AdGroupAttribution
+
+	// Conversion tracking
+	PixelID           string `json:"pixel_id,omitempty"`
+	OptimizationEvent string `json:"optimization_event,omitempty"`
+	AppID             string `json:"app_id,omitempty"`
+
+	// SKAdNetwork settings of ad groups in IOS14_CAMPAIGN campaigns
+	IOS14QuotaType       string                `json:"ios14_quota_type,omitempty"` // OCCUPIED, UNOCCUPIED
+	SKANConversionSchema *SKANConversionSchema `json:"skan_conversion_schema,omitempty"`
+}
+
+// AdGroupTargeting holds the audience targeting of an ad group
+type AdGroupTargeting struct {
+	LocationIDs         []string          `json:"location_ids,omitempty"`
+	AgeGroups           []models.AgeGroup `json:"age_groups,omitempty"`
+	Gender              models.Gender     `json:"gender,omitempty"`
+	Languages           []string          `json:"languages,omitempty"`
+	InterestCategoryIDs []string          `json:"interest_category_ids,omitempty"`
+	InterestKeywordIDs  []string          `json:"interest_keyword_ids,omitempty"`
+	ActionCategoryIDs   []string          `json:"action_category_ids,omitempty"`
+	AudienceIDs         []string          `json:"audience_ids,omitempty"`
+	ExcludedAudienceIDs []string          `json:"excluded_audience_ids,omitempty"`
+	OperatingSystems    []string          `json:"operating_systems,omitempty"`
+	DeviceModelIDs      []string          `json:"device_model_ids,omitempty"`
+}
+
+// AdGroupBidding holds the budget, bid strategy and optimization settings of an ad group
+type AdGroupBidding struct {
+	BudgetMode         models.BudgetMode       `json:"budget_mode,omitempty"`
+	Budget             float64                 `json:"budget,omitempty"`
+	BidType            models.BidType          `json:"bid_type,omitempty"`
+	BidPrice           float64                 `json:"bid_price,omitempty"`
+	ConversionBidPrice float64                 `json:"conversion_bid_price,omitempty"`
+	OptimizationGoal   models.OptimizationGoal `json:"optimization_goal,omitempty"`
+	BillingEvent       string                  `json:"billing_event,omitempty"` // CPC, CPM, OCPM, CPV
+	Pacing             string                  `json:"pacing,omitempty"`        // PACING_MODE_SMOOTH, PACING_MODE_FAST
+}
+
+// AdGroupAttribution holds the windows in which conversions are credited to
+// an ad group's ads. Click-through windows run up to 28 days and view-through
+// windows up to 7; OFF disables that kind of attribution.
+type AdGroupAttribution struct {
+	ClickAttributionWindow models.AttributionWindow     `json:"click_attribution_window,omitempty"`
+	ViewAttributionWindow  models.AttributionWindow     `json:"view_attribution_window,omitempty"`
+	AttributionEventCount  models.AttributionEventCount `json:"attribution_event_count,omitempty"`
+}
+
+// AdGroupSchedule holds the delivery schedule of an ad group
+type AdGroupSchedule struct {
+	ScheduleType      string `json:"schedule_type,omitempty"`       // SCHEDULE_FROM_NOW, SCHEDULE_START_END
+	ScheduleStartTime string `json:"schedule_start_time,omitempty"` // YYYY-MM-DD HH:MM:SS
+	ScheduleEndTime   string `json:"schedule_end_time,omitempty"`
+	Dayparting        string `json:"dayparting,omitempty"`
+}
+
+type AdGroupCreateResponse struct {
+	models.BaseResponse
+	Data struct {
+		AdGroupID string `json:"adgroup_id"`
+	} `json:"data"`
+}
+
+type AdGroupGetRequest struct {
+	AdvertiserID string            `json:"advertiser_id"`
+	Fields       []string          `json:"fields,omitempty"`
+	Filtering    *AdGroupFiltering `json:"filtering,omitempty"`
+	Page         int               `json:"page,omitempty"`
+	PageSize     int               `json:"page_size,omitempty"`
+}
+
+// AdGroupFiltering narrows the ad groups returned by adgroup/get
+type AdGroupFiltering struct {
+	CampaignIDs     []string `json:"campaign_ids,omitempty"`
+	AdGroupIDs      []string `json:"adgroup_ids,omitempty"`
+	AdGroupName     string   `json:"adgroup_name,omitempty"`
+	PrimaryStatus   string   `json:"primary_status,omitempty"`
+	SecondaryStatus string   `json:"secondary_status,omitempty"`
+}
+
+type AdGroupGetResponse struct {
+	models.ListResponse
+	Data []AdGroupInfo `json:"data"`
+}
+
+type AdGroupInfo struct {
+	AdGroupID         string               `json:"adgroup_id"`
+	AdGroupName       string               `json:"adgroup_name"`
+	AdvertiserID      string               `json:"advertiser_id"`
+	CampaignID        string               `json:"campaign_id"`
+	PromotionType     string               `json:"promotion_type,omitempty"`
+	PlacementType     models.PlacementType `json:"placement_type"`
+	Placements        []models.Placement   `json:"placements,omitempty"`
+	OperationStatus   string               `json:"operation_status"`
+	SecondaryStatus   string               `json:"secondary_status,omitempty"`
+	PixelID           string               `json:"pixel_id,omitempty"`
+	OptimizationEvent string               `json:"optimization_event,omitempty"`
+	CreateTime        models.Time          `json:"create_time,omitempty"`
+	ModifyTime        models.Time          `json:"modify_time,omitempty"`
+
+	AdGroupTargeting
+	AdGroupBidding
+	AdGroupSchedule
+	AdGroupAttribution
+}
+
+type AdGroupUpdateRequest struct {
+	AdvertiserID string             `json:"advertiser_id"`
+	AdGroupID    string             `json:"adgroup_id"`
+	AdGroupName  string             `json:"adgroup_name,omitempty"`
+	Placements   []models.Placement `json:"placements,omitempty"`
+
+	AdGroupTargeting
+	AdGroupBidding
+	AdGroupSchedule
+	AdGroupAttribution
+}
+
+type AdGroupUpdateResponse struct {
+	models.BaseResponse
+	Data struct {
+		AdGroupID string `json:"adgroup_id"`
+	} `json:"data"`
+}
+
+type AdGroupDeleteRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	AdGroupIDs   []string `json:"adgroup_ids"`
+}
+
+type AdGroupDeleteResponse struct {
+	models.BaseResponse
+	Data struct {
+		AdGroupIDs []string `json:"adgroup_ids"`
+	} `json:"data"`
+}
+
+type AdGroupStatusUpdateRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	AdGroupIDs   []string `json:"adgroup_ids"`
+	Operation    string   `json:"operation_status"` // ENABLE, DISABLE, DELETE
+}
+
+type AdGroupStatusUpdateResponse struct {
+	models.BaseResponse
+	Data struct {
+		AdGroupIDs []string `json:"adgroup_ids"`
+		Status     string   `json:"status,omitempty"`
+	} `json:"data"`
+}
+
+// AdGroupListDeletedRequest lists ad groups in the deleted (recycle) state
+type AdGroupListDeletedRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	Page         int    `json:"page,omitempty"`
+	PageSize     int    `json:"page_size,omitempty"`
+}
+
+// AdGroupRestoreRequest restores deleted ad groups
+type AdGroupRestoreRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	AdGroupIDs   []string `json:"adgroup_ids"`
+}
+
+// AdGroupCopyRequest represents the request for duplicating ad groups
+type AdGroupCopyRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	AdGroupIDs   []string `json:"adgroup_ids"`
+
+	// TargetAdvertiserID copies the ad groups into another advertiser under
+	// the same Business Center, which requires TargetCampaignID
+	TargetAdvertiserID string `json:"target_advertiser_id,omitempty"`
+
+	// TargetCampaignID is the campaign the copies are created in; each ad
+	// group is copied into its own campaign when empty
+	TargetCampaignID string `json:"target_campaign_id,omitempty"`
+
+	// NameSuffix is appended to the copied names; the API's default suffix
+	// is used when empty
+	NameSuffix string `json:"name_suffix,omitempty"`
+
+	// CopyChildren also copies each ad group's ads
+	CopyChildren bool `json:"copy_child_objects,omitempty"`
+
+	// OperationStatus is the status of the copies, ENABLE or DISABLE
+	// (defaults to DISABLE)
+	OperationStatus string `json:"operation_status,omitempty"`
+}
+
+// AdGroupCopyResponse represents the response from duplicating ad groups
+type AdGroupCopyResponse struct {
+	models.BaseResponse
+	Data struct {
+		AdGroups []CopiedEntity `json:"adgroups"`
+		Ads      []CopiedEntity `json:"ads,omitempty"`
+	} `json:"data"`
+}
 
 // Custom audience types moved to dmp_service.go to avoid duplication
 
-type ReportingRequest struct{}
-type ReportingResponse struct{}
-type AudienceReportingRequest struct{}
-type AudienceReportingResponse struct{}
-type AsyncReportRequest struct{}
-type AsyncReportResponse struct{}
-type AsyncReportStatusResponse struct{}
+// Reporting types
+type ReportingRequest struct {
+	AdvertiserID  string             `json:"advertiser_id"`
+	ServiceType   models.ServiceType `json:"service_type,omitempty"` // defaults to AUCTION
+	ReportType    models.ReportType  `json:"report_type"`
+	DataLevel     models.DataLevel   `json:"data_level,omitempty"`
+	Dimensions    []models.Dimension `json:"dimensions"`
+	Metrics       []models.Metric    `json:"metrics,omitempty"`
+	Filters       []ReportingFilter  `json:"filtering,omitempty"`
+	StartDate     string             `json:"start_date,omitempty"` // YYYY-MM-DD
+	EndDate       string             `json:"end_date,omitempty"`
+	QueryLifetime bool               `json:"query_lifetime,omitempty"`
+	OrderField    string             `json:"order_field,omitempty"`
+	OrderType     string             `json:"order_type,omitempty"` // ASC, DESC
+	Page          int                `json:"page,omitempty"`
+	PageSize      int                `json:"page_size,omitempty"`
+}
+
+type ReportingFilter struct {
+	FieldName   string `json:"field_name"`
+	FilterType  string `json:"filter_type"` // IN, MATCH, GREATER_EQUAL, LOWER_EQUAL
+	FilterValue string `json:"filter_value"`
+}
+
+type ReportingResponse struct {
+	models.BaseResponse
+	Data struct {
+		List     []ReportingRow        `json:"list"`
+		PageInfo models.PaginationInfo `json:"page_info"`
+	} `json:"data"`
+}
+
+type ReportingRow struct {
+	Dimensions ReportingDimensions `json:"dimensions"`
+	Metrics    ReportingMetrics    `json:"metrics"`
+}
+
+type ReportingDimensions struct {
+	AdvertiserID     string `json:"advertiser_id,omitempty"`
+	CampaignID       string `json:"campaign_id,omitempty"`
+	AdGroupID        string `json:"adgroup_id,omitempty"`
+	AdID             string `json:"ad_id,omitempty"`
+	StatTimeDay      string `json:"stat_time_day,omitempty"`
+	StatTimeHour     string `json:"stat_time_hour,omitempty"`
+	CountryCode      string `json:"country_code,omitempty"`
+	Age              string `json:"age,omitempty"`
+	Gender           string `json:"gender,omitempty"`
+	Platform         string `json:"platform,omitempty"`
+	SearchTerms      string `json:"search_terms,omitempty"`
+	Placement        string `json:"placement,omitempty"`
+	Language         string `json:"language,omitempty"`
+	ProvinceID       string `json:"province_id,omitempty"`
+	InterestCategory string `json:"interest_category,omitempty"`
+}
+
+type ReportingMetrics struct {
+	CampaignName      string             `json:"campaign_name,omitempty"`
+	AdGroupName       string             `json:"adgroup_name,omitempty"`
+	AdName            string             `json:"ad_name,omitempty"`
+	AdGroupID         string             `json:"adgroup_id,omitempty"`
+	Spend             models.MetricValue `json:"spend"`
+	Impressions       models.MetricValue `json:"impressions"`
+	Clicks            models.MetricValue `json:"clicks"`
+	Reach             models.MetricValue `json:"reach"`
+	CTR               models.MetricValue `json:"ctr"`
+	CPC               models.MetricValue `json:"cpc"`
+	CPM               models.MetricValue `json:"cpm"`
+	Conversion        models.MetricValue `json:"conversion"`
+	CostPerConversion models.MetricValue `json:"cost_per_conversion"`
+	ConversionRate    models.MetricValue `json:"conversion_rate"`
+	VideoPlayActions  models.MetricValue `json:"video_play_actions"`
+	Frequency         models.MetricValue `json:"frequency"`
+
+	CampaignBudget     models.MetricValue `json:"campaign_budget,omitempty"`
+	AdText             string             `json:"ad_text,omitempty"`
+	Result             models.MetricValue `json:"result,omitempty"`
+	CostPerResult      models.MetricValue `json:"cost_per_result,omitempty"`
+	RealTimeConversion models.MetricValue `json:"real_time_conversion,omitempty"`
+	BilledCost         models.MetricValue `json:"billed_cost,omitempty"`
+	CashSpend          models.MetricValue `json:"cash_spend,omitempty"`
+	VoucherSpend       models.MetricValue `json:"voucher_spend,omitempty"`
+	Likes              models.MetricValue `json:"likes,omitempty"`
+	Comments           models.MetricValue `json:"comments,omitempty"`
+	Shares             models.MetricValue `json:"shares,omitempty"`
+	Follows            models.MetricValue `json:"follows,omitempty"`
+	ProfileVisits      models.MetricValue `json:"profile_visits,omitempty"`
+	VideoWatched2s     models.MetricValue `json:"video_watched_2s,omitempty"`
+	VideoWatched6s     models.MetricValue `json:"video_watched_6s,omitempty"`
+	VideoViewsP25      models.MetricValue `json:"video_views_p25,omitempty"`
+	VideoViewsP50      models.MetricValue `json:"video_views_p50,omitempty"`
+	VideoViewsP75      models.MetricValue `json:"video_views_p75,omitempty"`
+	VideoViewsP100     models.MetricValue `json:"video_views_p100,omitempty"`
+	AverageVideoPlay   models.MetricValue `json:"average_video_play,omitempty"`
+
+	SKANAppInstall         models.MetricValue `json:"skan_app_install,omitempty"`
+	SKANCostPerAppInstall  models.MetricValue `json:"skan_cost_per_app_install,omitempty"`
+	SKANConversion         models.MetricValue `json:"skan_conversion,omitempty"`
+	SKANCostPerConversion  models.MetricValue `json:"skan_cost_per_conversion,omitempty"`
+	SKANTotalPurchaseValue models.MetricValue `json:"skan_total_purchase_value,omitempty"`
+
+	CTAConversion models.MetricValue `json:"cta_conversion,omitempty"`
+	VTAConversion models.MetricValue `json:"vta_conversion,omitempty"`
+}
+
+type AudienceReportingRequest struct {
+	ReportingRequest
+}
+
+type AudienceReportingResponse struct {
+	ReportingResponse
+}
+
+type AsyncReportRequest struct {
+	ReportingRequest
+	OutputFormat string `json:"output_format,omitempty"` // CSV, XLSX
+	FileName     string `json:"file_name,omitempty"`
+}
+
+type AsyncReportResponse struct {
+	models.BaseResponse
+	Data struct {
+		TaskID string `json:"task_id"`
+	} `json:"data"`
+}
+
+type AsyncReportStatusRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	TaskID       string `json:"task_id"`
+}
+
+type AsyncReportStatusResponse struct {
+	models.BaseResponse
+	Data struct {
+		TaskID       string `json:"task_id"`
+		Status       string `json:"status"` // QUEUING, PROCESSING, SUCCESS, FAILED, CANCELED
+		ErrorMessage string `json:"error_message,omitempty"`
+	} `json:"data"`
+}
 
 // Tool API types
 type LanguagesResponse struct {
@@ -329,12 +962,6 @@ type DeviceModelInfo struct {
 	OSType          string `json:"os_type"`
 }
 
-type BusinessCentersResponse struct{}
-type BusinessCenterInfo struct{}
-type BCAdvertisersResponse struct{}
-type TransferAdvertiserRequest struct{}
-type TransferAdvertiserResponse struct{}
-
 // Tool API - Additional types for new endpoints
 type TargetingInfoRequest struct {
 	AdvertiserID string   `json:"advertiser_id"`
@@ -613,16 +1240,16 @@ type CreativeGetData struct {
 }
 
 type CreativeInfo struct {
-	CreativeID   string `json:"creative_id"`
-	CreativeName string `json:"creative_name"`
-	CreativeType string `json:"creative_type"`
-	URL          string `json:"url"`
-	Width        int    `json:"width,omitempty"`
-	Height       int    `json:"height,omitempty"`
-	Duration     float64 `json:"duration,omitempty"`
-	Size         int64  `json:"size"`
-	CreateTime   string `json:"create_time"`
-	UpdateTime   string `json:"update_time"`
+	CreativeID   string      `json:"creative_id"`
+	CreativeName string      `json:"creative_name"`
+	CreativeType string      `json:"creative_type"`
+	URL          string      `json:"url"`
+	Width        int         `json:"width,omitempty"`
+	Height       int         `json:"height,omitempty"`
+	Duration     float64     `json:"duration,omitempty"`
+	Size         int64       `json:"size"`
+	CreateTime   models.Time `json:"create_time"`
+	UpdateTime   models.Time `json:"update_time"`
 }
 
 type CreativeUpdateRequest struct {
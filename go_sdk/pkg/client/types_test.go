@@ -0,0 +1,56 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestModelsTime(t *testing.T) {
+	want := time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	for _, payload := range []string{
+		`{"create_time":"2024-03-05 10:00:00"}`,
+		`{"create_time":1709632800}`,
+		`{"create_time":"1709632800"}`,
+		`{"create_time":"2024-03-05T18:00:00+08:00"}`,
+	} {
+		var pixel PixelData
+		if err := json.Unmarshal([]byte(payload), &pixel); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", payload, err)
+		}
+		if !pixel.CreateTime.Equal(want) {
+			t.Errorf("Unmarshal(%s) = %v, want %v", payload, pixel.CreateTime.Time, want)
+		}
+
+		// Times are encoded back in the format they were decoded from
+		var wantValue, gotValue map[string]interface{}
+		encoded, err := json.Marshal(pixel)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		_ = json.Unmarshal([]byte(payload), &wantValue)
+		_ = json.Unmarshal(encoded, &gotValue)
+		if gotValue["create_time"] != wantValue["create_time"] {
+			t.Errorf("create_time encoded as %v, want %v", gotValue["create_time"], wantValue["create_time"])
+		}
+	}
+
+	var pixel PixelData
+	if err := json.Unmarshal([]byte(`{"create_time":"","update_time":null}`), &pixel); err != nil || !pixel.CreateTime.IsZero() || !pixel.UpdateTime.IsZero() {
+		t.Errorf("empty times = %v, %v, %v", pixel.CreateTime, pixel.UpdateTime, err)
+	}
+	if err := json.Unmarshal([]byte(`{"create_time":"yesterday"}`), &pixel); err == nil {
+		t.Error("Expected an unparseable time to fail")
+	}
+
+	shanghai := time.FixedZone("CST", 8*3600)
+	local, err := models.ParseTime("2024-03-05 18:00:00", shanghai)
+	if err != nil || !local.Equal(want) || local.String() != "2024-03-05 18:00:00" {
+		t.Errorf("ParseTime() = %v (%s), %v", local.Time, local, err)
+	}
+	if got := models.NewTime(want.In(shanghai)).String(); got != "2024-03-05 10:00:00" {
+		t.Errorf("NewTime().String() = %q", got)
+	}
+}
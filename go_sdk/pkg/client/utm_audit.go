@@ -0,0 +1,238 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// UTM audit deviation reasons
+const (
+	UTMInvalidURL       = "INVALID_URL"
+	UTMMissing          = "MISSING"
+	UTMEmpty            = "EMPTY"
+	UTMDuplicate        = "DUPLICATE"
+	UTMUnexpectedValue  = "UNEXPECTED_VALUE"
+	UTMPatternMismatch  = "PATTERN_MISMATCH"
+	UTMNotLowercase     = "NOT_LOWERCASE"
+	UTMUnknownParameter = "UNKNOWN_PARAMETER"
+)
+
+// DefaultRequiredUTMParameters are required when a UTMConvention lists none
+var DefaultRequiredUTMParameters = []string{"utm_source", "utm_medium", "utm_campaign"}
+
+// utmMacro matches a TikTok dynamic parameter such as __CAMPAIGN_NAME__
+var utmMacro = regexp.MustCompile(`^__[A-Z0-9_]+__$`)
+
+// UTMConvention describes the UTM parameters landing URLs must carry
+type UTMConvention struct {
+	// Required lists the parameters every URL must carry (defaults to
+	// DefaultRequiredUTMParameters)
+	Required []string
+
+	// Values fixes the value of a parameter, such as utm_source=tiktok
+	Values map[string]string
+
+	// Patterns constrains the value of a parameter. Patterns should be
+	// anchored to match whole values.
+	Patterns map[string]*regexp.Regexp
+
+	// Lowercase requires every UTM value to be lowercase
+	Lowercase bool
+
+	// AllowMacros accepts TikTok dynamic parameters such as __CAMPAIGN_NAME__
+	// as values, skipping the Values, Patterns and Lowercase checks for them
+	AllowMacros bool
+
+	// RejectUnknown reports utm_ parameters not named in Required, Values or
+	// Patterns
+	RejectUnknown bool
+}
+
+// UTMDeviation is a landing URL departing from the UTM convention
+type UTMDeviation struct {
+	AdID      string
+	AdGroupID string
+	URL       string
+	Parameter string
+	Value     string
+	Reason    string
+	Message   string
+}
+
+// UTMCampaignAudit groups the deviations of one campaign's ads
+type UTMCampaignAudit struct {
+	CampaignID string
+	AdsAudited int
+	Deviations []UTMDeviation
+}
+
+// UTMAuditReport is the result of auditing an advertiser's active ads
+type UTMAuditReport struct {
+	AdvertiserID      string
+	AdsAudited        int
+	AdsWithDeviations int
+
+	// Campaigns lists the audited campaigns by ID
+	Campaigns []UTMCampaignAudit
+}
+
+// Deviations returns the number of deviations across all campaigns
+func (r *UTMAuditReport) Deviations() int {
+	total := 0
+	for _, campaign := range r.Campaigns {
+		total += len(campaign.Deviations)
+	}
+	return total
+}
+
+// UTMAuditor checks the UTM parameters of active ads' landing URLs against a
+// convention
+type UTMAuditor struct {
+	client     *Client
+	convention UTMConvention
+}
+
+// NewUTMAuditor creates a new UTMAuditor
+func NewUTMAuditor(client *Client, convention UTMConvention) (*UTMAuditor, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+	if len(convention.Required) == 0 {
+		convention.Required = DefaultRequiredUTMParameters
+	}
+	for name, pattern := range convention.Patterns {
+		if pattern == nil {
+			return nil, fmt.Errorf("pattern for %s cannot be nil", name)
+		}
+	}
+	return &UTMAuditor{client: client, convention: convention}, nil
+}
+
+// Audit checks the landing URLs of an advertiser's active ads and groups the
+// deviations by campaign. Ads without a landing URL are not audited.
+func (a *UTMAuditor) Audit(ctx context.Context, advertiserID string) (*UTMAuditReport, error) {
+	if advertiserID == "" {
+		return nil, fmt.Errorf("advertiser_id is required")
+	}
+	ads, err := activeAds(ctx, a.client, advertiserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ads: %w", err)
+	}
+
+	report := &UTMAuditReport{AdvertiserID: advertiserID}
+	campaigns := make(map[string]*UTMCampaignAudit)
+	for _, ad := range ads {
+		if ad.LandingPageURL == "" {
+			continue
+		}
+		campaign, ok := campaigns[ad.CampaignID]
+		if !ok {
+			campaign = &UTMCampaignAudit{CampaignID: ad.CampaignID}
+			campaigns[ad.CampaignID] = campaign
+		}
+		campaign.AdsAudited++
+		report.AdsAudited++
+
+		deviations := a.AuditURL(ad.LandingPageURL)
+		if len(deviations) > 0 {
+			report.AdsWithDeviations++
+		}
+		for _, deviation := range deviations {
+			deviation.AdID = ad.AdID
+			deviation.AdGroupID = ad.AdGroupID
+			campaign.Deviations = append(campaign.Deviations, deviation)
+		}
+	}
+
+	for _, campaign := range campaigns {
+		report.Campaigns = append(report.Campaigns, *campaign)
+	}
+	sort.Slice(report.Campaigns, func(i, j int) bool {
+		return report.Campaigns[i].CampaignID < report.Campaigns[j].CampaignID
+	})
+	return report, nil
+}
+
+// AuditURL checks the UTM parameters of a single URL
+func (a *UTMAuditor) AuditURL(landingURL string) []UTMDeviation {
+	parsed, err := url.Parse(landingURL)
+	if err != nil {
+		return []UTMDeviation{{URL: landingURL, Reason: UTMInvalidURL, Message: err.Error()}}
+	}
+	query, err := url.ParseQuery(parsed.RawQuery)
+	if err != nil {
+		return []UTMDeviation{{URL: landingURL, Reason: UTMInvalidURL, Message: err.Error()}}
+	}
+
+	c := a.convention
+	var deviations []UTMDeviation
+	add := func(parameter, value, reason, message string) {
+		deviations = append(deviations, UTMDeviation{
+			URL:       landingURL,
+			Parameter: parameter,
+			Value:     value,
+			Reason:    reason,
+			Message:   message,
+		})
+	}
+
+	for _, name := range c.Required {
+		if _, ok := query[name]; !ok {
+			add(name, "", UTMMissing, fmt.Sprintf("%s is required", name))
+		}
+	}
+
+	names := make([]string, 0, len(query))
+	for name := range query {
+		if strings.HasPrefix(strings.ToLower(name), "utm_") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		values := query[name]
+		if len(values) > 1 {
+			add(name, strings.Join(values, ","), UTMDuplicate, fmt.Sprintf("%s is set %d times", name, len(values)))
+		}
+		value := values[0]
+
+		expected, hasValue := c.Values[name]
+		pattern, hasPattern := c.Patterns[name]
+		if c.RejectUnknown && !hasValue && !hasPattern && !containsString(c.Required, name) {
+			add(name, value, UTMUnknownParameter, fmt.Sprintf("%s is not part of the convention", name))
+			continue
+		}
+		if value == "" {
+			add(name, value, UTMEmpty, fmt.Sprintf("%s is empty", name))
+			continue
+		}
+		if c.AllowMacros && utmMacro.MatchString(value) {
+			continue
+		}
+		if hasValue && value != expected {
+			add(name, value, UTMUnexpectedValue, fmt.Sprintf("%s should be %q", name, expected))
+		}
+		if hasPattern && !pattern.MatchString(value) {
+			add(name, value, UTMPatternMismatch, fmt.Sprintf("%s does not match %s", name, pattern))
+		}
+		if c.Lowercase && value != strings.ToLower(value) {
+			add(name, value, UTMNotLowercase, fmt.Sprintf("%s should be lowercase", name))
+		}
+	}
+	return deviations
+}
+
+// containsString reports whether values contains s
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestUTMAuditor(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"code":0,"data":[
+			{"ad_id":"1","campaign_id":"c1","adgroup_id":"g1","operation_status":"ENABLE","landing_page_url":"https://shop.example.com/?utm_source=tiktok&utm_medium=paid_social&utm_campaign=__CAMPAIGN_NAME__"},
+			{"ad_id":"2","campaign_id":"c1","adgroup_id":"g1","operation_status":"ENABLE","landing_page_url":"https://shop.example.com/?utm_source=TikTok&utm_medium=cpc"},
+			{"ad_id":"3","campaign_id":"c2","adgroup_id":"g2","operation_status":"ENABLE","landing_page_url":"https://shop.example.com/?utm_source=tiktok&utm_medium=paid_social&utm_campaign=spring&utm_term=x"},
+			{"ad_id":"4","campaign_id":"c3","adgroup_id":"g3","operation_status":"DISABLE","landing_page_url":"https://shop.example.com/"},
+			{"ad_id":"5","campaign_id":"c3","adgroup_id":"g3","operation_status":"ENABLE"}
+		],"page_info":{"page":1,"total_page":1}}`)
+	})
+
+	client := newTestClient(t, server)
+	auditor, err := NewUTMAuditor(client, UTMConvention{
+		Values:        map[string]string{"utm_source": "tiktok"},
+		Patterns:      map[string]*regexp.Regexp{"utm_medium": regexp.MustCompile(`^paid_social$`)},
+		Lowercase:     true,
+		AllowMacros:   true,
+		RejectUnknown: true,
+	})
+	if err != nil {
+		t.Fatalf("NewUTMAuditor() error = %v", err)
+	}
+
+	report, err := auditor.Audit(context.Background(), "adv_1")
+	if err != nil {
+		t.Fatalf("Audit() error = %v", err)
+	}
+	if report.AdsAudited != 3 || report.AdsWithDeviations != 2 || len(report.Campaigns) != 2 {
+		t.Fatalf("report = %+v", report)
+	}
+
+	var reasons []string
+	for _, deviation := range report.Campaigns[0].Deviations {
+		if deviation.AdID != "2" {
+			t.Errorf("Unexpected deviation %+v", deviation)
+		}
+		reasons = append(reasons, deviation.Parameter+":"+deviation.Reason)
+	}
+	want := "utm_campaign:MISSING utm_medium:PATTERN_MISMATCH utm_source:UNEXPECTED_VALUE utm_source:NOT_LOWERCASE"
+	if got := strings.Join(reasons, " "); got != want {
+		t.Errorf("c1 deviations = %s, want %s", got, want)
+	}
+	if deviations := report.Campaigns[1].Deviations; len(deviations) != 1 || deviations[0].Reason != UTMUnknownParameter {
+		t.Errorf("c2 deviations = %+v", deviations)
+	}
+}
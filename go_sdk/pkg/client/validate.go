@@ -0,0 +1,554 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+// Services generated from the OpenAPI schemas; validategen must run after
+// them to add the Validate methods of their requests.
+//go:generate go run ../../gen -include blockedword_*.yml -service BlockedWordService -prefix BlockedWord -trim /blockedword/ -doc "manages the blocked words that hide matching comments on ads" -out blocked_word_gen.go
+//go:generate go run ../../cmd/validategen -out validate_gen.go -responses response_types_gen.go
+
+// Validator is implemented by every request struct. Validate checks a request
+// offline, without network access or credentials, so definitions can be
+// verified in CI before they are submitted.
+type Validator interface {
+	Validate() error
+}
+
+// Validate checks the campaign name, objective and budget
+func (r *CampaignCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateCampaignName(r.CampaignName); err != nil {
+		return err
+	}
+	if err := utils.ValidateObjectiveType(r.ObjectiveType); err != nil {
+		return err
+	}
+	if err := validateSKANCampaign(r); err != nil {
+		return err
+	}
+	return utils.ValidateBudget(r.Budget, r.BudgetMode)
+}
+
+// Validate checks the campaign IDs and operation
+func (r *CampaignStatusUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.CampaignIDs) == 0 {
+		return models.NewValidationError("campaign_ids", "campaign_ids is required")
+	}
+	if len(r.CampaignIDs) > MaxCampaignStatusIDs {
+		return models.NewValidationError("campaign_ids", fmt.Sprintf("at most %d campaign_ids per call; use the batch status helpers for more", MaxCampaignStatusIDs))
+	}
+	return validateOperationStatus(r.Operation)
+}
+
+// Validate checks placement, budget, bid and schedule settings
+func (r *AdGroupCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CampaignID, "campaign_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AdGroupName, "adgroup_name"); err != nil {
+		return err
+	}
+	switch r.PlacementType {
+	case models.PlacementTypeAutomatic:
+	case models.PlacementTypeNormal:
+		if len(r.Placements) == 0 {
+			return models.NewValidationError("placements", "placements are required for PLACEMENT_TYPE_NORMAL")
+		}
+	default:
+		return models.NewValidationError("placement_type", "placement_type must be PLACEMENT_TYPE_AUTOMATIC or PLACEMENT_TYPE_NORMAL")
+	}
+	if r.ScheduleType == "" {
+		return models.NewValidationError("schedule_type", "schedule_type is required")
+	}
+	if err := validateAdGroupAudiences(&r.AdGroupTargeting); err != nil {
+		return err
+	}
+	if err := validateSKANAdGroup(r); err != nil {
+		return err
+	}
+	if err := validateAdGroupAttribution(&r.AdGroupAttribution); err != nil {
+		return err
+	}
+	return validateAdGroupSettings(&r.AdGroupBidding, &r.AdGroupSchedule)
+}
+
+// Validate checks the ad group ID and any audience, budget, bid or schedule changes
+func (r *AdGroupUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AdGroupID, "adgroup_id"); err != nil {
+		return err
+	}
+	if err := validateAdGroupAudiences(&r.AdGroupTargeting); err != nil {
+		return err
+	}
+	if err := validateAdGroupAttribution(&r.AdGroupAttribution); err != nil {
+		return err
+	}
+	return validateAdGroupSettings(&r.AdGroupBidding, &r.AdGroupSchedule)
+}
+
+// Validate checks the ad group IDs and operation
+func (r *AdGroupStatusUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AdGroupIDs) == 0 {
+		return models.NewValidationError("adgroup_ids", "adgroup_ids is required")
+	}
+	if len(r.AdGroupIDs) > MaxAdGroupStatusIDs {
+		return models.NewValidationError("adgroup_ids", fmt.Sprintf("at most %d adgroup_ids per call; use the batch status helpers for more", MaxAdGroupStatusIDs))
+	}
+	return validateOperationStatus(r.Operation)
+}
+
+// Validate checks the ad group ID and every creative
+func (r *AdCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AdGroupID, "adgroup_id"); err != nil {
+		return err
+	}
+	if len(r.Creatives) == 0 {
+		return models.NewValidationError("creatives", "creatives is required")
+	}
+	for i := range r.Creatives {
+		if err := validateAdCreative(&r.Creatives[i]); err != nil {
+			return fmt.Errorf("creative %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the ad group ID and that every creative names its ad
+func (r *AdUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AdGroupID, "adgroup_id"); err != nil {
+		return err
+	}
+	if len(r.Creatives) == 0 {
+		return models.NewValidationError("creatives", "creatives is required")
+	}
+	for i, creative := range r.Creatives {
+		if creative.AdID == "" {
+			return fmt.Errorf("creative %d: %w", i, models.NewValidationError("ad_id", "ad_id is required"))
+		}
+	}
+	return nil
+}
+
+// Validate checks the ad IDs and operation
+func (r *AdStatusUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AdIDs) == 0 {
+		return models.NewValidationError("ad_ids", "ad_ids is required")
+	}
+	if len(r.AdIDs) > MaxAdStatusIDs {
+		return models.NewValidationError("ad_ids", fmt.Sprintf("at most %d ad_ids per call; use the batch status helpers for more", MaxAdStatusIDs))
+	}
+	return validateOperationStatus(r.Operation)
+}
+
+// Validate checks the report type, dimensions, service type and date range
+func (r *ReportingRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(string(r.ReportType), "report_type"); err != nil {
+		return err
+	}
+	if len(r.Dimensions) == 0 {
+		return models.NewValidationError("dimensions", "dimensions is required")
+	}
+	switch r.ServiceType {
+	case "", models.ServiceTypeAuction, models.ServiceTypeReservation:
+	default:
+		return models.NewValidationError("service_type", "service_type must be AUCTION or RESERVATION")
+	}
+	if r.DataLevel != "" {
+		if err := validateDataLevel(r); err != nil {
+			return err
+		}
+	}
+	if r.PageSize != 0 {
+		if err := utils.ValidatePageSize(r.PageSize); err != nil {
+			return err
+		}
+	}
+	if r.QueryLifetime {
+		return nil
+	}
+	return utils.ValidateDateRange(r.StartDate, r.EndDate)
+}
+
+// Validate checks the advertiser, date range and page size
+func (r *AdvertiserFundTransactionGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if r.StartDate != "" || r.EndDate != "" {
+		if err := utils.ValidateDateRange(r.StartDate, r.EndDate); err != nil {
+			return err
+		}
+	}
+	if r.PageSize != 0 {
+		return utils.ValidatePageSize(r.PageSize)
+	}
+	return nil
+}
+
+// Validate checks the advertiser, email and role
+func (r *AdvertiserUserAddRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Email, "email"); err != nil {
+		return err
+	}
+	if !strings.Contains(r.Email, "@") {
+		return models.NewValidationError("email", "email must be an email address")
+	}
+	return validateAdvertiserRole(r.Role)
+}
+
+// Validate checks the advertiser, user and role
+func (r *AdvertiserUserRoleUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.UserID, "user_id"); err != nil {
+		return err
+	}
+	return validateAdvertiserRole(r.Role)
+}
+
+// Validate checks the business center, account settings, business details
+// and documents
+func (r *BCAdvertiserCreateRequest) Validate() error {
+	required := []struct{ value, field string }{
+		{r.BCID, "bc_id"},
+		{r.AdvertiserInfo.Name, "advertiser_info.name"},
+		{r.AdvertiserInfo.Currency, "advertiser_info.currency"},
+		{r.AdvertiserInfo.Timezone, "advertiser_info.timezone"},
+		{r.CustomerInfo.Company, "customer_info.company"},
+		{r.CustomerInfo.IndustryID, "customer_info.industry"},
+		{r.CustomerInfo.RegisteredArea, "customer_info.registered_area"},
+	}
+	for _, field := range required {
+		if err := utils.ValidateRequiredString(field.value, field.field); err != nil {
+			return err
+		}
+	}
+	if len(r.AdvertiserInfo.Currency) != 3 || strings.ToUpper(r.AdvertiserInfo.Currency) != r.AdvertiserInfo.Currency {
+		return models.NewValidationError("advertiser_info.currency", "currency must be an uppercase ISO 4217 code")
+	}
+	if _, err := time.LoadLocation(r.AdvertiserInfo.Timezone); err != nil {
+		return models.NewValidationError("advertiser_info.timezone", fmt.Sprintf("unknown timezone %q", r.AdvertiserInfo.Timezone))
+	}
+	if err := utils.ValidateURL(r.QualificationInfo.PromotionLink); err != nil {
+		return models.NewValidationError("qualification_info.promotion_link", err.Error())
+	}
+	if r.ContactInfo != nil && r.ContactInfo.Email != "" && !strings.Contains(r.ContactInfo.Email, "@") {
+		return models.NewValidationError("contact_info.email", "email must be an email address")
+	}
+
+	licenses := 0
+	for i, document := range r.Documents {
+		if document.Reader == nil {
+			return models.NewValidationError("documents", fmt.Sprintf("document %d has no reader", i))
+		}
+		if document.License {
+			licenses++
+		}
+	}
+	if licenses > 1 {
+		return models.NewValidationError("documents", "only one document can be the business license")
+	}
+	if licenses == 1 && r.QualificationInfo.LicenseImageID != "" {
+		return models.NewValidationError("documents", "license_image_id is already set")
+	}
+	return nil
+}
+
+// Validate checks the catalog, batch size and every product in the batch
+func (r *CatalogProductUploadRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	if len(r.Products) == 0 {
+		return models.NewValidationError("products", "products is required")
+	}
+	if len(r.Products) > CatalogProductUploadMaxBatch {
+		return models.NewValidationError("products", fmt.Sprintf("products cannot exceed %d per upload", CatalogProductUploadMaxBatch))
+	}
+	for i := range r.Products {
+		if err := r.Products[i].Validate(); err != nil {
+			return fmt.Errorf("product %d (%s): %w", i, r.Products[i].SKUID, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the required product fields, availability and pricing
+func (p *Product) Validate() error {
+	if err := utils.ValidateRequiredString(p.SKUID, "sku_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateStringLength(p.Title, "title", 1, 150); err != nil {
+		return err
+	}
+	if err := utils.ValidateStringLength(p.Description, "description", 1, 5000); err != nil {
+		return err
+	}
+	switch p.Availability {
+	case ProductInStock, ProductOutOfStock, ProductPreorder, ProductAvailableForOrder, ProductDiscontinued:
+	default:
+		return models.NewValidationError("availability", fmt.Sprintf("invalid availability: %s", p.Availability))
+	}
+	if err := utils.ValidateURL(p.ImageURL); err != nil {
+		return models.NewValidationError("image_url", err.Error())
+	}
+	if p.LandingPageURL != "" {
+		if err := utils.ValidateURL(p.LandingPageURL); err != nil {
+			return models.NewValidationError("landing_page_url", err.Error())
+		}
+	}
+	if p.Price <= 0 {
+		return models.NewValidationError("price", "price must be greater than 0")
+	}
+	if p.SalePrice < 0 || p.SalePrice > p.Price {
+		return models.NewValidationError("sale_price", "sale_price must be between 0 and price")
+	}
+	if len(p.Currency) != 3 {
+		return models.NewValidationError("currency", "currency must be an ISO 4217 code")
+	}
+	if p.Inventory != nil && *p.Inventory < 0 {
+		return models.NewValidationError("inventory", "inventory cannot be negative")
+	}
+	if p.HotelProductFields != nil {
+		if err := utils.ValidateRequiredString(p.HotelID, "hotel_id"); err != nil {
+			return err
+		}
+	}
+	if p.FlightProductFields != nil {
+		if err := utils.ValidateRequiredString(p.OriginAirport, "origin_airport"); err != nil {
+			return err
+		}
+		if err := utils.ValidateRequiredString(p.DestinationAirport, "destination_airport"); err != nil {
+			return err
+		}
+	}
+	if p.VehicleProductFields != nil {
+		if err := utils.ValidateRequiredString(p.Make, "make"); err != nil {
+			return err
+		}
+		if err := utils.ValidateRequiredString(p.Model, "model"); err != nil {
+			return err
+		}
+		if p.Year <= 0 {
+			return models.NewValidationError("year", "year is required")
+		}
+	}
+	return nil
+}
+
+// validateAdvertiserRole checks a role that can be granted on an advertiser
+func validateAdvertiserRole(role models.AdvertiserRole) error {
+	switch role {
+	case models.AdvertiserRoleAdmin, models.AdvertiserRoleOperator, models.AdvertiserRoleAnalyst:
+		return nil
+	default:
+		return models.NewValidationError("role", "role must be ADMIN, OPERATOR or ANALYST")
+	}
+}
+
+// validateOperationStatus checks a status update operation
+func validateOperationStatus(operation string) error {
+	switch operation {
+	case "ENABLE", "DISABLE", "DELETE":
+		return nil
+	default:
+		return models.NewValidationError("operation_status", "operation must be ENABLE, DISABLE or DELETE")
+	}
+}
+
+// validateAdGroupAttribution checks the attribution windows and event count
+func validateAdGroupAttribution(attribution *AdGroupAttribution) error {
+	switch attribution.ClickAttributionWindow {
+	case "", models.AttributionWindowOff, models.AttributionWindowOneDay, models.AttributionWindowSevenDays,
+		models.AttributionWindowFourteenDays, models.AttributionWindowTwentyEightDays:
+	default:
+		return models.NewValidationError("click_attribution_window", "click_attribution_window must be OFF, ONE_DAY, SEVEN_DAYS, FOURTEEN_DAYS or TWENTY_EIGHT_DAYS")
+	}
+	switch attribution.ViewAttributionWindow {
+	case "", models.AttributionWindowOff, models.AttributionWindowOneDay, models.AttributionWindowSevenDays:
+	default:
+		return models.NewValidationError("view_attribution_window", "view_attribution_window must be OFF, ONE_DAY or SEVEN_DAYS")
+	}
+	if attribution.ClickAttributionWindow == models.AttributionWindowOff && attribution.ViewAttributionWindow == models.AttributionWindowOff {
+		return models.NewValidationError("click_attribution_window", "click and view attribution cannot both be OFF")
+	}
+	switch attribution.AttributionEventCount {
+	case "", models.AttributionEventCountEvery, models.AttributionEventCountOnce:
+	default:
+		return models.NewValidationError("attribution_event_count", "attribution_event_count must be EVERY or ONCE")
+	}
+	return nil
+}
+
+// validateAdGroupSettings checks budget, bid and schedule fields for consistency
+func validateAdGroupSettings(bidding *AdGroupBidding, schedule *AdGroupSchedule) error {
+	if bidding.Budget > 0 {
+		if err := utils.ValidateBudget(bidding.Budget, bidding.BudgetMode); err != nil {
+			return err
+		}
+	}
+	if bidding.BidType == models.BidTypeMaxBid && bidding.BidPrice <= 0 && bidding.ConversionBidPrice <= 0 {
+		return models.NewValidationError("bid_price", "bid_price or conversion_bid_price is required for BID_TYPE_CUSTOM")
+	}
+
+	switch schedule.ScheduleType {
+	case "", "SCHEDULE_FROM_NOW":
+	case "SCHEDULE_START_END":
+		if schedule.ScheduleStartTime == "" || schedule.ScheduleEndTime == "" {
+			return models.NewValidationError("schedule_end_time", "schedule_start_time and schedule_end_time are required for SCHEDULE_START_END")
+		}
+	default:
+		return models.NewValidationError("schedule_type", "schedule_type must be SCHEDULE_FROM_NOW or SCHEDULE_START_END")
+	}
+	if schedule.ScheduleStartTime != "" && schedule.ScheduleEndTime != "" {
+		start, err := time.Parse("2006-01-02 15:04:05", schedule.ScheduleStartTime)
+		if err != nil {
+			return models.NewValidationError("schedule_start_time", "schedule_start_time must be in YYYY-MM-DD HH:MM:SS format")
+		}
+		end, err := time.Parse("2006-01-02 15:04:05", schedule.ScheduleEndTime)
+		if err != nil {
+			return models.NewValidationError("schedule_end_time", "schedule_end_time must be in YYYY-MM-DD HH:MM:SS format")
+		}
+		if !end.After(start) {
+			return models.NewValidationError("schedule_end_time", "schedule_end_time must be after schedule_start_time")
+		}
+	}
+	return nil
+}
+
+// validateAdCreative checks that a creative has the media its format requires
+func validateAdCreative(creative *AdCreative) error {
+	if creative.AdText == "" && creative.TikTokItemID == "" {
+		return models.NewValidationError("ad_text", "ad_text is required")
+	}
+	if creative.IdentityID == "" {
+		return models.NewValidationError("identity_id", "identity_id is required")
+	}
+
+	switch creative.AdFormat {
+	case "SINGLE_VIDEO", "SINGLE_IMAGE", "CAROUSEL_ADS":
+	default:
+		return models.NewValidationError("ad_format", "ad_format must be SINGLE_VIDEO, SINGLE_IMAGE or CAROUSEL_ADS")
+	}
+
+	// Default media may be omitted when every placement is customized
+	if len(creative.PlacementMaterials) == 0 || hasDefaultMedia(creative) {
+		if err := validateCreativeMedia(creative.AdFormat, creative.VideoID, creative.TikTokItemID, creative.ImageIDs); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[models.Placement]bool, len(creative.PlacementMaterials))
+	for _, material := range creative.PlacementMaterials {
+		if material.Placement == "" {
+			return models.NewValidationError("placement_materials", "placement is required")
+		}
+		if seen[material.Placement] {
+			return models.NewValidationError("placement_materials", fmt.Sprintf("placement %s is customized more than once", material.Placement))
+		}
+		seen[material.Placement] = true
+
+		if err := validateCreativeMedia(creative.AdFormat, material.VideoID, "", material.ImageIDs); err != nil {
+			return fmt.Errorf("placement %s: %w", material.Placement, err)
+		}
+	}
+
+	if creative.LandingPageURL != "" {
+		if err := utils.ValidateURL(creative.LandingPageURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCreativeMedia checks that media matches an ad format
+func validateCreativeMedia(format, videoID, tiktokItemID string, imageIDs []string) error {
+	switch format {
+	case "SINGLE_VIDEO":
+		if videoID == "" && tiktokItemID == "" {
+			return models.NewValidationError("video_id", "video_id is required for SINGLE_VIDEO ads")
+		}
+	case "SINGLE_IMAGE":
+		if len(imageIDs) != 1 {
+			return models.NewValidationError("image_ids", "exactly one image_id is required for SINGLE_IMAGE ads")
+		}
+	case "CAROUSEL_ADS":
+		if len(imageIDs) < 2 {
+			return models.NewValidationError("image_ids", "at least two image_ids are required for CAROUSEL_ADS")
+		}
+	}
+	return nil
+}
+
+// hasDefaultMedia reports whether a creative sets media outside its placement materials
+func hasDefaultMedia(creative *AdCreative) bool {
+	return creative.VideoID != "" || creative.TikTokItemID != "" || len(creative.ImageIDs) > 0
+}
+
+// ValidatePlacementCoverage checks that a placement-customized creative has
+// media for every placement selected on its ad group and customizes no
+// placement outside that selection
+func ValidatePlacementCoverage(placements []models.Placement, creative *AdCreative) error {
+	selected := make(map[models.Placement]bool, len(placements))
+	for _, placement := range placements {
+		selected[placement] = true
+	}
+
+	customized := make(map[models.Placement]bool, len(creative.PlacementMaterials))
+	for _, material := range creative.PlacementMaterials {
+		if !selected[material.Placement] {
+			return models.NewValidationError("placement_materials", fmt.Sprintf("placement %s is not selected on the ad group", material.Placement))
+		}
+		customized[material.Placement] = true
+	}
+
+	if hasDefaultMedia(creative) {
+		return nil
+	}
+	for _, placement := range placements {
+		if !customized[placement] {
+			return models.NewValidationError("placement_materials", fmt.Sprintf("placement %s has no media", placement))
+		}
+	}
+	return nil
+}
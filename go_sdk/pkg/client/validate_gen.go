@@ -0,0 +1,1932 @@
+// Code generated by validategen. DO NOT EDIT.
+
+package client
+
+import (
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+// Validate checks that the required fields of ActionCategoryRequest are set
+func (r *ActionCategoryRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdDeleteRequest are set
+func (r *AdDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AdIDs) == 0 {
+		return models.NewValidationError("ad_ids", "ad_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdGetRequest are set
+func (r *AdGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdGroupAudienceRequest are set
+func (r *AdGroupAudienceRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AdGroupID, "adgroup_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdGroupCopyRequest are set
+func (r *AdGroupCopyRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AdGroupIDs) == 0 {
+		return models.NewValidationError("adgroup_ids", "adgroup_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdGroupDeleteRequest are set
+func (r *AdGroupDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AdGroupIDs) == 0 {
+		return models.NewValidationError("adgroup_ids", "adgroup_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdGroupGetRequest are set
+func (r *AdGroupGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdGroupListDeletedRequest are set
+func (r *AdGroupListDeletedRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdGroupRestoreRequest are set
+func (r *AdGroupRestoreRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AdGroupIDs) == 0 {
+		return models.NewValidationError("adgroup_ids", "adgroup_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdListDeletedRequest are set
+func (r *AdListDeletedRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdRestoreRequest are set
+func (r *AdRestoreRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AdIDs) == 0 {
+		return models.NewValidationError("ad_ids", "ad_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdvertiserUserGetRequest are set
+func (r *AdvertiserUserGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AdvertiserUserRemoveRequest are set
+func (r *AdvertiserUserRemoveRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.UserID, "user_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AgencyRollupRequest are set
+func (r *AgencyRollupRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of AppCreateRequest are set
+func (r *AppCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.DownloadURL, "download_url"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AppUpdateRequest are set
+func (r *AppUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AppID, "app_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AsyncReportStatusRequest are set
+func (r *AsyncReportStatusRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.TaskID, "task_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AudienceOverlapRequest are set
+func (r *AudienceOverlapRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AudienceIDs) == 0 {
+		return models.NewValidationError("custom_audience_ids", "custom_audience_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of AudienceSyncRequest are set
+func (r *AudienceSyncRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of AuthorizedPostListRequest are set
+func (r *AuthorizedPostListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAccountTransactionGetRequest are set
+func (r *BCAccountTransactionGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetAdminDeleteRequest are set
+func (r *BCAssetAdminDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AssetID, "asset_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.UserID, "user_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetAdminGetRequest are set
+func (r *BCAssetAdminGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetAssignRequest are set
+func (r *BCAssetAssignRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AssetID, "asset_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AssetType, "asset_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetGetRequest are set
+func (r *BCAssetGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetGroupCreateRequest are set
+func (r *BCAssetGroupCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.GroupName, "group_name"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetGroupDeleteRequest are set
+func (r *BCAssetGroupDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.GroupID, "group_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetGroupGetRequest are set
+func (r *BCAssetGroupGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetGroupListRequest are set
+func (r *BCAssetGroupListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetGroupUpdateRequest are set
+func (r *BCAssetGroupUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.GroupID, "group_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetMemberGetRequest are set
+func (r *BCAssetMemberGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetPartnerGetRequest are set
+func (r *BCAssetPartnerGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCAssetUnassignRequest are set
+func (r *BCAssetUnassignRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AssetID, "asset_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCBalanceGetRequest are set
+func (r *BCBalanceGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCBillingGroupCreateRequest are set
+func (r *BCBillingGroupCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.GroupName, "group_name"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCBillingGroupGetRequest are set
+func (r *BCBillingGroupGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCBillingGroupUpdateRequest are set
+func (r *BCBillingGroupUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.GroupID, "group_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCCreateRequest are set
+func (r *BCCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCName, "bc_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CompanyName, "company_name"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCFinancialSnapshotRequest are set
+func (r *BCFinancialSnapshotRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of BCGetRequest are set
+func (r *BCGetRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of BCInvoiceGetRequest are set
+func (r *BCInvoiceGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCInvoiceUnpaidGetRequest are set
+func (r *BCInvoiceUnpaidGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCMemberAssignRequest are set
+func (r *BCMemberAssignRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.MemberID, "member_id"); err != nil {
+		return err
+	}
+	if len(r.AssetIDs) == 0 {
+		return models.NewValidationError("asset_ids", "asset_ids is required")
+	}
+	if err := utils.ValidateRequiredString(r.AssetType, "asset_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCMemberDeleteRequest are set
+func (r *BCMemberDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.MemberID, "member_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCMemberGetRequest are set
+func (r *BCMemberGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCMemberInviteRequest are set
+func (r *BCMemberInviteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Email, "email"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Role, "role"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCMemberUpdateRequest are set
+func (r *BCMemberUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.MemberID, "member_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCPartnerAddRequest are set
+func (r *BCPartnerAddRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PartnerID, "partner_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCPartnerAssetDeleteRequest are set
+func (r *BCPartnerAssetDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PartnerID, "partner_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AssetID, "asset_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCPartnerAssetGetRequest are set
+func (r *BCPartnerAssetGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCPartnerDeleteRequest are set
+func (r *BCPartnerDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PartnerID, "partner_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCPartnerGetRequest are set
+func (r *BCPartnerGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCPixelLinkGetRequest are set
+func (r *BCPixelLinkGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCPixelLinkUpdateRequest are set
+func (r *BCPixelLinkUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PixelID, "pixel_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCPixelTransferRequest are set
+func (r *BCPixelTransferRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PixelID, "pixel_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.TargetBCID, "target_bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCTransactionGetRequest are set
+func (r *BCTransactionGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BCTransferRequest are set
+func (r *BCTransferRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.FromAccountID, "from_account_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.ToAccountID, "to_account_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BidMigrationRequest are set
+func (r *BidMigrationRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of BidRecommendRequest are set
+func (r *BidRecommendRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Objective, "objective"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.OptimizationEvent, "optimization_event"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BlockedWordCheckRequest are set
+func (r *BlockedWordCheckRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.BlockedWords) == 0 {
+		return models.NewValidationError("blocked_words", "blocked_words is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BlockedWordCreateRequest are set
+func (r *BlockedWordCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.BlockedWords) == 0 {
+		return models.NewValidationError("blocked_words", "blocked_words is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BlockedWordDeleteRequest are set
+func (r *BlockedWordDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.BlockedWords) == 0 {
+		return models.NewValidationError("blocked_words", "blocked_words is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BlockedWordListRequest are set
+func (r *BlockedWordListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BlockedWordTaskCheckRequest are set
+func (r *BlockedWordTaskCheckRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.TaskID, "task_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BlockedWordTaskCreateRequest are set
+func (r *BlockedWordTaskCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BlockedWordUpdateRequest are set
+func (r *BlockedWordUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.NewWord, "new_word"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.OldWord, "old_word"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of BudgetProjectionRequest are set
+func (r *BudgetProjectionRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of BulkCreativeUploadRequest are set
+func (r *BulkCreativeUploadRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of CampaignCopyRequest are set
+func (r *CampaignCopyRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.CampaignIDs) == 0 {
+		return models.NewValidationError("campaign_ids", "campaign_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CampaignDeleteRequest are set
+func (r *CampaignDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.CampaignIDs) == 0 {
+		return models.NewValidationError("campaign_ids", "campaign_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CampaignGetRequest are set
+func (r *CampaignGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CampaignListDeletedRequest are set
+func (r *CampaignListDeletedRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CampaignRestoreRequest are set
+func (r *CampaignRestoreRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.CampaignIDs) == 0 {
+		return models.NewValidationError("campaign_ids", "campaign_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CampaignUpdateRequest are set
+func (r *CampaignUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CampaignID, "campaign_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CarriersRequest are set
+func (r *CarriersRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogCreateRequest are set
+func (r *CatalogCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogName, "catalog_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogType, "catalog_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogDeleteRequest are set
+func (r *CatalogDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogFeedCreateRequest are set
+func (r *CatalogFeedCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.FeedURL, "feed_url"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogFeedDeleteRequest are set
+func (r *CatalogFeedDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.FeedID, "feed_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogFeedGetRequest are set
+func (r *CatalogFeedGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogFeedLogRequest are set
+func (r *CatalogFeedLogRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.FeedID, "feed_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogFeedUpdateRequest are set
+func (r *CatalogFeedUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.FeedID, "feed_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogGetRequest are set
+func (r *CatalogGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogOverviewRequest are set
+func (r *CatalogOverviewRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogProductDeleteRequest are set
+func (r *CatalogProductDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	if len(r.ProductIDs) == 0 {
+		return models.NewValidationError("product_ids", "product_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogProductFileRequest are set
+func (r *CatalogProductFileRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogProductGetRequest are set
+func (r *CatalogProductGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogProductLogRequest are set
+func (r *CatalogProductLogRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CatalogUpdateRequest are set
+func (r *CatalogUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CatalogID, "catalog_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CommentDeleteRequest are set
+func (r *CommentDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CommentID, "comment_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CommentListRequest are set
+func (r *CommentListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CommentPostRequest are set
+func (r *CommentPostRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.VideoID, "video_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CommentText, "comment_text"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CommentReferenceRequest are set
+func (r *CommentReferenceRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CommentStatusUpdateRequest are set
+func (r *CommentStatusUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CommentID, "comment_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Status, "status"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CommentTaskCheckRequest are set
+func (r *CommentTaskCheckRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.TaskID, "task_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CommentTaskCreateRequest are set
+func (r *CommentTaskCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.TaskType, "task_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of ContextualTagRequest are set
+func (r *ContextualTagRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreateAdvertiserRequest are set
+func (r *CreateAdvertiserRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserName, "advertiser_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CompanyName, "company_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Industry, "industry"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Currency, "currency"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Timezone, "timezone"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.ContactName, "contact_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.ContactEmail, "contact_email"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.ContactPhone, "contact_phone"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Address, "address"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreativeAssetDeleteRequest are set
+func (r *CreativeAssetDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AssetIDs) == 0 {
+		return models.NewValidationError("asset_ids", "asset_ids is required")
+	}
+	if err := utils.ValidateRequiredString(r.AssetType, "asset_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreativeAssetShareRequest are set
+func (r *CreativeAssetShareRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AssetIDs) == 0 {
+		return models.NewValidationError("asset_ids", "asset_ids is required")
+	}
+	if err := utils.ValidateRequiredString(r.TargetAdvertiserID, "target_advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AssetType, "asset_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreativeFatigueRequest are set
+func (r *CreativeFatigueRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of CreativeGetRequest are set
+func (r *CreativeGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreativeImageEditRequest are set
+func (r *CreativeImageEditRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.ImageID, "image_id"); err != nil {
+		return err
+	}
+	if len(r.Operations) == 0 {
+		return models.NewValidationError("operations", "operations is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreativePortfolioCreateRequest are set
+func (r *CreativePortfolioCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Name, "name"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreativePortfolioGetRequest are set
+func (r *CreativePortfolioGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CreativePortfolioID, "creative_portfolio_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreativePortfolioListRequest are set
+func (r *CreativePortfolioListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreativeShareableLinkCreateRequest are set
+func (r *CreativeShareableLinkCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.AssetIDs) == 0 {
+		return models.NewValidationError("asset_ids", "asset_ids is required")
+	}
+	if err := utils.ValidateRequiredString(r.AssetType, "asset_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreativeSmartTextGenerateRequest are set
+func (r *CreativeSmartTextGenerateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Prompt, "prompt"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CreativeUpdateRequest are set
+func (r *CreativeUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CreativeID, "creative_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CrossAccountCopyRequest are set
+func (r *CrossAccountCopyRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceApplyLogRequest are set
+func (r *CustomAudienceApplyLogRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CustomAudienceID, "custom_audience_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceApplyRequest are set
+func (r *CustomAudienceApplyRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CustomAudienceID, "custom_audience_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Operation, "operation"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceCreateRequest are set
+func (r *CustomAudienceCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AudienceName, "audience_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AudienceType, "audience_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceDeleteRequest are set
+func (r *CustomAudienceDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AudienceID, "audience_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceGetRequest are set
+func (r *CustomAudienceGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceListRequest are set
+func (r *CustomAudienceListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceRuleCreateRequest are set
+func (r *CustomAudienceRuleCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AudienceName, "audience_name"); err != nil {
+		return err
+	}
+	if len(r.Rules) == 0 {
+		return models.NewValidationError("rules", "rules is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceShareCancelRequest are set
+func (r *CustomAudienceShareCancelRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CustomAudienceID, "custom_audience_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.TargetAdvertiserID, "target_advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceShareLogRequest are set
+func (r *CustomAudienceShareLogRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceShareRequest are set
+func (r *CustomAudienceShareRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CustomAudienceID, "custom_audience_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.TargetAdvertiserID, "target_advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of CustomAudienceUpdateRequest are set
+func (r *CustomAudienceUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AudienceID, "audience_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of DeviceModelsRequest are set
+func (r *DeviceModelsRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of GetAdvertiserBalanceRequest are set
+func (r *GetAdvertiserBalanceRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of GetAdvertiserFundRequest are set
+func (r *GetAdvertiserFundRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of GetAdvertisersRequest are set
+func (r *GetAdvertisersRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of HashtagRecommendRequest are set
+func (r *HashtagRecommendRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.Keywords) == 0 {
+		return models.NewValidationError("keywords", "keywords is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of IdentityAvatarUploadRequest are set
+func (r *IdentityAvatarUploadRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of IdentityCreateRequest are set
+func (r *IdentityCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.DisplayName, "display_name"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of IdentityGetRequest are set
+func (r *IdentityGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of IdentityUpdateRequest are set
+func (r *IdentityUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.IdentityID, "identity_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of IdentityVideoInfoRequest are set
+func (r *IdentityVideoInfoRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(string(r.IdentityType), "identity_type"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.IdentityID, "identity_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.ItemID, "item_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of ImageUploadRequest are set
+func (r *ImageUploadRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.ImageData) == 0 {
+		return models.NewValidationError("image_data", "image_data is required")
+	}
+	if err := utils.ValidateRequiredString(r.ImageName, "image_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.ImageType, "image_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of InstantPageListRequest are set
+func (r *InstantPageListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of InterestCategoriesRequest are set
+func (r *InterestCategoriesRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of InterestKeywordRequest are set
+func (r *InterestKeywordRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Keyword, "keyword"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of LeadFormCreateRequest are set
+func (r *LeadFormCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.FormName, "form_name"); err != nil {
+		return err
+	}
+	if len(r.Questions) == 0 {
+		return models.NewValidationError("questions", "questions is required")
+	}
+	if err := utils.ValidateRequiredString(r.PrivacyPolicyURL, "privacy_policy_url"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of LeadFormGetRequest are set
+func (r *LeadFormGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of LeadTaskCreateRequest are set
+func (r *LeadTaskCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.FormID, "form_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of LookalikeAudienceCreateRequest are set
+func (r *LookalikeAudienceCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AudienceName, "audience_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.SourceAudienceID, "source_audience_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CountryCode, "country_code"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of LookalikeAudienceUpdateRequest are set
+func (r *LookalikeAudienceUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CustomAudienceID, "custom_audience_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of OSVersionRequest are set
+func (r *OSVersionRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.OSType, "os_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of OptimizerRuleBatchBindRequest are set
+func (r *OptimizerRuleBatchBindRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.RuleID, "rule_id"); err != nil {
+		return err
+	}
+	if len(r.ObjectIDs) == 0 {
+		return models.NewValidationError("object_ids", "object_ids is required")
+	}
+	if err := utils.ValidateRequiredString(r.ObjectType, "object_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of OptimizerRuleCreateRequest are set
+func (r *OptimizerRuleCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.RuleName, "rule_name"); err != nil {
+		return err
+	}
+	if len(r.Conditions) == 0 {
+		return models.NewValidationError("conditions", "conditions is required")
+	}
+	if len(r.Actions) == 0 {
+		return models.NewValidationError("actions", "actions is required")
+	}
+	if err := utils.ValidateRequiredString(r.ObjectType, "object_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of OptimizerRuleGetRequest are set
+func (r *OptimizerRuleGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.RuleID, "rule_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of OptimizerRuleListRequest are set
+func (r *OptimizerRuleListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of OptimizerRuleResultGetRequest are set
+func (r *OptimizerRuleResultGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.RuleID, "rule_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of OptimizerRuleResultListRequest are set
+func (r *OptimizerRuleResultListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of OptimizerRuleUpdateRequest are set
+func (r *OptimizerRuleUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.RuleID, "rule_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of PixelCreateRequest are set
+func (r *PixelCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PixelName, "pixel_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PixelMode, "pixel_mode"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of PixelEventCreateRequest are set
+func (r *PixelEventCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PixelID, "pixel_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.EventName, "event_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.EventType, "event_type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of PixelEventDeleteRequest are set
+func (r *PixelEventDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PixelID, "pixel_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.EventID, "event_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of PixelEventGetRequest are set
+func (r *PixelEventGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PixelID, "pixel_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of PixelEventUpdateRequest are set
+func (r *PixelEventUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PixelID, "pixel_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.EventID, "event_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of PixelGetRequest are set
+func (r *PixelGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of PixelUpdateRequest are set
+func (r *PixelUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.PixelID, "pixel_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of PostAuthorizationRequest are set
+func (r *PostAuthorizationRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AuthCode, "auth_code"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of PostAuthorizeRequest are set
+func (r *PostAuthorizeRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AuthCode, "auth_code"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of ReportIntegratedGetRequest are set
+func (r *ReportIntegratedGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of ReportTaskCancelRequest are set
+func (r *ReportTaskCancelRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.TaskID, "task_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of ReportTaskCheckRequest are set
+func (r *ReportTaskCheckRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.TaskID, "task_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of ReportTaskCreateRequest are set
+func (r *ReportTaskCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.ReportType, "report_type"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.StartDate, "start_date"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.EndDate, "end_date"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of SavedAudienceCreateRequest are set
+func (r *SavedAudienceCreateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AudienceName, "audience_name"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of SavedAudienceDeleteRequest are set
+func (r *SavedAudienceDeleteRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.SavedAudienceID, "saved_audience_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of SavedAudienceListRequest are set
+func (r *SavedAudienceListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of SearchTermReportRequest are set
+func (r *SearchTermReportRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.StartDate, "start_date"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.EndDate, "end_date"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of SmartPlusCampaignGetRequest are set
+func (r *SmartPlusCampaignGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.CampaignIDs) == 0 {
+		return models.NewValidationError("campaign_ids", "campaign_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of SmartPlusCampaignUpdateRequest are set
+func (r *SmartPlusCampaignUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.CampaignID, "campaign_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of SmartPlusReportRequest are set
+func (r *SmartPlusReportRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of SmartPlusStatusUpdateRequest are set
+func (r *SmartPlusStatusUpdateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.CampaignIDs) == 0 {
+		return models.NewValidationError("campaign_ids", "campaign_ids is required")
+	}
+	if err := utils.ValidateRequiredString(r.Operation, "operation_status"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of SnapshotImportRequest are set
+func (r *SnapshotImportRequest) Validate() error {
+	return nil
+}
+
+// Validate checks that the required fields of TargetingInfoRequest are set
+func (r *TargetingInfoRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Type, "type"); err != nil {
+		return err
+	}
+	if len(r.IDs) == 0 {
+		return models.NewValidationError("ids", "ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of TargetingListRequest are set
+func (r *TargetingListRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Type, "type"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of TargetingSearchRequest are set
+func (r *TargetingSearchRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Type, "type"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.Keyword, "keyword"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of TransferAdvertiserRequest are set
+func (r *TransferAdvertiserRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.BCID, "bc_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of URLValidateRequest are set
+func (r *URLValidateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.URL, "url"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of UpdateAdvertiserRequest are set
+func (r *UpdateAdvertiserRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks that the required fields of VideoCaptionGenerateRequest are set
+func (r *VideoCaptionGenerateRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.VideoIDs) == 0 {
+		return models.NewValidationError("video_ids", "video_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of VideoCaptionGetRequest are set
+func (r *VideoCaptionGetRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.VideoIDs) == 0 {
+		return models.NewValidationError("video_ids", "video_ids is required")
+	}
+	return nil
+}
+
+// Validate checks that the required fields of VideoUploadRequest are set
+func (r *VideoUploadRequest) Validate() error {
+	if err := utils.ValidateRequiredString(r.AdvertiserID, "advertiser_id"); err != nil {
+		return err
+	}
+	if len(r.VideoData) == 0 {
+		return models.NewValidationError("video_data", "video_data is required")
+	}
+	if err := utils.ValidateRequiredString(r.VideoName, "video_name"); err != nil {
+		return err
+	}
+	if err := utils.ValidateRequiredString(r.VideoType, "video_type"); err != nil {
+		return err
+	}
+	return nil
+}
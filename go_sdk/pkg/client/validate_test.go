@@ -0,0 +1,206 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     Validator
+		wantErr bool
+	}{
+		{
+			name: "valid campaign",
+			req: &CampaignCreateRequest{
+				AdvertiserID:  "123",
+				CampaignName:  "Spring sale",
+				ObjectiveType: models.ObjectiveTraffic,
+				Budget:        100,
+				BudgetMode:    models.BudgetModeDaily,
+			},
+			wantErr: false,
+		},
+		{
+			name: "campaign below minimum budget",
+			req: &CampaignCreateRequest{
+				AdvertiserID:  "123",
+				CampaignName:  "Spring sale",
+				ObjectiveType: models.ObjectiveTraffic,
+				Budget:        5,
+				BudgetMode:    models.BudgetModeDaily,
+			},
+			wantErr: true,
+		},
+		{
+			name: "ad group with end before start",
+			req: &AdGroupCreateRequest{
+				AdvertiserID:  "123",
+				CampaignID:    "456",
+				AdGroupName:   "US 18-24",
+				PlacementType: models.PlacementTypeAutomatic,
+				AdGroupSchedule: AdGroupSchedule{
+					ScheduleType:      "SCHEDULE_START_END",
+					ScheduleStartTime: "2024-02-01 00:00:00",
+					ScheduleEndTime:   "2024-01-01 00:00:00",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "generated check for missing required slice",
+			req:     &CustomAudienceRuleCreateRequest{AdvertiserID: "123", AudienceName: "Visitors"},
+			wantErr: true,
+		},
+		{
+			name:    "generated check passes",
+			req:     &PixelGetRequest{AdvertiserID: "123"},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var validationErr models.ValidationError
+			if err != nil && !errors.As(err, &validationErr) {
+				t.Errorf("Validate() error = %v, want a models.ValidationError", err)
+			}
+		})
+	}
+}
+
+func TestValidatePlacementCoverage(t *testing.T) {
+	placements := []models.Placement{models.PlacementTikTok, models.PlacementPangle}
+	creative := &AdCreative{
+		AdFormat:   "SINGLE_VIDEO",
+		AdText:     "Shop now",
+		IdentityID: "identity",
+		PlacementMaterials: []PlacementMaterial{
+			{Placement: models.PlacementTikTok, VideoID: "v-vertical"},
+		},
+	}
+
+	if err := validateAdCreative(creative); err != nil {
+		t.Fatalf("validateAdCreative() error = %v", err)
+	}
+	if err := ValidatePlacementCoverage(placements, creative); err == nil {
+		t.Error("Expected an error for a placement without media")
+	}
+
+	creative.PlacementMaterials = append(creative.PlacementMaterials, PlacementMaterial{Placement: models.PlacementPangle, VideoID: "v-square"})
+	if err := ValidatePlacementCoverage(placements, creative); err != nil {
+		t.Errorf("ValidatePlacementCoverage() error = %v", err)
+	}
+
+	if err := ValidatePlacementCoverage([]models.Placement{models.PlacementTikTok}, creative); err == nil {
+		t.Error("Expected an error for a placement not selected on the ad group")
+	}
+}
+
+func TestValidateAdCreative(t *testing.T) {
+	tests := []struct {
+		name     string
+		creative AdCreative
+		wantErr  string
+	}{
+		{name: "video", creative: AdCreative{AdFormat: "SINGLE_VIDEO", AdText: "Shop now", IdentityID: "id1", VideoID: "v1", LandingPageURL: "https://example.com"}},
+		{name: "spark ad without text", creative: AdCreative{AdFormat: "SINGLE_VIDEO", IdentityID: "id1", TikTokItemID: "7234567890123456789"}},
+		{name: "carousel", creative: AdCreative{AdFormat: "CAROUSEL_ADS", AdText: "Shop now", IdentityID: "id1", ImageIDs: []string{"i1", "i2"}}},
+		{name: "missing text", creative: AdCreative{AdFormat: "SINGLE_VIDEO", IdentityID: "id1", VideoID: "v1"}, wantErr: "ad_text"},
+		{name: "missing identity", creative: AdCreative{AdFormat: "SINGLE_VIDEO", AdText: "Shop now", VideoID: "v1"}, wantErr: "identity_id"},
+		{name: "unknown format", creative: AdCreative{AdFormat: "PLAYABLE", AdText: "Shop now", IdentityID: "id1"}, wantErr: "ad_format"},
+		{name: "video without media", creative: AdCreative{AdFormat: "SINGLE_VIDEO", AdText: "Shop now", IdentityID: "id1"}, wantErr: "video_id"},
+		{name: "image with two images", creative: AdCreative{AdFormat: "SINGLE_IMAGE", AdText: "Shop now", IdentityID: "id1", ImageIDs: []string{"i1", "i2"}}, wantErr: "exactly one"},
+		{name: "carousel with one image", creative: AdCreative{AdFormat: "CAROUSEL_ADS", AdText: "Shop now", IdentityID: "id1", ImageIDs: []string{"i1"}}, wantErr: "at least two"},
+		{name: "invalid landing page", creative: AdCreative{AdFormat: "SINGLE_VIDEO", AdText: "Shop now", IdentityID: "id1", VideoID: "v1", LandingPageURL: "not a url"}, wantErr: "url"},
+		{name: "placement customized twice", creative: AdCreative{AdFormat: "SINGLE_VIDEO", AdText: "Shop now", IdentityID: "id1", PlacementMaterials: []PlacementMaterial{
+			{Placement: models.PlacementTikTok, VideoID: "v1"}, {Placement: models.PlacementTikTok, VideoID: "v2"},
+		}}, wantErr: "more than once"},
+		{name: "placement without media", creative: AdCreative{AdFormat: "SINGLE_VIDEO", AdText: "Shop now", IdentityID: "id1", PlacementMaterials: []PlacementMaterial{
+			{Placement: models.PlacementTikTok},
+		}}, wantErr: "placement PLACEMENT_TIKTOK"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAdCreative(&tt.creative)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateAdCreative() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(strings.ToLower(err.Error()), strings.ToLower(tt.wantErr)) {
+				t.Errorf("validateAdCreative() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAdGroupRequest(t *testing.T) {
+	valid := func() *AdGroupCreateRequest {
+		return &AdGroupCreateRequest{
+			AdvertiserID:    "123",
+			CampaignID:      "456",
+			AdGroupName:     "US 18-24",
+			PlacementType:   models.PlacementTypeNormal,
+			Placements:      []models.Placement{models.PlacementTikTok},
+			AdGroupBidding:  AdGroupBidding{BudgetMode: models.BudgetModeDaily, Budget: 50, BidType: models.BidTypeMaxBid, BidPrice: 1},
+			AdGroupSchedule: AdGroupSchedule{ScheduleType: "SCHEDULE_FROM_NOW"},
+		}
+	}
+	tests := []struct {
+		name      string
+		modify    func(r *AdGroupCreateRequest)
+		wantField string
+	}{
+		{"valid", func(r *AdGroupCreateRequest) {}, ""},
+		{"automatic placement without placements", func(r *AdGroupCreateRequest) {
+			r.PlacementType, r.Placements = models.PlacementTypeAutomatic, nil
+		}, ""},
+		{"missing campaign", func(r *AdGroupCreateRequest) { r.CampaignID = "" }, "campaign_id"},
+		{"missing name", func(r *AdGroupCreateRequest) { r.AdGroupName = "" }, "adgroup_name"},
+		{"normal placement without placements", func(r *AdGroupCreateRequest) { r.Placements = nil }, "placements"},
+		{"unknown placement type", func(r *AdGroupCreateRequest) { r.PlacementType = "PLACEMENT_TYPE_OTHER" }, "placement_type"},
+		{"missing schedule type", func(r *AdGroupCreateRequest) { r.ScheduleType = "" }, "schedule_type"},
+		{"unknown schedule type", func(r *AdGroupCreateRequest) { r.ScheduleType = "SCHEDULE_ALWAYS" }, "schedule_type"},
+		{"start and end without end time", func(r *AdGroupCreateRequest) {
+			r.ScheduleType, r.ScheduleStartTime = "SCHEDULE_START_END", "2024-01-01 00:00:00"
+		}, "schedule_end_time"},
+		{"malformed start time", func(r *AdGroupCreateRequest) {
+			r.ScheduleType, r.ScheduleStartTime, r.ScheduleEndTime = "SCHEDULE_START_END", "2024-01-01", "2024-02-01 00:00:00"
+		}, "schedule_start_time"},
+		{"custom bid without price", func(r *AdGroupCreateRequest) { r.BidPrice = 0 }, "bid_price"},
+		{"custom bid with conversion price", func(r *AdGroupCreateRequest) { r.BidPrice, r.ConversionBidPrice = 0, 2 }, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			err := req.Validate()
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			var validationErr models.ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField {
+				t.Errorf("Validate() error = %v, want a %s error", err, tt.wantField)
+			}
+		})
+	}
+
+	update := &AdGroupUpdateRequest{AdvertiserID: "123", AdGroupID: "g1", AdGroupBidding: AdGroupBidding{BidType: models.BidTypeMaxBid}}
+	var validationErr models.ValidationError
+	if err := update.Validate(); !errors.As(err, &validationErr) || validationErr.Field != "bid_price" {
+		t.Errorf("update Validate() error = %v, want a bid_price error", err)
+	}
+}
@@ -0,0 +1,195 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// VerifyMaxClockSkew is the largest difference between the local clock and
+// the API server's clock that Verify accepts
+const VerifyMaxClockSkew = 5 * time.Minute
+
+// ReadinessStatus is the outcome of a readiness check
+type ReadinessStatus string
+
+const (
+	ReadinessPass    ReadinessStatus = "PASS"
+	ReadinessFail    ReadinessStatus = "FAIL"
+	ReadinessSkipped ReadinessStatus = "SKIPPED"
+)
+
+// Readiness check names
+const (
+	CheckReachability = "reachability"
+	CheckClockSkew    = "clock_skew"
+	CheckToken        = "token"
+	CheckAdvertisers  = "advertisers"
+)
+
+// ReadinessCheck is the result of one Verify check
+type ReadinessCheck struct {
+	Name     string          `json:"name"`
+	Status   ReadinessStatus `json:"status"`
+	Detail   string          `json:"detail,omitempty"`
+	Duration time.Duration   `json:"duration_ns"`
+}
+
+// ReadinessReport is the result of Verify, suitable for serving from a
+// health endpoint
+type ReadinessReport struct {
+	Ready         bool             `json:"ready"`
+	CheckedAt     time.Time        `json:"checked_at"`
+	ClockSkew     time.Duration    `json:"clock_skew_ns"`
+	AdvertiserIDs []string         `json:"advertiser_ids,omitempty"`
+	Checks        []ReadinessCheck `json:"checks"`
+}
+
+// Check returns the named check, or nil if it did not run
+func (r *ReadinessReport) Check(name string) *ReadinessCheck {
+	for i := range r.Checks {
+		if r.Checks[i].Name == name {
+			return &r.Checks[i]
+		}
+	}
+	return nil
+}
+
+// Err returns an error listing the failed checks, or nil when the client is ready
+func (r *ReadinessReport) Err() error {
+	if r.Ready {
+		return nil
+	}
+	var failed []string
+	for _, check := range r.Checks {
+		if check.Status == ReadinessFail {
+			failed = append(failed, fmt.Sprintf("%s: %s", check.Name, check.Detail))
+		}
+	}
+	return fmt.Errorf("client not ready: %v", failed)
+}
+
+// authorizedAdvertisersResponse is the response of /oauth2/advertiser/get/
+type authorizedAdvertisersResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		List []struct {
+			AdvertiserID   string `json:"advertiser_id"`
+			AdvertiserName string `json:"advertiser_name"`
+		} `json:"list"`
+	} `json:"data"`
+}
+
+// Verify performs cheap startup checks: the base URL is reachable, the local
+// clock agrees with the server's, the access token is valid and, when
+// ClientID and ClientSecret are configured, the token grants access to at
+// least one advertiser. Failed checks are reported in the returned report
+// rather than as an error, so it can be served as-is from a health endpoint.
+func (c *Client) Verify(ctx context.Context) *ReadinessReport {
+	report := &ReadinessReport{CheckedAt: time.Now().UTC(), Ready: true}
+	run := func(name string, check func() (ReadinessStatus, string)) {
+		start := time.Now()
+		status, detail := check()
+		report.Checks = append(report.Checks, ReadinessCheck{
+			Name:     name,
+			Status:   status,
+			Detail:   detail,
+			Duration: time.Since(start),
+		})
+		if status == ReadinessFail {
+			report.Ready = false
+		}
+	}
+
+	var serverDate time.Time
+	run(CheckReachability, func() (ReadinessStatus, string) {
+		req, err := http.NewRequestWithContext(ctx, "HEAD", c.baseURL.String(), nil)
+		if err != nil {
+			return ReadinessFail, err.Error()
+		}
+		req.Header.Set("User-Agent", c.config.UserAgent)
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return ReadinessFail, err.Error()
+		}
+		resp.Body.Close()
+		serverDate, _ = http.ParseTime(resp.Header.Get("Date"))
+		return ReadinessPass, fmt.Sprintf("HTTP %d", resp.StatusCode)
+	})
+
+	run(CheckClockSkew, func() (ReadinessStatus, string) {
+		if serverDate.IsZero() {
+			return ReadinessSkipped, "server did not send a Date header"
+		}
+		report.ClockSkew = time.Since(serverDate).Round(time.Second)
+		skew := report.ClockSkew
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew > VerifyMaxClockSkew {
+			return ReadinessFail, fmt.Sprintf("local clock is %s off the server clock", report.ClockSkew)
+		}
+		return ReadinessPass, report.ClockSkew.String()
+	})
+
+	var token string
+	run(CheckToken, func() (ReadinessStatus, string) {
+		token = c.config.AccessToken
+		if c.config.TokenSource != nil {
+			var err error
+			if token, err = c.config.TokenSource.Token(ctx); err != nil {
+				return ReadinessFail, fmt.Sprintf("failed to get access token: %v", err)
+			}
+		}
+		if token == "" {
+			return ReadinessFail, "no access token configured"
+		}
+		result, err := c.Auth().ValidateToken(ctx, token)
+		if err != nil {
+			return ReadinessFail, err.Error()
+		}
+		if !result.Valid {
+			return ReadinessFail, "access token is invalid or expired"
+		}
+		return ReadinessPass, ""
+	})
+
+	run(CheckAdvertisers, func() (ReadinessStatus, string) {
+		if c.config.ClientID == "" || c.config.ClientSecret == "" {
+			return ReadinessSkipped, "ClientID and ClientSecret are not configured"
+		}
+		if token == "" {
+			return ReadinessSkipped, "no access token"
+		}
+
+		url, err := c.BuildURL("/oauth2/advertiser/get/", map[string]interface{}{
+			"app_id": c.config.ClientID,
+			"secret": c.config.ClientSecret,
+		})
+		if err != nil {
+			return ReadinessFail, err.Error()
+		}
+		resp, err := c.DoRequest(ctx, "GET", url, nil, map[string]string{"Access-Token": token})
+		if err != nil {
+			return ReadinessFail, err.Error()
+		}
+		var response authorizedAdvertisersResponse
+		if err := c.ParseResponse(resp, &response); err != nil {
+			return ReadinessFail, err.Error()
+		}
+		if response.Code != 0 {
+			return ReadinessFail, fmt.Sprintf("API error: %s", response.Message)
+		}
+		for _, advertiser := range response.Data.List {
+			report.AdvertiserIDs = append(report.AdvertiserIDs, advertiser.AdvertiserID)
+		}
+		if len(report.AdvertiserIDs) == 0 {
+			return ReadinessFail, "access token is not authorized for any advertiser"
+		}
+		return ReadinessPass, fmt.Sprintf("%d advertisers", len(report.AdvertiserIDs))
+	})
+
+	return report
+}
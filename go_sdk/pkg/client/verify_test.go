@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_Verify(t *testing.T) {
+	var advertisers string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/":
+			w.Header().Set("Date", time.Now().Add(-10*time.Minute).UTC().Format(http.TimeFormat))
+		case "/open_api/v1.3/oauth2/user_info/":
+			w.Write([]byte(`{"code":0,"data":{"scope":"ads"}}`))
+		case "/open_api/v1.3/oauth2/advertiser/get/":
+			if r.URL.Query().Get("app_id") != "app" || r.Header.Get("Access-Token") != "test_token" {
+				t.Errorf("request = %s %v", r.URL, r.Header)
+			}
+			w.Write([]byte(`{"code":0,"data":{"list":[` + advertisers + `]}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	client, err := NewClient(&Config{BaseURL: server.URL, AccessToken: "test_token", ClientID: "app", ClientSecret: "secret", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	report := client.Verify(context.Background())
+	if report.Ready || report.Err() == nil {
+		t.Fatalf("report = %+v", report)
+	}
+	for name, want := range map[string]ReadinessStatus{
+		CheckReachability: ReadinessPass,
+		CheckClockSkew:    ReadinessFail,
+		CheckToken:        ReadinessPass,
+		CheckAdvertisers:  ReadinessFail,
+	} {
+		if check := report.Check(name); check == nil || check.Status != want {
+			t.Errorf("%s check = %+v, want %s", name, check, want)
+		}
+	}
+	if report.ClockSkew < 9*time.Minute {
+		t.Errorf("ClockSkew = %s", report.ClockSkew)
+	}
+
+	advertisers = `{"advertiser_id":"123"}`
+	report = client.Verify(context.Background())
+	if check := report.Check(CheckAdvertisers); check.Status != ReadinessPass || len(report.AdvertiserIDs) != 1 {
+		t.Errorf("advertisers check = %+v, ids = %v", check, report.AdvertiserIDs)
+	}
+
+	client.config.ClientSecret = ""
+	report = client.Verify(context.Background())
+	if check := report.Check(CheckAdvertisers); check.Status != ReadinessSkipped {
+		t.Errorf("advertisers check = %+v", check)
+	}
+}
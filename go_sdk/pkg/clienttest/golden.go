@@ -0,0 +1,279 @@
+// Package clienttest provides golden tests for decoding API responses.
+//
+// A fixture is a recorded API response body stored as NAME.json or
+// NAME.json.gz, where NAME is the response type it decodes into, optionally
+// followed by a variant: AdGetResponse.json.gz, AdGetResponse.carousel.json.gz.
+// RunGoldenTests decodes every fixture of a directory into its type, encodes
+// it again and fails when a field of the recorded payload did not survive,
+// catching struct changes that silently drop or retype API fields.
+//
+//	func TestResponseFixtures(t *testing.T) {
+//		clienttest.RunGoldenTests(t, "testdata/responses", client.ResponseTypes())
+//	}
+package clienttest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// LoadFixture reads a fixture, decompressing it when its name ends in .gz
+func LoadFixture(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(path, ".gz") {
+		return data, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s: %w", path, err)
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// WriteFixture records a response body, gzip-compressed when path ends in .gz
+func WriteFixture(path string, data []byte) error {
+	if !strings.HasSuffix(path, ".gz") {
+		return os.WriteFile(path, data, 0o644)
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// FixtureType returns the response type name of a fixture file
+func FixtureType(path string) string {
+	name := filepath.Base(path)
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// RoundTrip decodes data into a new value of typ, encodes it again and
+// returns the differences from data. Fields absent after encoding are not
+// reported when their recorded value is null, zero or empty, since omitempty
+// legitimately drops them.
+func RoundTrip(data []byte, typ reflect.Type) ([]string, error) {
+	value := reflect.New(typ)
+	if err := json.Unmarshal(data, value.Interface()); err != nil {
+		return nil, fmt.Errorf("failed to decode into %s: %w", typ, err)
+	}
+	encoded, err := json.Marshal(value.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", typ, err)
+	}
+
+	want, err := decodeTree(data)
+	if err != nil {
+		return nil, fmt.Errorf("fixture is not valid JSON: %w", err)
+	}
+	got, err := decodeTree(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []string
+	compareTrees("$", want, got, &diffs)
+	return diffs, nil
+}
+
+// RunGoldenTests round-trips every fixture in dir through its type in types,
+// running one subtest per fixture. Fixtures naming a type missing from types
+// fail, so a renamed response struct cannot silently skip its fixtures.
+func RunGoldenTests(t *testing.T, dir string, types map[string]reflect.Type) {
+	t.Helper()
+
+	paths, err := fixturePaths(dir)
+	if err != nil {
+		t.Fatalf("Failed to list fixtures: %v", err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("No fixtures in %s", dir)
+	}
+
+	covered := make(map[string]bool)
+	for _, path := range paths {
+		name := FixtureType(path)
+		covered[name] = true
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			typ, ok := types[name]
+			if !ok {
+				t.Fatalf("Unknown response type %s", name)
+			}
+			data, err := LoadFixture(path)
+			if err != nil {
+				t.Fatalf("Failed to load fixture: %v", err)
+			}
+			diffs, err := RoundTrip(data, typ)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, diff := range diffs {
+				t.Errorf("%s: %s", name, diff)
+			}
+		})
+	}
+
+	missing := 0
+	for name := range types {
+		if !covered[name] {
+			missing++
+		}
+	}
+	if missing > 0 {
+		t.Logf("%d of %d response types have no fixture", missing, len(types))
+	}
+}
+
+// fixturePaths lists the .json and .json.gz files of dir in name order
+func fixturePaths(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && (strings.HasSuffix(name, ".json") || strings.HasSuffix(name, ".json.gz")) {
+			paths = append(paths, filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// decodeTree decodes JSON keeping numbers exact
+func decodeTree(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var tree interface{}
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// compareTrees appends the differences between a recorded and a re-encoded
+// JSON value to diffs
+func compareTrees(path string, want, got interface{}, diffs *[]string) {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			*diffs = append(*diffs, fmt.Sprintf("%s: recorded an object, encoded %s", path, describe(got)))
+			return
+		}
+		keys := make([]string, 0, len(w))
+		for key := range w {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value, ok := g[key]
+			if !ok {
+				if !isEmpty(w[key]) {
+					*diffs = append(*diffs, fmt.Sprintf("%s.%s: dropped", path, key))
+				}
+				continue
+			}
+			compareTrees(path+"."+key, w[key], value, diffs)
+		}
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok {
+			if len(w) > 0 || !isEmpty(got) {
+				*diffs = append(*diffs, fmt.Sprintf("%s: recorded an array, encoded %s", path, describe(got)))
+			}
+			return
+		}
+		if len(w) != len(g) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: recorded %d elements, encoded %d", path, len(w), len(g)))
+			return
+		}
+		for i := range w {
+			compareTrees(fmt.Sprintf("%s[%d]", path, i), w[i], g[i], diffs)
+		}
+	case json.Number:
+		g, ok := got.(json.Number)
+		if !ok || !equalNumbers(w, g) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: recorded %s, encoded %s", path, w, describe(got)))
+		}
+	case nil:
+		if !isEmpty(got) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: recorded null, encoded %s", path, describe(got)))
+		}
+	default:
+		if want != got {
+			*diffs = append(*diffs, fmt.Sprintf("%s: recorded %s, encoded %s", path, describe(want), describe(got)))
+		}
+	}
+}
+
+// equalNumbers compares JSON numbers by value, so 1.50 equals 1.5
+func equalNumbers(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	x, errX := strconv.ParseFloat(string(a), 64)
+	y, errY := strconv.ParseFloat(string(b), 64)
+	return errX == nil && errY == nil && x == y
+}
+
+// isEmpty reports whether a JSON value is null, zero or empty
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case json.Number:
+		f, err := strconv.ParseFloat(string(v), 64)
+		return err == nil && f == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		for _, item := range v {
+			if !isEmpty(item) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// describe renders a JSON value for a difference message
+func describe(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	if len(data) > 60 {
+		return string(data[:57]) + "..."
+	}
+	return string(data)
+}
@@ -0,0 +1,60 @@
+package clienttest
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type goldenItem struct {
+	ID    string  `json:"id"`
+	Price float64 `json:"price"`
+}
+
+type goldenResponse struct {
+	Code  int          `json:"code"`
+	Items []goldenItem `json:"items"`
+	Note  string       `json:"note,omitempty"`
+}
+
+func TestRoundTrip(t *testing.T) {
+	typ := reflect.TypeFor[goldenResponse]()
+
+	diffs, err := RoundTrip([]byte(`{"code":0,"items":[{"id":"1","price":1.50}],"note":"","extra":null}`), typ)
+	if err != nil || len(diffs) != 0 {
+		t.Errorf("RoundTrip() = %v, %v, want no differences", diffs, err)
+	}
+
+	diffs, err = RoundTrip([]byte(`{"code":0,"items":[{"id":"1","price":2,"sku":"A"}],"page_info":{"page":1}}`), typ)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if got := strings.Join(diffs, "; "); got != "$.items[0].sku: dropped; $.page_info: dropped" {
+		t.Errorf("RoundTrip() = %s", got)
+	}
+
+	if _, err := RoundTrip([]byte(`{"code":"0"}`), typ); err == nil {
+		t.Error("RoundTrip() decoded a string code into an int")
+	}
+}
+
+func TestFixtures(t *testing.T) {
+	dir := t.TempDir()
+	body := []byte(`{"code":0,"items":[{"id":"1","price":3}]}`)
+	for _, name := range []string{"goldenResponse.json.gz", "goldenResponse.empty.json"} {
+		if err := WriteFixture(filepath.Join(dir, name), body); err != nil {
+			t.Fatalf("WriteFixture() error = %v", err)
+		}
+	}
+
+	data, err := LoadFixture(filepath.Join(dir, "goldenResponse.json.gz"))
+	if err != nil || string(data) != string(body) {
+		t.Errorf("LoadFixture() = %q, %v", data, err)
+	}
+	if name := FixtureType(filepath.Join(dir, "goldenResponse.empty.json")); name != "goldenResponse" {
+		t.Errorf("FixtureType() = %q", name)
+	}
+
+	RunGoldenTests(t, dir, map[string]reflect.Type{"goldenResponse": reflect.TypeFor[goldenResponse]()})
+}
@@ -0,0 +1,338 @@
+// Package feed validates catalog products and writes them as the CSV and
+// XML product feeds TikTok catalogs import, so a feed can be checked locally
+// before it is registered with CatalogService.CreateFeed.
+package feed
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/utils"
+)
+
+// Feed field limits
+const (
+	MaxSKUIDLength         = 100
+	MaxBrandLength         = 150
+	MaxAdditionalImages    = 10
+	MaxCustomLabels        = 5
+	MaxProductsPerFeedFile = 1000000
+)
+
+// googleBaseNamespace is the XML namespace of the feed fields
+const googleBaseNamespace = "http://base.google.com/ns/1.0"
+
+// Issue is a feed rule broken by one product
+type Issue struct {
+	Index   int    `json:"index"`
+	SKUID   string `json:"sku_id"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error implements the error interface
+func (i Issue) Error() string {
+	return fmt.Sprintf("product %d (%s): %s: %s", i.Index, i.SKUID, i.Field, i.Message)
+}
+
+// Issues is the list of rules broken by a feed
+type Issues []Issue
+
+// Error implements the error interface
+func (is Issues) Error() string {
+	if len(is) == 1 {
+		return is[0].Error()
+	}
+	return fmt.Sprintf("%d feed issues, first: %s", len(is), is[0].Error())
+}
+
+// Validate checks products against the catalog field rules and the rules
+// that apply across a feed, returning every issue found
+func Validate(products []client.Product) Issues {
+	var issues Issues
+	add := func(i int, field, message string) {
+		issues = append(issues, Issue{Index: i, SKUID: products[i].SKUID, Field: field, Message: message})
+	}
+
+	if len(products) > MaxProductsPerFeedFile {
+		issues = append(issues, Issue{Index: -1, Field: "products", Message: fmt.Sprintf("a feed file cannot exceed %d products", MaxProductsPerFeedFile)})
+	}
+
+	seen := make(map[string]int, len(products))
+	for i := range products {
+		p := &products[i]
+
+		if err := p.Validate(); err != nil {
+			var verr models.ValidationError
+			if errors.As(err, &verr) {
+				add(i, verr.Field, verr.Message)
+			} else {
+				add(i, "product", err.Error())
+			}
+		}
+		if first, ok := seen[p.SKUID]; ok && p.SKUID != "" {
+			add(i, "sku_id", fmt.Sprintf("duplicate of product %d", first))
+		} else {
+			seen[p.SKUID] = i
+		}
+		if len(p.SKUID) > MaxSKUIDLength {
+			add(i, "sku_id", fmt.Sprintf("sku_id cannot exceed %d characters", MaxSKUIDLength))
+		}
+		if len(p.Brand) > MaxBrandLength {
+			add(i, "brand", fmt.Sprintf("brand cannot exceed %d characters", MaxBrandLength))
+		}
+		if p.LandingPageURL == "" {
+			add(i, "landing_page_url", "landing_page_url is required in feeds")
+		}
+		if len(p.AdditionalImageURLs) > MaxAdditionalImages {
+			add(i, "additional_image_urls", fmt.Sprintf("at most %d additional images are allowed", MaxAdditionalImages))
+		}
+		for _, u := range p.AdditionalImageURLs {
+			if err := utils.ValidateURL(u); err != nil {
+				add(i, "additional_image_urls", fmt.Sprintf("invalid URL %q", u))
+			}
+		}
+		if p.VideoURL != "" {
+			if err := utils.ValidateURL(p.VideoURL); err != nil {
+				add(i, "video_url", fmt.Sprintf("invalid URL %q", p.VideoURL))
+			}
+		}
+		if len(p.CustomLabels) > MaxCustomLabels {
+			add(i, "custom_labels", fmt.Sprintf("at most %d custom labels are allowed", MaxCustomLabels))
+		}
+		if strings.ContainsAny(p.SKUID, ",\n\t") {
+			add(i, "sku_id", "sku_id cannot contain commas, tabs or line breaks")
+		}
+	}
+	return issues
+}
+
+// column is one feed field and how it is read from a product
+type column struct {
+	name     string
+	required bool
+	value    func(p *client.Product) string
+}
+
+// columns lists the feed fields in the order they are written
+var columns = []column{
+	{"sku_id", true, func(p *client.Product) string { return p.SKUID }},
+	{"title", true, func(p *client.Product) string { return p.Title }},
+	{"description", true, func(p *client.Product) string { return p.Description }},
+	{"availability", true, func(p *client.Product) string { return string(p.Availability) }},
+	{"condition", false, func(p *client.Product) string { return string(p.Condition) }},
+	{"price", true, func(p *client.Product) string { return formatPrice(p.Price, p.Currency) }},
+	{"sale_price", false, func(p *client.Product) string { return formatPrice(p.SalePrice, p.Currency) }},
+	{"link", true, func(p *client.Product) string { return p.LandingPageURL }},
+	{"image_link", true, func(p *client.Product) string { return p.ImageURL }},
+	{"additional_image_link", false, func(p *client.Product) string { return strings.Join(p.AdditionalImageURLs, ",") }},
+	{"video_link", false, func(p *client.Product) string { return p.VideoURL }},
+	{"brand", false, func(p *client.Product) string { return p.Brand }},
+	{"inventory", false, func(p *client.Product) string {
+		if p.Inventory == nil {
+			return ""
+		}
+		return strconv.Itoa(*p.Inventory)
+	}},
+	{"gtin", false, func(p *client.Product) string { return p.GTIN }},
+	{"mpn", false, func(p *client.Product) string { return p.MPN }},
+	{"item_group_id", false, func(p *client.Product) string { return p.ItemGroupID }},
+	{"google_product_category", false, func(p *client.Product) string { return p.ProductCategory }},
+	{"product_type", false, func(p *client.Product) string { return p.ProductType }},
+	{"color", false, func(p *client.Product) string { return p.Color }},
+	{"size", false, func(p *client.Product) string { return p.Size }},
+	{"gender", false, func(p *client.Product) string { return p.Gender }},
+	{"age_group", false, func(p *client.Product) string { return p.AgeGroup }},
+	{"custom_label_0", false, customLabel(0)},
+	{"custom_label_1", false, customLabel(1)},
+	{"custom_label_2", false, customLabel(2)},
+	{"custom_label_3", false, customLabel(3)},
+	{"custom_label_4", false, customLabel(4)},
+	{"hotel_id", false, hotelField(func(h *client.HotelProductFields) string { return h.HotelID })},
+	{"star_rating", false, hotelField(func(h *client.HotelProductFields) string { return formatFloat(h.StarRating) })},
+	{"address", false, hotelField(func(h *client.HotelProductFields) string { return h.Address })},
+	{"city", false, hotelField(func(h *client.HotelProductFields) string { return h.City })},
+	{"country", false, hotelField(func(h *client.HotelProductFields) string { return h.Country })},
+	{"latitude", false, hotelField(func(h *client.HotelProductFields) string { return formatFloat(h.Latitude) })},
+	{"longitude", false, hotelField(func(h *client.HotelProductFields) string { return formatFloat(h.Longitude) })},
+	{"origin_airport", false, flightField(func(f *client.FlightProductFields) string { return f.OriginAirport })},
+	{"destination_airport", false, flightField(func(f *client.FlightProductFields) string { return f.DestinationAirport })},
+	{"origin_city", false, flightField(func(f *client.FlightProductFields) string { return f.OriginCity })},
+	{"destination_city", false, flightField(func(f *client.FlightProductFields) string { return f.DestinationCity })},
+	{"vin", false, vehicleField(func(v *client.VehicleProductFields) string { return v.VIN })},
+	{"make", false, vehicleField(func(v *client.VehicleProductFields) string { return v.Make })},
+	{"model", false, vehicleField(func(v *client.VehicleProductFields) string { return v.Model })},
+	{"year", false, vehicleField(func(v *client.VehicleProductFields) string { return formatInt(v.Year) })},
+	{"mileage", false, vehicleField(func(v *client.VehicleProductFields) string {
+		if v.Mileage == 0 {
+			return ""
+		}
+		return strings.TrimSpace(fmt.Sprintf("%d %s", v.Mileage, v.MileageUnit))
+	})},
+	{"body_style", false, vehicleField(func(v *client.VehicleProductFields) string { return v.BodyStyle })},
+	{"state_of_vehicle", false, vehicleField(func(v *client.VehicleProductFields) string { return v.StateOfVehicle })},
+}
+
+// WriteCSV validates products and writes them as a CSV feed. Optional
+// columns that no product uses are left out.
+func WriteCSV(w io.Writer, products []client.Product) error {
+	if issues := Validate(products); len(issues) > 0 {
+		return issues
+	}
+
+	used := usedColumns(products)
+	writer := csv.NewWriter(w)
+	header := make([]string, len(used))
+	for i, col := range used {
+		header[i] = col.name
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write feed header: %w", err)
+	}
+
+	record := make([]string, len(used))
+	for i := range products {
+		for j, col := range used {
+			record[j] = col.value(&products[i])
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write product %s: %w", products[i].SKUID, err)
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteXML validates products and writes them as an RSS 2.0 feed with the
+// fields in the Google base namespace. An empty title uses "Product feed".
+func WriteXML(w io.Writer, title string, products []client.Product) error {
+	if issues := Validate(products); len(issues) > 0 {
+		return issues
+	}
+	if title == "" {
+		title = "Product feed"
+	}
+
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<rss version="2.0" xmlns:g="` + googleBaseNamespace + `">` + "\n<channel>\n<title>")
+	xml.EscapeText(&b, []byte(title))
+	b.WriteString("</title>\n")
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write feed header: %w", err)
+	}
+
+	used := usedColumns(products)
+	for i := range products {
+		b.Reset()
+		b.WriteString("<item>\n")
+		for _, col := range used {
+			value := col.value(&products[i])
+			if value == "" {
+				continue
+			}
+			b.WriteString("  <g:" + col.name + ">")
+			xml.EscapeText(&b, []byte(value))
+			b.WriteString("</g:" + col.name + ">\n")
+		}
+		b.WriteString("</item>\n")
+		if _, err := io.WriteString(w, b.String()); err != nil {
+			return fmt.Errorf("failed to write product %s: %w", products[i].SKUID, err)
+		}
+	}
+
+	if _, err := io.WriteString(w, "</channel>\n</rss>\n"); err != nil {
+		return fmt.Errorf("failed to write feed footer: %w", err)
+	}
+	return nil
+}
+
+// usedColumns returns the required columns and the optional columns set on
+// at least one product
+func usedColumns(products []client.Product) []column {
+	var used []column
+	for _, col := range columns {
+		if col.required {
+			used = append(used, col)
+			continue
+		}
+		for i := range products {
+			if col.value(&products[i]) != "" {
+				used = append(used, col)
+				break
+			}
+		}
+	}
+	return used
+}
+
+// formatPrice formats a price as the feed expects, e.g. "12.50 USD"
+func formatPrice(price float64, currency string) string {
+	if price == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.2f %s", price, strings.ToUpper(currency))
+}
+
+// formatFloat formats an optional number, empty when zero
+func formatFloat(f float64) string {
+	if f == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// formatInt formats an optional integer, empty when zero
+func formatInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+// customLabel reads the custom label at index i
+func customLabel(i int) func(p *client.Product) string {
+	return func(p *client.Product) string {
+		if i < len(p.CustomLabels) {
+			return p.CustomLabels[i]
+		}
+		return ""
+	}
+}
+
+// hotelField reads a field of the hotel vertical
+func hotelField(get func(*client.HotelProductFields) string) func(p *client.Product) string {
+	return func(p *client.Product) string {
+		if p.HotelProductFields == nil {
+			return ""
+		}
+		return get(p.HotelProductFields)
+	}
+}
+
+// flightField reads a field of the flight vertical
+func flightField(get func(*client.FlightProductFields) string) func(p *client.Product) string {
+	return func(p *client.Product) string {
+		if p.FlightProductFields == nil {
+			return ""
+		}
+		return get(p.FlightProductFields)
+	}
+}
+
+// vehicleField reads a field of the vehicle vertical
+func vehicleField(get func(*client.VehicleProductFields) string) func(p *client.Product) string {
+	return func(p *client.Product) string {
+		if p.VehicleProductFields == nil {
+			return ""
+		}
+		return get(p.VehicleProductFields)
+	}
+}
@@ -0,0 +1,102 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+)
+
+func feedProduct(sku string) client.Product {
+	return client.Product{
+		SKUID:          sku,
+		Title:          "Mug & saucer",
+		Description:    "Blue mug",
+		Availability:   client.ProductInStock,
+		ImageURL:       "https://example.com/mug.jpg",
+		LandingPageURL: "https://example.com/mug",
+		Price:          12.5,
+		SalePrice:      10,
+		Currency:       "usd",
+		CustomLabels:   []string{"kitchen"},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := feedProduct("sku-2")
+	invalid.Availability = "SOLD_OUT"
+	invalid.LandingPageURL = ""
+	invalid.AdditionalImageURLs = []string{"not a url"}
+
+	issues := Validate([]client.Product{feedProduct("sku-1"), invalid, feedProduct("sku-1")})
+
+	got := make(map[string]bool)
+	for _, issue := range issues {
+		got[issue.SKUID+"/"+issue.Field] = true
+	}
+	for _, want := range []string{"sku-2/availability", "sku-2/landing_page_url", "sku-2/additional_image_urls", "sku-1/sku_id"} {
+		if !got[want] {
+			t.Errorf("Missing issue %s in %v", want, issues)
+		}
+	}
+	if len(issues) != 4 {
+		t.Errorf("Expected 4 issues, got %v", issues)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, []client.Product{feedProduct("sku-1")}); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to read feed: %v", err)
+	}
+	header := strings.Join(records[0], ",")
+	if header != "sku_id,title,description,availability,price,sale_price,link,image_link,custom_label_0" {
+		t.Errorf("header = %s", header)
+	}
+	if records[1][4] != "12.50 USD" || records[1][5] != "10.00 USD" {
+		t.Errorf("row = %v", records[1])
+	}
+
+	invalid := feedProduct("sku-1")
+	invalid.Price = 0
+	if err := WriteCSV(&buf, []client.Product{invalid}); err == nil {
+		t.Error("WriteCSV() accepted a product without a price")
+	}
+}
+
+func TestWriteXML(t *testing.T) {
+	vehicle := feedProduct("car-1")
+	vehicle.VehicleProductFields = &client.VehicleProductFields{Make: "Volvo", Model: "XC60", Year: 2021, Mileage: 12000, MileageUnit: "KM"}
+
+	var buf bytes.Buffer
+	if err := WriteXML(&buf, "", []client.Product{vehicle}); err != nil {
+		t.Fatalf("WriteXML() error = %v", err)
+	}
+
+	var rss struct {
+		Channel struct {
+			Title string `xml:"title"`
+			Items []struct {
+				Title   string `xml:"http://base.google.com/ns/1.0 title"`
+				Mileage string `xml:"http://base.google.com/ns/1.0 mileage"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &rss); err != nil {
+		t.Fatalf("Feed is not valid XML: %v\n%s", err, buf.String())
+	}
+	if rss.Channel.Title != "Product feed" || len(rss.Channel.Items) != 1 {
+		t.Fatalf("feed = %+v", rss)
+	}
+	if item := rss.Channel.Items[0]; item.Title != "Mug & saucer" || item.Mileage != "12000 KM" {
+		t.Errorf("item = %+v", item)
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -37,6 +40,52 @@ const (
 	StatusPaused   Status = "PAUSE"
 )
 
+// OperationStatus is the on/off switch of a campaign, ad group or ad, set
+// through the status update endpoints
+type OperationStatus string
+
+const (
+	OperationStatusEnable  OperationStatus = "ENABLE"
+	OperationStatusDisable OperationStatus = "DISABLE"
+	OperationStatusDelete  OperationStatus = "DELETE"
+)
+
+// SecondaryStatus is the delivery status the API reports for a campaign, ad
+// group or ad, combining its own switch with that of its parents
+type SecondaryStatus string
+
+const (
+	CampaignStatusEnable       SecondaryStatus = "CAMPAIGN_STATUS_ENABLE"
+	CampaignStatusDisable      SecondaryStatus = "CAMPAIGN_STATUS_DISABLE"
+	CampaignStatusDelete       SecondaryStatus = "CAMPAIGN_STATUS_DELETE"
+	CampaignStatusBudgetExceed SecondaryStatus = "CAMPAIGN_STATUS_BUDGET_EXCEED"
+
+	AdGroupStatusDeliveryOK      SecondaryStatus = "ADGROUP_STATUS_DELIVERY_OK"
+	AdGroupStatusDisable         SecondaryStatus = "ADGROUP_STATUS_DISABLE"
+	AdGroupStatusDelete          SecondaryStatus = "ADGROUP_STATUS_DELETE"
+	AdGroupStatusCampaignDisable SecondaryStatus = "ADGROUP_STATUS_CAMPAIGN_DISABLE"
+	AdGroupStatusCampaignDelete  SecondaryStatus = "ADGROUP_STATUS_CAMPAIGN_DELETE"
+
+	AdStatusDeliveryOK      SecondaryStatus = "AD_STATUS_DELIVERY_OK"
+	AdStatusDisable         SecondaryStatus = "AD_STATUS_DISABLE"
+	AdStatusDelete          SecondaryStatus = "AD_STATUS_DELETE"
+	AdStatusAdGroupDisable  SecondaryStatus = "AD_STATUS_ADGROUP_DISABLE"
+	AdStatusAdGroupDelete   SecondaryStatus = "AD_STATUS_ADGROUP_DELETE"
+	AdStatusCampaignDisable SecondaryStatus = "AD_STATUS_CAMPAIGN_DISABLE"
+	AdStatusCampaignDelete  SecondaryStatus = "AD_STATUS_CAMPAIGN_DELETE"
+)
+
+// Deleted reports whether the entity itself has been deleted
+func (s SecondaryStatus) Deleted() bool {
+	return s == CampaignStatusDelete || s == AdGroupStatusDelete || s == AdStatusDelete
+}
+
+// ParentDeleted reports whether the campaign or ad group above the entity
+// has been deleted, taking the entity with it
+func (s SecondaryStatus) ParentDeleted() bool {
+	return s == AdGroupStatusCampaignDelete || s == AdStatusAdGroupDelete || s == AdStatusCampaignDelete
+}
+
 // ObjectiveType represents campaign objective types
 type ObjectiveType string
 
@@ -51,6 +100,27 @@ const (
 	ObjectiveEngagement     ObjectiveType = "ENGAGEMENT"
 )
 
+// AttributionWindow is how long after a click or view a conversion is
+// credited to the ad
+type AttributionWindow string
+
+const (
+	AttributionWindowOff             AttributionWindow = "OFF"
+	AttributionWindowOneDay          AttributionWindow = "ONE_DAY"
+	AttributionWindowSevenDays       AttributionWindow = "SEVEN_DAYS"
+	AttributionWindowFourteenDays    AttributionWindow = "FOURTEEN_DAYS"
+	AttributionWindowTwentyEightDays AttributionWindow = "TWENTY_EIGHT_DAYS"
+)
+
+// AttributionEventCount is whether every conversion of a user within the
+// window is counted, or only the first
+type AttributionEventCount string
+
+const (
+	AttributionEventCountEvery AttributionEventCount = "EVERY"
+	AttributionEventCountOnce  AttributionEventCount = "ONCE"
+)
+
 // BudgetMode represents budget mode types
 type BudgetMode string
 
@@ -149,38 +219,159 @@ const (
 type DataLevel string
 
 const (
-	DataLevelCampaign DataLevel = "AUCTION_CAMPAIGN"
-	DataLevelAdGroup  DataLevel = "AUCTION_ADGROUP"
-	DataLevelAd       DataLevel = "AUCTION_AD"
-	DataLevelCreative DataLevel = "AUCTION_CREATIVE"
+	DataLevelAdvertiser DataLevel = "AUCTION_ADVERTISER"
+	DataLevelCampaign   DataLevel = "AUCTION_CAMPAIGN"
+	DataLevelAdGroup    DataLevel = "AUCTION_ADGROUP"
+	DataLevelAd         DataLevel = "AUCTION_AD"
+	DataLevelCreative   DataLevel = "AUCTION_CREATIVE"
+
+	DataLevelReservationAdvertiser DataLevel = "RESERVATION_ADVERTISER"
+	DataLevelReservationCampaign   DataLevel = "RESERVATION_CAMPAIGN"
+	DataLevelReservationAdGroup    DataLevel = "RESERVATION_ADGROUP"
+	DataLevelReservationAd         DataLevel = "RESERVATION_AD"
 )
 
+// ServiceType returns the service type a data level reports on
+func (l DataLevel) ServiceType() ServiceType {
+	if strings.HasPrefix(string(l), "RESERVATION_") {
+		return ServiceTypeReservation
+	}
+	return ServiceTypeAuction
+}
+
 // Dimension represents report dimensions
 type Dimension string
 
 const (
-	DimensionCampaignID   Dimension = "campaign_id"
-	DimensionAdGroupID    Dimension = "adgroup_id"
-	DimensionAdID         Dimension = "ad_id"
-	DimensionStatTimeDay  Dimension = "stat_time_day"
-	DimensionStatTimeHour Dimension = "stat_time_hour"
+	DimensionCampaignID       Dimension = "campaign_id"
+	DimensionAdGroupID        Dimension = "adgroup_id"
+	DimensionAdID             Dimension = "ad_id"
+	DimensionStatTimeDay      Dimension = "stat_time_day"
+	DimensionStatTimeHour     Dimension = "stat_time_hour"
+	DimensionAdvertiserID     Dimension = "advertiser_id"
+	DimensionCountryCode      Dimension = "country_code"
+	DimensionAge              Dimension = "age"
+	DimensionGender           Dimension = "gender"
+	DimensionPlatform         Dimension = "platform"
+	DimensionSearchTerms      Dimension = "search_terms"
+	DimensionPlacement        Dimension = "placement"
+	DimensionLanguage         Dimension = "language"
+	DimensionProvinceID       Dimension = "province_id"
+	DimensionInterestCategory Dimension = "interest_category"
+)
+
+// ServiceType represents the ad service type of a report
+type ServiceType string
+
+const (
+	ServiceTypeAuction     ServiceType = "AUCTION"
+	ServiceTypeReservation ServiceType = "RESERVATION"
+)
+
+// AdvertiserRole represents a user's role on an advertiser account
+type AdvertiserRole string
+
+const (
+	AdvertiserRoleAdmin    AdvertiserRole = "ADMIN"
+	AdvertiserRoleOperator AdvertiserRole = "OPERATOR"
+	AdvertiserRoleAnalyst  AdvertiserRole = "ANALYST"
+)
+
+// IdentityType represents the kind of identity an ad is posted as
+type IdentityType string
+
+const (
+	// IdentityTypeCustomizedUser is a custom display name and avatar, for non-Spark ads
+	IdentityTypeCustomizedUser IdentityType = "CUSTOMIZED_USER"
+	// IdentityTypeAuthCode is a TikTok account authorized with a video code
+	IdentityTypeAuthCode IdentityType = "AUTH_CODE"
+	// IdentityTypeTTUser is a TikTok account linked to the advertiser
+	IdentityTypeTTUser IdentityType = "TT_USER"
+	// IdentityTypeBCAuthTT is a TikTok account authorized through a Business Center
+	IdentityTypeBCAuthTT IdentityType = "BC_AUTH_TT"
 )
 
 // Metric represents report metrics
 type Metric string
 
 const (
-	MetricImpressions Metric = "impressions"
-	MetricClicks      Metric = "clicks"
-	MetricCost        Metric = "cost"
-	MetricCTR         Metric = "ctr"
-	MetricCPC         Metric = "cpc"
-	MetricCPM         Metric = "cpm"
-	MetricConversions Metric = "conversions"
-	MetricCPA         Metric = "cpa"
-	MetricROAS        Metric = "roas"
+	MetricImpressions       Metric = "impressions"
+	MetricClicks            Metric = "clicks"
+	MetricCost              Metric = "cost"
+	MetricCTR               Metric = "ctr"
+	MetricCPC               Metric = "cpc"
+	MetricCPM               Metric = "cpm"
+	MetricConversions       Metric = "conversions"
+	MetricCPA               Metric = "cpa"
+	MetricROAS              Metric = "roas"
+	MetricSpend             Metric = "spend"
+	MetricReach             Metric = "reach"
+	MetricConversion        Metric = "conversion"
+	MetricCostPerConversion Metric = "cost_per_conversion"
+	MetricConversionRate    Metric = "conversion_rate"
+	MetricVideoPlayActions  Metric = "video_play_actions"
+	MetricFrequency         Metric = "frequency"
+	MetricCampaignName      Metric = "campaign_name"
+	MetricAdGroupName       Metric = "adgroup_name"
+	MetricAdName            Metric = "ad_name"
+	MetricAdGroupID         Metric = "adgroup_id"
+	MetricCampaignBudget    Metric = "campaign_budget"
+	MetricAdText            Metric = "ad_text"
+
+	// Conversion and billing metrics
+	MetricResult             Metric = "result"
+	MetricCostPerResult      Metric = "cost_per_result"
+	MetricRealTimeConversion Metric = "real_time_conversion"
+	MetricBilledCost         Metric = "billed_cost"
+	MetricCashSpend          Metric = "cash_spend"
+	MetricVoucherSpend       Metric = "voucher_spend"
+
+	// Engagement and video metrics
+	MetricLikes            Metric = "likes"
+	MetricComments         Metric = "comments"
+	MetricShares           Metric = "shares"
+	MetricFollows          Metric = "follows"
+	MetricProfileVisits    Metric = "profile_visits"
+	MetricVideoWatched2s   Metric = "video_watched_2s"
+	MetricVideoWatched6s   Metric = "video_watched_6s"
+	MetricVideoViewsP25    Metric = "video_views_p25"
+	MetricVideoViewsP50    Metric = "video_views_p50"
+	MetricVideoViewsP75    Metric = "video_views_p75"
+	MetricVideoViewsP100   Metric = "video_views_p100"
+	MetricAverageVideoPlay Metric = "average_video_play"
+
+	// SKAdNetwork metrics of iOS 14 dedicated campaigns, counted from the
+	// postbacks Apple sends with a delay of one to several days
+	MetricSKANAppInstall         Metric = "skan_app_install"
+	MetricSKANCostPerAppInstall  Metric = "skan_cost_per_app_install"
+	MetricSKANConversion         Metric = "skan_conversion"
+	MetricSKANCostPerConversion  Metric = "skan_cost_per_conversion"
+	MetricSKANTotalPurchaseValue Metric = "skan_total_purchase_value"
+
+	// Conversions split by how they were attributed
+	MetricCTAConversion Metric = "cta_conversion"
+	MetricVTAConversion Metric = "vta_conversion"
 )
 
+// MetricValue is a report metric value. The API returns metrics as strings,
+// with "-" when a value is not available; both decode to a float64.
+type MetricValue float64
+
+// UnmarshalJSON implements json.Unmarshaler
+func (m *MetricValue) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "-" || s == "null" {
+		*m = 0
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("invalid metric value %s: %w", data, err)
+	}
+	*m = MetricValue(v)
+	return nil
+}
+
 // TimestampField represents a timestamp field that can be marshaled/unmarshaled
 type TimestampField struct {
 	time.Time
@@ -21,8 +21,22 @@ type APIError struct {
 
 	// HTTPStatusCode is the HTTP status code of the response
 	HTTPStatusCode int `json:"-"`
+
+	// SuggestedAction is what a generic caller should do about the error
+	SuggestedAction SuggestedAction `json:"suggested_action,omitempty"`
 }
 
+// SuggestedAction represents the recommended response to an API error
+type SuggestedAction string
+
+const (
+	ActionRetry          SuggestedAction = "RETRY"
+	ActionRefreshToken   SuggestedAction = "REFRESH_TOKEN"
+	ActionReduceRate     SuggestedAction = "REDUCE_RATE"
+	ActionFixRequest     SuggestedAction = "FIX_REQUEST"
+	ActionContactSupport SuggestedAction = "CONTACT_SUPPORT"
+)
+
 // Error implements the error interface
 func (e *APIError) Error() string {
 	if e.RequestID != "" {
@@ -123,6 +137,88 @@ func (e NetworkError) Unwrap() error {
 	return e.Err
 }
 
+// TruncatedResponseError represents a successful HTTP response whose body
+// was cut short or is not valid JSON, typically after a proxy reset
+type TruncatedResponseError struct {
+	StatusCode    int
+	BytesRead     int
+	ContentLength int64
+	Err           error
+}
+
+// Error implements the error interface
+func (e TruncatedResponseError) Error() string {
+	expected := "unknown"
+	if e.ContentLength >= 0 {
+		expected = fmt.Sprintf("%d", e.ContentLength)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("truncated response body (HTTP %d, read %d of %s bytes): %v", e.StatusCode, e.BytesRead, expected, e.Err)
+	}
+	return fmt.Sprintf("truncated response body (HTTP %d, read %d of %s bytes)", e.StatusCode, e.BytesRead, expected)
+}
+
+// Unwrap returns the underlying error
+func (e TruncatedResponseError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetryable returns true since re-issuing the request usually yields a complete body
+func (e TruncatedResponseError) IsRetryable() bool {
+	return true
+}
+
+// ResponseTooLargeError represents a response body larger than the client
+// reads into memory. Such responses must be streamed or the limit raised.
+type ResponseTooLargeError struct {
+	StatusCode    int
+	Limit         int64
+	ContentLength int64
+}
+
+// Error implements the error interface
+func (e ResponseTooLargeError) Error() string {
+	if e.ContentLength > 0 {
+		return fmt.Sprintf("response body of %d bytes exceeds the %d byte limit (HTTP %d)", e.ContentLength, e.Limit, e.StatusCode)
+	}
+	return fmt.Sprintf("response body exceeds the %d byte limit (HTTP %d)", e.Limit, e.StatusCode)
+}
+
+// IsRetryable returns false since the same request yields the same body
+func (e ResponseTooLargeError) IsRetryable() bool {
+	return false
+}
+
+// HTTPError represents a non-2xx response without a structured API error.
+// Body holds a bounded excerpt of the response body for diagnostics.
+type HTTPError struct {
+	StatusCode  int
+	Status      string
+	ContentType string
+	Body        string
+	Truncated   bool
+}
+
+// Error implements the error interface
+func (e HTTPError) Error() string {
+	status := e.Status
+	if status == "" {
+		status = fmt.Sprintf("%d", e.StatusCode)
+	}
+	if e.Body == "" {
+		return fmt.Sprintf("HTTP %s", status)
+	}
+	if e.Truncated {
+		return fmt.Sprintf("HTTP %s: %s... (truncated)", status, e.Body)
+	}
+	return fmt.Sprintf("HTTP %s: %s", status, e.Body)
+}
+
+// IsRetryable returns true for rate limiting and server errors
+func (e HTTPError) IsRetryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
 // ConfigurationError represents a configuration-related error
 type ConfigurationError struct {
 	Field   string
@@ -169,13 +265,71 @@ const (
 	ErrCodeCreativeNotApproved = "CREATIVE_NOT_APPROVED"
 )
 
+// errorCodeActions maps catalog error codes, including TikTok's numeric
+// codes, to the action a caller should take
+var errorCodeActions = map[string]SuggestedAction{
+	ErrCodeUnauthorized:          ActionRefreshToken,
+	ErrCodeInvalidAccessToken:    ActionRefreshToken,
+	ErrCodeAccessTokenExpired:    ActionRefreshToken,
+	ErrCodeForbidden:             ActionContactSupport,
+	ErrCodeInsufficientPerms:     ActionContactSupport,
+	ErrCodeInvalidParameter:      ActionFixRequest,
+	ErrCodeMissingParameter:      ActionFixRequest,
+	ErrCodeParameterNotSupported: ActionFixRequest,
+	ErrCodeValidationError:       ActionFixRequest,
+	ErrCodeRateLimitExceeded:     ActionReduceRate,
+	ErrCodeInternalError:         ActionRetry,
+	ErrCodeServiceUnavailable:    ActionRetry,
+	ErrCodeTimeout:               ActionRetry,
+	ErrCodeResourceNotFound:      ActionFixRequest,
+	ErrCodeResourceConflict:      ActionFixRequest,
+	ErrCodeResourceLimitExceeded: ActionFixRequest,
+	ErrCodeInsufficientBalance:   ActionContactSupport,
+	ErrCodeCampaignNotActive:     ActionFixRequest,
+	ErrCodeAdGroupNotActive:      ActionFixRequest,
+	ErrCodeCreativeNotApproved:   ActionFixRequest,
+
+	"40001": ActionContactSupport, // no permission for the resource
+	"40002": ActionFixRequest,     // invalid parameter
+	"40100": ActionReduceRate,     // requests too frequent
+	"40102": ActionRefreshToken,   // access token expired
+	"40104": ActionRefreshToken,   // access token empty
+	"40105": ActionRefreshToken,   // access token invalid
+	"50000": ActionRetry,          // system error
+	"50002": ActionRetry,          // service busy
+}
+
+// SuggestedActionFor returns the catalog action for an error code, falling
+// back to the HTTP status when the code is not in the catalog
+func SuggestedActionFor(code string, httpStatusCode int) SuggestedAction {
+	if action, ok := errorCodeActions[code]; ok {
+		return action
+	}
+
+	switch {
+	case httpStatusCode == http.StatusTooManyRequests:
+		return ActionReduceRate
+	case httpStatusCode == http.StatusUnauthorized:
+		return ActionRefreshToken
+	case httpStatusCode == http.StatusForbidden:
+		return ActionContactSupport
+	case httpStatusCode >= 500:
+		return ActionRetry
+	case httpStatusCode >= 400:
+		return ActionFixRequest
+	}
+
+	return ActionContactSupport
+}
+
 // NewAPIError creates a new APIError
 func NewAPIError(code, message, requestID string, httpStatusCode int) *APIError {
 	return &APIError{
-		Code:           code,
-		Message:        message,
-		RequestID:      requestID,
-		HTTPStatusCode: httpStatusCode,
+		Code:            code,
+		Message:         message,
+		RequestID:       requestID,
+		HTTPStatusCode:  httpStatusCode,
+		SuggestedAction: SuggestedActionFor(code, httpStatusCode),
 	}
 }
 
@@ -195,6 +349,36 @@ func NewNetworkError(operation string, err error) NetworkError {
 	}
 }
 
+// NewTruncatedResponseError creates a new TruncatedResponseError
+func NewTruncatedResponseError(statusCode, bytesRead int, contentLength int64, err error) TruncatedResponseError {
+	return TruncatedResponseError{
+		StatusCode:    statusCode,
+		BytesRead:     bytesRead,
+		ContentLength: contentLength,
+		Err:           err,
+	}
+}
+
+// NewResponseTooLargeError creates a new ResponseTooLargeError
+func NewResponseTooLargeError(statusCode int, limit, contentLength int64) ResponseTooLargeError {
+	return ResponseTooLargeError{
+		StatusCode:    statusCode,
+		Limit:         limit,
+		ContentLength: contentLength,
+	}
+}
+
+// NewHTTPError creates a new HTTPError
+func NewHTTPError(statusCode int, status, contentType, body string, truncated bool) HTTPError {
+	return HTTPError{
+		StatusCode:  statusCode,
+		Status:      status,
+		ContentType: contentType,
+		Body:        body,
+		Truncated:   truncated,
+	}
+}
+
 // NewConfigurationError creates a new ConfigurationError
 func NewConfigurationError(field, message string) ConfigurationError {
 	return ConfigurationError{
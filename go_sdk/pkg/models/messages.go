@@ -0,0 +1,252 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Language is a BCP 47 language tag such as en, zh-CN or ja
+type Language string
+
+const (
+	LanguageEnglish           Language = "en"
+	LanguageChineseSimplified Language = "zh-CN"
+	LanguageJapanese          Language = "ja"
+)
+
+// base returns the primary subtag of the language, e.g. ja for ja-JP
+func (l Language) base() Language {
+	if i := strings.IndexAny(string(l), "-_"); i >= 0 {
+		return l[:i]
+	}
+	return l
+}
+
+// Translator renders SDK messages in another language
+type Translator interface {
+	// Translate returns message in lang, or false when it has no translation
+	Translate(lang Language, message string) (string, bool)
+}
+
+// TranslatorFunc adapts a function to the Translator interface
+type TranslatorFunc func(lang Language, message string) (string, bool)
+
+// Translate calls f
+func (f TranslatorFunc) Translate(lang Language, message string) (string, bool) {
+	return f(lang, message)
+}
+
+// APIErrorMessageKey is the catalog key describing an API error code, used
+// when the API's own message has no translation
+func APIErrorMessageKey(code string) string {
+	return "api_error." + code
+}
+
+// messageTemplate is a catalog entry whose English message has %s verbs
+type messageTemplate struct {
+	message     string
+	pattern     *regexp.Regexp
+	translation string
+}
+
+// MessageCatalog is a Translator holding translations keyed by their English
+// message. Messages with variable parts are added as templates whose %s verbs
+// match any text, e.g. "%s is required"; the translation receives the matched
+// parts in order, or by index with %[n]s when the language reorders them.
+// Longer templates are tried first, so the most specific one wins.
+type MessageCatalog struct {
+	mu        sync.RWMutex
+	messages  map[Language]map[string]string
+	templates map[Language][]messageTemplate
+}
+
+// NewMessageCatalog creates an empty MessageCatalog
+func NewMessageCatalog() *MessageCatalog {
+	return &MessageCatalog{
+		messages:  make(map[Language]map[string]string),
+		templates: make(map[Language][]messageTemplate),
+	}
+}
+
+// Add adds the translation of an English message or template, replacing an
+// existing translation of the same message
+func (c *MessageCatalog) Add(lang Language, message, translation string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !strings.Contains(message, "%s") {
+		if c.messages[lang] == nil {
+			c.messages[lang] = make(map[string]string)
+		}
+		c.messages[lang][message] = translation
+		return
+	}
+
+	for i, template := range c.templates[lang] {
+		if template.message == message {
+			c.templates[lang][i].translation = translation
+			return
+		}
+	}
+	pattern := regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(message), "%s", "(.+?)") + "$")
+	templates := append(c.templates[lang], messageTemplate{message: message, pattern: pattern, translation: translation})
+	sort.SliceStable(templates, func(i, j int) bool {
+		return len(templates[i].message) > len(templates[j].message)
+	})
+	c.templates[lang] = templates
+}
+
+// Translate returns the translation of message, falling back from a regional
+// language such as ja-JP to its base language
+func (c *MessageCatalog) Translate(lang Language, message string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if translation, ok := c.lookup(lang, message); ok {
+		return translation, true
+	}
+	if base := lang.base(); base != lang {
+		return c.lookup(base, message)
+	}
+	return "", false
+}
+
+// lookup translates message in exactly lang; c.mu must be held
+func (c *MessageCatalog) lookup(lang Language, message string) (string, bool) {
+	if translation, ok := c.messages[lang][message]; ok {
+		return translation, true
+	}
+	for _, template := range c.templates[lang] {
+		match := template.pattern.FindStringSubmatch(message)
+		if match == nil {
+			continue
+		}
+		args := make([]interface{}, len(match)-1)
+		for i, part := range match[1:] {
+			args[i] = part
+		}
+		return fmt.Sprintf(template.translation, args...), true
+	}
+	return "", false
+}
+
+// LocalizeError renders err in lang using t. Validation, configuration and
+// API errors are rebuilt from their translated parts; other errors are
+// translated as a whole when t knows their message. Untranslated parts stay
+// in English.
+func LocalizeError(err error, lang Language, t Translator) string {
+	if err == nil {
+		return ""
+	}
+	if t == nil || lang == "" || lang.base() == LanguageEnglish {
+		return err.Error()
+	}
+
+	// Error formats are templates too; matched against themselves they
+	// translate with their verbs intact
+	translate := func(message string) string {
+		if translation, ok := t.Translate(lang, message); ok {
+			return translation
+		}
+		return message
+	}
+
+	var validationErr ValidationError
+	if errors.As(err, &validationErr) {
+		return fmt.Sprintf(translate("validation error for field '%s': %s"), validationErr.Field, translate(validationErr.Message))
+	}
+
+	var configErr ConfigurationError
+	if errors.As(err, &configErr) {
+		return fmt.Sprintf(translate("configuration error for field '%s': %s"), configErr.Field, translate(configErr.Message))
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		message, ok := t.Translate(lang, apiErr.Message)
+		if !ok {
+			message = apiErr.Message
+			if description, ok := t.Translate(lang, APIErrorMessageKey(apiErr.Code)); ok {
+				message = fmt.Sprintf("%s (%s)", description, apiErr.Message)
+			}
+		}
+		if apiErr.RequestID != "" {
+			return fmt.Sprintf(translate("TikTok API error [%s]: %s (request_id: %s)"), apiErr.Code, message, apiErr.RequestID)
+		}
+		return fmt.Sprintf(translate("TikTok API error [%s]: %s"), apiErr.Code, message)
+	}
+
+	return translate(err.Error())
+}
+
+var (
+	defaultCatalogOnce sync.Once
+	defaultCatalog     *MessageCatalog
+)
+
+// DefaultTranslator returns the shared catalog of built-in translations. Use
+// DefaultMessageCatalog to get a copy that can be extended.
+func DefaultTranslator() Translator {
+	defaultCatalogOnce.Do(func() {
+		defaultCatalog = DefaultMessageCatalog()
+	})
+	return defaultCatalog
+}
+
+// DefaultMessageCatalog returns a new catalog with the built-in Simplified
+// Chinese and Japanese translations of common SDK and API error messages
+func DefaultMessageCatalog() *MessageCatalog {
+	c := NewMessageCatalog()
+	for _, entry := range builtinTranslations {
+		c.Add(LanguageChineseSimplified, entry.message, entry.zhCN)
+		c.Add(LanguageJapanese, entry.message, entry.ja)
+	}
+	return c
+}
+
+// builtinTranslations are the messages translated by DefaultMessageCatalog
+var builtinTranslations = []struct {
+	message, zhCN, ja string
+}{
+	// Error formats
+	{"validation error for field '%s': %s", "字段“%s”校验失败：%s", "フィールド「%s」の検証エラー: %s"},
+	{"configuration error for field '%s': %s", "字段“%s”配置错误：%s", "フィールド「%s」の設定エラー: %s"},
+	{"TikTok API error [%s]: %s", "TikTok API 错误 [%s]：%s", "TikTok API エラー [%s]: %s"},
+	{"TikTok API error [%s]: %s (request_id: %s)", "TikTok API 错误 [%s]：%s（request_id：%s）", "TikTok API エラー [%s]: %s (request_id: %s)"},
+
+	// Validation messages
+	{"%s is required", "%s 为必填项", "%s は必須です"},
+	{"%s cannot be empty", "%s 不能为空", "%s は空にできません"},
+	{"%s must be a UUID in 8-4-4-4-12 hex format", "%s 必须是 8-4-4-4-12 格式的十六进制 UUID", "%s は 8-4-4-4-12 形式の16進 UUID である必要があります"},
+	{"advertiser ID cannot be empty", "广告主 ID 不能为空", "広告主 ID は空にできません"},
+	{"advertiser ID must be numeric", "广告主 ID 必须为数字", "広告主 ID は数字である必要があります"},
+	{"campaign name cannot be empty", "推广系列名称不能为空", "キャンペーン名は空にできません"},
+	{"campaign name cannot exceed 512 characters", "推广系列名称不能超过 512 个字符", "キャンペーン名は 512 文字以内にしてください"},
+	{"budget must be greater than 0", "预算必须大于 0", "予算は 0 より大きくする必要があります"},
+	{"URL cannot be empty", "URL 不能为空", "URL は空にできません"},
+	{"URL must use http or https scheme", "URL 必须使用 http 或 https 协议", "URL は http または https を使用する必要があります"},
+	{"URL must have a valid host", "URL 必须包含有效的主机名", "URL には有効なホストが必要です"},
+	{"access token cannot be empty", "访问令牌不能为空", "アクセストークンは空にできません"},
+	{"start date cannot be empty", "开始日期不能为空", "開始日は空にできません"},
+	{"end date cannot be empty", "结束日期不能为空", "終了日は空にできません"},
+	{"start date must be in YYYY-MM-DD format", "开始日期必须为 YYYY-MM-DD 格式", "開始日は YYYY-MM-DD 形式で指定してください"},
+	{"end date must be in YYYY-MM-DD format", "结束日期必须为 YYYY-MM-DD 格式", "終了日は YYYY-MM-DD 形式で指定してください"},
+	{"start date must be before or equal to end date", "开始日期不能晚于结束日期", "開始日は終了日以前である必要があります"},
+	{"page must be greater than 0", "页码必须大于 0", "ページ番号は 0 より大きくする必要があります"},
+	{"page size must be greater than 0", "每页数量必须大于 0", "ページサイズは 0 より大きくする必要があります"},
+	{"page size cannot exceed 1000", "每页数量不能超过 1000", "ページサイズは 1000 以下にしてください"},
+
+	// API error codes
+	{APIErrorMessageKey("40001"), "无权访问该资源", "このリソースへのアクセス権限がありません"},
+	{APIErrorMessageKey("40002"), "参数无效", "パラメータが無効です"},
+	{APIErrorMessageKey("40100"), "请求过于频繁", "リクエストが多すぎます"},
+	{APIErrorMessageKey("40102"), "访问令牌已过期", "アクセストークンの有効期限が切れています"},
+	{APIErrorMessageKey("40104"), "访问令牌为空", "アクセストークンが空です"},
+	{APIErrorMessageKey("40105"), "访问令牌无效", "アクセストークンが無効です"},
+	{APIErrorMessageKey("50000"), "系统错误", "システムエラー"},
+	{APIErrorMessageKey("50002"), "服务繁忙", "サービスが混雑しています"},
+}
@@ -0,0 +1,141 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateTimeLayout is the layout of the API's "2006-01-02 15:04:05" timestamps
+const DateTimeLayout = "2006-01-02 15:04:05"
+
+// timeFormat is the wire format a Time was decoded from
+type timeFormat int
+
+const (
+	timeFormatDateTime timeFormat = iota
+	timeFormatUnix
+	timeFormatUnixString
+	timeFormatRFC3339
+	timeFormatDate
+)
+
+// Time is an API timestamp. The API reports times as unix seconds, either as
+// numbers or strings, or as "2006-01-02 15:04:05" strings in UTC; RFC 3339
+// and plain dates are accepted too. Times are decoded into UTC unless the
+// value carries its own offset, and are encoded back in the format they were
+// decoded from, so responses round-trip unchanged. Times built with NewTime
+// are encoded in DateTimeLayout. The zero Time encodes as "".
+type Time struct {
+	time.Time
+	format timeFormat
+}
+
+// NewTime wraps t as an API timestamp, in UTC like the API's own
+func NewTime(t time.Time) Time {
+	return Time{Time: t.UTC()}
+}
+
+// ParseTime parses a timestamp in any of the API's formats. Timestamps
+// without an offset are taken to be in loc, or UTC when loc is nil.
+func ParseTime(value string, loc *time.Location) (Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	value = strings.TrimSpace(value)
+	if value == "" || value == "-" {
+		return Time{}, nil
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return Time{Time: unixTime(seconds), format: timeFormatUnixString}, nil
+	}
+	if t, err := time.ParseInLocation(DateTimeLayout, value, loc); err == nil {
+		return Time{Time: t, format: timeFormatDateTime}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return Time{Time: t, format: timeFormatRFC3339}, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, loc); err == nil {
+		return Time{Time: t, format: timeFormatDate}, nil
+	}
+	return Time{}, fmt.Errorf("invalid timestamp %q", value)
+}
+
+// unixTime converts unix seconds, or milliseconds for values too large to
+// be seconds, to UTC
+func unixTime(value int64) time.Time {
+	if value > 1e11 || value < -1e11 {
+		return time.UnixMilli(value).UTC()
+	}
+	return time.Unix(value, 0).UTC()
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *Time) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		*t = Time{}
+		return nil
+	}
+
+	if data[0] != '"' {
+		seconds, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			f, ferr := strconv.ParseFloat(string(data), 64)
+			if ferr != nil {
+				return fmt.Errorf("invalid timestamp %s: %w", data, err)
+			}
+			seconds = int64(f)
+		}
+		*t = Time{Time: unixTime(seconds), format: timeFormatUnix}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := ParseTime(value, nil)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte(`""`), nil
+	}
+	switch t.format {
+	case timeFormatUnix:
+		return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+	case timeFormatUnixString:
+		return json.Marshal(strconv.FormatInt(t.Unix(), 10))
+	default:
+		return json.Marshal(t.String())
+	}
+}
+
+// String renders the time in the format it was decoded from, or in
+// DateTimeLayout, and "" for the zero Time
+func (t Time) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	switch t.format {
+	case timeFormatUnix, timeFormatUnixString:
+		return strconv.FormatInt(t.Unix(), 10)
+	case timeFormatRFC3339:
+		return t.Format(time.RFC3339)
+	case timeFormatDate:
+		return t.Format("2006-01-02")
+	default:
+		return t.Format(DateTimeLayout)
+	}
+}
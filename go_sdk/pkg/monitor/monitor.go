@@ -0,0 +1,453 @@
+// Package monitor watches advertiser spend against budgets. A Monitor
+// periodically reads account balances and spend from the integrated report,
+// compares spend with each budget's pacing curve and thresholds, and delivers
+// an Alert through a callback or channel when one is crossed.
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Period is the span a budget applies to
+type Period int
+
+const (
+	// Daily budgets reset at midnight
+	Daily Period = iota
+
+	// Monthly budgets reset at midnight on the first of the month
+	Monthly
+)
+
+// Alert kinds
+const (
+	AlertThreshold   = "THRESHOLD"
+	AlertOverpacing  = "OVERPACING"
+	AlertUnderpacing = "UNDERPACING"
+	AlertLowBalance  = "LOW_BALANCE"
+)
+
+// PacingCurve returns the fraction of a budget expected to be spent once
+// elapsed, a fraction of the period between 0 and 1, has passed
+type PacingCurve func(elapsed float64) float64
+
+// LinearPacing expects spend to be spread evenly over the period
+func LinearPacing(elapsed float64) float64 {
+	return elapsed
+}
+
+// Threshold is a share of a budget whose spending raises an alert
+type Threshold struct {
+	// Fraction of the budget, e.g. 0.8 for 80%
+	Fraction float64
+
+	// Within limits the threshold to spend reached this soon after the
+	// period starts, e.g. 12 hours for "80% of the daily budget by noon";
+	// zero matches any time in the period
+	Within time.Duration
+}
+
+// Budget is a spending limit for an advertiser or some of its campaigns
+type Budget struct {
+	// Name identifies the budget in alerts
+	Name string
+
+	AdvertiserID string
+
+	// CampaignIDs limits the spend counted to these campaigns; all spend of
+	// the advertiser counts when empty
+	CampaignIDs []string
+
+	Period Period
+	Amount float64
+
+	// Thresholds raise an alert the first time each is crossed in a period
+	Thresholds []Threshold
+
+	// Curve is the expected spend over the period (defaults to LinearPacing)
+	Curve PacingCurve
+
+	// Tolerance is how far spend may stray from the curve, as a fraction of
+	// the budget, before an overpacing or underpacing alert; zero disables
+	// pacing alerts
+	Tolerance float64
+}
+
+// Alert reports a crossed threshold, off-pace spend or low balance
+type Alert struct {
+	Kind         string
+	Budget       string
+	AdvertiserID string
+	Time         time.Time
+
+	// PeriodStart is the start of the budget period the alert applies to
+	PeriodStart time.Time
+
+	Spend  float64
+	Amount float64
+
+	// Expected is the spend the pacing curve expects by Time
+	Expected float64
+
+	// Threshold is set for AlertThreshold alerts
+	Threshold *Threshold
+
+	// Balance and Currency are set for AlertLowBalance alerts
+	Balance  float64
+	Currency string
+
+	Message string
+}
+
+// Config configures a Monitor
+type Config struct {
+	Budgets []Budget
+
+	// MinBalance maps advertiser IDs to the account balance below which an
+	// AlertLowBalance is raised
+	MinBalance map[string]float64
+
+	// Interval is the delay between checks (defaults to 15 minutes)
+	Interval time.Duration
+
+	// Location sets where days and months start, which should match the
+	// advertiser's reporting time zone (defaults to UTC)
+	Location *time.Location
+
+	// Notify is called for each alert
+	Notify func(ctx context.Context, alert Alert)
+
+	// Alerts receives each alert; a send blocks until the alert is received
+	// or the check's context ends
+	Alerts chan<- Alert
+}
+
+// Monitor checks spend against budgets and balances against minimums
+type Monitor struct {
+	client *client.Client
+	config Config
+
+	mu         sync.Mutex
+	fired      map[string]map[string]bool // alerts raised this period, by budget
+	periods    map[string]time.Time       // current period start of each budget
+	lowBalance map[string]bool            // advertisers already reported
+}
+
+// New creates a Monitor
+func New(c *client.Client, config Config) (*Monitor, error) {
+	if c == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+	if len(config.Budgets) == 0 && len(config.MinBalance) == 0 {
+		return nil, fmt.Errorf("at least one budget or minimum balance is required")
+	}
+	if config.Notify == nil && config.Alerts == nil {
+		return nil, fmt.Errorf("notify or alerts is required")
+	}
+	names := make(map[string]bool, len(config.Budgets))
+	for i, budget := range config.Budgets {
+		if budget.Name == "" {
+			return nil, fmt.Errorf("budget %d: name is required", i)
+		}
+		if names[budget.Name] {
+			return nil, fmt.Errorf("budget %s is defined more than once", budget.Name)
+		}
+		names[budget.Name] = true
+		if budget.AdvertiserID == "" {
+			return nil, fmt.Errorf("budget %s: advertiser_id is required", budget.Name)
+		}
+		if budget.Amount <= 0 {
+			return nil, fmt.Errorf("budget %s: amount must be positive", budget.Name)
+		}
+		if budget.Period != Daily && budget.Period != Monthly {
+			return nil, fmt.Errorf("budget %s: unknown period %d", budget.Name, budget.Period)
+		}
+		if budget.Tolerance < 0 {
+			return nil, fmt.Errorf("budget %s: tolerance cannot be negative", budget.Name)
+		}
+		for _, threshold := range budget.Thresholds {
+			if threshold.Fraction <= 0 || threshold.Within < 0 {
+				return nil, fmt.Errorf("budget %s: threshold fraction must be positive and within not negative", budget.Name)
+			}
+		}
+	}
+	if config.Interval <= 0 {
+		config.Interval = 15 * time.Minute
+	}
+	if config.Location == nil {
+		config.Location = time.UTC
+	}
+
+	return &Monitor{
+		client:     c,
+		config:     config,
+		fired:      make(map[string]map[string]bool),
+		periods:    make(map[string]time.Time),
+		lowBalance: make(map[string]bool),
+	}, nil
+}
+
+// Run checks every Interval until the context is cancelled
+func (m *Monitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce checks budgets and balances now
+func (m *Monitor) RunOnce(ctx context.Context) ([]Alert, error) {
+	return m.CheckAt(ctx, time.Now())
+}
+
+// CheckAt checks budgets and balances as of now and delivers the alerts not
+// already raised. An alert is raised once per budget period, or for low
+// balances once until the balance recovers; pacing alerts are raised again
+// after spend returns to the curve.
+func (m *Monitor) CheckAt(ctx context.Context, now time.Time) ([]Alert, error) {
+	now = now.In(m.config.Location)
+
+	alerts, err := m.check(ctx, now)
+
+	// Alerts found before an error are delivered, as they are not raised again
+	for _, alert := range alerts {
+		if m.config.Notify != nil {
+			m.config.Notify(ctx, alert)
+		}
+		if m.config.Alerts != nil {
+			select {
+			case m.config.Alerts <- alert:
+			case <-ctx.Done():
+				return alerts, ctx.Err()
+			}
+		}
+	}
+	return alerts, err
+}
+
+// check collects the new alerts of every budget and balance
+func (m *Monitor) check(ctx context.Context, now time.Time) ([]Alert, error) {
+	var alerts []Alert
+	for _, budget := range m.config.Budgets {
+		found, err := m.checkBudget(ctx, budget, now)
+		alerts = append(alerts, found...)
+		if err != nil {
+			return alerts, fmt.Errorf("failed to check budget %s: %w", budget.Name, err)
+		}
+	}
+	for advertiserID, minimum := range m.config.MinBalance {
+		alert, err := m.checkBalance(ctx, advertiserID, minimum, now)
+		if err != nil {
+			return alerts, fmt.Errorf("failed to check balance of advertiser %s: %w", advertiserID, err)
+		}
+		if alert != nil {
+			alerts = append(alerts, *alert)
+		}
+	}
+	return alerts, nil
+}
+
+// checkBudget compares a budget's spend so far this period with its
+// thresholds and pacing curve
+func (m *Monitor) checkBudget(ctx context.Context, budget Budget, now time.Time) ([]Alert, error) {
+	start, end := periodBounds(budget.Period, now)
+	spend, err := m.spend(ctx, budget, start, now)
+	if err != nil {
+		return nil, err
+	}
+
+	curve := budget.Curve
+	if curve == nil {
+		curve = LinearPacing
+	}
+	elapsed := float64(now.Sub(start)) / float64(end.Sub(start))
+	expected := curve(elapsed) * budget.Amount
+
+	base := Alert{
+		Budget:       budget.Name,
+		AdvertiserID: budget.AdvertiserID,
+		Time:         now,
+		PeriodStart:  start,
+		Spend:        spend,
+		Amount:       budget.Amount,
+		Expected:     expected,
+	}
+	m.startPeriod(budget.Name, start)
+
+	var alerts []Alert
+	for i := range budget.Thresholds {
+		threshold := budget.Thresholds[i]
+		if spend < threshold.Fraction*budget.Amount {
+			continue
+		}
+		if threshold.Within > 0 && now.Sub(start) > threshold.Within {
+			continue
+		}
+		if !m.fire(budget.Name, fmt.Sprintf("%s/%d", AlertThreshold, i)) {
+			continue
+		}
+		alert := base
+		alert.Kind = AlertThreshold
+		alert.Threshold = &threshold
+		alert.Message = fmt.Sprintf("%s has spent %.2f, %.0f%% of its %.2f budget", budget.Name, spend, spend/budget.Amount*100, budget.Amount)
+		if threshold.Within > 0 {
+			alert.Message += fmt.Sprintf(", within %s of the period starting", threshold.Within)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if budget.Tolerance > 0 {
+		deviation := (spend - expected) / budget.Amount
+		switch {
+		case deviation > budget.Tolerance:
+			m.reset(budget.Name, AlertUnderpacing)
+			if m.fire(budget.Name, AlertOverpacing) {
+				alert := base
+				alert.Kind = AlertOverpacing
+				alert.Message = fmt.Sprintf("%s has spent %.2f, ahead of the %.2f expected by now", budget.Name, spend, expected)
+				alerts = append(alerts, alert)
+			}
+		case deviation < -budget.Tolerance:
+			m.reset(budget.Name, AlertOverpacing)
+			if m.fire(budget.Name, AlertUnderpacing) {
+				alert := base
+				alert.Kind = AlertUnderpacing
+				alert.Message = fmt.Sprintf("%s has spent %.2f, behind the %.2f expected by now", budget.Name, spend, expected)
+				alerts = append(alerts, alert)
+			}
+		default:
+			m.reset(budget.Name, AlertOverpacing)
+			m.reset(budget.Name, AlertUnderpacing)
+		}
+	}
+	return alerts, nil
+}
+
+// checkBalance raises an alert the first time an advertiser's balance is
+// seen below its minimum
+func (m *Monitor) checkBalance(ctx context.Context, advertiserID string, minimum float64, now time.Time) (*Alert, error) {
+	resp, err := m.client.Account().GetAdvertiserBalance(ctx, &client.GetAdvertiserBalanceRequest{AdvertiserID: advertiserID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, fmt.Errorf("API error: %s", resp.Message)
+	}
+
+	m.mu.Lock()
+	reported := m.lowBalance[advertiserID]
+	m.lowBalance[advertiserID] = resp.Data.Balance < minimum
+	m.mu.Unlock()
+	if reported || resp.Data.Balance >= minimum {
+		return nil, nil
+	}
+	return &Alert{
+		Kind:         AlertLowBalance,
+		AdvertiserID: advertiserID,
+		Time:         now,
+		Balance:      resp.Data.Balance,
+		Currency:     resp.Data.Currency,
+		Message:      fmt.Sprintf("advertiser %s balance %.2f %s is below %.2f", advertiserID, resp.Data.Balance, resp.Data.Currency, minimum),
+	}, nil
+}
+
+// spend sums the budget's spend from the integrated report over the days
+// from start to now
+func (m *Monitor) spend(ctx context.Context, budget Budget, start, now time.Time) (float64, error) {
+	req := client.ReportingRequest{
+		AdvertiserID: budget.AdvertiserID,
+		ReportType:   models.ReportTypeBasic,
+		DataLevel:    models.DataLevelAdvertiser,
+		Dimensions:   []models.Dimension{models.DimensionAdvertiserID},
+		Metrics:      []models.Metric{models.MetricSpend},
+		StartDate:    start.Format("2006-01-02"),
+		EndDate:      now.Format("2006-01-02"),
+		PageSize:     1000,
+	}
+	if len(budget.CampaignIDs) > 0 {
+		ids, err := json.Marshal(budget.CampaignIDs)
+		if err != nil {
+			return 0, fmt.Errorf("failed to marshal campaign_ids: %w", err)
+		}
+		req.DataLevel = models.DataLevelCampaign
+		req.Dimensions = []models.Dimension{models.DimensionCampaignID}
+		req.Filters = []client.ReportingFilter{{FieldName: "campaign_ids", FilterType: "IN", FilterValue: string(ids)}}
+	}
+
+	var spend float64
+	for page := 1; ; page++ {
+		req.Page = page
+		resp, err := m.client.Reporting().GetIntegratedReport(ctx, &req)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get spend: %w", err)
+		}
+		if resp.Code != 0 {
+			return 0, fmt.Errorf("API error: %s", resp.Message)
+		}
+		for _, row := range resp.Data.List {
+			spend += float64(row.Metrics.Spend)
+		}
+		if page >= resp.Data.PageInfo.TotalPage {
+			return spend, nil
+		}
+	}
+}
+
+// fire records a budget's alert as raised and reports whether it was new
+func (m *Monitor) fire(budget, key string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.fired[budget][key] {
+		return false
+	}
+	if m.fired[budget] == nil {
+		m.fired[budget] = make(map[string]bool)
+	}
+	m.fired[budget][key] = true
+	return true
+}
+
+// reset allows a budget's alert to be raised again
+func (m *Monitor) reset(budget, key string) {
+	m.mu.Lock()
+	delete(m.fired[budget], key)
+	m.mu.Unlock()
+}
+
+// startPeriod forgets a budget's alerts when a new period has begun
+func (m *Monitor) startPeriod(budget string, start time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.periods[budget].Equal(start) {
+		m.periods[budget] = start
+		delete(m.fired, budget)
+	}
+}
+
+// periodBounds returns the start and end of the period containing now
+func periodBounds(period Period, now time.Time) (time.Time, time.Time) {
+	year, month, day := now.Date()
+	if period == Monthly {
+		start := time.Date(year, month, 1, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 1, 0)
+	}
+	start := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+	return start, start.AddDate(0, 0, 1)
+}
@@ -0,0 +1,170 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+)
+
+// fakeAPI serves a fixed spend and balance
+type fakeAPI struct {
+	spend     float64
+	balance   float64
+	filtering string
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/report/integrated/get/"):
+		f.filtering = r.URL.Query().Get("filtering")
+		fmt.Fprintf(w, `{"code":0,"data":{"list":[{"dimensions":{},"metrics":{"spend":"%.2f"}}],"page_info":{"page":1,"total_page":1}}}`, f.spend)
+	case strings.HasSuffix(r.URL.Path, "/advertiser/balance/get/"):
+		fmt.Fprintf(w, `{"code":0,"data":{"advertiser_id":"123","balance":%.2f,"currency":"USD"}}`, f.balance)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestMonitor(t *testing.T, api *fakeAPI, config Config) *Monitor {
+	t.Helper()
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(&client.Config{BaseURL: server.URL, AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	m, err := New(c, config)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return m
+}
+
+func TestMonitorThresholds(t *testing.T) {
+	api := &fakeAPI{spend: 850, balance: 10000}
+	var notified []Alert
+	m := newTestMonitor(t, api, Config{
+		Budgets: []Budget{{
+			Name:         "daily",
+			AdvertiserID: "123",
+			CampaignIDs:  []string{"c1"},
+			Amount:       1000,
+			Thresholds:   []Threshold{{Fraction: 0.8, Within: 12 * time.Hour}, {Fraction: 1}},
+		}},
+		Notify: func(ctx context.Context, alert Alert) { notified = append(notified, alert) },
+	})
+	ctx := context.Background()
+	morning := time.Date(2026, 6, 1, 11, 0, 0, 0, time.UTC)
+
+	alerts, err := m.CheckAt(ctx, morning)
+	if err != nil {
+		t.Fatalf("CheckAt() error = %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].Kind != AlertThreshold || alerts[0].Threshold.Fraction != 0.8 || alerts[0].Spend != 850 {
+		t.Fatalf("alerts = %+v", alerts)
+	}
+	if len(notified) != 1 {
+		t.Errorf("notified %d alerts, want 1", len(notified))
+	}
+	if !strings.Contains(api.filtering, "c1") {
+		t.Errorf("spend was not filtered to the budget's campaigns: %s", api.filtering)
+	}
+
+	// Each threshold fires once per period
+	if alerts, _ := m.CheckAt(ctx, morning.Add(time.Hour)); len(alerts) != 0 {
+		t.Errorf("repeated alerts = %+v", alerts)
+	}
+
+	api.spend = 1000
+	alerts, _ = m.CheckAt(ctx, morning.Add(2*time.Hour))
+	if len(alerts) != 1 || alerts[0].Threshold.Fraction != 1 {
+		t.Errorf("alerts at full spend = %+v", alerts)
+	}
+
+	// A new day re-arms the thresholds, but 80% after noon is on pace
+	api.spend = 850
+	alerts, _ = m.CheckAt(ctx, morning.Add(24*time.Hour+3*time.Hour))
+	if len(alerts) != 0 {
+		t.Errorf("alerts after noon = %+v", alerts)
+	}
+	alerts, _ = m.CheckAt(ctx, morning.Add(48*time.Hour))
+	if len(alerts) != 1 || !alerts[0].PeriodStart.Equal(time.Date(2026, 6, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("alerts on the next morning = %+v", alerts)
+	}
+}
+
+func TestMonitorPacingAndBalance(t *testing.T) {
+	api := &fakeAPI{spend: 700, balance: 40}
+	alertCh := make(chan Alert, 10)
+	m := newTestMonitor(t, api, Config{
+		Budgets: []Budget{{
+			Name:         "june",
+			AdvertiserID: "123",
+			Period:       Monthly,
+			Amount:       3000,
+			Tolerance:    0.1,
+		}},
+		MinBalance: map[string]float64{"123": 100},
+		Alerts:     alertCh,
+	})
+	ctx := context.Background()
+
+	// Three days into a 30 day month, 10% of the budget is expected
+	alerts, err := m.CheckAt(ctx, time.Date(2026, 6, 4, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("CheckAt() error = %v", err)
+	}
+	kinds := map[string]Alert{}
+	for _, alert := range alerts {
+		kinds[alert.Kind] = alert
+	}
+	if over, ok := kinds[AlertOverpacing]; !ok || over.Expected != 300 {
+		t.Errorf("overpacing alert = %+v", over)
+	}
+	if low, ok := kinds[AlertLowBalance]; !ok || low.Balance != 40 || low.Currency != "USD" {
+		t.Errorf("low balance alert = %+v", low)
+	}
+	if len(alertCh) != len(alerts) {
+		t.Errorf("channel received %d alerts, want %d", len(alertCh), len(alerts))
+	}
+
+	// Back on pace and topped up, then off pace and low again
+	api.spend, api.balance = 1500, 500
+	if alerts, _ := m.CheckAt(ctx, time.Date(2026, 6, 16, 0, 0, 0, 0, time.UTC)); len(alerts) != 0 {
+		t.Errorf("alerts on pace = %+v", alerts)
+	}
+	api.spend, api.balance = 1500, 50
+	alerts, _ = m.CheckAt(ctx, time.Date(2026, 6, 25, 0, 0, 0, 0, time.UTC))
+	if len(alerts) != 2 || alerts[0].Kind != AlertUnderpacing || alerts[1].Kind != AlertLowBalance {
+		t.Errorf("alerts = %+v", alerts)
+	}
+}
+
+func TestNewValidation(t *testing.T) {
+	c, err := client.NewClient(&client.Config{AccessToken: "test_token", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	notify := func(context.Context, Alert) {}
+	invalid := []Config{
+		{Notify: notify},
+		{Budgets: []Budget{{Name: "b", AdvertiserID: "123", Amount: 10}}},
+		{Budgets: []Budget{{AdvertiserID: "123", Amount: 10}}, Notify: notify},
+		{Budgets: []Budget{{Name: "b", AdvertiserID: "123"}}, Notify: notify},
+		{Budgets: []Budget{{Name: "b", AdvertiserID: "123", Amount: 10}, {Name: "b", AdvertiserID: "456", Amount: 10}}, Notify: notify},
+		{Budgets: []Budget{{Name: "b", AdvertiserID: "123", Amount: 10, Thresholds: []Threshold{{Fraction: 0}}}}, Notify: notify},
+	}
+	for i, config := range invalid {
+		if _, err := New(c, config); err == nil {
+			t.Errorf("case %d: New() should fail", i)
+		}
+	}
+}
@@ -0,0 +1,590 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Options configures Apply
+type Options struct {
+	// DryRun reads the live state and returns the plan without changing
+	// anything
+	DryRun bool
+
+	// Prune deletes live entities missing from the desired state: campaigns
+	// of the advertiser, ad groups of declared campaigns and ads of declared
+	// ad groups. Without it undeclared entities are left alone.
+	Prune bool
+}
+
+// Fields that the update endpoints cannot change
+var (
+	immutableCampaignFields = []string{"objective_type"}
+	immutableAdGroupFields  = []string{"promotion_type", "placement_type", "pixel_id", "optimization_event", "app_id"}
+)
+
+// applier walks the desired state and records the plan as it goes
+type applier struct {
+	ctx          context.Context
+	client       *client.Client
+	advertiserID string
+	options      Options
+	plan         *Plan
+}
+
+// Apply brings the advertiser's live campaigns, ad groups and ads in line
+// with state and returns the plan it followed. Only entities whose declared
+// fields differ from the live ones are updated; fields left zero in state
+// are not managed. A change that fails is recorded in the plan, see
+// Plan.Err, and the rest of the plan still runs. The returned error is for
+// invalid states and for live state that could not be read. opts may be nil.
+func Apply(ctx context.Context, c *client.Client, state *State, opts *Options) (*Plan, error) {
+	if state == nil {
+		return nil, fmt.Errorf("state is required")
+	}
+	if err := state.Validate(); err != nil {
+		return nil, err
+	}
+
+	a := &applier{ctx: ctx, client: c, advertiserID: state.AdvertiserID, plan: &Plan{}}
+	if opts != nil {
+		a.options = *opts
+	}
+	a.plan.DryRun = a.options.DryRun
+
+	live, err := a.liveCampaigns()
+	if err != nil {
+		return nil, err
+	}
+	for _, desired := range state.Campaigns {
+		matches := live[desired.CampaignName]
+		delete(live, desired.CampaignName)
+		if err := a.campaign(desired, matches); err != nil {
+			return a.plan, err
+		}
+	}
+	if a.options.Prune {
+		for _, name := range sortedKeys(live) {
+			for _, info := range live[name] {
+				a.delete(ResourceCampaign, name, info.CampaignID)
+			}
+		}
+	}
+	return a.plan, nil
+}
+
+// campaign plans and applies one desired campaign and its ad groups
+func (a *applier) campaign(desired Campaign, matches []client.CampaignInfo) error {
+	path := desired.CampaignName
+	change := Change{Resource: ResourceCampaign, Path: path}
+	liveAdGroups := map[string][]client.AdGroupInfo{}
+
+	switch len(matches) {
+	case 0:
+		change.Action = ActionCreate
+		if !a.options.DryRun {
+			change.ID, change.Err = a.createCampaign(desired)
+		}
+	case 1:
+		live := matches[0]
+		change.ID = live.CampaignID
+		change.Fields = diff(desired, liveCampaign(live))
+		change.Action = actionFor(change.Fields)
+		if change.Err = immutable(change.Fields, immutableCampaignFields); change.Err == nil && change.Action == ActionUpdate && !a.options.DryRun {
+			change.Err = a.updateCampaign(change.ID, desired, change.Fields)
+		}
+		var err error
+		if liveAdGroups, err = a.liveAdGroups(change.ID); err != nil {
+			return err
+		}
+	default:
+		change.Action = ActionNone
+		change.Err = fmt.Errorf("%d live campaigns share this name", len(matches))
+		a.record(change)
+		return nil
+	}
+	a.record(change)
+
+	parentFailed := !a.options.DryRun && change.ID == ""
+	for _, adGroup := range desired.AdGroups {
+		matches := liveAdGroups[adGroup.AdGroupName]
+		delete(liveAdGroups, adGroup.AdGroupName)
+		if err := a.adGroup(change.ID, path, adGroup, matches, parentFailed); err != nil {
+			return err
+		}
+	}
+	if a.options.Prune {
+		for _, name := range sortedKeys(liveAdGroups) {
+			for _, info := range liveAdGroups[name] {
+				a.delete(ResourceAdGroup, path+"/"+name, info.AdGroupID)
+			}
+		}
+	}
+	return nil
+}
+
+// adGroup plans and applies one desired ad group and its ads
+func (a *applier) adGroup(campaignID, parent string, desired AdGroup, matches []client.AdGroupInfo, parentFailed bool) error {
+	path := parent + "/" + desired.AdGroupName
+	change := Change{Resource: ResourceAdGroup, Path: path}
+	liveAds := map[string][]client.AdInfo{}
+
+	switch {
+	case len(matches) == 0:
+		change.Action = ActionCreate
+		if parentFailed {
+			change.Err = errParentNotCreated
+		} else if !a.options.DryRun {
+			change.ID, change.Err = a.createAdGroup(campaignID, desired)
+		}
+	case len(matches) == 1:
+		live := matches[0]
+		change.ID = live.AdGroupID
+		change.Fields = diff(desired, liveAdGroup(live))
+		change.Action = actionFor(change.Fields)
+		if change.Err = immutable(change.Fields, immutableAdGroupFields); change.Err == nil && change.Action == ActionUpdate && !a.options.DryRun {
+			change.Err = a.updateAdGroup(change.ID, desired, change.Fields)
+		}
+		var err error
+		if liveAds, err = a.liveAds(change.ID); err != nil {
+			return err
+		}
+	default:
+		change.Action = ActionNone
+		change.Err = fmt.Errorf("%d live ad groups share this name", len(matches))
+		a.record(change)
+		return nil
+	}
+	a.record(change)
+
+	parentFailed = parentFailed || (!a.options.DryRun && change.ID == "")
+	for _, ad := range desired.Ads {
+		matches := liveAds[ad.AdName]
+		delete(liveAds, ad.AdName)
+		a.ad(change.ID, path, ad, matches, parentFailed)
+	}
+	if a.options.Prune {
+		for _, name := range sortedKeys(liveAds) {
+			for _, info := range liveAds[name] {
+				a.delete(ResourceAd, path+"/"+name, info.AdID)
+			}
+		}
+	}
+	return nil
+}
+
+// ad plans and applies one desired ad
+func (a *applier) ad(adGroupID, parent string, desired Ad, matches []client.AdInfo, parentFailed bool) {
+	change := Change{Resource: ResourceAd, Path: parent + "/" + desired.AdName}
+	switch len(matches) {
+	case 0:
+		change.Action = ActionCreate
+		if parentFailed {
+			change.Err = errParentNotCreated
+		} else if !a.options.DryRun {
+			change.ID, change.Err = a.createAd(adGroupID, desired)
+		}
+	case 1:
+		change.ID = matches[0].AdID
+		change.Fields = diff(desired, liveAd(matches[0]))
+		change.Action = actionFor(change.Fields)
+		if change.Action == ActionUpdate && !a.options.DryRun {
+			change.Err = a.updateAd(adGroupID, change.ID, desired, change.Fields)
+		}
+	default:
+		change.Action = ActionNone
+		change.Err = fmt.Errorf("%d live ads share this name", len(matches))
+	}
+	a.record(change)
+}
+
+// delete plans and applies the deletion of an undeclared entity
+func (a *applier) delete(resource Resource, path, id string) {
+	change := Change{Resource: resource, Action: ActionDelete, Path: path, ID: id}
+	if !a.options.DryRun {
+		switch resource {
+		case ResourceCampaign:
+			change.Err = a.check(a.client.Campaign().Delete(a.ctx, &client.CampaignDeleteRequest{AdvertiserID: a.advertiserID, CampaignIDs: []string{id}}))
+		case ResourceAdGroup:
+			change.Err = a.check(a.client.AdGroup().Delete(a.ctx, &client.AdGroupDeleteRequest{AdvertiserID: a.advertiserID, AdGroupIDs: []string{id}}))
+		case ResourceAd:
+			change.Err = a.check(a.client.Ad().Delete(a.ctx, &client.AdDeleteRequest{AdvertiserID: a.advertiserID, AdIDs: []string{id}}))
+		}
+	}
+	a.record(change)
+}
+
+func (a *applier) record(change Change) {
+	a.plan.Changes = append(a.plan.Changes, change)
+}
+
+func (a *applier) createCampaign(desired Campaign) (string, error) {
+	resp, err := a.client.Campaign().Create(a.ctx, &client.CampaignCreateRequest{
+		AdvertiserID:  a.advertiserID,
+		CampaignName:  desired.CampaignName,
+		ObjectiveType: desired.ObjectiveType,
+		Budget:        desired.Budget,
+		BudgetMode:    desired.BudgetMode,
+	})
+	if err := a.check(resp, err); err != nil {
+		return "", err
+	}
+	id := resp.Data.CampaignID
+	if desired.Status == "DISABLE" {
+		return id, a.setCampaignStatus(id, desired.Status)
+	}
+	return id, nil
+}
+
+func (a *applier) updateCampaign(id string, desired Campaign, fields []string) error {
+	if hasFieldsBesidesStatus(fields) {
+		resp, err := a.client.Campaign().Update(a.ctx, &client.CampaignUpdateRequest{
+			AdvertiserID: a.advertiserID,
+			CampaignID:   id,
+			CampaignName: desired.CampaignName,
+			Budget:       desired.Budget,
+			BudgetMode:   string(desired.BudgetMode),
+		})
+		if err := a.check(resp, err); err != nil {
+			return err
+		}
+	}
+	if containsField(fields, "status") {
+		return a.setCampaignStatus(id, desired.Status)
+	}
+	return nil
+}
+
+func (a *applier) setCampaignStatus(id, status string) error {
+	return a.check(a.client.Campaign().UpdateStatus(a.ctx, &client.CampaignStatusUpdateRequest{AdvertiserID: a.advertiserID, CampaignIDs: []string{id}, Operation: status}))
+}
+
+func (a *applier) createAdGroup(campaignID string, desired AdGroup) (string, error) {
+	resp, err := a.client.AdGroup().Create(a.ctx, &client.AdGroupCreateRequest{
+		AdvertiserID:       a.advertiserID,
+		CampaignID:         campaignID,
+		AdGroupName:        desired.AdGroupName,
+		PromotionType:      desired.PromotionType,
+		PlacementType:      desired.PlacementType,
+		Placements:         desired.Placements,
+		AdGroupTargeting:   desired.AdGroupTargeting,
+		AdGroupBidding:     desired.AdGroupBidding,
+		AdGroupSchedule:    desired.AdGroupSchedule,
+		AdGroupAttribution: desired.AdGroupAttribution,
+		PixelID:            desired.PixelID,
+		OptimizationEvent:  desired.OptimizationEvent,
+		AppID:              desired.AppID,
+	})
+	if err := a.check(resp, err); err != nil {
+		return "", err
+	}
+	id := resp.Data.AdGroupID
+	if desired.Status == "DISABLE" {
+		return id, a.setAdGroupStatus(id, desired.Status)
+	}
+	return id, nil
+}
+
+func (a *applier) updateAdGroup(id string, desired AdGroup, fields []string) error {
+	if hasFieldsBesidesStatus(fields) {
+		resp, err := a.client.AdGroup().Update(a.ctx, &client.AdGroupUpdateRequest{
+			AdvertiserID:       a.advertiserID,
+			AdGroupID:          id,
+			AdGroupName:        desired.AdGroupName,
+			Placements:         desired.Placements,
+			AdGroupTargeting:   desired.AdGroupTargeting,
+			AdGroupBidding:     desired.AdGroupBidding,
+			AdGroupSchedule:    desired.AdGroupSchedule,
+			AdGroupAttribution: desired.AdGroupAttribution,
+		})
+		if err := a.check(resp, err); err != nil {
+			return err
+		}
+	}
+	if containsField(fields, "status") {
+		return a.setAdGroupStatus(id, desired.Status)
+	}
+	return nil
+}
+
+func (a *applier) setAdGroupStatus(id, status string) error {
+	return a.check(a.client.AdGroup().UpdateStatus(a.ctx, &client.AdGroupStatusUpdateRequest{AdvertiserID: a.advertiserID, AdGroupIDs: []string{id}, Operation: status}))
+}
+
+func (a *applier) createAd(adGroupID string, desired Ad) (string, error) {
+	resp, err := a.client.Ad().Create(a.ctx, &client.AdCreateRequest{
+		AdvertiserID: a.advertiserID,
+		AdGroupID:    adGroupID,
+		Creatives:    []client.AdCreative{desired.AdCreative},
+	})
+	if err := a.check(resp, err); err != nil {
+		return "", err
+	}
+	if len(resp.Data.AdIDs) == 0 {
+		return "", fmt.Errorf("ad create returned no ad_id")
+	}
+	id := resp.Data.AdIDs[0]
+	if desired.Status == "DISABLE" {
+		return id, a.setAdStatus(id, desired.Status)
+	}
+	return id, nil
+}
+
+func (a *applier) updateAd(adGroupID, id string, desired Ad, fields []string) error {
+	if hasFieldsBesidesStatus(fields) {
+		resp, err := a.client.Ad().Update(a.ctx, &client.AdUpdateRequest{
+			AdvertiserID: a.advertiserID,
+			AdGroupID:    adGroupID,
+			Creatives:    []client.AdUpdateCreative{{AdID: id, AdCreative: desired.AdCreative}},
+		})
+		if err := a.check(resp, err); err != nil {
+			return err
+		}
+	}
+	if containsField(fields, "status") {
+		return a.setAdStatus(id, desired.Status)
+	}
+	return nil
+}
+
+func (a *applier) setAdStatus(id, status string) error {
+	return a.check(a.client.Ad().UpdateStatus(a.ctx, &client.AdStatusUpdateRequest{AdvertiserID: a.advertiserID, AdIDs: []string{id}, Operation: status}))
+}
+
+// liveCampaigns lists the advertiser's campaigns that are not deleted, by name
+func (a *applier) liveCampaigns() (map[string][]client.CampaignInfo, error) {
+	campaigns := make(map[string][]client.CampaignInfo)
+	for page := 1; ; page++ {
+		resp, err := a.client.Campaign().Get(a.ctx, &client.CampaignGetRequest{AdvertiserID: a.advertiserID, Page: page, PageSize: 1000})
+		if err := a.check(resp, err); err != nil {
+			return nil, fmt.Errorf("failed to get campaigns: %w", err)
+		}
+		for _, campaign := range resp.Data {
+			if !isDeleted(campaign.Status) {
+				campaigns[campaign.CampaignName] = append(campaigns[campaign.CampaignName], campaign)
+			}
+		}
+		if page >= resp.PageInfo.TotalPage {
+			return campaigns, nil
+		}
+	}
+}
+
+// liveAdGroups lists the campaign's ad groups that are not deleted, by name
+func (a *applier) liveAdGroups(campaignID string) (map[string][]client.AdGroupInfo, error) {
+	adGroups := make(map[string][]client.AdGroupInfo)
+	for page := 1; ; page++ {
+		resp, err := a.client.AdGroup().Get(a.ctx, &client.AdGroupGetRequest{
+			AdvertiserID: a.advertiserID,
+			Filtering:    &client.AdGroupFiltering{CampaignIDs: []string{campaignID}},
+			Page:         page,
+			PageSize:     1000,
+		})
+		if err := a.check(resp, err); err != nil {
+			return nil, fmt.Errorf("failed to get ad groups of campaign %s: %w", campaignID, err)
+		}
+		for _, adGroup := range resp.Data {
+			if !isDeleted(adGroup.OperationStatus, adGroup.SecondaryStatus) {
+				adGroups[adGroup.AdGroupName] = append(adGroups[adGroup.AdGroupName], adGroup)
+			}
+		}
+		if page >= resp.PageInfo.TotalPage {
+			return adGroups, nil
+		}
+	}
+}
+
+// liveAds lists the ad group's ads that are not deleted, by name
+func (a *applier) liveAds(adGroupID string) (map[string][]client.AdInfo, error) {
+	ads := make(map[string][]client.AdInfo)
+	for page := 1; ; page++ {
+		resp, err := a.client.Ad().Get(a.ctx, &client.AdGetRequest{
+			AdvertiserID: a.advertiserID,
+			Filtering:    &client.AdFiltering{AdGroupIDs: []string{adGroupID}},
+			Page:         page,
+			PageSize:     1000,
+		})
+		if err := a.check(resp, err); err != nil {
+			return nil, fmt.Errorf("failed to get ads of ad group %s: %w", adGroupID, err)
+		}
+		for _, ad := range resp.Data {
+			if !isDeleted(ad.OperationStatus, ad.SecondaryStatus) {
+				ads[ad.AdName] = append(ads[ad.AdName], ad)
+			}
+		}
+		if page >= resp.PageInfo.TotalPage {
+			return ads, nil
+		}
+	}
+}
+
+// check turns a failed call or a non-zero response code into an error
+func (a *applier) check(resp interface{}, err error) error {
+	if err != nil {
+		return err
+	}
+	if base, ok := baseResponse(resp); ok && base.Code != 0 {
+		return models.NewAPIError(strconv.Itoa(base.Code), base.Message, base.RequestID, 200)
+	}
+	return nil
+}
+
+// baseResponse finds the response envelope embedded in a service response
+func baseResponse(resp interface{}) (models.BaseResponse, bool) {
+	v := reflect.ValueOf(resp)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return models.BaseResponse{}, false
+	}
+	v = v.Elem()
+	if field := v.FieldByName("BaseResponse"); field.IsValid() {
+		base, ok := field.Interface().(models.BaseResponse)
+		return base, ok
+	}
+	return models.BaseResponse{}, false
+}
+
+// liveCampaign projects a live campaign onto the desired state fields
+func liveCampaign(info client.CampaignInfo) Campaign {
+	return Campaign{
+		CampaignName:  info.CampaignName,
+		ObjectiveType: models.ObjectiveType(info.ObjectiveType),
+		BudgetMode:    models.BudgetMode(info.BudgetMode),
+		Budget:        info.Budget,
+		Status:        info.Status,
+	}
+}
+
+// liveAdGroup projects a live ad group onto the desired state fields
+func liveAdGroup(info client.AdGroupInfo) AdGroup {
+	return AdGroup{
+		AdGroupName:        info.AdGroupName,
+		PromotionType:      info.PromotionType,
+		PlacementType:      info.PlacementType,
+		Placements:         info.Placements,
+		AdGroupTargeting:   info.AdGroupTargeting,
+		AdGroupBidding:     info.AdGroupBidding,
+		AdGroupSchedule:    info.AdGroupSchedule,
+		AdGroupAttribution: info.AdGroupAttribution,
+		PixelID:            info.PixelID,
+		OptimizationEvent:  info.OptimizationEvent,
+		Status:             info.OperationStatus,
+	}
+}
+
+// liveAd projects a live ad onto the desired state fields
+func liveAd(info client.AdInfo) Ad {
+	return Ad{AdCreative: info.AdCreative, Status: info.OperationStatus}
+}
+
+// diff lists, by JSON name, the non-zero fields of desired that differ from
+// live. Child entity lists are skipped and list fields compare as sets.
+func diff(desired, live interface{}) []string {
+	var fields []string
+	diffStruct(reflect.ValueOf(desired), reflect.ValueOf(live), &fields)
+	return fields
+}
+
+func diffStruct(desired, live reflect.Value, fields *[]string) {
+	t := desired.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		d, l := desired.Field(i), live.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			diffStruct(d, l, fields)
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || name == "adgroups" || name == "ads" || d.IsZero() {
+			continue
+		}
+		if d.Kind() == reflect.Slice {
+			if !sameElements(d, l) {
+				*fields = append(*fields, name)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(d.Interface(), l.Interface()) {
+			*fields = append(*fields, name)
+		}
+	}
+}
+
+// sameElements compares two slices ignoring order
+func sameElements(a, b reflect.Value) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	as, bs := make([]string, a.Len()), make([]string, b.Len())
+	for i := range as {
+		as[i] = fmt.Sprint(a.Index(i).Interface())
+		bs[i] = fmt.Sprint(b.Index(i).Interface())
+	}
+	sort.Strings(as)
+	sort.Strings(bs)
+	return reflect.DeepEqual(as, bs)
+}
+
+// immutable returns an error naming the first changed field that cannot be
+// updated in place
+func immutable(fields, immutableFields []string) error {
+	for _, field := range fields {
+		if containsField(immutableFields, field) {
+			return fmt.Errorf("%s cannot be changed in place; rename the entity to replace it", field)
+		}
+	}
+	return nil
+}
+
+func actionFor(fields []string) Action {
+	if len(fields) == 0 {
+		return ActionNone
+	}
+	return ActionUpdate
+}
+
+func hasFieldsBesidesStatus(fields []string) bool {
+	for _, field := range fields {
+		if field != "status" {
+			return true
+		}
+	}
+	return false
+}
+
+func containsField(fields []string, name string) bool {
+	for _, field := range fields {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isDeleted reports whether any of the statuses marks a deleted entity
+func isDeleted(statuses ...string) bool {
+	for _, status := range statuses {
+		if strings.Contains(status, "DELETE") {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
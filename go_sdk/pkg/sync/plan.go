@@ -0,0 +1,119 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Action is what a change does to a live entity
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	ActionNone   Action = "none" // the live entity already matches
+)
+
+// Resource is the kind of entity a change applies to
+type Resource string
+
+const (
+	ResourceCampaign Resource = "campaign"
+	ResourceAdGroup  Resource = "adgroup"
+	ResourceAd       Resource = "ad"
+)
+
+// Change is one step of a plan
+type Change struct {
+	Resource Resource `json:"resource"`
+	Action   Action   `json:"action"`
+
+	// Path names the entity within its parents, such as "Spring/US/Video A"
+	Path string `json:"path"`
+
+	// ID is the live entity, or the one created when the plan was applied
+	ID string `json:"id,omitempty"`
+
+	// Fields lists the fields an update changes, by API name
+	Fields []string `json:"fields,omitempty"`
+
+	// Err is why the change could not be planned or applied
+	Err error `json:"-"`
+}
+
+// Plan lists the changes Apply made, or would make on a dry run, with
+// parents before their children
+type Plan struct {
+	DryRun  bool     `json:"dry_run"`
+	Changes []Change `json:"changes"`
+}
+
+// Count returns the number of changes with the action
+func (p *Plan) Count(action Action) int {
+	n := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// HasChanges reports whether the live state differs from the desired state
+func (p *Plan) HasChanges() bool {
+	return len(p.Changes) > p.Count(ActionNone)
+}
+
+// Err returns nil when every change succeeded, or an error counting the
+// failures and naming the first
+func (p *Plan) Err() error {
+	var failed []Change
+	for _, change := range p.Changes {
+		if change.Err != nil {
+			failed = append(failed, change)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	first := failed[0]
+	return fmt.Errorf("%d of %d changes failed, first %s %s %q: %w", len(failed), len(p.Changes), first.Action, first.Resource, first.Path, first.Err)
+}
+
+// String renders the plan in the style of a Terraform plan, omitting
+// entities that already match
+func (p *Plan) String() string {
+	var b strings.Builder
+	for _, change := range p.Changes {
+		var symbol string
+		switch change.Action {
+		case ActionCreate:
+			symbol = "+"
+		case ActionUpdate:
+			symbol = "~"
+		case ActionDelete:
+			symbol = "-"
+		default:
+			if change.Err == nil {
+				continue
+			}
+			symbol = "!"
+		}
+		fmt.Fprintf(&b, "%s %s %q", symbol, change.Resource, change.Path)
+		if len(change.Fields) > 0 {
+			fmt.Fprintf(&b, " (%s)", strings.Join(change.Fields, ", "))
+		}
+		if change.Err != nil {
+			fmt.Fprintf(&b, ": %v", change.Err)
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to delete.\n", p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete))
+	return b.String()
+}
+
+// errParentNotCreated is recorded for the children of an entity whose
+// creation failed
+var errParentNotCreated = errors.New("not applied: parent was not created")
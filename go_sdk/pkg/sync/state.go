@@ -0,0 +1,163 @@
+// Package sync applies a declared set of campaigns, ad groups and ads to an
+// advertiser account. The desired state is written as Go structs or loaded
+// from YAML; Apply reads the live entities, matches them to the declared ones
+// by name, and makes only the create, update and delete calls needed to bring
+// the account in line, returning the plan it carried out.
+//
+//	state, err := sync.LoadFile("campaigns.yaml")
+//	plan, err := sync.Apply(ctx, c, state, &sync.Options{DryRun: true})
+//	fmt.Print(plan)
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// State is the desired state of one advertiser's campaigns. Campaigns are
+// matched to live ones by name, ad groups by name within their campaign, and
+// ads by name within their ad group, so names must be unique at each level.
+type State struct {
+	AdvertiserID string     `json:"advertiser_id"`
+	Campaigns    []Campaign `json:"campaigns"`
+}
+
+// Campaign is a desired campaign. Zero fields are left as they are live.
+type Campaign struct {
+	CampaignName  string               `json:"campaign_name"`
+	ObjectiveType models.ObjectiveType `json:"objective_type"`
+	BudgetMode    models.BudgetMode    `json:"budget_mode,omitempty"`
+	Budget        float64              `json:"budget,omitempty"`
+
+	// Status is ENABLE or DISABLE; the live status is left alone when empty
+	Status string `json:"status,omitempty"`
+
+	AdGroups []AdGroup `json:"adgroups,omitempty"`
+}
+
+// AdGroup is a desired ad group. Zero fields are left as they are live.
+type AdGroup struct {
+	AdGroupName   string               `json:"adgroup_name"`
+	PromotionType string               `json:"promotion_type,omitempty"`
+	PlacementType models.PlacementType `json:"placement_type,omitempty"`
+	Placements    []models.Placement   `json:"placements,omitempty"`
+
+	client.AdGroupTargeting
+	client.AdGroupBidding
+	client.AdGroupSchedule
+	client.AdGroupAttribution
+
+	PixelID           string `json:"pixel_id,omitempty"`
+	OptimizationEvent string `json:"optimization_event,omitempty"`
+	AppID             string `json:"app_id,omitempty"`
+
+	// Status is ENABLE or DISABLE; the live status is left alone when empty
+	Status string `json:"status,omitempty"`
+
+	Ads []Ad `json:"ads,omitempty"`
+}
+
+// Ad is a desired ad, named by AdCreative.AdName. Zero fields are left as
+// they are live.
+type Ad struct {
+	client.AdCreative
+
+	// Status is ENABLE or DISABLE; the live status is left alone when empty
+	Status string `json:"status,omitempty"`
+}
+
+// Load reads a State from YAML. Keys are the API field names used by the
+// JSON tags of the state types, such as campaign_name and adgroups.
+func Load(r io.Reader) (*State, error) {
+	var document interface{}
+	if err := yaml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to parse state: %w", err)
+	}
+
+	// Round-trip through JSON so the state types need only their JSON tags
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert state: %w", err)
+	}
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode state: %w", err)
+	}
+	return &state, nil
+}
+
+// LoadFile reads a State from a YAML file
+func LoadFile(path string) (*State, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open state file: %w", err)
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+// Validate checks that every entity is named, that names are unique within
+// their parent, and that statuses are ENABLE or DISABLE
+func (s *State) Validate() error {
+	if s.AdvertiserID == "" {
+		return fmt.Errorf("advertiser_id is required")
+	}
+	campaigns := make(map[string]bool, len(s.Campaigns))
+	for i, campaign := range s.Campaigns {
+		if campaign.CampaignName == "" {
+			return fmt.Errorf("campaigns[%d]: campaign_name is required", i)
+		}
+		if campaigns[campaign.CampaignName] {
+			return fmt.Errorf("duplicate campaign %q", campaign.CampaignName)
+		}
+		campaigns[campaign.CampaignName] = true
+		if err := validateStatus(campaign.Status); err != nil {
+			return fmt.Errorf("campaign %q: %w", campaign.CampaignName, err)
+		}
+
+		adGroups := make(map[string]bool, len(campaign.AdGroups))
+		for j, adGroup := range campaign.AdGroups {
+			if adGroup.AdGroupName == "" {
+				return fmt.Errorf("campaign %q: adgroups[%d]: adgroup_name is required", campaign.CampaignName, j)
+			}
+			if adGroups[adGroup.AdGroupName] {
+				return fmt.Errorf("campaign %q: duplicate adgroup %q", campaign.CampaignName, adGroup.AdGroupName)
+			}
+			adGroups[adGroup.AdGroupName] = true
+			if err := validateStatus(adGroup.Status); err != nil {
+				return fmt.Errorf("adgroup %q: %w", adGroup.AdGroupName, err)
+			}
+
+			ads := make(map[string]bool, len(adGroup.Ads))
+			for k, ad := range adGroup.Ads {
+				if ad.AdName == "" {
+					return fmt.Errorf("adgroup %q: ads[%d]: ad_name is required", adGroup.AdGroupName, k)
+				}
+				if ads[ad.AdName] {
+					return fmt.Errorf("adgroup %q: duplicate ad %q", adGroup.AdGroupName, ad.AdName)
+				}
+				ads[ad.AdName] = true
+				if err := validateStatus(ad.Status); err != nil {
+					return fmt.Errorf("ad %q: %w", ad.AdName, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateStatus accepts an empty status or ENABLE or DISABLE
+func validateStatus(status string) error {
+	switch status {
+	case "", "ENABLE", "DISABLE":
+		return nil
+	}
+	return fmt.Errorf("status must be ENABLE or DISABLE, got %q", status)
+}
@@ -0,0 +1,271 @@
+package sync
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	gosync "sync"
+	"testing"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/client"
+)
+
+const testState = `
+advertiser_id: adv-1
+campaigns:
+  - campaign_name: Spring
+    objective_type: TRAFFIC
+    budget_mode: BUDGET_MODE_DAY
+    budget: 200
+    adgroups:
+      - adgroup_name: US
+        placement_type: PLACEMENT_TYPE_AUTOMATIC
+        schedule_type: SCHEDULE_FROM_NOW
+        location_ids: ["6252001"]
+        ads:
+          - ad_name: Video A
+            ad_format: SINGLE_VIDEO
+            ad_text: Fresh for spring
+            identity_id: id-1
+            video_id: v-1
+          - ad_name: Video B
+            ad_format: SINGLE_VIDEO
+            ad_text: New arrivals
+            identity_id: id-1
+            video_id: v-2
+  - campaign_name: Summer
+    objective_type: TRAFFIC
+    budget_mode: BUDGET_MODE_DAY
+    budget: 100
+    status: DISABLE
+    adgroups:
+      - adgroup_name: EU
+        placement_type: PLACEMENT_TYPE_AUTOMATIC
+        schedule_type: SCHEDULE_FROM_NOW
+        ads:
+          - ad_name: Video C
+            ad_format: SINGLE_VIDEO
+            ad_text: Sun is out
+            identity_id: id-1
+            video_id: v-3
+`
+
+// fakeAccount serves a small live account and records mutating calls
+type fakeAccount struct {
+	mu    gosync.Mutex
+	calls []string
+}
+
+func (f *fakeAccount) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// Drop the /open_api/<version>/ prefix and the trailing slash
+		endpoint := strings.TrimSuffix(strings.SplitN(r.URL.Path, "/", 4)[3], "/")
+		if !strings.HasSuffix(endpoint, "/get") {
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			f.mu.Lock()
+			f.calls = append(f.calls, endpoint+" "+summarize(body))
+			f.mu.Unlock()
+		}
+
+		switch endpoint {
+		case "campaign/get":
+			_, _ = w.Write([]byte(`{"code":0,"data":[
+				{"campaign_id":"c1","campaign_name":"Spring","objective_type":"TRAFFIC","budget_mode":"BUDGET_MODE_DAY","budget":150,"status":"ENABLE"},
+				{"campaign_id":"c2","campaign_name":"Old","objective_type":"TRAFFIC","budget_mode":"BUDGET_MODE_DAY","budget":50,"status":"ENABLE"},
+				{"campaign_id":"c3","campaign_name":"Gone","status":"CAMPAIGN_STATUS_DELETE"}],
+				"page_info":{"page":1,"total_page":1}}`))
+		case "adgroup/get":
+			if !strings.Contains(r.URL.Query().Get("filtering"), "c1") {
+				t.Errorf("unexpected adgroup lookup %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"code":0,"data":[
+				{"adgroup_id":"g1","adgroup_name":"US","campaign_id":"c1","placement_type":"PLACEMENT_TYPE_AUTOMATIC","schedule_type":"SCHEDULE_FROM_NOW","location_ids":["6252001"],"budget":20,"operation_status":"ENABLE"},
+				{"adgroup_id":"g2","adgroup_name":"Legacy","campaign_id":"c1","operation_status":"DISABLE"}],
+				"page_info":{"page":1,"total_page":1}}`))
+		case "ad/get":
+			_, _ = w.Write([]byte(`{"code":0,"data":[
+				{"ad_id":"a1","ad_name":"Video A","adgroup_id":"g1","ad_format":"SINGLE_VIDEO","ad_text":"Spring is here","identity_id":"id-1","video_id":"v-1","operation_status":"ENABLE"}],
+				"page_info":{"page":1,"total_page":1}}`))
+		case "campaign/create":
+			_, _ = w.Write([]byte(`{"code":0,"data":{"campaign_id":"c9"}}`))
+		case "adgroup/create":
+			_, _ = w.Write([]byte(`{"code":0,"data":{"adgroup_id":"g9"}}`))
+		case "ad/create":
+			_, _ = w.Write([]byte(`{"code":0,"data":{"ad_ids":["a9"]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"code":0,"message":"OK"}`))
+		}
+	}
+}
+
+// summarize renders the identifying fields of a request body
+func summarize(body map[string]interface{}) string {
+	var parts []string
+	for _, key := range []string{"campaign_id", "campaign_name", "campaign_ids", "adgroup_id", "adgroup_name", "adgroup_ids", "ad_ids", "operation", "operation_status"} {
+		if value, ok := body[key]; ok {
+			data, _ := json.Marshal(value)
+			parts = append(parts, key+"="+string(data))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(&client.Config{
+		BaseURL:     server.URL,
+		AccessToken: "test_token",
+		Timeout:     5 * time.Second,
+		RetryConfig: &client.RetryConfig{MaxRetries: 0, BackoffStrategy: client.NewConstantBackoff(time.Millisecond)},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return c
+}
+
+func TestApply(t *testing.T) {
+	state, err := Load(strings.NewReader(testState))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := state.Campaigns[0].AdGroups[0].LocationIDs; !reflect.DeepEqual(got, []string{"6252001"}) {
+		t.Fatalf("embedded targeting not loaded: %v", got)
+	}
+
+	type step struct {
+		action Action
+		path   string
+		fields []string
+	}
+	want := []step{
+		{ActionUpdate, "Spring", []string{"budget"}},
+		{ActionNone, "Spring/US", nil},
+		{ActionUpdate, "Spring/US/Video A", []string{"ad_text"}},
+		{ActionCreate, "Spring/US/Video B", nil},
+		{ActionDelete, "Spring/Legacy", nil},
+		{ActionCreate, "Summer", nil},
+		{ActionCreate, "Summer/EU", nil},
+		{ActionCreate, "Summer/EU/Video C", nil},
+		{ActionDelete, "Old", nil},
+	}
+	checkPlan := func(t *testing.T, plan *Plan) {
+		t.Helper()
+		if len(plan.Changes) != len(want) {
+			t.Fatalf("plan has %d changes, want %d:\n%s", len(plan.Changes), len(want), plan)
+		}
+		for i, change := range plan.Changes {
+			if change.Action != want[i].action || change.Path != want[i].path || !reflect.DeepEqual(change.Fields, want[i].fields) {
+				t.Errorf("change %d = %s %q %v, want %s %q %v", i, change.Action, change.Path, change.Fields, want[i].action, want[i].path, want[i].fields)
+			}
+		}
+		if err := plan.Err(); err != nil {
+			t.Errorf("plan.Err() = %v", err)
+		}
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		account := &fakeAccount{}
+		c := newTestClient(t, account.handler(t))
+		plan, err := Apply(t.Context(), c, state, &Options{DryRun: true, Prune: true})
+		if err != nil {
+			t.Fatalf("Apply() error = %v", err)
+		}
+		checkPlan(t, plan)
+		if len(account.calls) != 0 {
+			t.Errorf("dry run made changes: %v", account.calls)
+		}
+		if !strings.Contains(plan.String(), "Plan: 4 to create, 2 to update, 2 to delete.") {
+			t.Errorf("unexpected plan rendering:\n%s", plan)
+		}
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		account := &fakeAccount{}
+		c := newTestClient(t, account.handler(t))
+		plan, err := Apply(t.Context(), c, state, &Options{Prune: true})
+		if err != nil {
+			t.Fatalf("Apply() error = %v", err)
+		}
+		checkPlan(t, plan)
+		if plan.Changes[5].ID != "c9" || plan.Changes[6].ID != "g9" {
+			t.Errorf("created IDs not recorded: %+v", plan.Changes[5:7])
+		}
+
+		wantCalls := []string{
+			`campaign/update campaign_id="c1" campaign_name="Spring"`,
+			`ad/update adgroup_id="g1"`,
+			`ad/create adgroup_id="g1"`,
+			`adgroup/status/update adgroup_ids=["g2"] operation_status="DELETE"`,
+			`campaign/create campaign_name="Summer"`,
+			`campaign/status/update campaign_ids=["c9"] operation="DISABLE"`,
+			`adgroup/create campaign_id="c9" adgroup_name="EU"`,
+			`ad/create adgroup_id="g9"`,
+			`campaign/delete campaign_ids=["c2"]`,
+		}
+		if !reflect.DeepEqual(account.calls, wantCalls) {
+			t.Errorf("calls =\n%s\nwant\n%s", strings.Join(account.calls, "\n"), strings.Join(wantCalls, "\n"))
+		}
+	})
+
+	t.Run("without prune", func(t *testing.T) {
+		account := &fakeAccount{}
+		c := newTestClient(t, account.handler(t))
+		plan, err := Apply(t.Context(), c, state, &Options{DryRun: true})
+		if err != nil {
+			t.Fatalf("Apply() error = %v", err)
+		}
+		if n := plan.Count(ActionDelete); n != 0 {
+			t.Errorf("planned %d deletes without prune", n)
+		}
+	})
+}
+
+func TestApply_ImmutableField(t *testing.T) {
+	account := &fakeAccount{}
+	c := newTestClient(t, account.handler(t))
+	state := &State{AdvertiserID: "adv-1", Campaigns: []Campaign{{CampaignName: "Spring", ObjectiveType: "REACH"}}}
+
+	plan, err := Apply(t.Context(), c, state, nil)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if plan.Err() == nil || !strings.Contains(plan.Err().Error(), "objective_type cannot be changed") {
+		t.Errorf("plan.Err() = %v, want objective_type conflict", plan.Err())
+	}
+	if len(account.calls) != 0 {
+		t.Errorf("conflicting update was sent: %v", account.calls)
+	}
+}
+
+func TestState_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		state State
+		want  string
+	}{
+		{"missing advertiser", State{}, "advertiser_id is required"},
+		{"unnamed campaign", State{AdvertiserID: "a", Campaigns: []Campaign{{}}}, "campaign_name is required"},
+		{"duplicate campaign", State{AdvertiserID: "a", Campaigns: []Campaign{{CampaignName: "x"}, {CampaignName: "x"}}}, "duplicate campaign"},
+		{"duplicate ad", State{AdvertiserID: "a", Campaigns: []Campaign{{CampaignName: "x", AdGroups: []AdGroup{{AdGroupName: "g", Ads: []Ad{
+			{AdCreative: client.AdCreative{AdName: "a"}}, {AdCreative: client.AdCreative{AdName: "a"}},
+		}}}}}}, "duplicate ad"},
+		{"bad status", State{AdvertiserID: "a", Campaigns: []Campaign{{CampaignName: "x", Status: "DELETE"}}}, "status must be ENABLE or DISABLE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.state.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Validate() = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package utils
+
+import "os"
+
+// WriteFileAtomic replaces the file at path with data by writing a temporary
+// file beside it and renaming it into place, so readers never see a partial
+// file
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return nil
+}
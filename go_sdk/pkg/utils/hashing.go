@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+)
+
+var sha256HexPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// NormalizeEmail trims and lowercases an email address before hashing
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizePhone strips formatting from a phone number, keeping a leading +
+// and the digits, as expected for E.164 matching
+func NormalizePhone(phone string) string {
+	phone = strings.TrimSpace(phone)
+
+	var b strings.Builder
+	for i, r := range phone {
+		if r == '+' && i == 0 {
+			b.WriteRune(r)
+			continue
+		}
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// NormalizePhoneE164 formats a phone number as E.164. Numbers without a
+// leading + are taken as national numbers of countryCode, dropping a trunk
+// prefix of zeros. It reports false when the result is not a valid E.164
+// number.
+func NormalizePhoneE164(phone, countryCode string) (string, bool) {
+	phone = NormalizePhone(phone)
+	if !strings.HasPrefix(phone, "+") {
+		if countryCode == "" {
+			return "", false
+		}
+		phone = "+" + strings.TrimPrefix(countryCode, "+") + strings.TrimLeft(phone, "0")
+	}
+	if !e164Pattern.MatchString(phone) {
+		return "", false
+	}
+	return phone, true
+}
+
+// HashSHA256 returns the lowercase hex SHA-256 of a value. Values that are
+// already SHA-256 hex digests and empty values are returned unchanged.
+func HashSHA256(value string) string {
+	if value == "" || IsSHA256Hex(value) {
+		return value
+	}
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsSHA256Hex reports whether a value looks like a lowercase SHA-256 hex digest
+func IsSHA256Hex(value string) bool {
+	return sha256HexPattern.MatchString(value)
+}
+
+// HashEmail normalizes and hashes an email address, leaving digests unchanged
+func HashEmail(email string) string {
+	return HashSHA256(NormalizeEmail(email))
+}
+
+// HashPhone normalizes and hashes a phone number, leaving digests unchanged
+func HashPhone(phone string) string {
+	if IsSHA256Hex(phone) {
+		return phone
+	}
+	return HashSHA256(NormalizePhone(phone))
+}
+
+// HashExternalID trims and hashes an external ID, leaving digests unchanged
+func HashExternalID(id string) string {
+	return HashSHA256(strings.TrimSpace(id))
+}
@@ -0,0 +1,54 @@
+package utils
+
+import "testing"
+
+func TestHashSHA256(t *testing.T) {
+	hashed := HashSHA256(NormalizeEmail("  Jane.Doe@Example.com "))
+	if hashed != HashSHA256("jane.doe@example.com") {
+		t.Errorf("expected normalized emails to hash identically")
+	}
+	if !IsSHA256Hex(hashed) {
+		t.Errorf("expected hex digest, got %s", hashed)
+	}
+	if HashSHA256(hashed) != hashed {
+		t.Errorf("expected already-hashed value to be returned unchanged")
+	}
+	if HashSHA256("") != "" {
+		t.Errorf("expected empty value to stay empty")
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	if got := NormalizePhone(" +1 (555) 010-2000 "); got != "+15550102000" {
+		t.Errorf("NormalizePhone() = %s, want +15550102000", got)
+	}
+}
+
+func TestNormalizePhoneE164(t *testing.T) {
+	for _, tc := range []struct {
+		phone, countryCode, want string
+		ok                       bool
+	}{
+		{" +1 (555) 010-2000 ", "", "+15550102000", true},
+		{"555-010-2000", "1", "+15550102000", true},
+		{"07911 123456", "+44", "+447911123456", true},
+		{"555-010-2000", "", "", false},
+		{"+0123456789", "", "", false},
+		{"+1234", "", "", false},
+	} {
+		got, ok := NormalizePhoneE164(tc.phone, tc.countryCode)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("NormalizePhoneE164(%q, %q) = %q, %v, want %q, %v", tc.phone, tc.countryCode, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestHashPhone(t *testing.T) {
+	hashed := HashPhone("+1 (555) 010-2000")
+	if hashed != HashSHA256("+15550102000") {
+		t.Errorf("expected phone to be normalized before hashing")
+	}
+	if HashPhone(hashed) != hashed {
+		t.Errorf("expected already-hashed phone to be returned unchanged")
+	}
+}
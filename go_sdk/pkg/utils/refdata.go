@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// ReferenceSnapshotVersion is the format version written by this SDK
+const ReferenceSnapshotVersion = 1
+
+// ReferenceEntry is a single code and display name from reference data
+type ReferenceEntry struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ReferenceSnapshot bundles API reference data for offline validation
+type ReferenceSnapshot struct {
+	Version            int              `json:"version"`
+	CreatedAt          time.Time        `json:"created_at"`
+	Regions            []ReferenceEntry `json:"regions"`
+	Languages          []ReferenceEntry `json:"languages"`
+	InterestCategories []ReferenceEntry `json:"interest_categories"`
+	DeviceModels       []ReferenceEntry `json:"device_models"`
+
+	index map[string]map[string]bool
+}
+
+// LoadReferenceSnapshot reads a snapshot written by SaveReferenceSnapshot
+func LoadReferenceSnapshot(path string) (*ReferenceSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reference snapshot: %w", err)
+	}
+
+	var snapshot ReferenceSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse reference snapshot: %w", err)
+	}
+	if snapshot.Version != ReferenceSnapshotVersion {
+		return nil, fmt.Errorf("unsupported reference snapshot version %d", snapshot.Version)
+	}
+
+	return &snapshot, nil
+}
+
+// SaveReferenceSnapshot writes a snapshot as sorted, indented JSON so that
+// committed snapshots diff cleanly between refreshes
+func SaveReferenceSnapshot(path string, snapshot *ReferenceSnapshot) error {
+	if snapshot == nil {
+		return fmt.Errorf("snapshot cannot be nil")
+	}
+	if snapshot.Version == 0 {
+		snapshot.Version = ReferenceSnapshotVersion
+	}
+	for _, entries := range [][]ReferenceEntry{snapshot.Regions, snapshot.Languages, snapshot.InterestCategories, snapshot.DeviceModels} {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal reference snapshot: %w", err)
+	}
+
+	if err := WriteFileAtomic(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write reference snapshot: %w", err)
+	}
+	return nil
+}
+
+// CheckFreshness returns an error when the snapshot is older than maxAge
+func (s *ReferenceSnapshot) CheckFreshness(maxAge time.Duration) error {
+	age := time.Since(s.CreatedAt)
+	if age > maxAge {
+		return fmt.Errorf("reference snapshot is %s old, exceeding the %s limit", age.Round(time.Hour), maxAge)
+	}
+	return nil
+}
+
+// ValidateRegionCodes checks region codes against the snapshot
+func (s *ReferenceSnapshot) ValidateRegionCodes(codes []string) error {
+	return s.validate("location_ids", "regions", s.Regions, codes)
+}
+
+// ValidateLanguageCodes checks language codes against the snapshot
+func (s *ReferenceSnapshot) ValidateLanguageCodes(codes []string) error {
+	return s.validate("languages", "languages", s.Languages, codes)
+}
+
+// ValidateInterestCategoryIDs checks interest category IDs against the snapshot
+func (s *ReferenceSnapshot) ValidateInterestCategoryIDs(ids []string) error {
+	return s.validate("interest_category_ids", "interest_categories", s.InterestCategories, ids)
+}
+
+// ValidateDeviceModelIDs checks device model IDs against the snapshot
+func (s *ReferenceSnapshot) ValidateDeviceModelIDs(ids []string) error {
+	return s.validate("device_model_ids", "device_models", s.DeviceModels, ids)
+}
+
+// validate reports values missing from one reference list
+func (s *ReferenceSnapshot) validate(field, kind string, entries []ReferenceEntry, values []string) error {
+	if s.index == nil {
+		s.index = make(map[string]map[string]bool)
+	}
+	known, ok := s.index[kind]
+	if !ok {
+		known = make(map[string]bool, len(entries))
+		for _, entry := range entries {
+			known[entry.ID] = true
+		}
+		s.index[kind] = known
+	}
+
+	var unknown []string
+	for _, value := range values {
+		if !known[value] {
+			unknown = append(unknown, value)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return models.NewValidationError(field,
+			fmt.Sprintf("unknown values: %s", strings.Join(unknown, ", ")))
+	}
+
+	return nil
+}
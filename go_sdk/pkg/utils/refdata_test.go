@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestReferenceSnapshot_RoundTripAndValidate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "refdata.json")
+
+	snapshot := &ReferenceSnapshot{
+		CreatedAt: time.Now().UTC(),
+		Regions:   []ReferenceEntry{{ID: "US", Name: "United States"}, {ID: "GB", Name: "United Kingdom"}},
+		Languages: []ReferenceEntry{{ID: "en", Name: "English"}},
+	}
+	if err := SaveReferenceSnapshot(path, snapshot); err != nil {
+		t.Fatalf("SaveReferenceSnapshot() error = %v", err)
+	}
+
+	loaded, err := LoadReferenceSnapshot(path)
+	if err != nil {
+		t.Fatalf("LoadReferenceSnapshot() error = %v", err)
+	}
+	if loaded.Regions[0].ID != "GB" {
+		t.Errorf("expected regions sorted by ID, got %v", loaded.Regions)
+	}
+
+	if err := loaded.ValidateRegionCodes([]string{"US", "GB"}); err != nil {
+		t.Errorf("ValidateRegionCodes() unexpected error = %v", err)
+	}
+	if err := loaded.ValidateRegionCodes([]string{"US", "XX"}); err == nil {
+		t.Error("ValidateRegionCodes() expected error for unknown region")
+	}
+	if err := loaded.ValidateLanguageCodes([]string{"fr"}); err == nil {
+		t.Error("ValidateLanguageCodes() expected error for unknown language")
+	}
+}
+
+func TestReferenceSnapshot_CheckFreshness(t *testing.T) {
+	fresh := &ReferenceSnapshot{CreatedAt: time.Now().Add(-time.Hour)}
+	if err := fresh.CheckFreshness(24 * time.Hour); err != nil {
+		t.Errorf("CheckFreshness() unexpected error = %v", err)
+	}
+
+	stale := &ReferenceSnapshot{CreatedAt: time.Now().Add(-48 * time.Hour)}
+	if err := stale.CheckFreshness(24 * time.Hour); err == nil {
+		t.Error("CheckFreshness() expected error for stale snapshot")
+	}
+}
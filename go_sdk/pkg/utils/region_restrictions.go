@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tiktok/tiktok-business-api-sdk/go_sdk/pkg/models"
+)
+
+// Vertical is a product category whose ads are regulated by region
+type Vertical string
+
+const (
+	VerticalAlcohol            Vertical = "ALCOHOL"
+	VerticalGambling           Vertical = "GAMBLING"
+	VerticalCrypto             Vertical = "CRYPTO"
+	VerticalDating             Vertical = "DATING"
+	VerticalWeightLoss         Vertical = "WEIGHT_LOSS"
+	VerticalPrescriptionDrugs  Vertical = "PRESCRIPTION_DRUGS"
+	VerticalFinancialServices  Vertical = "FINANCIAL_SERVICES"
+	VerticalPolitical          Vertical = "POLITICAL"
+	VerticalTobaccoAlternative Vertical = "TOBACCO_ALTERNATIVES"
+)
+
+// RestrictionLevel is how far a vertical may be advertised in a region
+type RestrictionLevel string
+
+const (
+	// RestrictionAllowed needs no more than the global ad policies
+	RestrictionAllowed RestrictionLevel = "ALLOWED"
+
+	// RestrictionRestricted needs an authorization, licence or age targeting
+	RestrictionRestricted RestrictionLevel = "RESTRICTED"
+
+	// RestrictionProhibited cannot be advertised in the region
+	RestrictionProhibited RestrictionLevel = "PROHIBITED"
+)
+
+// verticalDefaults is the level of a vertical in regions not listed in
+// regionRestrictions. Both summarize the TikTok advertising policies, which
+// change often, so they serve as a pre-check and not a substitute for ad review.
+var verticalDefaults = map[Vertical]RestrictionLevel{
+	VerticalAlcohol:            RestrictionRestricted,
+	VerticalGambling:           RestrictionProhibited,
+	VerticalCrypto:             RestrictionProhibited,
+	VerticalDating:             RestrictionRestricted,
+	VerticalWeightLoss:         RestrictionRestricted,
+	VerticalPrescriptionDrugs:  RestrictionProhibited,
+	VerticalFinancialServices:  RestrictionRestricted,
+	VerticalPolitical:          RestrictionProhibited,
+	VerticalTobaccoAlternative: RestrictionProhibited,
+}
+
+// regionRestrictions lists regions, by ISO country code, that differ from
+// the vertical default
+var regionRestrictions = map[Vertical]map[string]RestrictionLevel{
+	VerticalAlcohol: {
+		"AE": RestrictionProhibited, "BH": RestrictionProhibited, "EG": RestrictionProhibited,
+		"ID": RestrictionProhibited, "IN": RestrictionProhibited, "IQ": RestrictionProhibited,
+		"JO": RestrictionProhibited, "KW": RestrictionProhibited, "LB": RestrictionProhibited,
+		"MA": RestrictionProhibited, "MY": RestrictionProhibited, "NO": RestrictionProhibited,
+		"OM": RestrictionProhibited, "PK": RestrictionProhibited, "QA": RestrictionProhibited,
+		"RU": RestrictionProhibited, "SA": RestrictionProhibited, "TH": RestrictionProhibited,
+		"TR": RestrictionProhibited, "VN": RestrictionProhibited,
+	},
+	VerticalGambling: {
+		"BR": RestrictionRestricted, "CO": RestrictionRestricted, "GB": RestrictionRestricted,
+		"IE": RestrictionRestricted, "MX": RestrictionRestricted, "PE": RestrictionRestricted,
+	},
+	VerticalCrypto: {
+		"GB": RestrictionRestricted, "JP": RestrictionRestricted, "US": RestrictionRestricted,
+	},
+	VerticalDating: {
+		"AE": RestrictionProhibited, "CN": RestrictionProhibited, "ID": RestrictionProhibited,
+		"KW": RestrictionProhibited, "MY": RestrictionProhibited, "QA": RestrictionProhibited,
+		"SA": RestrictionProhibited,
+	},
+	VerticalWeightLoss: {
+		"FR": RestrictionProhibited,
+	},
+	VerticalFinancialServices: {
+		"IN": RestrictionProhibited, "KR": RestrictionProhibited,
+	},
+}
+
+// RegionRestriction returns how far a vertical may be advertised in a region
+func RegionRestriction(vertical Vertical, countryCode string) RestrictionLevel {
+	if level, ok := regionRestrictions[vertical][strings.ToUpper(countryCode)]; ok {
+		return level
+	}
+	if level, ok := verticalDefaults[vertical]; ok {
+		return level
+	}
+	return RestrictionAllowed
+}
+
+// RestrictedRegions splits country codes into those where a vertical is
+// prohibited and those where it needs an authorization
+func RestrictedRegions(vertical Vertical, countryCodes []string) (prohibited, restricted []string) {
+	for _, code := range countryCodes {
+		switch RegionRestriction(vertical, code) {
+		case RestrictionProhibited:
+			prohibited = append(prohibited, code)
+		case RestrictionRestricted:
+			restricted = append(restricted, code)
+		}
+	}
+	sort.Strings(prohibited)
+	sort.Strings(restricted)
+	return prohibited, restricted
+}
+
+// CheckRegionRestrictions returns an error when a vertical is prohibited in
+// any of the targeted regions
+func CheckRegionRestrictions(vertical Vertical, countryCodes []string) error {
+	prohibited, _ := RestrictedRegions(vertical, countryCodes)
+	if len(prohibited) > 0 {
+		return models.NewValidationError("location_ids",
+			fmt.Sprintf("%s ads are prohibited in: %s", strings.ToLower(string(vertical)), strings.Join(prohibited, ", ")))
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package utils
+
+import "testing"
+
+func TestRegionRestrictions(t *testing.T) {
+	tests := []struct {
+		vertical Vertical
+		country  string
+		want     RestrictionLevel
+	}{
+		{VerticalAlcohol, "US", RestrictionRestricted},
+		{VerticalAlcohol, "sa", RestrictionProhibited},
+		{VerticalGambling, "GB", RestrictionRestricted},
+		{VerticalGambling, "US", RestrictionProhibited},
+		{VerticalCrypto, "DE", RestrictionProhibited},
+		{Vertical("TOYS"), "US", RestrictionAllowed},
+	}
+	for _, tt := range tests {
+		if got := RegionRestriction(tt.vertical, tt.country); got != tt.want {
+			t.Errorf("RegionRestriction(%s, %s) = %s, want %s", tt.vertical, tt.country, got, tt.want)
+		}
+	}
+
+	prohibited, restricted := RestrictedRegions(VerticalAlcohol, []string{"US", "SA", "GB", "AE"})
+	if len(prohibited) != 2 || prohibited[0] != "AE" || len(restricted) != 2 {
+		t.Errorf("RestrictedRegions() = %v, %v", prohibited, restricted)
+	}
+
+	if err := CheckRegionRestrictions(VerticalGambling, []string{"GB", "IE"}); err != nil {
+		t.Errorf("CheckRegionRestrictions() unexpected error = %v", err)
+	}
+	if err := CheckRegionRestrictions(VerticalGambling, []string{"GB", "US"}); err == nil {
+		t.Error("CheckRegionRestrictions() expected error for prohibited region")
+	}
+}
@@ -239,3 +239,34 @@ func ValidateStringLength(value, fieldName string, minLen, maxLen int) error {
 
 	return nil
 }
+
+// deviceIDPattern matches the UUID format shared by IDFA, IDFV and GAID
+var deviceIDPattern = regexp.MustCompile(`^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`)
+
+// zeroDeviceID is reported by devices with limited ad tracking
+const zeroDeviceID = "00000000-0000-0000-0000-000000000000"
+
+// ValidateIDFA validates an iOS advertising identifier. The all-zero IDFA
+// returned under limited ad tracking is rejected.
+func ValidateIDFA(idfa string) error {
+	return validateDeviceID("idfa", idfa)
+}
+
+// ValidateGAID validates an Android advertising identifier. The all-zero
+// GAID returned under limited ad tracking is rejected.
+func ValidateGAID(gaid string) error {
+	return validateDeviceID("gaid", gaid)
+}
+
+func validateDeviceID(field, id string) error {
+	if id == "" {
+		return models.NewValidationError(field, fmt.Sprintf("%s cannot be empty", field))
+	}
+	if !deviceIDPattern.MatchString(id) {
+		return models.NewValidationError(field, fmt.Sprintf("%s must be a UUID in 8-4-4-4-12 hex format", field))
+	}
+	if id == zeroDeviceID {
+		return models.NewValidationError(field, fmt.Sprintf("%s is all zeros, which indicates limited ad tracking", field))
+	}
+	return nil
+}
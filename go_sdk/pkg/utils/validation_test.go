@@ -567,3 +567,84 @@ func TestValidateStringLength(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIDFA(t *testing.T) {
+	tests := []struct {
+		name        string
+		idfa        string
+		expectError bool
+	}{
+		{
+			name:        "valid IDFA",
+			idfa:        "6D92078A-8246-4BA4-AE5B-76104861E7DC",
+			expectError: false,
+		},
+		{
+			name:        "lowercase IDFA",
+			idfa:        "6d92078a-8246-4ba4-ae5b-76104861e7dc",
+			expectError: false,
+		},
+		{
+			name:        "empty IDFA",
+			idfa:        "",
+			expectError: true,
+		},
+		{
+			name:        "missing hyphens",
+			idfa:        "6D92078A82464BA4AE5B76104861E7DC",
+			expectError: true,
+		},
+		{
+			name:        "non-hex characters",
+			idfa:        "6D92078A-8246-4BA4-AE5B-76104861E7DZ",
+			expectError: true,
+		},
+		{
+			name:        "limited ad tracking",
+			idfa:        "00000000-0000-0000-0000-000000000000",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIDFA(tt.idfa)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateIDFA() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateGAID(t *testing.T) {
+	tests := []struct {
+		name        string
+		gaid        string
+		expectError bool
+	}{
+		{
+			name:        "valid GAID",
+			gaid:        "38400000-8cf0-11bd-b23e-10b96e40000d",
+			expectError: false,
+		},
+		{
+			name:        "too short",
+			gaid:        "38400000-8cf0-11bd-b23e-10b96e40000",
+			expectError: true,
+		},
+		{
+			name:        "limited ad tracking",
+			gaid:        "00000000-0000-0000-0000-000000000000",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGAID(tt.gaid)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateGAID() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}